@@ -28,7 +28,7 @@ import (
 var (
 	username = flag.String("username", "test", "Username for connecting to the upload service")
 	password = flag.String("password", "", "Password for connecting to the upload service")
-	server   = flag.String("server", config.Server, "Server to upload to")
+	server   = flag.String("server", config.ProductionServer, "Server to upload to")
 	from     = flag.Int("from", 0, "Skip all mutaties < from")
 	test     = flag.Bool("test", true, "Use test mode")
 	batch    = flag.Int("batch", 100, "Batch size")
@@ -138,6 +138,7 @@ func run() error {
 
 		cfg := config.NewConfiguration()
 		cfg.SetCredentials(*username, *password)
+		cfg.SetEnvironments([]config.Environment{{Name: "import", URL: *server}}, "import")
 
 		u := uploader.Uploader{
 			Configuration: cfg,
@@ -149,7 +150,7 @@ func run() error {
 		}
 
 		for {
-			if err := u.UploadJSON(context.Background(), buf, config.PathVisitorUpload, true); err != nil {
+			if _, err := u.UploadJSON(context.Background(), buf, config.PathVisitorUpload, true); err != nil {
 				log.Println("Error", err)
 				<-time.After(time.Second)
 				continue
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/diff"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/web"
+)
+
+// envPassphrase is the environment variable the passphrase of a configuration bundle can be passed in, so that it does
+// not show up in the list of processes.
+const envPassphrase = "D2D_CONFIG_PASSPHRASE"
+
+// runConfig runs the config subcommand with the given arguments, on the configuration file at path, or the one in the
+// configuration folder if path is empty.
+func runConfig(path string, args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: d2d-upload config export|import [options]")
+	}
+
+	cfg := config.NewConfiguration()
+	if path != "" {
+		cfg.SetPath(path)
+	}
+	if err := cfg.Reload(); err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "export":
+		return exportConfig(cfg, args[1:])
+	case "import":
+		return importConfig(cfg, args[1:])
+	}
+	return fmt.Errorf("unknown config command %q, expected export or import", args[0])
+}
+
+func exportConfig(cfg *config.Configuration, args []string) error {
+	fs := flag.NewFlagSet("config export", flag.ExitOnError)
+	passphrase := fs.String("passphrase", os.Getenv(envPassphrase), "Encrypt secrets with this passphrase instead of removing them (default $"+envPassphrase+")")
+	output := fs.String("o", "", "Write the bundle to this file instead of standard output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	bs, err := cfg.Export(*passphrase)
+	if err != nil {
+		return err
+	}
+	if *output == "" {
+		_, err := os.Stdout.Write(bs)
+		return err
+	}
+	return ioutil.WriteFile(*output, bs, 0600)
+}
+
+func importConfig(cfg *config.Configuration, args []string) error {
+	fs := flag.NewFlagSet("config import", flag.ExitOnError)
+	passphrase := fs.String("passphrase", os.Getenv(envPassphrase), "Decrypt secrets with this passphrase (default $"+envPassphrase+")")
+	dryRun := fs.Bool("dry-run", false, "Only show and validate the changes, without applying them")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf("usage: d2d-upload config import [options] <bundle.json>")
+	}
+
+	bs, err := ioutil.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	fields, missing, validation, err := cfg.PreviewImport(ctx, bs, *passphrase)
+	if err != nil {
+		return err
+	}
+	changes := audit.Changes(cfg.Fields(), fields, config.IsSecretField)
+	printChanges(os.Stdout, changes)
+	for _, field := range missing {
+		fmt.Printf("Missing: %s is not in the bundle, and the current one is for another database.\n", field)
+	}
+	if !validation.IsValid() {
+		return fmt.Errorf("%v", web.Humanize(&config.InvalidConfigurationError{Validation: validation}))
+	}
+	if *dryRun || len(changes) == 0 {
+		return nil
+	}
+
+	if err := cfg.Import(ctx, bs, *passphrase); err != nil {
+		return err
+	}
+	fmt.Println("Configuration imported. A running service picks it up within a few seconds.")
+	return nil
+}
+
+// printChanges prints changes to the configuration as a diff.
+func printChanges(w io.Writer, changes []audit.Entry) {
+	if len(changes) == 0 {
+		fmt.Fprintln(w, "No changes.")
+		return
+	}
+	for _, e := range changes {
+		fmt.Fprintf(w, "%s:\n", e.Field)
+		for _, l := range diff.Lines(e.Before, e.After) {
+			fmt.Fprintf(w, "\t%s %s\n", l.Op, l.Text)
+		}
+	}
+}
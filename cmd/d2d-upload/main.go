@@ -6,35 +6,63 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 
 	"github.com/denisenkom/go-mssqldb"
 	"github.com/door2doc/d2d-uploader/pkg/uploader"
+	cfg "github.com/door2doc/d2d-uploader/pkg/uploader/config"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/metrics"
+	"github.com/go-sql-driver/mysql"
 	"github.com/kardianos/service"
 	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
 )
 
 var _ mssql.Driver
 var _ pq.Driver
+var _ sqlite3.SQLiteDriver
+var _ mysql.MySQLDriver
 
 var (
 	DevelopmentMode = flag.Bool("dev", false, "Run in development mode - this will cause live reloading of HTML templates.")
+	ConfigPath      = flag.String("config", "", "Path of the configuration file, instead of the one in the configuration folder.")
 )
 
 func main() {
 	flag.Parse()
 
+	if *ConfigPath != "" {
+		// the service runs in another working directory
+		path, err := filepath.Abs(*ConfigPath)
+		if err != nil {
+			log.Fatalf("Invalid configuration path: %v", err)
+		}
+		*ConfigPath = path
+	}
+
 	config := &service.Config{
 		Name:        "Door2docUploader",
 		DisplayName: "Door2doc Upload Service",
 		Description: "This service takes care of regular uploads to door2doc",
 	}
-	svc := uploader.NewService(*DevelopmentMode, Version)
+	if *ConfigPath != "" {
+		config.Arguments = []string{"-config", *ConfigPath}
+	}
+	build := metrics.BuildInfo{Version: Version, GitCommit: GitCommit, Built: Built}
+	svc := uploader.NewService(*DevelopmentMode, build, *ConfigPath)
 	s, err := service.New(svc, config)
 	if err != nil {
 		log.Fatalf("Failed to construct service: %v", err)
 	}
 
+	if flag.NArg() > 0 && flag.Arg(0) == "config" {
+		if err := runConfig(*ConfigPath, flag.Args()[1:]); err != nil {
+			log.Fatalf("Failed to run config command: %v", err)
+		}
+		return
+	}
+
 	if flag.NArg() == 1 {
 		switch action := flag.Arg(0); action {
 		case "install", "uninstall", "start", "stop", "restart":
@@ -60,6 +88,17 @@ func main() {
 			fmt.Println("\tstart       Start the service")
 			fmt.Println("\tstop        Stop the service")
 			fmt.Println("\trestart     Restart the service")
+			fmt.Println("\tconfig export [-passphrase <passphrase>] [-o <file>]")
+			fmt.Println("\t            Export the configuration, with secrets removed or encrypted")
+			fmt.Println("\tconfig import [-passphrase <passphrase>] [-dry-run] <file>")
+			fmt.Println("\t            Show, validate and apply the changes in an exported configuration")
+			fmt.Println()
+			fmt.Println("Use -config <file> before the command to use another configuration file. Settings can be")
+			fmt.Println("overridden with environment variables:")
+			fmt.Println()
+			for _, env := range cfg.EnvironmentVariables() {
+				fmt.Printf("\t%s\n", env)
+			}
 			return
 		}
 
@@ -4,11 +4,15 @@ require (
 	4d63.com/tz v1.1.0
 	cloud.google.com/go v0.36.0 // indirect
 	github.com/denisenkom/go-mssqldb v0.0.0-20190204142019-df6d76eb9289
+	github.com/go-sql-driver/mysql v1.5.0
 	github.com/kardianos/service v1.0.0
 	github.com/lib/pq v1.0.0
+	github.com/mattn/go-sqlite3 v1.14.6
 	github.com/mjibson/esc v0.2.0 // indirect
 	github.com/pkg/errors v0.8.1
 	github.com/shibukawa/configdir v0.0.0-20170330084843-e180dbdc8da0
+	golang.org/x/crypto v0.0.0-20190308221718-c2843e01d9a2
+	golang.org/x/net v0.0.0-20190620200207-3b0461eec859
 	golang.org/x/tools v0.0.0-20191114222411-4191b8cbba09 // indirect
 )
 
@@ -212,44 +212,70 @@ var _escData = map[string]*_escFile{
 	"/_layout.html": {
 		name:    "_layout.html",
 		local:   "pkg/uploader/assets/resources/_layout.html",
-		size:    5132,
-		modtime: 1619966579,
+		size:    6807,
+		modtime: 1792373094,
 		compressed: `
-H4sIAAAAAAAC/9RYS3PbNhC+61escQ7I+nFoO6RmUqfTSzvjxk16BoEViRoEaGApRePRf++AD5l6OGPl
-kMg+iAS4H/DtftiFgexCOUnrBqGi2sxnWXyAEbbMGVoWO1Co+QwAILvgHD7iY6s9KqiRBJAoA3A+fO+6
-ZCV8QMpZSwv+M5t+sqLGnC01rhrniYF0ltBSzlZaUZUrXGqJvGu8A201aWF4kMJgfvkOQuW1feDk+EJT
-bh2bz55p/eYcBfKigdv7+2dGRtsH8GhyFmhtMFSIxKDyuMhZKkJACmkxQpNa20SGwNIT0LIN5OoR1uNI
-k8H5B+f8lXISPjXGCYUeODw9AWHdGEEIrDNjsNlkaY+YZWkf7axwaj2fZUovQRoRQs4W2pgxmFZsu61Y
-FsJD/+BGlxVBUfYvg3kHEbsAXnhh1daXiWVnresSOko5G71gIAzljEHw8tl740qXNLZkUGGcMmfXP02n
-TcWk0Zo9EtGP2nPRkttnYPTElmvCGoQkvcQ9wwPneBRt4titswtdtl6QdnaHT0/Q6Cnd1kxak+gPhAm/
-0B6Bz+iDdjYqm4zvm81kSKWXg2ypFcthiTw9gV5A8odxhTC/e+/8CJrOKgx6gu6XK2FL9NPYVjc7djwu
-HW1LNv9kPUq3RC8Kg4Bx9CytbibQZteH9xbaQww4KVvvUSVwZ1AE7PJVSAI1ruzQNjGTE/inGkEeYw8q
-0OHXSRSal2fPpFM4j/HbDUeWdh9eN8hf4gEhtB6BHEhnDMoYORPTF5fCEgT0sbyAcWWAVYV2dEfbcutR
-cjjboOAoG5qAUasDsbrRtEXPF6bVChYGv/DSuxW/BMVjq++SzrS1ZcfXmXerHSA0a36znxuT9JfO8FDz
-ayicV+i538v7I7lndKA4fNvA82vkHKojwCG/xnQ6+h3gcOw+ZffaPEbb2TEc/7WB9GLNh22AF0grRAvC
-6NJ2gMAlWkI/5As+QnInqAKWxrI5VIROFatgs3mBf/y7J0FtOO7eflE49FsJEoUIeD7+bxmdGIcPAy4u
-fosdnRdthyJ1511hsA7JFjupb0fjFhphx5AUQpUI3e9Qw4ZG021oF1karedfI9E79a3aPbbo1z9MuEPl
-ej4nyvZZB03OQwd+tWB/R+u3pVZXxkLqhdLOuPKchDugdqKGH0fg61T8V3irbRmSZ9y3Kqntwu3oqL+X
-jkYU56dgJHWidn+K4kTVIuLt6SWdDa2h89NsJHaibrc97ETtRtQb0q/tzphnpNtA6ES9+qPyqze43vxt
-7XBCSgzhjJQaCJ36DzXK1mt6fVK976b5mlY/Np92D/+TA/tOVy203Tt8/XLsvFVdjVbVNTTEL6Ep+BWb
-v3QBVF0dDrJjWzi1ZpDsu5SlkdF8/75hdhiI6WF2+xjumdLu8u//AAAA///7uoYJDBQAAA==
+H4sIAAAAAAAC/9RZTXPbOBK9+1d0cNqtCsSNncPuFqmqjJOZOWSqMvYkcwaBFokxCDBAU45Kpf8+BX5I
+FCU7lg8ZWQeRALuB1+8RDbKZvlJO0qpGKKky84s0HsAIW2QMLYsdKNT8AgAgfcU53ODXRntUUCEJIFEE
+4Ly/3nbJUviAlLGGFvy/bHzJigozttR4XztPDKSzhJYydq8VlZnCpZbI28Zr0FaTFoYHKQxmb15DKL22
+d5wcX2jKrGPzix2sn5yjQF7UcH17u0NktL0DjyZjgVYGQ4lIDEqPi4wlIgSkkOSD66zSdiZDYMkJ3rIJ
+5KrBrfMjTQbn753zl8pJ+FwbJxR64LBeA2FVG0EIrDVjsNmkSedxkSYd22nu1Gp+kSq9BGlECBlbaGMG
+Mq3YdluxzIWH7sCNLkqCvOhOevPWRew78NwLq7axjCxba10V0ELK2BAFA2EoYwyCl7vojSvcrLYFgxLj
+lBm7+s942kSMGo2ZgIhxVJ6LhtwUgdEjW64JKxCS9BInhgfB8SjaKLBrZxe6aLwg7eweng6g0WO4jRm1
+Ruz3gAm/0QTAF/RBOxuVnQ3nm81oSKWXO4/1GvQCZp8D+j2rhfNVXFGlUxmrXSDWhutsxlqOG2IDlGjK
+tTXaIlSGXx1oZ+uGIC7pjJVaKbSsX3gy+AWDpTANZizivb69+Rk2m+kIeUPkbD9EaPJK72bPyUJOloeq
+PbiGIhAeUDqrhF+x+UdXgGuoJaSPE/4VGzfOIGw2/06TboIx7zGoPZbQqoGgNLFi2S+tnr9fjMuF+eC9
+29I4VksY9ATtP1fCFujH92T5ds+OxyWnbcHmn61H6ZboRW4QMI6eJuXbkWu9z9Q7C82hDzgpG+9RzeCT
+QRGwzXNCEqghI4SmjhlwBn+Ug5PH2IMKdPj/iJj64dlT6RTO1+spHWnSXnjaIL+JO4TQeARyIJ0xKCNz
+BjwaXApLENDHtAzGFQHuS7RDONoW24hmh7P1d/4gG5oQ1b84EKsdTVv0fGEarWBh8BsvvLvnb0Dx2Oq6
+pDNNZdnx9end/Z4j1Cv+dnpfj9KmdIaHil9B7rxCz/0kXx7JWUYHisM3NexOI+ZQHnHs89KQho5eBzgc
+u0t1kzbvcsFAx19NIL1Y8X775DnSPaIFYXRhW4fAJVpC368X/AqzT4JKYEncbvpMultmD+CPv1sS1ITj
+4U2T6WHcSpDIRcDziX+L6EQe3vd+8ea32MJ50LZPUp+8yw1WYbb1HWX8Y7801MJuE61QBUL73+ewvlG3
+DwKv0iRazx8DMcqhz9Dua4N+9Y8Jd6hch+dE2b7ooMl5aJ2fLNjv0fplqdWmsZB4obQzrjgn4Q6gnajh
+zeD4NBX/FN5qW4TZzu+5Smq7cHs66h+loxH5+SkYQZ2o3UeRn6ha9Hh5eklnQ2Po/DQbgJ2o23XndqJ2
+g9cL0q9p383PSLce0Il6dSWGJ29wnfnL2uGW3ct1OCOttpBOfSrp/Z5LRakDubN6OBsQnUjEr53bc3kQ
+jdLnlHM7PCdy8C46xbfr01jol/S1sEOF69H3ix1p5IUNC/TssbX/47nbwjqRvvj78C3WTkBYBbqKpw/T
+8BCdkxtLSgzhzBjqQT2Dn1uUjde0etRoupm/a6f7gXv593eJ7y2IB1z3y7qwKynudVVC20l56H/HKkLl
+5WBVXkFN/A3UOb9k84dK++Xl4SB7trHWz2A2RZ4mEdF8Wkk+UiQdl9u2h/4LQtJ91lmvQeFCWwRmnLzD
+9gGjV3yYOA2VMGavzBzr3RD/WjnZ/BYJ8hWgXWrvbIWWYCm8bmufu3Lkrgb5ul2TUljrCHKM34ZsgQpK
+9DhLk3bC+cU2kl1Ifw8ACK6RCZcaAAA=
 `,
 	},
 
 	"/access.html": {
 		name:    "access.html",
 		local:   "pkg/uploader/assets/resources/access.html",
-		size:    1197,
-		modtime: 1619793986,
+		size:    7320,
+		modtime: 1792371636,
 		compressed: `
-H4sIAAAAAAAC/6RTsa7bMAzc31cctCfeiyRbtw4d+lB0lC06FipThkQ5dV3/eyHHSRzjvaJFtUQhebzj
-UR5HGKotE5RYcaQwTV+phGWhUOuKEKlKwcowjiA2mKaXFab0ZsiQFwA4dKf5N58vDcWMFbF8jtCB4NkN
-COS0kIH4pTGfoauKYswhaWzEZU2/xzefUGkG5RA0D0iRAuuWik7HePHB3Fkr35aWtVjPGHzCRbPsr9qK
-Rdxa5CfSfRbgmeADSi8NqO1kwMU6B0e6J0hDz5KQeJZOZtX6eqt9aNGSNN4cVeejKOgqqzmq4jqlerAf
-jO1ROR3jUWXg7hx86lYFc5HTJTnUPhzVbW51el1uHw7FnN9gLHdJIENHRyX0QxSsWcGfSCvPErzDOMLW
-2H8MwQdMk407y7121uS9u0jX2D0yvwSF3G/dudcu0VGNI/Y3jbluNXRhbP8fHtxWrk6fl9tfeHAHzT48
-/v2jD9mGP7jw6Lty4aby2YXt6AvFriYypa6+b0rz2eh6K78kf6FJrWb7k96pWz7jJzGbtby/pfyedsGe
-G9nOUyYRz4vlMZWtlbvFpTBK4V0XbKvDoE6vndFCh+IKepP5UOTFnF4ein8HAAD//3PzUjatBAAA
+H4sIAAAAAAAC/+RZ3Y/jthF/v79iQBRBCpylvcslDxfZwN62QVIE7uE+GrRvtDi22JVIHTmy46j63wuS
++rItb7zb28Oi2Ye1Kc03hzPzM+saBK6lQmAkKUcGTfMLrkAqQrPmKYLFtDKS9nUNqAQ0zbMRz0qLvWN5
+BgCQlAv/6f4+ZGgdL5FUGwvcIGiV78FgzgkFkG4Fqw3wNEVr3SPKpIXdWH0E/9QVpFwBFwK42kNl0VjY
+6wp2XFHUa/yHxJ1742gtIlCGYIlTZYErAalWa7mpDCep1XPQJRpOuqXnudVARm42aKAqc81F4Cp5ZVtR
+aLYyxefuca+Ti0IqaelYVJpxtQl8B3q9zIIrvsHgRzA/icvFSQR/kZTpigKdF3UQGJAWKuVjiCKCn5Fv
+g8KSW7vTRgAWJe2BNNwilsGWyhhUNJDoNXCvYAjjjTPd7cqBrJ4Qcr2x4OyijNOI1/vgv9U1yDVEfzVG
+my41PIWQW0hzbu2c8RwNgf8/23GjpNqwwfVWSiviP5BVBVfyNzyQFgu5XXQK28wMlhBf5dhp8ouR7IQy
+5GK8NoeKE8oWHy0axQtMYspO377T+Zk3b9t4Tb89fJrEY81JfGKXO1vDuq7B+KSKPvqEGIVi0o3wUCzq
+OnA4d6BpkpjEeULn2e8SdU7+KBXdRdzvAP5KMyM3GbFTOk+71qaAAinTYs5KbYkBT915mbM4FIcznJ5b
+qtKl477EOcukEKgYOG/nLLVmzWDL8wrnrK7hT9HN+3c/QNM8TFywqRcoMEfCh4mq2h0ZWzfeprukrioi
+rVqxtloVklgX6xUpWJGa2cJ/6IpyqXAmXOYYtviLNzmJg4gzuxG77Th9d7rPhxnsTmFu8dLEPDiffQ2A
+VOe25GrOXrHFUofid4nmcP5H74fTk8ReR1udkuybTnX2LZQ0e8kW10KAdoVfcAqFOYmzb1rueyXnPZJx
+Ihcvzz1nI1tMllZn78zo3VEKnZBsjK5KF+9ZIWavJhIuyfkKc1hrM8rXvjS+TmL/foJv7IU7/AykGIk4
+sCLViozO26bh2mP0gzZFqPxfKwzLa+89MNK3qNifoWmknUm15bkU/e6fPVsMDH6qpMGTLOpbyOeJUtcu
+Wd8ILoxSz+gjNaweOVKDoj5SvCKd6qJ0dWLOFO5mg1OPGzyjXZ92HeiOoFnMMSUfJU//2BEKRk3WyaEh
+O5tPGvL4L9Gl1zk6/F5HMBQ/QQTMj5JulA4u4mDLoqVP4iDmrDlHRXDYqSDyzv07Wt43hGfzYDzodV3o
+2YTtIzXT8960kcd1f6T57NhxUQMtjSy42bPFezdXh5Zw3DVH5nRNc7rFvGKL67c/gQ+XHbWW0bT/PjWy
+pAAhOsjxt/d/X4JjrJRAA0mqBS5iXsp4+yKJ/Qp2kjLgCnr5IJVndtMkmsFWT35dUaaN/M2DkdfwBrlB
+A1/l9L3n/WpD37eCI4fgvFRpQaBNjVyhAKkGiRwyg+s5ay2KdYmKlzL6t9WKLcarJOaLCK47A22AgjbT
+OwVaOVDl9wn4mtCAJEeSGnRA8Qy4WOLugxd2Ab6w1dQIucTdELTXbXzq+kB0G4tkdTTC3OhyD5JA6d1z
+95lypTTBCluf+IZLFX0ZqLJ8AEy5CbF9VJTiY3g/mLL8XBBFrsHtR9T6Gf1k/4VGQ9PU9fDwZ53y3Jcd
+TsBeXl19N7t6Mbt6CS++fX31igXqsFl/WIhjcKtvHwhxTuDN8lGhzTtv6hOBNocgZlz7PyeSeZLIJMwE
+nxGafPc7c6NXOAvgZPkQYDIScI9xEj+F5b3GyceBJZdF6L7z9YhrKixffkLe+h94n/iIfD4t/n9H5NBR
+Q4n736bkdgp+6r++dNcad5W5cEL91zTD9PY4wmNlnmClfw31aMWtTGe8oqzL/+hmfIngJpdbFG/2wEIE
+3jiGa0/fNEJa1yOGs3F4eJ2mmdfd+TZW19VwU2Gvu5cOTePZx+fu2bmaM5Z6aoAnZIvrPNc7+PHDh7fg
+6cHRoyKZekedJLBdSkyUrLoGwqLMOSGw3EeFwdcXR+v0VNqC5/mBve6IgPs3KypCMXEyx8jNWQ/WxSbc
+lrgIt/dI7UVKi84m4FiH6AJwA6ksIXc3Lycac73xNzRSRfDG6J2/D+D5ju9tjx1zvZEKSr7B6Kg+eB/P
+VrVHKA8f/c+qFxWGoVz9dwD/HP4VmBwAAA==
 `,
 	},
 
@@ -257,395 +283,394 @@ G9nOUyYRz4vlMZWtlbvFpTBK4V0XbKvDoE6vndFCh+IKepP5UOTFnF4ein8HAAD//3PzUjatBAAA
 		name:    "bootstrap.min.css",
 		local:   "pkg/uploader/assets/resources/assets/bootstrap.min.css",
 		size:    155758,
-		modtime: 1553451733,
+		modtime: 1622811395,
 		compressed: `
-H4sIAAAAAAAC/+y9bY/jOJIg/P35FdosFLpy2nJJsmU7nejGzg52cQtMz4fpW+CAvjpAtmhbU3o7Sa5U
-tuH97Q/4JvElSElOZ3fv3W3vVMpUMCLICAYjKDL4+U//9P85f3L+pSiauqmi0vm2nC/mvvPp1DRlvf38
-+YiaHX853xfZ50cM/5eifK2S46lxAs/33cDzn5z/fkICnj+fm1NR1Ubgl6RpUDVz/j3fzzHQX5M9ymsU
-O+c8RpXz07//d4GHpDmdd4R687KrP3cMfd6lxe5zFtUNqj7/9d//8q9/+/lfMX+ft1VRNBfX3aVntP3g
-eevd4fDsukkeJ8di+2G18r1D8Oy65bkqU7T9sDosg72PC5L86/YD2izQZv/suhWKtx/i/SJchs+uW1RR
-fkTbD4d4jfzls+u+ojQtXrYfDoe9762fXfdYIZRvPwSbaE1qNChKtx8Cb//0hF/vX6N8+8FfR8Fu8+y6
-L6ekwegIb8cqet1+WO3X4TpmP904qr5uPyyWi2jpYeaqJIuqV6FBNdoXeUzKupr1eb9HdS1wkeSHQiQb
-VXmSHwW2Y9yuSmhpisW1/XDYHJ4OEQGQGNlVKPpaFkneuG29VUrqbBuuV2Url2bxdr3aqKXpcfv0FKil
-bbr1A88jxYcib9xDlCXpq1tHee3WqEoOWzcqyxS59WvdoGz2L2mSf/0p2v9Mfv5bkTezh5/RsUDOf/z7
-w+zvxa5oitnDf0PpN9Qk+8j5Gzqjh9mfqyRKZw9/K5rC+TnK64dZT2D28GdMwPlLkRaV869Z8Y/kocep
-F/z8mu2K9IFhE2spbciKvKjLaI+2P//bT0VeuH9Hx3MaVbOfUJ4Ws5+KPNoXs78UeV2kUT17+GuyQ1XU
-JEXuYPCH2cNfinOVoMr5G3p5mHXorn+abbfRAY+p7XaHDkWFLruidevkVyzrXVHFqHJ3RXs9NVl6EVja
-9q1+TpMcuSdEpO/P/fDZfUG7r0njNqhtMC7kRvE/znWz9T3vY/82Kt1TcjwRtXH3uPXbporyuowqlDfX
-qGqSfYpmUZ3EaHZIjvuoxE3Cj+cKzQ5FgRk/oSjGf45VcS5nWZTkszz6NqvRHgNf4qQu0+h1u0uL/dfr
-rohfL1lUHZN86z2L7fkvpBuEbdytW79CGf35Qrt/6XmKOMJn2rMfAj8Ig6dnIpMoTY75NkWH5nkX7b/i
-rstjJgJsWa6/NNEuyWPU/vDg+g9ftodif64vxbnByLfePyVZWVRNlDfXUyVqzL7IG5Q3WGWeGQvec/EN
-VQds8r4ldbJL0fXkz07B7LSYnZazUzg7rZhI3KYot94z+7ErmqbItvOwQtm1tIHgbrhGu131Sxw1kVtU
-yTHJo9RtkiZFX2bkDX2+kObHaF/Q4bElEwdulKy0EIQTF02D4udBgP25qotqe0Jp+dwNIcKoZ6Li1l+T
-0sXTSF7k6Nn69hrFcYXq+qJ3AlON5jVF27yosiiVtCHJT6hKmmuczop0dk4H+7RInQLDOmcM7pBKTl+P
-t+oaNxdRC9eed43jCyBHTgSr3ta7kkH5v89Fg7pB6XgOIb2b1U1V5EcJ8a5IY1Rd6yxKmTUiw2DjfbzW
-592sPpeXsqgTIpYKpVGTfEPCcFmHH6X+8J6/IWxkopSNiF1UIwyAsV0Y3+48CFF2xbhxP7lz/Cu6sMHC
-JlVVJ4gUtaElWbftCY+LHk+42i3MunWNtnnRfPrlVKHDl0f6zMfol0eGhckX5MaOgA7wmR1GZPitpHpb
-ct0XMZp93cWzskKzOspKaZ6574Qn2k5sVSo0NAZ66xWdm+JK5x5NW5PseFF0KUviOEXcAPAxiXXr2/HS
-IT0lcYzyZ7DutYl2KZ6QCYZ9kaZRWaMtf7iy+fBSRnGc5EfShPmaDDNexMceLWW6xpw+dSZg6Fw83W5p
-xWtzughg3H6k0Q6l3cya5GRMkbEM2u7duWmKnLejiuLkXOPBT4oVdfDLlptRXhSWrYP73uG2k9RwK9w+
-0iKGaZbk5bmZFWVDXYEapWjfzDD/UYUieNrnetzrBS+BDKdI6KJNa+wtpUv7jYz4Q1FlTPT01UtRxe5L
-FZXMSF9/aV5L9AOt/2VGf1WoRg3/UZ93WdJ8mbGu5D0RlSWKqijfoy19I2MiQ28bJzXWo/hRQgy/Y3TU
-l0xUcumFzXPE/UaVQnrrZsWvTFRJnqNKpm56zRnQ3zMetBdc/bceMNaIpCjm/Qntv+6K9stMKMTKWHyB
-fd7nDrGIJo4aJKHABU2SITct9lEqvcqKvDlJJRjwCyS+NKkb7Gd32iqZnecKEd3kZuJ6SFAa16i5ZEnu
-viRxc9p6Pb/PnarT1my9a4qOKI9lb/iZViROeRa1rvBTRSVP4oIRpQXAWHmW5wkStbo0hmFKX1bFkfgx
-pkmYdll+znaowhrBeo1I3a1LzBUdcQbA4tzIgBfGIrHkTN9QVO1PX7j9cYvDoUbN1iXhpS4molRSzZ4c
-LRAmw4sJQV/nkKTIPZdpEcWcR9y5XbeZB3pxbrARgozwtT5nOOTvXmLlcpMGzwDykG1QVqZRgzpI2j46
-KX2RSgWXf37yZ/NTMJufFrP5aTmbn8LZ/LSaGd16XXmYPxdq4UpAsJ98wbsL6CyCKZ4CsZyWLmanxUXU
-yDUDX85Oy4uqqlfM7CmUygP2YoVZloOr6zxFUaxDS61YeN51zvrKFTlfAZB6e3lNsW3hPJxUV+yB5cS6
-YictxtU9VaLb5AuOveg5cePD7TKBLVunLtIkdqrjLvrkzfB/c//xOidO/Qxw7dUA9zrPourrDP/T2f55
-gOnpoez+sEGL65wMgHNOJoa485doEPJMXgpzBoWmA2oKLBlh8IDUwOhUmkZ14+5PSRo/8v4kS57Mb5on
-edIkUZrUmdAjT97HZ8W3OJclqvZRja5zLaICwkNJ6/sKLl1QUeYIWRSSBwlU7laR2ELA9uF/Bp6//J+e
-92fv4TpPsqN7SM8Jjg2l+UY0zASqOZ2zXR4lqSBioprgegVXtV67PsQIBWj1LDudDIeNOHPwYTHSly72
-9iHTJg2TDpq76bII5Z7EMZCo9et52IGwdWXiNJL1zi3518UF1+hHUlWabK9fd7HQbRXKnPlSFr9EgHSg
-1q1svUjtQKw0X3exI9LwRMXylBG79jwSZxnVSmSFEsXwjtIlBrei7xTmVczLCrn1vsJBEo6esKyZSBZL
-r2y7eM593VKw6xwra5RgX1J3gdiI9MOycwipMSAl0qglnpq4wEE06p8zFCeR86n31MhC9+NFINsrZIh5
-vAKVyDq4odI6MFQiy+SGSk8rQyW6im6o5fuUwf4lG9Dv0HPzqnjp1MbNaveQohY75rwM/37mL2hAhf95
-Vn5KpFyROqFFSq7zvHCP56ZBVS3bYk9ZsxIAf5zvi3QmFvyyT6O6/tMP+yJ1v1zkjvDkXvCutDYG9dkf
-j/3lvwP6l/1Z0D9L+iekf1b0z5r+2dA/T/QP7kX6lB75X04LP3n9o1AadI/906J7WnZPYfe06p7W3dOm
-e3rqnnp+spj/5fzgJ69/FEqD7rF/WnRPy+4p7J5W3dO6e9p0T0/dU89PnfG/nB/85PWPQmnQPfZPi+5p
-2T2F3dOqe1p3T5vu6al76vlpU/6X89P26tH2GtL2StJ2etJ2qtJ22tJ2CtN2OtN2atN2mtN2ytNS/QEW
-VCcOdaLnl26YlhU6oKpCMbXrHh2tu6hOyEfBDoyQ/Ya2PgU4VsXL1lem7mun5h1+siZH7In8i1ZihkbH
-4csINvMF+b+Pz0BRX78ro0gCGYm/mq/w/60FLEKZwEZXSPEsZDxBKCDAP/qaQciqLOUqi4XeAKGsR9AX
-UjyhjGfp600Qyno8fSHFs5LxhJ6AIJTWG0IugrVSBZBBCAkhVKSwkfGsACmsICmsFCk8yXjWohTWkhTW
-XAq+p6gRIIYNJIaNIgZf0ccnQA5PkByeFDn4qk56oiSApZ/rnDp8h6Sqm37UUv/a9Z/5A4fDMYwK5i+e
-+QMH81QY75kvTTEIX8PCkXCIQIUIGETAIRYqBGek42OpQiwZxJJDhCpEyCBCDrFSIVYMYsUh1irEmkGs
-OcRGhdgwiA2HeFIhnhjEU9djWqf6vFf9vlv1fu06tutZX+tan/etjzuXLIq5/kX0hIRRx94H0nvRqDGA
-hQRAbBd7s5TeiEaJAYQSgGhtGMBKAiBGhb1Zy290vjcSwErn+0kCWAt8+57cJzrjvtxrwvi0BQbYHXjP
-KZM5G2+dNbE7dI+JE/tSd5o7sTM2ffrEjtudZlDs+d1pEsWu4/R5FLuZd5pKsZ96p9kUO7rTJ1Tihd9p
-TiVu/J2mVRIH3Diz1tnoybXOxs6vdTZiipWGq2mWlcaiaaKVRplprpUGlWm6lYaLacaVBoJp0pX03jTv
-ShptmnolXTXNvrJqmidgWe3Mc7CsUpZpmIhaWZPoXw1N0kS8A/M0ka5pqiZSHZitiVAHJmwiU9OcTWQ5
-MG0TUQ7M3ESSpsmbSnBg/qbiM0zh1nW61M3i95zD2QLGW+fwLL7PHJ7Fd5vDs/iWOTyL7zaHZ/Hd5vAs
-vmUOz+K7zeFZfLc5PItvmcPJyt6d5nCyNHinOZysLd44h2fx6Dk8i8fO4Vk8Yg6XhqtpDpfGomkOl0aZ
-aQ6XBpVpDpeGi2kOlwaCaQ6X9N40h0sabZrDJV01zeGyaprncFntzHO4rFKWOZyIGp7DiYDtczgR78Ac
-TqRrmsOJVAfmcCLUgTmcyNQ0hxNZDszhRJQDcziRpGkOpxIcmMOp+MbP4f1ns9RNj+85h7OPIm+dw9Pj
-febw9Hi3OTw93jKHp8e7zeHp8W5zeHq8ZQ5Pj3ebw9Pj3ebw9HjLHE6+Ft5pDiefG+80h5PvlTfO4elx
-/CL3cewcnh5HzOHScDXN4dJYNM3h0igzzeHSoDLN4dJwMc3h0kAwzeGS3pvmcEmjTXO4pKumOVxWTfMc
-LqudeQ6XVcoyhxNRw3M4EbB9DifiHZjDiXRNcziR6sAcToQ6MIcTmZrmcCLLgTmciHJgDieSNM3hVIID
-czgV3/g5XNjFkrrtu35/bu/zCbq901fo9n4fotubvkW39/sc3d7vi3R700fp9n7fpdv7fZpub/o63d7x
-A3V7x2/U7Rs+U7fp6Em8TcdO4m06YhKXhqtpEpfGomkSl0aZaRKXBpVpEpeGi2kSlwaCaRKX9N40iUsa
-bZrEJV01TeKyaponcVntzJO4rFKWSZyIGp7EiYDtkzgR78AkTqRrmsSJVAcmcSLUgUmcyNQ0iRNZDkzi
-RJQDkziRpGkSpxIcmMSp+EyT+Jyed5ROJWlb2OWNwrSK08Qz/nTqdz7TQ4/K6aKmKOHzCGzHeIfyhKIY
-o1NPJxFelHPdgRHLrohfvyf/XgSqJni3zvq2kB9CcxZkvz59RXGh+GLa+K4CCmj7otOE6rQ7ICSsn6Qe
-4S5B2cqYUlTXYqfMgLcxVHgCCyXSeOiyjmuqpMS8YRJOU23z5uQWB7d5LdGnIo4fL9p2d/E0ihc+ckzk
-yHOPRzqyzTfIW1Gte1wsEctM/vlj396u5KTz92G3iaPDQcEFdWX3KtZKTnqJLDxGax3tBFq0D+SarCd0
-Np8O+3hMVaHZNqDTIIkumc1MLRBICGUQxngVb+KdhhHqXeFlDJSdoDKwj3eL3Xq3Azuqz89j6uX9Zr/b
-H8ZUNvWzBnYaJMNSBM3kn2Iv8xIQ1wKt9jsFF9jD/FWslZz0ErBvN4fYf0Jw97A8R6ae3fnxwSQWsaqx
-X2Wg0yCJJD8UM+FZQEx/gigQCpGEAupJWh7LP0/KT7gDV/tDHIG9QNJCmXov2sUxCgfqmbpOhDgNImf5
-qGbyTwF3VwLhOhwQ2kUKLqgPu1exVnLSS8DOPBziwxrWRp5Uy9SfhwPaRP5wVVOXKkCnQRI0p9dM+iUg
-5wUgonAvDHAKCfUofxOrBSetAOxOFD+tDIObZSQz9qa/83brwZqmzpRhToMESF6rmfhDwMx+g1jiQ3xA
-EhaoH9mLWPl9Un/DOrk77A97sCtoFjdTH6I92h9WQxVNXSiBnAbRx1H1dSY8S5qIf4ITzWq/2UciClgL
-cXks/zwpP8Guewqfnp72Bi2qvppnlqfdbocG6pl1r4c4DSKP9k3yDc2kXwJmXnC6jPZdJWZofVNDJ6Mw
-tVmGGc+sMyeS40K8WE+5suyEsoiX4TIOQwUdH08c3/Ip9MI1gBI9oT06KCjlsAqzNoav65u1VWoKgTRE
-kB4Ac2scFYThjP9PjKYE1LbACuwRADEWebfw3y+7rsP5E92DR0lVqC6LvE6+4YjanAGlOxfc0iX6LosI
-L6enhfF02hTn/ekKof/R2LsAp+vV2shpFr8rp1k8idOnJ9/IaXp8V07T4yROff/pychqm74rq21qYVUD
-f09WzHzMD0WVufsib6rC0hvsxPw+Svef/HmIMud7h66nOd87Qdk+PvfLUrSYrbbdlpvSaEtVU5Am5bbP
-bdYCeR/2KF7GEZz3gWTMoIdZRSvpzP2wdlBU4xjELc7NjOSDOkVx8aK963SOfkes3QrF5z2K3axgp2Tx
-z8eL3NECZZI3RJbDlpxuR20Z5fHFljXwGZYjy6E2qjPlOM/bxYdDl2kNz4O84eTDi+fQ9BFspvWDBTW9
-AbbpSgu67EzluXHLNNqjE8nWeJGzzhVltE+aV5IfQ+mC4tcb6uGeewPNN1SeXoWnTptJxb9UKIqLPH39
-cjE6Ez1GmkIOED9tzbcoPaMxmiCzRhJRyWy5JG+10UTQT3ekAk0HKGYhJHaDW4bvHV8wGHzJHAJR0ooO
-JOXz56HKBJ6MdD4GuRAB9FRPQzTrDKAZDBINYKrzzRqmKsmmTKMkb1DbmE24lBVyAaeFZMVqv9szCI8x
-UNQY6y/4LF22jmdqklycHmej4AQpmNJtqPDgJAfOcVRWavI7g6jAlDlqo0DiPkSbkIazNw1RJl+MAIPx
-S3ZOm6RM0ZcZ9BaT+CLlyuNZCeVJTc6bhN+QxJtA4in2GlBYIY0Yz0hFQN8l9YuW+YVkp+D0WDaX7qct
-l4uW6KLHRPJMAlkz5HbLWTKkphMEdFrq0US7ukjPDZI6bKFmVHaNmLq55z/FV9RyK0m9tPdqtmcZOUmT
-JoqKpcxSJSYU94Kj31T3KG9Q9Ux+kPRoNS9S0q/JSdLWQFNJHm5LP9ZN1CT7ZyjpL8O68AMgU/X8W5Qm
-sXtAKMb2T0qN+Kx/se71GU6gRm9Z4FibosDjEZA2+USN8f7qkvTJ2/BZomxI2ykZLJElf5wFGwzEl97M
-X61nq6fZ/OkRdLZlizdPiGuSxLP5S8QeowbFjuzIkHJlBYNdSCGPPyAweRRZTbLoiLbnKv30EEdNtCW/
-P9ffjt+3WTr7uNjX345Om6V5/cN3p6Ypt58/v7y8zF8W86I6fg48z8PA3znfEvTyL0X7w3dkY5Sz+e7j
-An1c7MuoOTmHJE1/+O5jsKAcfufEP3z3UzBfOKv5evHX+cpZzsPF3p0vXX/uLefLlevPl44/9935JvXn
-voN/LuZLdzHf7Ocrd75aOD7+G6wdfx7M16m7dJbzFUaxmIfufENQ+XP/1+8+Uz4wkx8X6EFqe4VKFDXb
-vGBP4rtOw+j4cugNK50vRrvT3+gdStSFwnEw6jw8OmCpQfwsz/iwErCQBlQFW5AiKKYWpHRc/Kcymmd2
-MDY8R3CtIx5dhdsA+b4KpT44D1M8M/Bd15bLtPHT6UlTlCb1sCvPdb4/102RuczDMBoACWyiBfjkiw1w
-/uQsnM/OEntSepvubQ2WTghaA7pyy6yB4/3Vc4LT8tfMc8K/es7itNQHr9MNVdalbKWFjtHPm7J1fK9s
-Z//3mDMHTz1Ct0jWisn28yRrBCujwRwBKnkvewTzodsNO5zJIgGMD5okSx2DTdIWDkYaVhh2jHUlNW8w
-sUA9a5t6j1FgU/WHDRQFX9tQ8aI4fxaacDdaQO29qPM2qhNN1Qx9yDSJ973KqPIa7ksIx9aCQO3TG3jg
-OYvfzItwhZZuJQZZM1gAO/iAIQBZH2cPLFVvEf+WKBO6nwjGIVREsVjuEbggSl8MtYHMAfdrwQh0lxvm
-FhvzNO8467rHOzdlCnL7GCHXIhjGLnlnNR597a2p6g3kDePTAjswOHU2x41MUz37sAT4VBRwescaEbzd
-T0ry+y640Esqe7z/9ZZcgsCbhYtpSy6stfYwlAEpMmO3ev4+yy5dLEK5EAIXN3DcwFk7azF0qZuq+Iqk
-Cjh48RwvXTiLzHMXOPTiUcY+qfYpcqofvpuHStm+/eG7xXfwq1fzK1oLgqARzb/+l1igYXowZolGAoUV
-xzbYBVUGF2kY+v/UrMBsGHTUYo2FwKRqb1i04UPTuGzDh+UfYOHGZEbk0HGqHfl/izf/txvF32CZx27U
-QAW+l1Uz8QJZnWHYcUs+4w3bQL0pSz8jzbUZfvwS0G2G21B3wlJQV3f6YpCx6kVxTa10TV07AD56WWhq
-x9qqTlwf6OrfvkBkRaH28018TAuKR6ECLcwI9ozWY7jKpOWiybZkoPptanHPhaNJKNVjR8uVt4qh7f7k
-xXA77rZ4NAHh5Yb5yt6A+y8g3YzePn7k9Q51QE5a6zBXvokF4/gdgB+/nDR55NrqTllWglXzls4eu7R0
-a7QJ7FuybzEjd/FWxYvTbzOTi8buaZIYEKdTIfGF5W4IsbJ8D/dwG4Z2W/XJr6L9V174j3PdJIdXl9/o
-yIrhTWFSq+huwNHsmdJm3V8EY5hXj0hIt5wLmbzgm9vNCIGtu8odk2JNyUufye/oxnXayT1DZvX6ffRE
-6CpwP+7wlr1uB2VHNkdHUsKTt9WnKsm/9jv5oG194KY+qK+54N+jN6wUDbstr/Ndk8NqqJ6ukfdpCxf3
-M6ZAXe2OF51rVPF4kKx0kxMZQGmtF2oFYzaJ96d2xJfjDhYN7blWz/uYDvoobEJHgd77oBAWr34+aNfk
-YLoZItX+jnF2DfGuyedsiQNXpCsYtx3rwaj6gyoYG/914adQ5qvwGsmA/EL6DtghABd207iLvqG8qXtu
-eaIX+ylYz1vvtENLtFDCMuL86AfPWz3FTxquVbDfS7iEfuzQ8xUhcy8uNjN/ybqR9WKHUOrNDmfXq/fp
-AeI4c5yP5GdH+HHOT4SPrrHlNepT8fKj3JyqKOPiBZvZ4zFFg70e7Pca/+F+dwP/W0A0Y1rB6w225UZB
-d8l07H3BTofJfdFdpS3iGaPPYbQKVhsFW7gMd6tAwSZqdE9iuKn+wpv5pL1QWxW17hGPU+wJnTFetUfW
-AZRbaNYU9ab9raYPQGEY7m5pxRaU1G0qbmzRzZKnqY3sHdJ96oe2VwhYxmh44G82C1XDfbRGi6WES9Jv
-hn64jetg5m+82dNaa6Gq2QzlOL0e3QETtHpMDUineXOmaDTtX7XT9+vlwpvO/xaQzI3aDLflNjkn+aEY
-6IV1FOw01SOFPYoxOuwvNsunlYrIX0ebXY9IVGCCeLhV4Wbmr1cz/ymUm6XoLsE2TnHHNXm81g6DAypL
-mzBJX0lfqmx7a299mMj2VpXCbZoKNeFGgbL0XUMJJz8cDnvfWz+racdwoYRoXP7KD8iLNp6aEydePCHP
-k9CJasspDDc0CIKZv8ZRh9ZQRXk5TlV/394P47V4VA1AkbsWwbpsbALtZqUJ+9VTqPT9ZI2e0hBdqQ3N
-uVHcNJOafVx3C7vQAnuPZIwN3m+CxWKhoNrFgc+nNIpKVGeGfEzzwtlmIc0uDJusywzhOFM8tu3j1XhM
-BUCLeVOmGGTasWpvB/4hiCczv9VFcpv+wg25Tb4kEdiwLdocng6RaotIoYBmrEUO0AqpyOIIeSgUkIkK
-TLGPaJ+/mgX+ehb4T0oLFQWmCMeb4nHNH6/BI+ABBWYtmWqESc9qE2D8FB+mMr/VZHKbAoMNuVW+Y9PP
-PaunJ2hOOo5iVAy3CNaB5p7FgR8se0Sy5a2+jmjXJphtNrOnhdwozehWX0ea3HENnmJwh8BBc4ubMMn7
-JT2pOe1+5McT2d6qMrjV0OpNuEmcbPFaXSceXA9V6o1bGZ6GU1RXldxwU4X1dkNbFS1WSagKzdm3fHGB
-u2a8Nk+qCSi21sBpS8gTRT5V3W9pna75A218m2ZoK8yDy6dazTEjYTpWaCxMWmP2NjPfX8/8wNZqw4gw
-rznzhoweE7esNk+saxkXN64+36AEt46Nt65GD7b0zboir04PLsMq9Ub5MhNxgmNj9Pq0cCLO0FbTqDCs
-V3P2x4+JySvVk2raxsMtK9eTRX7zWHjTSvZAG9+mGeLK9uCCrlhp1Fr2FISQ8o9c2w4WM38VzPzNEm6g
-Qe/BtW7O82iln7jKPb6aRd1vWPWeJNtbFf0Nq+C2pr1RBZRV8cFFX6XeyCWXqWghfZ+wKB6GM/9pMVsb
-m2tQetMaOWd/tN5PXxqfVNOi/bculU8W/K3D4G1L5wPNfJtyyCvpg+vGcrUxNn8iSmgUTFhL7zZqw+00
-jAHD2jpnffQQmLyqPqWiZQDcsso+VdS36v6bVt3tDXybRkir8IOrzVKtsTPAJKSQ5o9ehF9uZsHyaRaE
-nqGhBs2HF+U546MVf+pa/IR6FrW/bW1+oqhv1fu3rNVbG/hWdRDX7gcXrcVKY6z9JISwrR+1eh8Gs3Az
-Wy3h5hnNPLCazzmeYOQnreOPr2Y18FPX9adJ9nbjfvM6v61pNylAmuRfL4YDDWwF2Lj1nVSW9dvzwtVu
-oVU55zGqMON9Pfmrac7V11hT3Ewvc6B9Mc2/mhYmjdviyXEecunNrskZnuPlbsngBRp1JtDos+ffJdk9
-RkmOqFiuj+hgvhfAxcRJJKUROQv0S/Naoh9256Yp8i899Ex4WaEaNYZ39XmXJeJL8aTd/BDFSDx/wQ45
-0PMcuLVRNf7OFwUXv+slihEdWHgAPXbnKDxyl0QalbX0Wkw31UHgiFc/EsUE4XV3Bm1PSRyjXDz6QmGc
-+YKdTxndGoGy3iY+5mfkKUWHhj5V9NZD/HgudY6vmh18OSUNcusy2uNOeKmiUoPZbqNDgyr4EJR4pGse
-hKF+5Tcr5UexHh7EC8DnC5TRU0idKrM88LwcusaiuyqD/abUwSp6a1BWNq+8TcqJtA42Q/nZlp2MZcTn
-Scp8z/PkPGWHtIiaLQZ77k+R+p5wDQgzJ/yk3HZOM99jW60etzKeLCP406Ru3Lp5TZHh8Nf0e5zEu/38
-0JTvTOot0oeXqruT4hnsUyreC78jhMCQc5PmI7dy/TobSajOjLQAYuvVBiCWxSOJZfEUYk9PAUAsPY4k
-lh6nEPMDzwOotelIam1qpsbsjKOMGzxKCEZ+B4mSKdBTTgAzzQfQvb8B8m6wO7rRGjRAUKuGDBFLaKX3
-rfesSs3Yw5QxsX9VrL+ljYf6lKuVqYuhOkp3Wxs2tZfl/lDayerg+jbB9FMEEZHlXLQoGQXr+whmAinq
-AFnAWSIQG2vd8e/3UBp9HFq1x9aWIS2x9wCsJZ1u/NLSC+4ylDf/6wfK45eZDQaTs0OQHrCDNEX5RbTv
-rHNomoKuXpx8S2JUXTpflrsjzDtRXVtBRsK9j/SiPAFt0qBs8HY0Hu34NNzZpyiqtruiOY0/W8+vptP9
-2OeRVzlKLPNQWy6UYlt/5W/8HRgOGxfMFCrdGodMhpaKSxMjifDtWDKRPh6WyYwPigcWdiTdI9GTmqam
-gzihKBZMi3QLF4+r5+BteHoMLLNtDWBwe6F7zwz6JyqZEKzPhLidD3XLFWMjb+EypC7RSQkLBeTFj+xo
-vylBx9Z3fCGTC/9lRE3VWyXAlL6LcIzVpQU7CP3Qe2EFqCevYzUgE3CozDZFke6i6h432clZRuomqhot
-yQhNhoJfSeTNqWLkNtBHuiB4SKq6cfenJI0ftbZqEBf5ErqytaFOo64eqFgaFFuTlKe+x0s/D9DZmAeH
-iienvBzbaAtrUssFLnDrTUxI77QlAbcu06RRUv3Ow1Ug3dpJvSxWasDBPIiZxbMEAIHgQIIa7ZDwWswt
-kXwwD1h9/B5GwBclvx/XUcrtpsw5Z9cDaquqVprpcSRNiKRGsbPV3eiNkwrteUaac5Y/w6VK8iE63MXc
-Q/1Qn5Z/aNi4yy8ko9Ev3BohrdZDMbtGM4JdO8WKmGlZzAlEb7RdAa2HfVRP7Jkx7E6yNbopFHmi7dOI
-CsXi7K6lSANrOMIyO0netSvaL7NhWMxf8WWYjSn4DdUoKWAplSxC4nHH1hm9R/izjDh52n2ut07tfLBX
-qNmfpOHOy8RxKPD1o5gncQa+4SnkpHdiYjrLKyFn3WSnj7P8UU+6Z2rA98OtkcDApkmAlnZKOL4f24+j
-aHPQkb1sIw7CDYr0++kCHssEUGOQHbGOlGNR9x5NooQyhpqTjZqFwl1+I6+KP7+4gaWu8vJq40SdlWxM
-qbA3+8BD/MgT+ABDd/CHTZ17v6SVZhparzpjdWlsbe5F31NaPW2x+x1zwtzbJeJGZYnyWOoDt6wQLhwU
-EITJoT4IgM4xrCzwoRQY0QEjWkJqHdAiou915qS34gtsy2BQFcqCVQe1kxBb9b29J0ejGsGuBVYtsRv0
-TnXky/k1MNo/A7ikpbW3Z7Y1JB81Lwn2e3GEtWLD3V7Lp9AL10Be1jFLx2yBW/9S/mGP4mUcGb6Qq31l
-cKhtYMyBFr8hyXhxXG32AchrbdLg1x498mV/6WIj3KvO905Qto9vIqW/1RXsR13VTYD2waTWYmo+An8H
-qWn2/TZ+iajrzNqL+PVEgVH+IIlNpKW/HSUxE6BdYmots8SMkGaJ3WEb3QTN17pZWa/y+eKU5EvoXSZ4
-MlMWTWaT8KqdNuQDA+gMSz/Tq1k1ZKR6jNWNu/h/Y8bDDYMBbIPoWxoWpaYigWU/EaNpKe2WijYpTfCX
-lezx5oUitjshyYXhry0l0zLgThbt1ooR3/kkP8uH8UoZ97slMu4tu/6zuGcVusZl3J064nZAIJ+H5csy
-SPStV+Couc/NRN5wMc3G28XGFii77+nHxQmdqKdfW8RrrXdpoYEFTt1+exZPK3EDir5fDE7tFaasjyHV
-D1e+YDdFebVzAG+o5X4UDTHocAR3juAXfNSSMQvsWBB3E8H7YKkH/yGKd+EuZl48CWxg1unawZ05B7ns
-r6R1Qu/j59D7iP/2fLF4wRml/UogMoRjlBVhyyj3v3BYuhx042zAy0EPhwO9Q3M1D1fL+TpM3cU8fHIW
-85Uf4N5fbPC/4V89ZzkPVk4wf1ovnfU8CJ2NE8z9p4V+fejYjsF2uEFVluRRgyaZn9GW9Q4M/DbyWTpL
-w0WnnYTIva03dzY3Z2MvigPv61ayOU2kPEneE+iTYH7SABYtAFz7DzJ03aXjLoXB219pu/hOHsRGvbC1
-7x2Von5Jmv3pInmAgWI4KcyA5Og8wKpy08+3KshzVZSmatA5gR6VJp6B6EW8gRD/09mIlHNW+BvKEV05
-wMWus8TF0oqCUK7bLDpnQoyLJ57e8VafGb8tiZA7FFWmgYicmKHe8+qh35+DEd00/hjaBIXUjqnZK99m
-uQRfTmsm7e80atD/+MSvMbe9HMfhe9oeulpkueaO7JcGF/3W4thWl86Z3XHs93hNWzqHLzD7P+iaeHvI
-MG7Rv1PKqCxRVEX5Hgn3uamFyu8r9J0cDGifb7teDEC/JQsZ36L03MW2TN5gbyg4fsnOaZOUKfqiXKP+
-C9ayLyTCJo8/PPgPX7rla+lGQmlPn+ajQP0yNuGkGuGy6qTFqC0j4QsqQMats8Hl9q4RQjzYH62kp1HA
-faTgwrTKQHoc+kAj0wfJA+tb4JcM6VZZyxLa7UZK24Ok7d2wfXy+hdbWA5bORuxegcfcDSNNoKUt04jk
-hjRXwJNG+fETyh//07zVoVtf+JeqeKnRA4AGqP0LNt3ujlT5oqKKmqb6JAAoTVTWjKRlEn4sztOOTd8w
-sYkT2eiZ6w52/Wru7aFWdwtm3fYHZZXI3AvG9tvbq8p/6IM2NQv8LJXcfKzllDI7ny7Ea/kRXUyjkspw
-vpRaYc8Vc5+5k7ClXsIJvd1uOb06TchHh9M52+lL1lhBsMLMxg56mQbmnZS8F/76zogpywQ9DhW6rVNa
-L8L9Z1jzFHeXsylxINu4cDibqqL/20WaRlVUtW5iHGXrOGP8ZKnEDw2aPkUM4KjOeY4nJRxCSZlZuOjE
-k3HiSN2fq7qotmxZA0qRh1CAlKvXoJO8vWRBLRQHjkGx/tBaNMZw3ahCavcMqo9SYbLqCPX/iNpSj7Y/
-2u3k6uXktOSPrFj30qF6tPLUt2pNfXd1ATTD+IJSlVdXBdYOSZq6afECrjHJWjmgfATTuSz783rsk38I
-LiyMxd0tPhmcFcMi7VgsygTAej5Gh+icNmYkmk8zmQ3VmIymXI8lCa7OzaDd5fJi3G8zgqeO2Du0hg3s
-PPp2jyPR0oqCp20OULJSEbI0zaA1CQAZAByU7yrvftMz6XAmQg40n5DfQFU6jKOJdrV8ClGMEOmw7SEd
-8kSyXcg9QDdLy2Ck+VrUCRgtdfcT95cNO9h4hKpTE3tQeUP7Ul7noEGh0/2FW2vr5ZtsNdSdJJ9ETw9q
-wlxOmDEi1peNr9P9JeuaPQE5s49yLPb5lnOgGHeZpKmuCJAMFcguF4LwjuYC7XCNuMKJosazlKCzQ8cH
-td3yFAs935ygGEDlUoNWoZiuLnrUcOyiOsGd1IORVZNvaOtTgGNVvGx9iGIT7fhJ6h/JjzIStuHR0S/B
-ML1QkpHk0bddVP0mh0jNRxzk4+LsnLh2XpwcRnB3qHlBKDeZyF1U/TjHNaIkR9VML3IP6TmJL3/w5vG2
-uLtKXI+XVpmlRe6FDy+z83Jtw+XApn1zCiGZNcGW9mUDMxIGnDbfjkxRcMPsy3gRLJD8AcZTkMqVDKkx
-6yZqkj1LdymRks4GmaVp/GTRIeJJWo0WhoQUgpEhv4fszPiTi7w9ZK99pR0x0D6sQudCRiWjsroE+jQh
-MKWoJi8doZwM1E32RW79DD0PhXh6DqRzY5+Dh7Y20l5lf8Ru6UTpkAP2XSbLqO0SgobzJ5qmkzFPP+HR
-0xeqHQRfMos4oPeWZKQq7l4nSY60qnhx2BEysHBqGiGVnCPaFMBkVMXLs15kx+MMZb0dqm4wJ3PDV8/r
-W6UnT1didwsFFqY7ewIY5X9KsrKomihvJPMsFJuMUOc8MSNEUzwZmeDWRPJiAKVfr9aw0mexpduklzcr
-PU+Kq+L+TZU+i++j9DKeyUqvVn+j0k+W3k1KLzD9+ym9wMRYpX968mGlJ8fwTN0mvbxZ6XlyZhX3b6r0
-6fE+Si/jmaz0avU3Kv1k6d2k9ALTv5/SC0yMVXrff3qCtb5NLf0mvbxZ67ss4Sry31Tt2/Q+ai/jmaz2
-avU3qv1k8d2k9gLTv5/aC0zAai/D/5bKZZbB5PEjV7+Lyr5FX++prFN66RY1/f111K6g/GVKdwlLK1PS
-Zm5yNcbTo62CEpRDEGJi6VGIZRHrFcNRFa2cyZAmDtejCKlfCUQMCysGuoT7o7z+P0RNWCcfAq1PxYsN
-EFxfHyUirleQcOTPENItKwPo6ALN1ANc0nb6Bf7/70bswgdO9zFWHfb/8/DxO/aOOhA/fBd0BWmSo31U
-/vAdYbQrzpIGVWmSJc0P3/ls9/7SWZ+C4Kel44f0b7A4BQFwQgzuGtQ2U8YASa0TTZIlqWEdLgxkxEiO
-o+oraFK6z08wlEIeAFDvFoSRGW1HEIYz/j+x98y1bSwN2A+J2HoUNdCKSHgCKx6jLbGQVEyJBVKyJDqc
-8UMdzDBgPRTxADZEgvAfrZj/CIYE8+n0//xu5kTuH8WajBoVkkmxSBWyIxCEPpL3URW/6ZvlyA9KfSzo
-Pb8UVUyduF2Foq8u/j3yWrFu48TQrWKB8Vox3OIfT8ouKvlGI49Bzcl3LprgWcgr4wjl7FqP/uXlbXss
-NLp9PiOdbP/OltdapgwkuBFpu7sifh38bs8/UvlS1SZpUqTsU+GZqQhAfd5JMHSz9gJMw8dxorYR2wlD
-De7e6aC+7x+l5INyS9h9It23OH7oMQATBppOZBJN9BaPz6btPqrGStQhpWICE49lu45fto8OUOQ5noTw
-e0F/xqpw18OHomjMPTK2B+RrfQzNp6QA5e5OjHjOqB6QWk83XslZKecrSKCunBeSHrvnoMoVkTIJsnnG
-TkPCxmon2dHFuptGr+MP+7Av8/BITLKjuB92SHsEPpqiBGqqxkxHYTRsVmq0NQBByI6ZiQI2zUg2RsLF
-tfea3gTcDp1SZZXyw7K1fPbtGdNXrID1KlKk5FcNy1ZWMUJSY2t0w7c+th8fn8XnyXO+tk9ZtZySBcYM
-M45pPv53khJPtXybmChro+UE0LR1MTy9CbW/l/hmRkA8ZQfUuV9m8UHMjmgPZ6PhueWxbHEci4rOHtNI
-MzN0S9vvkB59GPGoXgUqAN16K217txpog/0qc8BYoAO0Bo0ncyEHxiXDcOEHEOhvd1+c82a7oCellDIV
-RK55jMpus5VYWywHioqqPEV5vfWfX5K4eKm3PtjEgdPu1+s82u+LKk6KnJkN5aZEDUCURHFwm9cSyYpj
-cSi9UdiEIcSLLoYrs814JZSm6jdcz6PSEdm86W4dBaHUleBmfBzMxvvqnO3ueP6h87R1P9Wn2wCHbueW
-T2IrIV/PM4kCvlcLLtAnnaFK9qtboQ9H8ib/bo/f54cRxEjY15FUw79zHqMKU38zJhpIKnWUMwI8j2YZ
-HZOcVBtWBWWrrSZQUG5ldETsO9xALlp5dzcU2vhlq5z3D7oD/8b8gnCCA36go2NPueYx6PGGq91i7AWk
-sgpLhyxEWvIlEMGNOWwIPjUkdoQeB30v+3KPZdGlJyeu9PTU7AtGz4PrPgIFpqwi9j5VxogjHmBWXxE/
-X0gXKYw+JUUvER59ukYcZGQ/jDAk1EWKcFRqfRijTRNMsl8MiH7B5QJTm6gIiyE90MjVGdhdk5Lawwhv
-6a1gaKAY2b9p2AyOGjrHRfHRPoHh/sLdtZR6ax1+lJLHrNXkMeNuCFE2v++iGmEscEow4Qym6aDoH+Cg
-Ke1R7bxoRF+wjxf8l20tl0KQK9WV3RW7JncYHX1aFO6dxBBksU7dxbLSNrGsem3hh6i9XkHcskqyqHod
-dUAukuvILe5K1U80IL5VsN+r+OYwPiVRzdipEM+EFFGN9kUeDzaSuT6RWktpZl8+oqHhMtytAh3n3IRz
-SmN9bzPz/fXMD+Tmnvd7VNd2xoJNtF6GPWO0jtpUVjqioT5ao8VSxTeH8U1p5NKb+av1bPUkNjHJD8UA
-P+so2G06fnAFpXGkaEzL/HW02UmY5gCmKW0KFjN/Fcz8zVJs1EtU5Ul+vMhX+UP+xN731h1DrJrSOl4q
-NdCIMl48Ic9TUc5hlJNaGoYz/2kxW4sNjaP8ONTr8X4RCvpJqyhtZIUjZLiLA3/hKdjmILZJrQu8WbhQ
-dJPsfhkW4ubwdIg6jkglpXm0bKQAI+ShUEY3h9BNat5yMwuWT7Mg9GTxVQPnqWn+UaG7q6+a6KqvowZf
-HPjBUsI0BzBNaVUYzMLNbMWG3j/O2a5oqiLvnMjAsFhhyHgDL04s7Ot9OtUlpkocN4GnUbttgQWrFFVQ
-oshRH4P93rucdN6SECWLS70NYweH+Uvi1iquJX8XJ3WW1HWyS5HS3qWAXoBy5vu0qNHg50ZDo0EGFV/I
-85beJgRkvt+jUL/EZBNHOJiUUDkn6VpNBvp02Mc6qNhLHQfBOgw4oObHLDaLeOlDShmgBQrVqHMVb+Kd
-hgxmcb/Z7/YHHRhgMvCCRbDqQGXvww/DdbCELNYSxX0mSU51gVb7nYIKZnDnx4edBgr14S5A/oIDip6D
-tw+XKw/izUf7g6/KF6EQ7UQ8MGPRLo5RKMFBXK2C/aLjSpn6N+Fq6S3hpYTFPlYYOxwQ2kUKKpi3wwFt
-Il8FBdgLF4uD17EnT9jrwN+DIj1s4rUm0kO4F0RKMRmY83febq1AArwtn/zAX/dGRZhuN/7G3wQQawj/
-p7IWH+IDkhDBnKE92h9WMiDA2GqD/+sb0E+T/s5HATRQyZh8UkfBar/ZRyIewxB42u12SIKDNG3phV54
-/Wf+cegrej1UUYZqp6yKY4Xq2t1FlVs3VVKi+nKoikzc/djZV5/mNm0K8K3neNfrP78j7jnHOLweLSa1
-U745iatC63DKjM6XIoV23T9phXyAhy2uqCd4WLHgN914ZStbExUWNIiX4sxXdH1k9MKI1Cl6Pi1AF2J9
-gy123aLKPeIOR3nzaRnG6DgDtu+Gj04QfpwJLon2O/Q+Gmqa36wVHMrvRz0VQ59sRmphlCdZ1KC4+5BL
-C3CHQKPC8WuHtt1J8kOSJw16nlzjJlHZOKV5OKWf1+ucEJl8nzE9iCZmDhEPoRGc6qZNtqXkKmzy+61T
-xFzVDYZuRGqLe72MNyd3jiWMg290NryVPzn5EJnhT04swjTRkD/7GQNLnmVdwTL+o50t1qDZwsZ9mdO3
-Wr7fFmbLLmVt5+wddi0r5LqPUJp+bCdk7jPcTaHSYmqgfd684SuagPpUVMmvRd5E6ZQDnyACbZ+vfi+7
-deRaME3XGKMQbdswRjKjK1m/Y/ZtH1Ct207MixQg31JKnxtFSj5/3UuqELLfU7AgP7+TbC0pa2DexdQ1
-two3i+8o3Cz+Ywk3i//IwuWpWWDexRQttwo3Pd5RuACy31W4ED9/HOF2GUhg5tu3T7UkO8XdpNv+sSZc
-kJ/fS7oiI4f0XJ/0fufLDzI/piX/QXxmH5buKFAR3HJaEGJk7Ok/q18NBWNj1+r5sryh/sQAbaCW9EXL
-yBJf/p+GXNmoaXLQKU3FQceFOr3x3xP4pwMjhondOFhP6kgjW/wjxVT0o7qSU5W6khYCFEd+9eAfOAz1
-p3ajvZbUiUaW+IeUachHdSGnKXUhLdTpjfoywz/CQJUndp6tijyOTczwLz0TMI8bxIygPIhJoU5s/Kcj
-+pXIUH9i5w3UkvrPxhL5GjUN+agu5DTlSwpJoU5v7Oct9iULrj6xA+2VpP4zM8Q+mE3CPar7OEmp+2ih
-Tm7kFzj2sQ2sPbHzrHVk3TOxwz/pTUE9TvMYRVnzSCEkqRHfCPnnQKjyZK0zV5FnCxMz/JvjBMzjpgpG
-UJ4qSOGV7fSgKdSJQyxthdeuu9R3LHeOkEdXz7u9QX7ZOh653qK7BnUeMoKqN+c9GzIzEGBysoyvz9KD
-ZvP+J5PHGFBKteNmHV5356Ypcr7fZdxFkd0NXG+6MTKiRPv8QsBK83XeFFHdXPpEnYvQK1vbl9fN2pJz
-QfowtwkftQwqfS78oRQqj/JGMCkhvQrJ+wlTi6uidA9J2qBqu0vP1Sff4/f9G151d+gaPhuTLtJPMcOZ
-RggwyYuEJ3aO2hdfKHcEaTCnJNYuAMEv+Om/qZ/wzBdawNn+B+68uVXQ9gQkXvjIm0k+J8rfoK7zrIij
-1C1KlOtHUft3Dn3uQNyWa3BX8sryJlLA7lPYIWlRzLafqRf5eqH3LMoDuKuZPKujRriflbIYJ1FaHIHv
-bxQhOYPErnZmKS2hIUtwzQ9RjBwZr/D1no8IUnQoqsyZL9jBieLciNsGboWYDZDQXlM8adSgT97MDcnQ
-s70c/Vl8TH/wb+AElF6+JIHq3BJByz9lMbr1virSFNvW4SGJDax0gbDnfXRcsgPh0YiW88g2j1wAJN9O
-HMuzYVgYsfIz9QNgzOh0NiVHR6Kz/Oqj+lQl+VdVxwFEZFjDw5BXomYJxXc0cVmSj+14Tl07QAzcYy31
-fH8riAGjUW/ec4+RYJkmsmXRO2AYDGHrupPzJ+LgJH6DHHCC0VbMqu3s563+S/AIbSvXpwTul4yciZZe
-345vL4KMv53AFUWNDrGTnV+ivyYOiuhOS1bgnruH3nRbWLd/63ngMsPnMadk4VOtctsdxYf3laMGW5eU
-iP+KFg7KTufpp1oFU6kPidF58QTmWea0+xlUWWooj+Es5fiFyA+cg00RkvH88PBxZrG1PwLJceQLkENT
-HcDB59coi3WoddtFlZuhqD5XpqMM7tPT01PZsjFLQismbTnMovgs+zkkZ0W4Ncrzuo96W58FR8DEKkw5
-Bk9kQRzOt/siMJ5uYjdMx/Za3QwygmCdicEs7h7bx3ZaJz1yL6hNhdobU+3uay5QyfeXpNa8KYq0SUpA
-MXprvvaUnXdMkh4NuA9RlqSvWxzpp8itX+sGZbN/SZP860/R/mfy89+KvJk9/IyOBXL+498fZn8vdkVT
-zB7+G0q/oSbZR87f0Bk9zP5cJVE6e/hb0RTOz1FeP8zqKK/dGlXJYfbwZ0zA+Qs5ff2vWfGP5KHHqRf8
-/JrtivSBYRNrsXUClj6kyqJUWttZeprFE/dd4rEp/qazBLhjUlwI6gsU5z1FDZ7k8QSCrRBjiKR/JZlf
-pRIVStqDTYroGXxSkdpebU0EzCwrTLVMJZQ59ql74cyjqipeAJWRtYTd+r0RLoGfL9nqg4ioGzc6QvEi
-u4E7xqk4ib2+zne1y2i4uBN+ad0yjfYoQ3nzv35oivLLTARpsF/DA/klPScwjIJxr2LivdN/Vh6Lqb9Q
-GsTY59whPpd8tTrhmvH+rB/jIB6WlQ8yfcjdQor6xUCHCW8EGqhrKnakhXQOcxgF5jsN2UwkAvaaSKzr
-N2Wfg95zSv/R+X10D1KBy13IcrtNUy6GCOpE+or3ItsPMQEZ2FkS0q631G0SLC+12FWKuzO6p7D05X7C
-JRM1jSCB+gi/4D3ERX6jook0wK4TaHUdJ++fEbUM7DziJIpdx3EneU5yqPEpm8zmz2AeGus5HTDeMh09
-KkryacB04lUL9Fa6a9Dxi33C/+ch/B/jIfwmKw+dCk5zMnxxYAunQLAtgdBut9GBrCqqxUZPRKb6Br+E
-UbT5JRwE+yXK55uQGy07mh8Fuyhg+1H2TUiA8onmvPue5gf/k+P6j+MJSEZRJzQwmcx7EwZ5LZK2hJO4
-YtI1MEXeXvqlmElc0TMuVlYEX4oDUV9Kiu5HiJIigoRJ3vwoelMmYT4LrZKOrfIvOIveJxnJCSh1kSP7
-XCj0r9LLosc1TfoKf7r8ZfaoBtCM2jb5W/gboQeiR8ihmEco3O0wRhEYJkgT6KsfBZfwxmEt0wBlLNGy
-xSOeo/Ui6CxOE7LKoS5lhUEqZraWdxuH48Xs8MmEZxI296AJ1O53hd5H8+Qn35zAvEJ1ktJXnw9r/N9A
-A3t/ncMQf11eeByhxQQPpMP4xY+Sy/6+1kxkBBSTwNBA+Bgyx15XI8Gxn6bmMne6kkvMURVntwfApmyI
-O6riskZebBlxu9lc2CIF5zmg6mVWP7TD/9kv0FiMvj9jId5joYwwKBNiPyjFnRzAHhN6dJmkKC/ONUr1
-jx79u7n01Y58Om2K8/7E98CVUe6+PutFPQYW+Zm2X0B7ONTKXC8UrzVFUbXdFc1J+hbcV7WfuiaxCN0h
-RyIcgR3lBA8uEjc7HaI9cr8ldbJL0qR55RtPLK/se0RWUnJNwyaQG4Bmg7RG7/aQO1Xf4iG9d3PUNjOl
-rKzQN6WMb3OUxHplpUp1IosZQIduBpTLsTwfL5a9MP+DfAIBd8KwVwY2MGaoZRAXhOUBNlwLHy5jpMNK
-N9vIoui30fVCccuqKFHVvG7Z2+eRG21slEhn23sDqoU7Z0CUQDX1kL0v7tdTKo6RkwWShjKckPcMdejW
-q8nIYe+mj5o7cmoZe9gKVkWqDj9ejEVhdMm6SbDv8vf6em3fQMPMcPjRvgzYb5YQ5cRK+4TF00U1phNH
-yqDbwwy+JLuWDRSMNclLLQskuBbX53YUP3rBDRJuEdI55a6i4TW5/dPAKr0Z1HLvSSB8nCfPvdO1zQu3
-QiWKSHKiz+SbNd1WZSE19RLS4QtHnUOSpj989zFYHA6H7+RbSzfORryQNP7hu5/CeRA6XuouHfqfPw9d
-/L+A/s9hf11W/itwk6i5l3//5gXzNWmePw9x0xyhSeSZly9d8p+1eUkeJ/uoKaoasEqmu+868xROsE8j
-zA6c2YhfY/ZRubXso3plB9gsJ00uZDt98it2xRlFspbcbSrypE1F3S++k6IfHYv+HHmlFhCu8G9iCHAH
-5Q3ZBVO2/KYF5sbfvOxNtxB5tvSmPB6CgQYM9upWey13t80z7QG5ewE5FfuoJDmrTCqJhc/z22LxdBrR
-f0XqlAn3WSB85nLFirbpDco9WJckFnJpb1+aAvAsq6KJGvRpsfJidBTdSvmFlHfwrnjnCjab6RfWPMiz
-cv8B6RTaYfzTC719gerW/lxhvSKfuaAFTXP+3VAI5/p8bTLjOGK2JZkbAa32hVt3l16qqz3yKkeAMpv8
-j1XxcvE+AlKq91GKPnmifHjRNfQ+CuoOyf8ueOcStntJX7VZoOx74fYuvFnMmL+xQjbCys01C/g6p04x
-v9LjYrjq45+SrCyqJsobXqMpShW4KUodLkviONXw0lIdmq3aq1yQUoCHXhQXs5QM9aAGsHKxxu7Ypacw
-5VATwCMBvk+TfpTv05ixQ4AaqF5OHWrTpRsKn30OCNOFGCqnwL0YR/VSDIErFRx6Y+KY3p6hcsxSLZju
-tND4Va+2OMr3WogcSaB6uYlPevmFwifJZ2C6mEJlUr6f4ihcTiGwIQAphUbGyN0VCmM8Y4DpZgmVN+2C
-iaN8u4TAjAyql5v4pPdPKHyyY/mmuyFUNtUrIo7S/RACMxKgVmxikV4hobBIj76bcm+qHCqXPBzFGx4E
-RkQwtdTYgeT+B60Dq68X0+0MeveJlzQchRsapD7qgJRCowaSCxxUDTwlDQL176BACn7PxXbeWapGnTXT
-3X46qHLD7KgqdPlKStI0php0n+yoiuSjnpgEakwlj3eCBze6A6DZm0zN7MFYHGtsWA/JI11DU3pAGqIC
-YN0MCiQbBcCFiUyqoE1iXQU+j0jg2hzCwak5l9MTqKacw3YWVsnTr1hXDs4NnXxbg2rkuu4TNc9kb3rU
-1VcFVhv+Hdd0VCo8S31NRh+KsW+o7lGsUAZAXqCtjBBGyyXPaqWB3GwQdmm0DtYbzu4G0Ri+gnuAjq3N
-PRnREIztqWkEjhdg7x8EuU+qfYouWsgCwZK76lRIA15PvdtZBCLfSQ9JO+krauzmRS4nRBBxxi6N6ZQQ
-DwKhYR8YC8rgMhwA0EinvskvAMAVI8+uBALcozRVIHGRDHpIUQsd5BN0Rlx0BHtAwyGUw6gEAAGj+aQa
-NjBDEquzMULroEbLrc6GRVdnw9LjMGME2MGOkmGdvU2MfZ/cS5KWPMOxmw0OvmzU+MsmD8FsxCjMRgzE
-bMJYzCYNx+yNIzKLfwNR8oOOeGoYEmV6HCPKDmq0KNPjsCjT47AoOcwYUXawo0SZHt8myr5P3lGU3anT
-2G3TIVm26RhZdlCjZdmmw7Js02FZcpgxsuxgR8myTd8my75P7i3LskryBouPPAxJkAKNEKIIOFqOtNKg
-KCnYoDQFsDECFcFHyZRWeJNYpV66m2TnKNvhEAXVZZHXyTc0eLeKmIWkywanbTpU0RpS0ohuslrF0UrI
-TpiZDkgKgPKEfJYBXhS7f6B9A7z4lsSoGN7YIx1S1bJo8WUPvUlu4O9en/o93cK3zWUw34Rrf7n4CFTz
-V6Zq4WoehFCV5e51AdZYg+D+7tUHwek2EfLpHw8MOMu7oG+2lwwPTWdjTh1kxqa+7xlzK/QNVTUyMMhf
-WxnVgSSGbSRkiKEGGAm9VFHZoyfH7PA/Kj7gBUNAb1tTUNBCEIn6SuBDb25H1tROCwBDfMCBuJYFRsED
-lLPq5NOg13NEB+o3JAbpzx2kHLr3CHwAgQ8i8DUENFmYyEOfTkxFwROLmZD4ABLfgETiRNl3Q7MRXeQt
-OqRMQAbmuFGAdMwojy9avpwhrBKIjpNuy7hAO4osmFUAHS/LsHQBszJZMEv5mWwEIrJEpOCPk7qpkt25
-QYMkaH394y7Z36kKUTgfLCCGE1GZUErSowhl8WnokJk/VWziHhsDSl1mIsLuC76CUv+CLyE1f+Dn/Vih
-Zn/Se5IUG5BqbzlOwxAjvhQ4zqRqVhmBY6xHDEnKNsxkpKq0erwGiZnHmYxYG2U9Zn2oyaiNA02moA6z
-ngA41iAappHWS1PREVGesJ50BEyaUqP0QI5s9VhpGmqifso011fZqhOdgE1ROgEdrHUEn03lCFZJ3wSc
-kML1GEFtI/hUVRNQGnSNYDUpGsGp2wYBq9FAELxm+8D6VBG91Kuw7AleXfDmJWQqg+xuHnOd3d9ppuy9
-q9/csf0buM519nbvmaz738WBZty8iw9dZ291o+vs7Z40x/EmZzq7kz+d3d+lzt7Rq66zd3Gs8XB7J9+6
-zt7fva6z9/aws/dwslVhvtXPBqT4Zlcbi++dvO3svRzu7D19blVo93K7AeHdzfOGxuDdnW9gEL6H/529
-nwuOW3BfLzx7J0dcVcI7+OKA/t3DHQftx9088uyeTrllNwDBnMV388qz+P5eOWXvXb3yju3fwCvP4rd7
-5WQLx128csbNu3jlWfxWrzyL3+6Vcxxv8cqz+D5eeY/nbl45RvluXnkWv4tXjofbO3nlWfz+XnkWv7NX
-rsv0Hl65Ksy3euWAFN/slWPxvY9XTvr0PbxyXVj39MpVod3LKweEdzevHBqDd/fKgUH4Dl45pDX38spx
-C+7qleuaeCevXFXCO3jlgP7dwysH7ce9vHJIGe7qlfONnVTNjnfzytPj/b1yyt67euUd27+BV54e3+6V
-k924d/HKGTfv4pWnx7d65enx7V45x/EWrzw93scr7/HczSvHKN/NK0+P7+KVp8d388rT4/t75enxnb1y
-Xab38MpVYb7VKwek+GavHIvvfbxy0qfv4ZXrwrqnV64K7V5eOSC8u3nl0Bi8u1cODMJ38MohrbmXV45b
-cFevXNfEO3nlqhLewSsH9O8eXjloP+7llUPKcFevvDujQ1C36d3c8ja9v1tO2XtXt7xj+zdwy9v07W45
-OVh1F7eccfMubnmbvtUtb9O3u+Ucx1vc8ja9j1ve47mbW45Rvptb3qbv4pbj4fZObnmbvr9b3qbv7Jbr
-Mr2HW64K861uOSDFN7vlWHzv45aTPn0Pt1wX1j3dclVo93LLAeHdzS2HxuDd3XJgEL6DWw5pzb3cctyC
-u7rluibeyS1XlfAObjmgf/dwy0H7cS+3HFKGN7jlc3KVBk2209+qIfsMGIAmFqIQ5FkHIWe0KYRyQtu2
-ex3XrLNhBupsDA88nQrIhnW/Dq6dxcN8ZPEYPngukLF89F8oiDSOw3ykxzF88EQWY/kQYjJcvU2HGcGR
-0TAjPAsDzMicH+qmxqg74q3amQ6Mnv2+KGfBRVB+sBrblCbZ9wet6W8QlB9D1w+mg+D8yLZ+iBsEPyQt
-intY8tPAcbL/+tpD8nS4tFwY2kKb5DfXOcFO75SWCLLj5TxPu3aV6sLjdfmtwXL1wQTvGMM/1+cSc1I7
-nz4ZWvHoFJXzSWnA4+NlTp9kzuWqarNZk3oOAu96ndeVW+TpK3DAnml6n6PdF2601fILPJPM6jisZndn
-eY/KdaY4/BQO4jPCLskeGe1StKVpy2fAG/KkqiZjkCSUZxyS544xclFRiihnNO+8dr/qdU7vc6UZ7Fp+
-u6vnzH12Zy/9I14K5q3DR1GHaB2lOqnlq1V9qCbNsCZUJtUWWl2QLDUXQnU168eLG4QscXMQfpTfhB57
-oyRpe3HXvM5areN7vJLvqbWIVerFIr48YTZ4RmwZ5wnzwV4pjJwwI+zVWq2FORGSPMgvCSuCWohvM9qK
-/vZjtXp26gAMBPBU8I2h6WcFz/v2okGdOqge1zfJz/ym9KqM5JvaUrkyc1ZQjH3drzwLnilfxqBdepZu
-RKvpuBEvJ1STrgoq6j1e55nrXfh1vlJXNK43m2ev3Ws9xWhWEZC2BwHSi2Y7FQ+UWTRLVVR6WtHM9Tmn
-evLDrHF9QsaXbv/U4SoC1/Zw7IZFHXKnYuTXBOugqYqUXkKrA7pB1wSgBQGhF2j3lyoNCAitALgiUuFf
-wSdec6ywr6Ds79CVuV9w7n2d+QUhthCZ93XeF4TQQubd11lXsPFEuzrnCkKaWldjfNkxDvX7khBbSqxD
-Hb8ktJYK81DPKxg5+1DXK0hpA4C+D3kTFnoDQkIuFBuw0NkPCaVQZn+hM69gY8wvdNYVhPTeFwWsdL3u
-9kdpOJfEwJSv/XvdwpTEwpStAAOYmHKnYYJsTJlqyHQjU7p+f1mlNn5LYmXK1x7IYGZKYmbKVgA02Zly
-p+E0Gpoy1dAaLE3pBvKlm0ozAkIykJsBtCIg5AK1FUAjVIwma1OmGlLY3JTuomuCr7dgQegt5ExLegMW
-hNZCaYCv86/iM5icMtVQgjandJc995AEloTeUuYfEsGSkFuqLYBkoOI02p0y1dAaDE/phl07FnorQkIx
-lFqx0NsQEmKh0oaF3gIVn8H4lKmGErQ+mZt3ToMLeg05neRzyW9wQcchp5N8rrgOLug7aHhZS1zQfdBQ
-s6umAQ8iD/oGQe2hk34eyO2BmkMn/TxQmwO1RsXatQZqjIpYuDdbaUvnULiAR5FTJyCXfAoXcCpy6gTk
-ilvhAn6FhpM3BHAtNLS0Hbp3kS/7ZoAyoe5AvpQbAgqFugP5Um0KKBUVb9cYUCwqatYcSC6ds+EC3kZO
-HYRc8jdcwOHIqYOQKy6HC/gcGk7eFMDt0NDShuhjn8SUrCFaTNmQ14SqAEfaosFWHLaVYCs4Wt3BmFmL
-NPAURk4apQCbF5wzt7ZEcvgd4UcAAuM5BthKgHBUB+I0xHYgWijCq4eCPAzAqQ6Hegy6laAtAR+I3Rb2
-gQSMwV89EP/h95z8YBTIgFsJ2BwLgrgtESGI3hQX1vbQEL/mtIcCRAbbSrDGMBHEbA4WQeSGkLEeihox
-AKc9HDsy6FaCtkSQIHZbHAkSMEaTtT2gxK859aGwksG2EqwxuAQxm0NMELkh0CTGxRRrUhNUvkpQYMTJ
-IFsZEo47YayG6BNGDMWgxJpYw1BqeMpXCdQcjDLwVga3hKQwfltgCpMwhqfErNgiVGqAylcJ0hinMuhW
-hjZHqzB2S8wKEzBFrsS+WIJXaojKVwnQFMIy4FYGNgayMG5zOAujNwS1xLhY41pqh8pXCdQc3TLwVga3
-xLgwflukC5MwxrvE0lhCXmqSylcJ0BT4MuBWBjaGvzBucxAMozeEwvVwNExAuHkeExPzCq1cwRYZG2hY
-42MDGXOUXA8GygSiY2M4XObwrQxvCZoNFGyhs4GIMYCuh2JoAtDxMBhJc/BWBjfH0wb8lqjaQMIUW9fD
-4TUB6XgYEWTzCq1cwRZqG2hYA24DGXPYXQ9F3gSg42Iw/ubgrQxujsIN+C2xuIGEKSKvB4NyBsGZGBGa
-9zVatYYxQLdQMYfpFkJQsG7ZlpW5WWyO1vE7wpkABEbrDLCVAOFoHcRpiNZBtFC0nsUD0ToG4FSHo3UG
-3UrQlmgdxG6L1kECxmg9i+3ROn7PyQ9G6wy4lYDN0TqI2xKtg+hN0XoWW6N1/JrTHorWGWwrwRqjdRCz
-OVoHkRui9SweiNYxAKc9HK0z6FaCtkTrIHZbtA4SMEbrWWyN1vFrTn0oWmewrQRrjNZBzOZoHURuiNaJ
-cTFF69QEla8SFBitM8hWhoSjdRirIVqHEUPROrEm1midGp7yVQI1R+sMvJXBLdE6jN8WrcMkjNE6MSu2
-aJ0aoPJVgjRG6wy6laHN0TqM3RKtwwRM0TqxL5ZonRqi8lUCNEXrDLiVgY3ROozbHK3D6A3ROjEu1mid
-2qHyVQI1R+sMvJXBLdE6jN8WrcMkjNE6sTSWaJ2apPJVAjRF6wy4lYGN0TqM2xytw+gN0XoWD0brBISb
-5zHROq/QyhVs0bqBhjVaN5AxR+sYzB6tE4iOjeFoncO3MrwlWjdQsEXrBiLGaB1DWaN1AtDxMBitc/BW
-BjdH6wb8lmjdQMIUrWOggWidgHQ8jIjWeYVWrmCL1g00rNG6gYw5Wsdg1midAHRcDEbrHLyVwc3RugG/
-JVo3kDBF6zzjnDlaZxCciRHRel+jVWsYo3ULFXO0biE0Mlrnh5cyNz2ao3X8jnAmAIHROgNsJUA4Wgdx
-GqJ1EC0UrafHgWgdA3Cqw9E6g24laEu0DmK3ResgAWO0nh7t0Tp+z8kPRusMuJWAzdE6iNsSrYPoTdF6
-erRG6/g1pz0UrTPYVoI1RusgZnO0DiI3ROvpcSBaxwCc9nC0zqBbCdoSrYPYbdE6SMAYradHa7SOX3Pq
-Q9E6g20lWGO0DmI2R+sgckO0ToyLKVqnJqh8laDAaJ1BtjIkHK3DWA3ROowYitaJNbFG69TwlK8SqDla
-Z+CtDG6J1mH8tmgdJmGM1olZsUXr1ACVrxKkMVpn0K0MbY7WYeyWaB0mYIrWiX2xROvUEJWvEqApWmfA
-rQxsjNZh3OZoHUZviNaJcbFG69QOla8SqDlaZ+CtDG6J1mH8tmgdJmGM1omlsUTr1CSVrxKgKVpnwK0M
-bIzWYdzmaB1Gb4jW0+NgtE5AuHkeE63zCq1cwRatG2hYo3UDGXO0jsHs0TqB6NgYjtY5fCvDW6J1AwVb
-tG4gYozWMZQ1WicAHQ+D0ToHb2Vwc7RuwG+J1g0kTNE6BhqI1glIx8OIaJ1XaOUKtmjdQMMarRvImKN1
-DGaN1glAx8VgtM7BWxncHK0b8FuidQMJU7TOM1Gao3UGwZkYEa33NVq1hjFat1AxR+sWQiOj9S7FR+a2
-qTlcb1MWWgtAYLje8iPJIiAcroM4DeE6iBYK19t0IFxvUxZQC5DmcL3lZ5RFaEu4DmK3hesgAWO43qb2
-cL1NWUgtABrD9ZYfYBaBzeE6iNsSroPoTeF6m1rD9TZlQbUAZwrXW366WYQ1husgZnO4DiI3hOttOhCu
-tykLqAVIc7je8kPPIrQlXAex28J1kIAxXG9Ta7jepiyoFuBM4XrLz0SLsMZwHcRsDtdB5IZwnRgXU7hO
-TVD5KkGB4XrLj0xLkHC4DmM1hOswYihcJ9bEGq5Tw1O+SqDmcL3lZ6glcEu4DuO3heswCWO4TsyKLVyn
-Bqh8lSCN4XrLD1hL0OZwHcZuCddhAqZwndgXS7hODVH5KgGawvWWn76WgI3hOozbHK7D6A3hOjEu1nCd
-2qHyVQI1h+stP5QtgVvCdRi/LVyHSRjDdWJpLOE6NUnlqwRoCtdbfmZbAjaG6zBuc7gOozeE6206GK63
-KQ+lRWBLuN52x7ilCrZw3UDDGq4byJjDdQxmD9fblAfTIqw5XG+7M94SvCVcN1CwhesGIsZwHUNZw/U2
-5eG0CGoM19vuALgEbg7XDfgt4bqBhClcx0AD4Xqb8lBaBLaE6213LlyqYAvXDTSs4bqBjDlcx2DWcL1N
-eTgtghrD9bY7Ni6Bm8N1A35LuG4gYQrXeYZac7jepn0gLUObwvVWOEuu1DCG6xYq5nDdQggM1+cNahs3
-K/KCJPO7HIq8cQ9RlqSv25//7aciL9y/o+M5jarZTyhPi9lPRR7ti9lfirwu0qiePfw12aEqapIidzD4
-w+zhL8W5SlDl/A29PMw61CKjhChLiXwhP2gyWS1LMgMlSf/1nIMaHLseQE+XqEE21TnfRw1SM3o+k7dd
-IUrTpKyTGsjAyBCRdKVCE9ScpeQVzVcqQGlJS8k7lhtYgNPS/poTDZBaPLHtEENdcttBnvpbt21sWY5U
-UAWLx/HVJbsd5Ku/d3AiX3zzCJXecRxfXfLbQb76m1cm8tUtk5F6PBHuEGNdMtxBxvrc01bGWCuKF1Tt
-oxpd2GiJ8vpQVNm2e6HhP5clXKV7oet7VCZNlCa/anX6N1KOWWycXkj6RjelKYD7ku3C86zAqJLAWZmp
-CrUwUo2lmcCuSGMJdm2HVXihRVoF0gV7Clk3ryna0hLdPmLrdKFpJD8cDgcNoKySLKpeOYjnrXcSVCSB
-0TSxM6XwhK1ijyFc7Ra6tUD7Io8FSqv9OlzHOqUOUKbVF0vUlk/LQ7jUqZ33e1TXHCrYROtlCNCiYAol
-VijR8Z9WT4HevUl+KDqQdRTsNjoRDCNTICVypx1Wq7XejJeoypP82Mtv73trnQIDk4nwQonOLtrsZP0j
-sHGUH3ugeL8Iod6iUDIVViYRidZ+HES6/SPjkjdlc3g6RDoNAiSToEUShf0uXsQR0IzqKwdZLBfR0oMa
-UX1Vm1B9VYQd+Et/paHfFXGnvYEfhMGTPvecGxQbNZyhSaP9Vzf0Llpy17mcfbgfwAp0EIYz/j+ozimJ
-qb+29T57TvRMqxIbWkYVyhvqywjZjPWcsyJ0n1GadhjaF9Svo5mRlUItPTJtdYWir5eXoorp45b86+IC
-IY14l1Wd3KYEgXC/CdWIK1OSn1CVSPMay0t9IX+TNGleeapqESrJATgtfzubkMsqyZvLn2Ys/e9su92h
-Q1Gx5oOZoZ/NOaOjbV40n+a7Jn/U+u+cx6hKkxxdo92u+qVJmhR94VmHuzzBzqcHJ2qa6hN5/+g8PD5c
-ywpJLm5ZIVdxcndpsf/6v89Fg2YYmonWL1unLtIkdj5E8S7cxc9ldERUUm6S10mMttG3IomvzQlF8SVO
-6jKNXrdNtEuRi4tQ5WL9Ka9Jdpw11cVU/xTMTotZeSmq8hTl9Xbx/JLExUu9XdBXYkXSYlbvn3H5pU5+
-RdtocSVDUfHcRMniXoqSHFU2oDz6touqri1YQtf5LoqPULd4HlZ/3Fz2Eo+TNCprtOUPkpJiSKeJZ/zp
-dNGGmDYfk86k2FHcVxaKTgBnMUIBWumIBGvIRoj4xmlwH35P/p1J5bH886T8RJHAB28J5YE3e06geAWZ
-h2ew4vX/+/ynD05dnKs9+ikqyyQ//sff//rDriiauqmicp4l+Xxf1/MsKp0/ff7/AwAA///R/xXTbmAC
-AA==
+H4sIAAAAAAAC/+y9bY/jOJIg/P35FdoqFLpz2nJJsmU7bXRjZge7uAWm58P2LXBAXx0gW7StKb2dJFcq
+x/D+9gd8k/gSpCSns6f37rZ3KmUqGBFkBIMRFBn8/Id/+v+cPzj/XBRN3VRR6Xxbzhdz3/n+3DRlvf38
++YSaPX85PxTZ5ycM/+eifK2S07lxAs/33cDzn53/fkYCnj9dmnNR1Ubgl6RpUDVz/i0/zDHQX5IDymsU
+O5c8RpXz87/9d4GHpDlf9oR687KvP3cMfd6nxf5zFtUNqj7/5d/+/C9//eVfMH+ft1VRNFfX3acXtP3o
+eev98bhz3SSPk1Ox/bha+d4x2LlueanKFG0/ro7L4ODjgiT/uv2INgu0Oexct0Lx9mN8WITLcOe6RRXl
+J7T9eIzXyF/uXPcVpWnxsv14PB58b71z3VOFUL79GGyiNanRoCjdfgy8w/Mzfn14jfLtR38dBfvNznVf
+zkmD0RHeTlX0uv24OqzDdcx+unFUfd1+XCwX0dLDzFVJFlWvQoNqdCjyOKrEmvXlcEB1LXCR5MdCJBtV
+eZKfBLZj3K5KaGmKxbX9eNwcn4/RzlUZ2Vco+loWSd64bb1VSupsG65XZSuXZvF2vdqopelp+/wcqKVt
+uvUDzyPFxyJv3GOUJemrW0d57daoSo5bNyrLFLn1a92gbPbPaZJ//Tk6/EJ+/muRN7MPv6BTgZz/+LcP
+s38v9kVTzD78N5R+Q01yiJy/ogv6MPtTlUTp7MNfi6Zwfony+sOsJzD78CdMwPlzkRaV8y9Z8bfkQ49T
+L/jlNdsX6QeGTayltCEr8qIuowPa/vKvPxd54f47Ol3SqJr9jPK0mP1c5NGhmP25yOsijerZh78ke1RF
+TVLkDgb/MPvw5+JSJahy/opePsw6dLc/zLbb6IjH1Ha7R8eiQtd90bp18ncs631Rxahy90V7OzdZehVY
+2vat3qVJjtwzItL35364c1/Q/mvSuA1qG4wLuVH8t0vdbH3P+9S/jUr3nJzORG3cA279tqmivC6jCuXN
+Laqa5JCiWVQnMZodk9MhKnGT8OOlQrNjUWDGzyiK8Z9TVVzKWRYl+SyPvs1qdMDA1zipyzR63e7T4vD1
+ti/i12sWVack33o7sT3/hXSDsI27detXKKM/X2j3Lz1PEUe4oz37MfCDMHjeEZlEaXLKtyk6Nrt9dPiK
+uy6PmQiwZbn92kT7JI9R++MH1//wZXssDpf6WlwajHzr/VOSlUXVRHlzO1eixhyKvEF5g1Vmx1jwdsU3
+VB2xyfuW1Mk+RbezPzsHs/Nidl7OzuHsvGIicZui3Ho79mNfNE2RbedhhbJbaQPB3XCL9vvq1zhqIreo
+klOSR6nbJE2KvszIG/p8Jc2P0aGgw2NLJg7cKFlpIQgnLpoGxbtBgMOlqotqe0ZpueuGEGHUM1Fx669J
+6eJpJC9ytLO+vUVxXKG6vuqdwFSjeU3RNi+qLEolbUjyM6qS5hansyKdXdLBPi1Sp8CwzgWDO6SS09fj
+rbrFzVXUwrXn3eL4CsiRE8Gqt/VuZFD+70vRoG5QOp5DSO9ndVMV+UlCvC/SGFW3OovS9NoPg4336VZf
+9rP6Ul7Lok6IWCqURk3yDQnDZR1+kvrD231D2MhEKRsR+6hGGABjuzK+3XkQouyGceN+cuf4V3Rlg4VN
+qqpOEClqQ0uybtszHhc9nnC1X5h16xZt86L5/tdzhY5fnugzH6NfnhgWJl+QGzsCOsBndhiR4beS6m3J
+7VDEaPZ1H8/KCs3qKCuleeaxE55oO7FVqdDQGOitV3RpihudezRtTbLTVdGlLInjFHEDwMck1q1vp2uH
+9JzEMcp3YN1bE+1TdGUYDkWaRmWNtvzhxubDaxnFcZKfSBPmazLMeBEfe7SU6Rpz+tSZgKFz8XS7pRVv
+zfkqgHH7kUZ7lHYza5KTMUXGMmi795emKXLejiqKk0u99Vixog5+2XIzyovCsnVw3zvcdpIaboXbR1rE
+MM2SvLw0s6JsqCtQoxQdmhnmP6pQBE/7XI97veAlkOEUCV21aY29pXRpv5ERfyyqjImevnopqth9qaKS
+Genbr81riX6k9b/M6K8K1ajhP+rLPkuaLzPWlbwnorJEURXlB7Slb2RMZOht46TGehQ/SYjhd4yO+pKJ
+Si69snmOuN+oUkhv3az4OxNVkueokqmbXnMG9PeMB+0FV/+tB4w1IimK+XBGh6/7ov0yEwqxMhZfYJ93
+1yEW0cRRg77MlIImyZCbFocolV5lRd6cpRIM+AUSX5rUDfazO22VzM6uQkQ3uZm4HROUxjVqrlmSuy9J
+3Jy3Xs/vrlN12pqtd0vRCeWx7A3vaEXilGdR6wo/VVTyJC4YUVoAjJWdPE+QqNWlMQxT+rIqTsSPMU3C
+tMvyS7ZHFdYI1mtE6m5dYq7oiDMAFpdGBrwyFoklZ/qGoupw/sLtj1scjzVqti4JL3UxEaWSavbkaIEw
+GV5NCPo6xyRF7qVMiyjmPOLO7brNPNCLS4ONEGSEb/UlwyF/9xIrl5s0eAaQh2yDsjKNGtRB0vbRSemL
+VCq4/POzP5ufg9n8vJjNz8vZ/BzO5ufVzOjW68rD/LlQC1cCgv3sC95dQGcRTPEciOW0dDE7L66iRq4Z
++HJ2Xl5VVb1hZs+hVB6wFyvMshxc3eYpimIdWmrFwvNuc9ZXrsj5CoDU28trim0L5+GkumIPLCfWFTtp
+Ma7uuRLdJl9w7EXPiRsf9kBhy9apizSJneq0j773Zvi/uf90mxOnfga49mqAe5tnUfV1hv/pbP88QJnu
+b388Ho4btLjNyQC45GRiiHklFoTsyEthzqDQdEBNgSUjDB6QGhidStOobtzDOUnjJ96fZMmT+U3zJE+a
+JEqTOhN65Nn7tFN8i0tZouoQ1eg21yIqIDyUtL6v4NIFFWWOkEUheZBA5W4ViS0EbD/8z8Dzl//T8/7k
+fbjNk+zkHtNLEl+V+UY0zASqOV+yfR4lqSDisIKFfDxyVeu162OMUIBWO9npZDhsxJmDD4uRvnSxtw+Z
+NmmYdNDcTZdFKPckjoFErV/Pww6ErSsTp5Gsd27Jvy4uuEU/karSZHv7uo+FbqtQ5syXsvglAqQDtW5l
+60VqB2Kl+bqPHZGGJyqWp4xYvBhQCj2qqZXICiWK4R2lSwxuRd8pzKuYlxVy60NVpCmJnrCsmUgWS7w6
+zB0r93VLwW5zrKxRgn1J3QViI9IPy3YnGQNSIo1a4qmJCxxEo/6YoTiJnO97T40sdD9dBbK9QoaYxxtQ
+iayDGyqtA0MlskxuqPS8MlSiq+iGWr5PGexfsgH9Dj03r4qXTm3crHaPKWqxY87L8O8df0EDKvzPTvkp
+kXJF6oQWKbnN88I9XZoGVbVsiz1lzUoA/Gl+KNKZWPDrIY3q+g8/HorU/XKVO8KTe8G70doY1Gd/PPaX
+/w7oX/ZnQf8s6Z+Q/lnRP2v6Z0P/PNM/uBfpU3rif12/f/L6R6E06B77p0X3tOyewu5p1T2tu6dN9/Tc
+PfX8ZDH/6/r9k9c/CqVB99g/LbqnZfcUdk+r7mndPW26p+fuqeenzvhf1++fvP5RKA26x/5p0T0tu6ew
+e1p1T+vuadM9PXdPPT9tyv+6fv/k9Y9CadA99k+L7mnZPYXd06p7WndPm+7puXvC/AALqhOHOtHzazdM
+ywodUVWhmNp1j47WfVQn5KNgB0bIfkNbnwKcquJl6ytT961T8w4/WZPDJTv5F63EDI2Ow5cRbOYL8n+f
+dkBRX78ro0gCGYm/mq/w/60/7aAygY2ukOJZyHiC8NNO+tHXDEJWZSlXWSz0BghlPYK+kOIJZTxLX2+C
+UNbj6QspnpWMJ/Q+7aQffc2Qi2CtVAFkEEJCCBUpbGQ8K0AKK0gKK0UKzzKetSiFtSSFNZeC7ylqBIhh
+A4lho4jBV/TxGZDDMySHZ0UOvqqTnigJYOnnNqcO3zGp6qar65LCrevv+AOHSyMdzF/s+AMH81QYj4F4
+HMLXsHAkHCJQIQIGEXCIhQrBGen4WKoQSwax5BChChEyiJBDrFSIFYNYcYi1CrFmEGsOsVEhNgxiwyGe
+VYhnBvHc9ZjWqT7vVb/vVr1fu47tetbXutbnfevjziWLYq5/FT0hYdSx94H0XjRqDGAhAQRh/2YpvRGN
+EgMIJQDR2jCAlQQQev2btfxG53sjAax0vp8lgLXAt+/JfaIz7su9JoxPW2CA3YH3nDKZs/HWWRO7Q4+Y
+OLEv9aC5Eztj06dP7Lg9aAbFnt+DJlHsOk6fR7Gb+aCpFPupD5pNsaM7fUIlXviD5lTixj9oWiVxwJ0z
+a52NnlzrbOz8WmcjplhpuJpmWWksmiZaaZSZ5lppUJmmW2m4mGZcaSCYJl1J703zrqTRpqlX0lXT7Cur
+pnkCltXOPAfLKmWZhomolTWJ/tXQJE3EOzBPE+mapmoi1YHZmgh1YMImMjXN2USWA9M2EeXAzE0kaZq8
+qQQH5m8qPsMUbl2nS90sfs85nC1gvHUOx0ssj5jD8frMg+ZwvMAzfQ7Hi0EPmsPxatKD5nC8HDV9DsdL
+Vw+aw/Ha14PmcLx4Nn0OJyt7D5rDMa5HzeFkbfHOOTyLR8/hWTx2Ds/iEXO4NFxNc7g0Fk1zuDTKTHO4
+NKhMc7g0XExzuDQQTHO4pPemOVzSaNMcLumqaQ6XVdM8h8tqZ57DZZWyzOFE1PAcTgRsn8OJeAfmcCJd
+0xxOpDowhxOhDszhRKamOZzIcmAOJ6IcmMOJJE1zOJXgwBxOxTd+Du8/m6VuenrPOZx9FHnrHI4/2zxi
+DsfffB40h+OPRtPncPyB6UFzOP5C9aA5HH/imj6H489hD5rD8fe0B83h+IPc9DmcfC180ByOcT1qDiff
+K++cw9PT+EXu09g5PD2NmMOl4Wqaw6WxaJrDpVFmmsOlQWWaw6XhYprDpYFgmsMlvTfN4ZJGm+ZwSVdN
+c7ismuY5XFY78xwuq5RlDieihudwImD7HE7EOzCHE+ma5nAi1YE5nAh1YA4nMjXN4USWA3M4EeXAHE4k
+aZrDqQQH5nAqvvFzuLCLJXXbd/3+3D7mE3T7oK/Q7eM+RLd3fYtuH/c5un3cF+n2ro/S7eO+S7eP+zTd
+3vV1un3gB+r2gd+o2zd8pm7T0ZN4m46dxNt0xCQuDVfTJC6NRdMkLo0y0yQuDSrTJC4NF9MkLg0E0yQu
+6b1pEpc02jSJS7pqmsRl1TRP4rLamSdxWaUskzgRNTyJEwHbJ3Ei3oFJnEjXNIkTqQ5M4kSoA5M4kalp
+EieyHJjEiSgHJnEiSdMkTiU4MIlT8Zkm8Tk97yidStK2sMsbhWkVp4ln/Onc73ymhx6V00VNUcLnEdiO
+8Q7lGUUxRqfUp7wo57oDIxaccOAH8u9VoGqCd+usbwv5ITRnQfbr01cUF4qvpo3vKqCAti86T6hOuyOe
+GV6cr1KPcJcA7/4TK6SorsVOmQFvY6jwDBZKpPHQZR3XVEmJecMknKba5s3ZLY4uPrf1fRHHT1dtu7t4
+GsULnzgmcuS5xyMd2eYb5K2o1j0ulohlJv/8qYm1krPO38f9Jo6ORwUX1JXdq1grOeslsvAYrXW0F2jR
+PpBrsp7Q2Xw+HuIxVYVm24DOgyS6ZDYztUAgIZRBGONVvIn3Gkaod4WXMVB2hsrAPt4v9uv9Huyorq6x
+lw+bw/5wHFPZ1M8a2HmQDEsRNJN/ir3MS0BcC7Q67BVcYA/zV7FWctZLwL7dHGP/GcHdQ2sae3bvx8f9
+friqsV9loPMgiSQ/FjPhWUBMf4IoEAqRhALqSVoeyz/Pyk+4A1eHYxyBvYCrGXsv2scxCgfqmbpOhDgP
+Imf5qGbyTwF3VwLhOh4R2kcKLqgPu1exVnLWS8DOPB7j4xrWRlbT2J/HI9pE/nBVU5cqQOdBEjSn10z6
+JSDnBSCi8CAMcAoJ9Sh/E6sFZ60A7E4UP68Mg5tWNPemv/f268Gaps6UYc6DBEheq5n4Q8DMfoNY4mN8
+RBIWqB/Zi1j5fVZ/wzq5Px6OB7ArSD1jH6IDOhxXQxVNXSiBnAfR4wRyM+FZ0sTqK4zisDpsDpGIAtZC
+XB7LP8/KT7DrnsPn5+eDQYuqr+aZ5Xm/36OBembd6yHOg8ijA165nEm/BMy84Hwd7btKzND6poZORmFq
+swwznllnTiTHhXi1nnJl2QllES/DZRyGCjo+nji+5XPohWsAJXpGB3RUUMphFWZtDF+3N2ur1BQCaYgg
+PQDm3jgqCMMZ/58YTQmobYEV2CMAYizybuG/X3Zdh/NnugePkqpQXRZ5nXzDEbU5A0p3LrilS/RdFhFe
+Tk8L4+m0KS6H8w1C/5OxdwFO16u1kdMsfldOs3gSp8/PvpHT9PSunKanSZz6/vOzkdU2fVdW29TCqgb+
+nqyY+ZgfiypzD0XeVIWlN9iJ+UOUHr735yHKnB8cup7m/ODgzTG7flmKFtO3d+amNNpS1RSkSbntc5u1
+QN6HA4qXcQTnfSAZM+hhVtFKOnM/rB0U1TgGcYtLMyP5oM5RXLxo7zqdo98Ra7dC8eWAYjcr2ClZ/PPp
+Kne0QJnkDZHlsCWn21FbRnl8tWUN3MFyZDnURnWmHOd5+/h47DKtebu+4eTDi+fQ9BFspvWDBTW9Qfik
+cCBkZyovjVum0QGdSbbGq5x1riijQ9K8bn0NAc7rNb0e7rk30HxD5elVeOq0mVT8a4WiuMjT1y9XozPR
+Y6Qp5ADx09Z8i9ILGqMJMmskEZXMlkvyVhtNBP10RyrQdIBiFkJiN7hl+MHxBYPBl8whECWt6EBSPn8e
+qkzgyUjnY5ALEUBP9TREs84AmsEg0QCmOt+sYaqSbMo0SvIGtY3ZhIs8sX5WGeHFar/bMwiPMVDUGOsv
++Cxdto5napJcjDNXjIETpGBKt6HCg5McOMdRWanJ7wyiAlPmqI0CifsQbUIazt40RJl8MQIMxq/ZJW2S
+EudGht5iEl+kXHk8K6E8qcl5k/AbkngTSDzFXgMKK6QR4xmpCOi7pH7RMr+Q7BScHsvm0v205XLREl30
+mEieSSBrhtxuqbIvNZ0goNNSjyba10V6aZDUYQs1o7JrxNTNPf8pvqKWW0nqpb1Xsz3LyEmaNFFUtEiT
+mFDcC45+Uz2gvEHVjvwg6dFqXqSkX5PEyRP9adw4ln6sm6hJDjso6S/DuvADIFP1/FuUJrF7RCjG9k9K
+jbjTv1j3+gwnUKO3LHCsTVHg8QhIG+MheP/ukvTJ23AnUTak7ZQMlsiSP86CDQbiS2/mr9az1fNs/vwE
+OtuyxZsnxDVJ4tn8JWKPUYNiR4LaknJlBYN2lZJpBghMnkRWkyw6oe2lSr//EEdNtCW/P9ffTj+0WTr7
+tDjU305Om6V5/eN3+MKQ7efPLy8v85fFvKhOnwPP8zDwd863BL38c9H++B3ZGOVsvvu0QJ8WhzJqzs4x
+SdMfv/sULCiH3znxj9/9HMwXzmq+XvxlvnKW83BxcOdL1597y/ly5frzpePPfXe+Sf257+Cfi/nSXcw3
+h/nKna8Wjo//BmvHnwfzdeouneV8hVEs5qE73xBU/tz/+3efKR+YyU8L9EFqe4VKFDXbvGBP4rtOw+j4
+ckhvOp0vRrvT3+gdStSFwnEw6jw8OWCpQfwsz/iwElBAWBVsQYqgmEFo4uI/ldE8s4Ox4TmCax3x6Crc
+Bsj3VSj1wXmY4pmB77q2XKeNn05PmqI0qYddeW7zw6VuisxlHobRAEhgEy3A977YAOcPzsL57CyxJ6W3
+6dHWYOmEoDWgK7fMGjjeXzwnOC//nnlO+BfPWZyX+uB1uqHKupSttNAx+nlTto7vle3s/x5z5uCpR+gW
+yVox2X6eZI1gZTSYI0AlH2WPYD50u2GHM1kkgPFBk2SpY7BJ2sLBSMMKw46xrqTmHSYWqGdtU+8xCmyq
+/rCBYl93a6h4VZw/C024Gy2g9l7UeRvViaZqhj5kmsT7XmVUeQ33JYRja0Gg9ukdPPCcxW/mRbhCS7cS
+g6wZLIAdfMAQgKyPsweWqveIf0uUCT1OBOMQKqJYLA8IXBClL4baQOaAx7VgBLrrHXOLjXmad5x13dOD
+mzIFuX2MYLNtGrvkndV49LW3pqp3kDeMTwvswODU2Rw3Mk317MMS4FNRwOkda0Twdj8pyR+74EIvqezx
+/tdbcgkCbxYupi25sNbaw1AGpMiMdtg/aNmli0UoF0Lg4gaOGzhrZy2GLnVTFV+RVAEHL57jpQtnkXnu
+AodePMo4JNUhRU7143fzUCk7tD9+t/gOfvVqfkVrQRA0ovmX/xILNEkuDOpRKgMGRUxxbINdUGVwkYah
+/0/NCsyGQUct1lgITKr2hkUbPjSNyzZ8WP4OFm5MZkQCnGxH/t/izf/tRvE3WOaxGzVQgR9l1Uy8QFZn
+GHbcks94wzZQb8rSz0hzbYYfvwR0n+E21J2wFNTVnb4YZKx6VVxTK11T1w6Aj14WmtqxtqoT1we6+vcv
+EFlRqP18Fx/TguJRqEALM4I9o/UYrjJpuWiyLRmofp9aPHLhaBJKRTxoufJWMbTdn7wYbsfDFo+S/DHL
+R4b5yt6Axy8g3Y3ePn7k9Q51QE5a6zBXvosF4/gdgB+/nDR55NrqTllWglXzns4eu7R0b7QJ7FuybzEj
+d/FWxYvTbzOTi8buaZIYEKdTIfGF5W4IsbJ8D/dwGwY46wHL6PCVF/7tUjfJ8dXlNzqyYnhTmNQquhtw
+NHumtFmPF8EY5tUjEtIt50ImL/jmdjNCYOuucsekWFPy0mfyOzJeWCf3DJnV6x+jJ0JXgftxh7fsdTso
+O7I5OpESnrytPldJ/rXfyQdt6wM39UF9zQX/Hr1hpWjYbXmb75scVkP1dI28T1u4uJ8xBepqd7zoUqOK
+x4NkpZucyABKa71QKxizSbw/tSO+HHewaGjPtXrex3TQR2ETgHj3g0JYvPr5oH2Tg+lmiFT7O8YpOIae
+syUOXJE8Xu871oNR9QdVMDb+68pPocxX4S2SAfmF9B2wQwCu7KZxF31DeVP33PJEL/ZTsJ633muHlmih
+hGXE+dGPnrd6jp81XKvgcJBwCf3YoecrQuZeXGxm/pJ1I+vFDqHUmx3Orlcf0wPEceY4n8jPjvDTnJ8I
+H11jy2vUZ7wzXmpOVZRx8YLN7OmUosFeDw4Hjf/wsL+D/y0gmjGt4PUG23KnoLtkOva+YKfD5L7ortIW
+8YzR5zBaBauNgi1chvtVoGATNbonMdxUf+HNfNJeqK2KWveIxyn2hM4Yr9oj6wDKLTRrinrT/lbTB6Aw
+DPf3tGILSuo+FTe26G7J09RG9g7pPvVD2ysELGM0PPA3m4Wq4T5ao8VSwiXpN0M/3MZ1MPM33ux5rbVQ
+1WyGcpxej+6ACVo9pgak07w5UzSa9q/a6Yf1cuFN538LSOZObYbbcp+ck/xYDPTCOgr2muqRwh7FGB32
+F5vl80pF5K+jzb5HJCowQTzcqnAz89ermf8cys1SdJdgG6e445o8XmuHwQGVpU2YpK+kL1W2vbW3Pk5k
+e6tK4T5NhZpwp0BZ+q6hhJMfj8eD7613atoxXCghGpe/8iPyoo2n5sSJF8/I8yR0otpyCsMNDYJg5q9x
+1KE1VFFejlPV37f3w3gtHlUDUOSuRbAuG5tAu1lpwmH1HCp9P1mjpzREV2pDc+4UN82kZh/X3cIutMDe
+Ixljgw+bYLFYKKj2ceAvPBGVqM4M+ZjmhbPNQppdGDZZlxnCcaZ4bNvHq/GYCoAW86ZMMci0Y9XeDvxj
+EE9mfquL5D79hRtyn3xJIrBhW7Q5Ph8j1RaRQgHNWIscoBVSkcUR8lAoIBMVmGIf0T5/NQv89Szwn5UW
+KgpMEY43xeOaP16DR8ADCsxaMtUIk57VJsD4OT5OZX6ryeQ+BQYbcq98x6af26mnJ2hOOo5iVAy3CNaB
+5p7FgR8se0Sy5a2+jmjXJphtNrPnhdwozehWX0ea3HENnmJwq6+jRC1bqeqrKuMB75f0pOa0+5EfT2R7
+q8rgXkOrN+EucbLFa3WdeHA9VKk3bmV4Gk5RXVVyw00V1tsNbVW0WCWhKjRn3/LFBe6a8do8qSag2FoD
+py0hTxT5VHW/p3W65g+08W2aoa0wDy6fajXHjITpWKGxMGmN2dvMfH898wNbqw0jwrzmzBsyekzcs9o8
+sa5lXNy5+nyHEtw7Nt66Gj3Y0jfrirw6PbgMq9Qb5ctMxAmOjdHr08KJOENbTaPCsF7N2R8/JiavVE+q
+aRsP96xcTxb53WPhTSvZA218m2aIK9uDC7pipVFr2VMQQso/cm07WMz8FV61X8INNOg9uNbNeR6t9BNX
+ucdXs6j7Havek2R7r6K/YRXc1rQ3qoCyKj646KvUG7nkMhUtpO8TFsXDcOY/L2ZrY3MNSm9aI+fsj9b7
+6Uvjk2patP/epfLJgr93GLxt6XygmW9TDnklfXDdWK42xuZPRAmNgglr6d1GbbidhjFgWFvnrI8eApNX
+1adUtAyAe1bZp4r6Xt1/06q7vYFv0whpFX5wtVmqNXYGmIQU0vzRi/DLzSxYPs+C0DM01KD58KI8Z3y0
+4k9di59Qz6L2963NTxT1vXr/lrV6awPfqg7i2v3gorVYaYy1n4QQtvWjVu/DYBZuZqsl3DyjmQdW8znH
+E4z8pHX88dWsBn7quv40yd5v3O9e57c17S4FSJP869VwoIGtABu3vpPKsn57XrjaL7QqlzxGVZqI9eSv
+pjlXX2NNcTO9zIH2xTT/alqYNG6LJ8d5yKU3+yZneE7XhyWDF2jUmUCjz57/kGT3GCU5omK5PqKD+UEA
+FxMnYXI3chboV3zh1I/7S9MU+Zceeia8rFCNGsO7+rLPEvGleNJufoxiJJ6/YIcc6HkO3NqoGn/ni4KL
+3/USxYgOLDyAnrpzFB65SyKNylp6Laab6iBwxKsfiWKC8Lo7g7bnJI5RLh59oTDOfMHOp4xujUBZbxMf
+8zPylKJjQ58qeushfryUOsc3zQ6+nJMGuXUZHXAn4HN8Gsx2Gx0bVMGHoMQjXfMgDPUrv1kpP4r14YN4
+Afh8gTJ6ComX8jzwKANOJ8n3fncWmlIHq+itQVnZvPI2KSfSOtgM5RdbdjJaoUtS5nueJ+cpO6ZF1Gwx
+2K4/Rep7wjUgzJzwk3LbOc18j221etzKeLKM4E+TunHr5jVFhsNf0+9xEu/280NTvjOpt0gfXqvuTood
+2KdUvFd+RwiBwdCWI7dy/TobSajOjLQAYuvVBiCWxSOJZfEUYs/PAUAsPY0klp6mEPMDzwOotelIam1q
+psbsjKOMGzxKCEZ+B4mSKdBTTgAzzQfQvb8B8u6wO7rRGjRAUKuGDBFLaKX3rbdTpWbsYcqY2L8q1t/S
+xkN9ytXK1MVQHaW7rQ2b2styfyjtZHVwfZtg+imCiMhyLlqUjIL1fQQzgRR1gCzgLBGIjbXu+Pd7KI0+
+Dq3aY2vLkJbYewDWkk43fm3pBXcZypv/9SPl8cvMBoPJ2SFID9hBmqL8Itp31jkRSVPQ1YuTb0mMqmvn
+y3J3hHknqmsryEi495FelCegTRqUDd6OxqMdn4Y7hxRF1XZfNOfxZ+v51XS6H7sbeZWjxDIPteVCKbb1
+V/7G34PhsHHBTKHSrXHIZGipuDQxkgjfjiUT6eNhmcz4oHhgYUfSPRI9qWlqOogzimJUya/VS9bm4G14
+egwss20NYHB7oXvPDPonKpkQrM/6R5cPdcsVYyNv4TKkLtFJCQsF5MVP7Gi/KUHH1nd8IZML/2VETdVb
+JcCUvotwjNWlBTsI/dB7YQWoJ69jNSATcKjMNkWR7qPqETfZyVlG6iaqGi3JCHlPXknkzali5DbQR7og
+eEyqunEP5ySNn7S2ahBX+RI6fBeeGXUadfVAxdKg2JqkPPU9Xft5gM7GPDhUPDnl5dhGW1iTWi5wgVtv
+YkJ6py0JuHWZJo2S6ncergLp1k7qZbFSAw7mQcwsniUAeCntUKMdEl6LuSWSD+YBq48/wAj4ouQP4zpK
+ud2UOefsekBtVdVKMz2NpAmR1Ch2trobvXFSoQPPSHPJ8h1cqiQfosNdzD3UD/Vp+YeGjbv8QjIa/cKt
+EdJqPeQKZjOCXTvFiphpWcwJRG+0XQGth31UT+yZMexOsjW6KRR5ou3TiArF4uyupUgDazjCMjtJ3rUv
+2i+zYVjMX/FlmI0p+A3VKClgKZUsQuJxx9YZvSf4s4w4edp9rrdO7XywV6g5nKXhzsvEcSjw9ZOYJ3EG
+vuEp5KR3YmI6yyshZ91kp4+z/ElPumdqwA/DrZHAwKZJgJZ2Sjh+GNuPo2hz0JG9bCMOwg2K9IfpAh7L
+BFBjkB2xjlgOeI8mUUIZQ83JRs1C4S6/kVfFn1/cwVJXeXmzcaLOSjamVNi7feAhfuQJfIChB/jDps59
+XNJKMw2tV52xujS2NveiHymtnrbY/Y45Ye79EnGjskR5LPWBW1YIFw4KCMLkUB8EQOcYVhb4UAqM6IAR
+LSG1DmgR0Q86c9Jb8QW2ZTCoCmXBqoPaSYit+sHek6NRjWDXAquW2A16pzry5fwaWFSKUAZc0tLa2zPb
+GpKPmpcE+704wlqx4W6v5XPohWsgL+uYpWO2wK1/Kf94QPEyjgxfyNW+MjjUNjDmQIvfkGS8OK42+wDk
+tTZp8GuPnviyv3SxEe5V5wcHf6R+Eyn9ra5gP+mqbgK0Dya1FlPzEfg7SE2zH7fxS0RdZ9ZerDO5F0cI
+bB6aJDaRlv52lMRMgHaJ1ZlBDoP4R0jsAdvoJmi+1s3KepXPF6ckX0LvMsGTmbJoMpuEV+20IR8YQGdY
++plezaohI9VjrG48xP8bMx7uGAxgG0Tf0rAoNRUJLPuJGE1LafdUtElpgr+sZI83LxSx3QlJLgx/bSmZ
+lgF3smi3Voz4zif5WT6MV8q43y2RcW/Z9XfinlWg8sg7dcTtgEA+D8uXZZDoW6/AUXOfm4m84WKajbeP
+jS1Qdt/Tj4sTOlHrr/0iXmu9SwsNLHDq9tuzeFqJO1D0/WJwam8wZX0MqX648gW7KcqbnQN4Qy33o2iI
+QYcjuHMEv+CjFj9DOxbE3UTwPljqwX+M4n24j5kXTwIbmHW6dvBgzkEu+ytpndD79Dn0PuG/PV8sXnBG
+ab8SiAzhGGVF2DLK4y8cli4H3Tgb8HLQ4/FI79BczcPVcr4OU3cxD5+dxXzlB7j3Fxv8L74qdDkPVk4w
+f14vnfU8CJ2NE8z954V+fejYjsF2uEFVluRRgyaZn9GW9QEM/DbyWTpLw0WnnYTIva13dzY3Z2MvigPv
+61ayOU2kPEneE+iTYH7SABYtAFz7dzJ03aXjLoXB219pu/hOHsRGvbC17x2Von5JmsP5KnmAgWI4KcyA
+5EhNl1Xlpp9vVZDnqihN1aBzAj0qTTwD0Yt4AyH+p7MRKXcD+Q3liLwiSzyus8TF0oqCUK7bLDpnQoyL
+J57e8VafGb8tiZA7FlWmgYicmKHe8+qhfzwHI7pp/DG0CQqpHVOzV77Pcgm+nNZM2t9p1KD/8T2/xtz2
+chyH72l76GqR5Zo7sl8aXPRbi2NbXTpndsex3+M1bekcvsDs/6Br4u0hw7hF/04p8bpPVEX5AQn3uamF
+yu8b9J0cDGh3910vBqDfkoWMb1F6QVdZ3mBvKDh+zS5pk5Qp+qJco/4r1rIvJMImjz9+8D986ZavpRsJ
+pT19mo8C9cvYhJNqhMuqkxajtoyEL6gAGXwue2i5vWuEEA/2RyvpaRRwHym4MK0ykJ6GPtDI9EHyOnX4
+S4Z0q6xlCe1+I6XtQdL2btg+Pt9Da+sBS2cjdq/AY+6OkSbQ0pZpRHJDmivgSaP89D3Kn/7TvNWhW1/4
+56p4qdEHAA1Q+1dsut09qfJFRRU1TfW9APB00xEYlkm8nXR2VDg2fcfEJk5ko2euB9j1m7m3h1rdLZh1
+2x+UVSJzLxjbb2+vKv+hD9rULPCzVHLzsZZTyvSPJ8Rr+QldTaOSynC+lFphzxXzmLmTsKVewgm93W45
+vTpNcIub8yXb60vWWEGwwszGDnqZBuadlLwX/vrBiCnLBD0OFbqtU1ovwv1nWPMUd5ezKXEg2/jWU1TR
+/+0iTaMqqlo3MY6ydZwxfrJU4ocGTZ8iBnBUlzzHk5LbVJGUmYWLTjwZJ47Uw6Wqi2rLljUAScYIX5si
+fwmBTvL2kgW1UBw4BsX6XWvRGMN1pwqp3TOoPkqFyaoj1P89aks92v5ot5Orl5PTkt+zYj1Kh+rRylPf
+qzX1w9UF0AzjC0pVXl0VWMOLCm5avIBrTLJWDigfwXQpy/68HvvkH4ILC2Nxd4tPBmfFsEg7FosyAbCe
+j9ExuqSNGYnm00xmQzUmoynXY0mCq3MzaHe5vBj324zgqSP2Aa1hAzuPvj3iSLS0ouBpmwOUrFSELE0z
+aE0CQAYAB+W7yrvf9Ew6nImQA80n5DdQlQ7jaKJ9LZ9CFCNEOmx7SIc8kWwXcg/QzdIyGGm+FnUCRkvd
+/cT9ZcMONh6h6tTEHlTe0L6U1zloUOh0f+HW2nr5LlsNdSfJJ9HTg5owlxNmjIj1ZePrdH/JumZPQM7s
+oxyL3d1zDhTjLpM01RUBkqEC2eVCEN7RXKAdrhFXOFHUeJYSdHbo+KC2W55ioeebExQDqFxq0CoU09VF
+j6B091Gd4E7qwciqyTe09SnAqSpetj5EsYn2/CT1T+RHGQnb8Ojol2CYXijJSPLo2z6qfpNDpOYjDvJx
+cXZOXDsvTg4juHvUvCCUm0zkPqp+muMaUZKjaqYXucf0ksTX33nzeFvcfSWux0urzNIi98KHl9l5ubbh
+cmDTvjmFkMyaYEv7soEZCQNOm29Hpii4Y/ZlvAgWSP4A4ylI5UqG1Jh1EzXJgaW7lEhJZ4PM0jR+sugQ
+8SStRgtDQgrByJDfQ3Zm/MlF3h6y177SjhhoH1ahcyGjklFZXQJ9mhCYUlSTl45QTgbqJocit36GxsvS
+u37BGWWGz8FDWxtpr7I/Yrd0onTwP30my6jtEoKG82eappMxTz/h0dMXqh0EXzKLOKD3lmSkKu5eJ0mO
+tKp4cdgRMrBwahohlZwj2hTAZFTFy04vsuNxhrLeDlU3mJO54avn7a3Sk6crsbuFAgvTnT0BjPI/JVlZ
+VE2UN5J5FopNRqhznpgRoimejExwayJ5MYDSr1drWOmz2NJtWax323Sl50lxVdy/qdJn8WOUPovfpPRZ
+/FClnyy9u5Q+i38HSp/Fk5X++dmHlT49CT0zs728W+l5cmYV92+q9OnpMUqfnt6k9OnpoUo/WXp3KX16
++h0ofXqarPS+//wMa32bWvqtTfV+m671XZZwFflvqvZt+hi1b9M3qX2bPlTtJ4vvLrVv09+B2rfpgNrL
+8L+lcpllMHn8yNUforJv0ddHKuuUXrpHTf/xOmpXUP4ypbuEpZUpaTM3/m/+/GSroATlEISYWHoUYlnE
+esVwVEUrZzKkicP1KELqVwIRw8KKgS7h/iSv/w9RE9bJh0Dx+rkNEFxfHyUirleQcOTPEOI7fwAdXaCZ
+eoBL2k6/wP//3Yhd+MDpPsaqw/5/Hj59x95RB+LH74KuIE1ydIjKH78jjHbFWdKgKk2ypPnxO5/t3l86
+63MQ/Lx0/JD+DRbnIABOiMFdg1cYJ4wBDO9Ek2RJaliHCwMZMZLjqPoKmpTu8xMMpZAHANS7BWFkRtuB
+twHy/4m9Z65tY2nAfkjE1qOogVZEwhNY8RhtiYWkYkoskJIl0eGMH+pghgHroYgHsCEShP9kxfx7MCSY
+T6f/5x9mTuT+UazJqFEhmRSLVCE7AkHoI/kQVfGbvlmO/KDUx4Le7qWoYurE7SsUfXXx75HXinUbJ4Zu
+FQuM14rhFv90VnZRyTcaeQxqTr5z0QTPQl4ZRyhn13r0L69v22Oh0U0jM9k0UqlCOYZkykCCG5G2uy/i
+18Hv9vwjlS9VbZImRco+lflaAKgvewmGbtZegGn4OE7UNmI7YajB3Tsd1A/9o5R8UG4Ju0+EN3PODz0G
+EKc704lM/N/cWzztTNt9VI2VqENKxQQmHst28Tb8Jwco8hxPQviDoD9jVbjr4WNRNOYeGdsD8rU+huZT
+UoBydydGPGdUD0itpxuvpEHvzleQQF05LyRRjw5UFqXcwXTzjJ2GhI3VTrKTi3U3jV7HH/ZhX+bhkZhk
+J3E/7JD2CHw0RQnUVI2ZjsJo2KzUaGsAgpAdMxMFbJqRbIyEi2sfNb0JuB3yqNgpvEnX8tm3Z0xfsQLW
+q0iRkl8V7wKWk6mGJOmQwtbohm99bD8+7cTnyXO+tk9ZtZySBcYMM45pPv53khJPtXyfmChro+UE0LR1
+MTy9CbV/kPhmRkA8ZQfUeVxm8UHMjmgPZ6PhueWxbHEci4rOHtNIMzN0T9sfkB59GPGoXgUqAN16L217
+txpog/0qc8BYoAO0Bo0ncyEHxiXDcOUHEOhv91Bc8ma7oCellDIVRK55ispus5VYWywHioqqPEd5vfXx
+zqbipca3hQFNHDjtfrvNo8OhqOKkyJnZUG5K1ABESRRHF2d3lhXH4lB6o7AJQ4gXXQ1XZpvxSihN1e+4
+nkelk0Y6mUl7qhWEUleCm/H3FYriQ4UPjzzu/EPnaet+Kikavp1bPomthHw9zyQK+EEtuEKfdIYq2a9u
+hT4cyZv8uz1+nz+MIEbCvo6kGv5d8hhVmPqbMdFAUqmjnBHgeTTL6JTkpNqwKihbbTWBgnIroxNi3+EG
+ctHKu7uh0AYfOpDP+wfhTtrbPzrBAT/Q0bGnXPMY9HjD1X4x9gJSWYWlQxYiLfkSiODOHDYEnxoSO0KP
+g76XfbnHsujSkxNXenpq9gWj3eC6j0CBKauIvU+VMeKIB5jVV8TPF9JFCqNPSdFLhEefrhEHGdkPIwwJ
+dZEiHJVaH8Zo0wST7BcDol9wucDUJirCYkgPNHJ1JiK+L6k9jPCe3gqGBoqR/buGzeCooXNcFJ/sExju
+L9xdS6m31uEnKXnMWk0eM+6GEGXz+z6qEcYCpwQTzmCaDor+Dg6a0h7VzotG9AX7eMF/2dZyKQS5Ul3Z
+XbFvcofR0adF4d5JDEEW69RdLCttE8sKZUqn+16vIG5ZJVlUvY46IBfJdeQWd6XqJxoQ3yo4HFR8cxif
+kqhm7FSIZ0KKqEaHIo8HG8lcn0itpTSzLx/R0HAZ7leBjnNuwjmlsb63mfn+euYHcnMvhwOqaztjwSZa
+L8NbJNdRm8pKRzTUR2u0WKr45jC+KY1cejN/tZ6tnsUmJvmxGOBnHQX7zS0SKiiNI0VjWuavo81ewjQH
+ME1pU7CY+atg5m+WYqNeoipP8tNVvsof8icOvre+RXI1pXW8VGqgEWW8eEaep6KcwygntTQMZ/7zYrYW
+GxpH+Wmo1+PDIhT0k1ZR2sgKR8hwHwf+wlOwzUFsk1oXeLNwoegm2f0yLMTN8fkY3SKxktI8WjZSgBHy
+UCijm0PoJjVvuZkFy+dZEHqy+KqB89Q0/6jQ3dVXTXTV11GDLw78YClhmgOYprQqDGbhZrZiQ+9vl2xf
+NFWRd05kYFisMGS8gRcnFvb1Pp0qzgLnEMdN4GnUbltgwSpFFZQoctTHYL/3LiedtyREyeJSb8PYwWH+
+kri1imvJ38VJnSV1nexxZk2pvUsBvQDlzA9pUaPBz42GRoMMKr6Q5y29TQjI/HBAoX6JySaOjkcFlXOW
+rtVkoM/HQ6yDir3UcRCsw4ADan7MYrOIlz6klAFaoFCNOlfxJt5ryGAWD5vD/nDUgQEmAy9YBKsOVPY+
+/DBcB0vIYi1R3GeS5FQXaHXYK6hgBvd+fNxroFAf7gPkLzig6Dl4h3C58iDefHQ4+qp8EQrRXsQDMxbt
+4xiFEhzE1So4LDqulKl/E66W3hJeSlgcYoWx4xGhfaSggnk7HtEm8lVQgL1wsTh6HXvyhL0O/AMo0uMm
+XmsiPYYHQaQUk4E5f+/t1wokwNvy2Q/8dW9UhOl242/8TQCxhvB/KmvxMT4iCRHMGTqgw3ElAwKMrTb4
+v74B/TTp730UQAOVjMlndRSsDptDJOIxDIHn/X6PJDhI05Ze6IW3P/KPQ1/R67GKMlQ7ZVWcKlTX7j6q
+3LqpkhLV12OFv3f1jHb21ae5TZsCfIu36tz++I645xzj8Hq0mNRO+eYkrgqtwykzOl+KFNr1+KQV8gEe
+triinuBhxYLfdOeVrWxNVFjQIF6KM1/R9ZHRCyNSp+j5tABdiPUNtth1iyr3hDsc5c33yzBGpxmwfTd8
+coLw00xwSbTfoffJUNP8Zq3gUH4/6akY+mQzUgujPMmiBsXdh1xagDsEGhWOXzu07U6SH5M8adBuco27
+RGXjlObhlH7ebnNCZPJ9xvQgmpg5RDyERnCqmzbZlpKbsMnvt04Rc1M3GLoRqS3u9TLenNw5ljAOvtHZ
+8Fb+5ORDZIY/ObEI00RD/uxnDCx5lnUFy/iPdrZYg2YLG/dlTt9q+X5bmC27lLWdsw/YtayQ6z5Cafqx
+nZC5z3A3hUqLqYH2efOOr2gC6nNRJX8v8iZKpxz4BBFo+3z1e9mtI9eCabrGGIVo24Yxkhldyfods2/7
+gGrddmJepAD5llL63ClS8vnrUVKFkP0jBQvy8w+SrSVlDcy7mLrmXuFm8QOFm8W/L+Fm8e9ZuDw1C8y7
+mKLlXuHiz/wPE256+n0JNz39noXbZSCBmW/fPtWS7BQPk277+5pwQX7+UdIVGTmml/qs9zurr/BjWvIf
+xGf2YemOAhXBPacFIUbGnv6z+tVQMDZ2rZ4vyxvqTwzQBmpJX7SMLPHl/2nIlY2aJged0lQcdFyo0xv/
+PYF/OjBimNiNg/WkjjSyxT9STEU/qis5VakraSFAceRXD/6Bw1B/ajfaa0mdaGSJf0iZhnxUF3KaUhfS
+Qp3eqC8z/CMMVHli59mqyOPYxAz/0jMB87hBzAjKg5gU6sTGfzqiX4kM9Sd23kAtqf9sLJGvUdOQj+pC
+TlO+pJAU6vTGft5iX7Lg6hM70F5J6j8zQ+yD2STco7qPk5S6jxbq5EZ+gWMf28DaEzvPWkfWPRM7/JPe
+FNTjNI9RlDWPFEKSGvGNkH8OhCpP1rrq66h+MzLDvzlOwDxuqmAE5amCFN7YTg+aQp04xNJWeO26S33H
+cucIeXT1vNsbhNeXPXK9RXcN6jxkBFVvztsZMjMQYHKyjK/P0oNm8/4nk8cYUEq142Yd3vaXpilyvt9l
+3EWR3Q1cb7oxMqJEO+6uwErzbd4UUd1c+0SdixBfFW358rpZW3IuSB/mNuGTlkGlz4U/lELlSd4IJiWk
+VyF5P2FqcVWU7jFJG1Rt9+ml+h7fff20s7zi4vIMn41JF+mnmOFMIwSY5EXCEztH7YsvlDuCNJhzEmsX
+gOAX/PTf1E945gst4Gz/A3fe3CtoewISL3zizSSfE+VvULd5VsRR6hYlyvWjqP07hz53IG7LNbgreWV5
+Eylg9ynsmLQoZtvP1It8vdDbifIA7momz+qoEe5npSzGSZQWJ+D7G0WIGeNXO7OUltCQJbjmxyhGjoxX
++HrPRwQpOhYVviufHZwoLo24beBeiNkACe01xZNGDfrem7khGXq2l6M/i4/pD/4NnIDSy5ckUJ1bImj5
+pyxGtz5URZpi2zo8JLGBlS4QxldBuGQHwpMRLeeRbR65Aki+nTmWnWFYGLHyM/UDYMzodDYlRyeis/zq
+o/pc4dOP3iA9MqzhYcgrUbOE4geauCzJx3Y8p64dIAbusZZ6vr8VxIDRqDfvucdIsEwT2bLoHTAMhrB1
+3cn5E3FwEr9BDjjBaCtm1Xb2817/JXiCtpXrUwL3S0bOREuvb8e3F0HG387giqJGh9jJzi/RXxMHRXSn
+JSvwyN1Db7otrNu/tRu4zHA35pQsfKpVbruj+PC+ctRg65IS8V/RwkHZ6Tz9VKtgKvUhMTovnsA8y5z2
+OIMqSw3lMZylHL8Q+YFzsClCMp4fHj7OLLb2JyA5jnwBcmiqAzj4/BplsQ61bvuocjMU1ZfKdJTBfX5+
+fi5bNmZJaMWkLYdZFJ9lP4fkrAi3RuHPilwBfRYcAROrMOUYPJHFPASmxOm+CIynm9gN07G9VjeDjCBY
+Z2Iwi7vH9rGd1klP3AtqU6H2xlS7+5oLVPL9Jak1b4oibZISUIzemq89Zecdk6RHA+5jlCXp6xZH+ily
+69e6Qdnsn/H+8J+jwy/k578WeTP78As6Fcj5j3/7MPv3Yl80xezDf0PpN9Qkh8j5K7qgD7M/VUmUzj78
+tWgK55corz/M6iiv3RpVyXH24U+YgPNncvr6X7Lib8mHHqde8Mtrti/SDwybWIuyzdOHVFmUSms7S0+z
+eOK+Szw2xd90lgB3TIoLQX2B4rynqGlQRTZSYyvEGCLpX0nmV6lEhZL2YJMiwjmtSG2vtiYCZpYVplqm
+Esoc+9y9cOZRVRUvgMrIWsJu/d4Il8DPl2z1QUTUjRsdoXiR3cAd41ScxF7f5vvaZTRc3Am/tm6ZRgeU
+obz5Xz82RfllJoI02K/hgfySnhMYRsG4VzHx3uk/K4/F1F8oDWLsuon6XPLV6oRrxvtOP8ZBPCwrH2T6
+kLuFFPWLgQ4T3gg0UNdU7EgL6RzmMArMdxqymUgE7DWRWNdvyj4HveeU/iPw43uQClzuQlo2UbkYIqgT
+6Svei2w/xARkYGdJSLveUrdJsLzUYlcp7s7onsLSl/sJl0zUNIIE6iP8gvcQF/mdiibSALtOoNV1nLx/
+RtQysPMwuNR1HHeS56gSpmwym+/APDTWczpgvGU6elSU5NOA6cSrFuitdNeg4xf7hP/PQ/g/xkP4TVYe
+OhWc5mT44sAWToFgWwKh3W6jI1lVVIuNnohM9Q1+CaNo80s4CPZLlM83ITdadjQ/CXZRwPaT7JuQAOV7
+gtP5geYH/4Pj4rsrxhKQjKJOaGAymfcmDPJaJG0JJ3HFpGtgiry99ksxk7iiZ1ysrAi+FAciRXJ0P0KU
+FBEkTPLmJ9GbMglzJ7RKOrbKv+Asep9kJCeg1EWO7HOh0L9KL4se1zTpK/zp8pfZoxpAM2qX7V38jdAD
+0SPkULRMvNthjCIwTJAm0Fc/CS7hncNapgHKWKJli0c8R+tF0FmcJmSVQ13KCoNUzGwt7z4Ox4vZ4ZMJ
+zyRs7kETqN3vCr1P5slPvjmBeYXqJKWvPh/X+L+BBvb+OofBJcrC4wgtJnggHcYvfpJc9ve1ZiIjoJgE
+hgbCx5A59roaCY79NDWXudOVXGKOqji7PaBs7+GOqriskVdbRtxuNhe2SMF5Dqh6mdUP7fF/9gs0FqPv
+z1iI91goIwzKhNgPSnEnB7DHhB5dJinKi0uNUv2jR/9uLn21I59Om+JyOPM9cGWUu687vajHwCI/0/YL
+aA+HWpnrheK1piiqtvuiOUvfgvuq9lPXJBahO+RIhCOwo5zgwUXiZqdjdEDut6RO9kmKFxMp0zvLK/se
+kZWUXNOwCeQOoNkgrdG7PeRO1bd4SO/dHLXNTCkrK/RNKePbHCWx3lipUp3IYgbQoZsB5XIsz6erZS/M
+/yCfQJ525lcGNjBmqGUQF4TlATZcCx8uY6TDSjfbyKLot9H1QnHLqihR1bxu2dvdyI02Nkqks+29AdXC
+nTMgSqCaesjeF/frKRXHyMkCSUMZTsjbQR269Woycti76aPmgZxaxh62glWRqsOPF2NRGF2ybhLsu/y9
+vl7bN9AwMxx+si8D9pslRDmx0j5h8XRRjenEkTLo9jCDL8muZQMFY03yUssCCa7F9bkdxY9ecIOEW4R0
+TrmraHhNbv80sEre2e49CYSP8+S5d7q2eeFWqEQRSU70mXyzptuqLKSmXkI6fOGoc0zS9MfvPgWL4/H4
+nXxr6cbZiBeS4vtCw3kQOl7qLh36nz8PXfy/gP7PYX9dVv534CZRcy//45sXzNekef48dHzaHEdomsPL
+ly75z9q8JI+TQ9QUVQ1YJdPdd515CifYpxFmB85sxK8x+6TcWvZJvbIDbJaTJleynT75O3bFGUWylsxZ
+wwcqhE1F3S++k6IfHYuylblaKLe/4d/EEOAOyhuyC6Zs+U0LzI2/e9mbbiHybOlNeTwEAw0Y7NW99lru
+bptn2gNy9wJyKg5RiasbVRILn+e3xeLpNKL/itQpE+6zQPjM5YoVbdMblHuwLkks5NLevjYF4FlWRYM3
+cy9WXoxOTzvTCynv4EPxzhVsNtMvrHmQZ+X+A9IptMP4pxd6+wLVrcOlwnpFPnNBC5rm/LuhEM71+dpk
+xnHEbEsyNwJa7Qu8SUr/vCPu0qNv5wFOhGyW/wkvK3mfACnVhyhF33tPO73oFnqfBHW//fGd8M4lbI+S
+vmqzQNn3wu1deLOYMX9jhWyElZtrFvBtThrl8is9roarPv4pycqiaqK84TXwNzO1Z4pSh8uSOE41vLRU
+h2ar9ioXpBTgoRfF1SwlQz2oAaxcrLE/dekpTDnUBPBIgO/TpJ/k+zRm7BCgBqqXE/ir6dINhc8+B4Tp
+QgyVU+BejJN6KYbAlQoOvTFxTG/PUDlmqRZMd1po/KpXW5zkey1EjiRQvdzEJ738QuGT5DMwXUyhMinf
+T3ESLqcQ2BCAlEIjY+TuCoUxnjHAdLOEypt2wcRJvl1CYEYG1ctNfNL7JxQ+2bF8090QKpvqFREn6X4I
+gRkJUCs2sUivkFBYpEffTbk3VQ6VSx5O4g0PAiMimFpq7EBy/4PWgdXXq+l2Br37xEsaTsINDVIfdUBK
+oVEDyQUOqgaekwaB+ndUIAW/52o77yxVo86a6W4/HVS5YXZUFbp8Jf4YVQ26T3ZURfJRT3geVcnjneDB
+je4ASLM9UzN7MBbHGhvWQ/JI19CUHpCGqABYN4MCyUYBcGEikypok1hXgc8jErg2h3Bwas4lWM2Uc9jO
+wip5+hXrysG5oZOgNSPXdZ+oeSZ706Ouviqw2vDvuKajUuFZ6msy+lCMfUN1j2KFMgDyCm1lhDBaLnlW
+Kw3kZoOwS6N1sN5wdjeIxvAV3AN0bG3uyYiGYGxPTSNwugJ7/yDIQ1IdUnTVQhYIltxVp0Ia8HoKoKSs
+5DvpMWknfUWN3bzI5YQIIs7YpTGdEuJBIDTsA2NBGVyGAwAa6dQ3+QUAuGLk2ZVAgAeUpgokLpJB8boc
+dJBP0Blx0RHsAQ2HUA6jEgAEjOaTatjADEmszsYIrYMaLbc6GxZdnQ1Lj8OMEWAHO0qGdfY2MfZ98ihJ
+WvIMx242OPiyUeMvmzwEsxGjMBsxELMJYzGbNByzN47ILP4NRMkPOuKpYUiU6WmMKDuo0aJMT8OiTE/D
+ouQwY0TZwY4SZXp6myj7PnlHUXanTmO3TYdk2aZjZNlBjZZlmw7Lsk2HZclhxsiygx0lyzZ9myz7Pnm0
+LMsqyRssPvIwJEEKNEKIIuBoOdJKg6KkYIPSFMDGCFQEHyVTWuFNYpV66WGSnaNsj2K3QnVZ5DX+kDd0
+t4qYhaTLBqdtOlTRGlLSiG6yWsXRSshOmJkOSAqA8oR8lgFeFPu/oUMDvPiWxKgY3tgjHVLVsmjxZQ+9
+SW7g71+fu74Qv20ug/kmXPvLxSegmr8yVQtX8yCEqiz3rwuwxhoE9/evPghOt4mQT/94YMBZ3gV9s71k
+eGg6G3PqIDM29X3PmFuhb6iqkYFB/trKqA4kMWwjIUMMNcBICB+s69HjX1v8j4oPeMEQ5AWAIi9k2J35
+lcCH3tyOrKmdFgCG+IgDcS0LjIIHKGfVyadBr+eIDlScyktlBUPKoXuPwAcQ+CACX0NAk4W5HpROTEXB
+E4uZkPgAEt+AROJE2XdDsxFd5S06pExABua4UYB0zCiPr1q+nCGsEoiOk27LuEI7iiyYVQAdL8uwdAWz
+MlkwS/mZbAQiskSk4I+TuqmS/aVBgyRoff3jLtnfqQpROB8sIIYTUZlQStKjCGXxaeiQmT9VbOIeGwNK
+XWYiwu4LvoJS/4IvITV/4Of9WKHmcNZ7khQbkGpvOU7DEMMMwONMqmaVETjGesSQpGzDTEaqSqvHa5CY
+eZzJiLVR1mPWh5qM2jjQZArqMOsJgGMNomEaab00FR0R5QnrSUfApCk1So/kyFaPlaahxm+3yjTXV9mq
+E52ATVE6AR2sdQSfTeUIVknfBJyQwvUYQW0j+FRVE1AadI1gNSkawanbBgGr0UAQvGb7wPpUEb3Uq7Ds
+CV5d8OYlZCqD7GEec5093mmm7L2r39yx/Ru4znX2du+ZrPs/xIFm3LyLD11nb3Wj6+ztnjTH8SZnOnuQ
+P5093qXO3tGrrrN3cazxcHsn37rO+Lz9fu51nb23h529h5OtCvOtfjYgxTe72lh87+RtZ+/lcGfv6XOr
+QnuU2w0I72GeNzQGH+58A4PwPfzv7P1c8Dp7tBeevZMjrirhA3xxQP8e4Y6D9uNhHnn2SKfcshuAYM7i
+h3nlWfx4r5yy965eecf2b+CVZ/HbvXKyheMhXjnj5l288ix+q1eexW/3yjmOt3jlWfwYr7zH8zCvPIup
+QX0XrzyL38Urx8PtnbzyLOYT+vt55Vn8zl65LtNHeOWqMN/qlQNSfLNXjsX3Pl456dP38Mp1YT3SK1eF
+9iivHBDew7xyaAw+3CsHBuE7eOWQ1jzKK8/iB3vluiY+yCtXlfABXjmgf4/wykH78SivHFKGh3rlfGMn
+VbPTw7zy9PR4r5yy965eecf2b+CVp6e3e+VkN+5DvHLGzbt45enprV55enq7V85xvMUrT0+P8cp7PA/z
+ytMTNajv4pWnp3fxytPTu3nl6YlP6O/nlaend/bKdZk+witXhflWrxyQ4pu9ciy+9/HKSZ++h1euC+uR
+XrkqtEd55YDwHuaVQ2Pw4V45MAjfwSuHtOZRXnl6erBXrmvig7xyVQkf4JUD+vcIrxy0H4/yyiFleKhX
+3p3RIajb9GFueZs+3i2n7L2rW96x/Ru45W36drecHKx6iFvOuHkXt7xN3+qWt+nb3XKO4y1ueZs+xi3v
+8TzMLW9TalHfxS1v03dxy/Fweye3vE35jP5+bnmbvrNbrsv0EW65Ksy3uuWAFN/slmPxvY9bTvr0Pdxy
+XViPdMtVoT3KLQeE9zC3HBqDD3fLgUH4Dm45pDWPcsvb9MFuua6JD3LLVSV8gFsO6N8j3HLQfjzKLYeU
+4Q1u+ZxcpUGT7fS3asg+AwagiYUoBHnWQcgZbQqhnNC27V7HNetsmIE6G8MDT6cCsmHdr4NrZ/EwH1k8
+hg+eC2QsH/0XCiKN0zAf6WkMHzyRxVg+hJgMV2/TYUZwZDTMCM/CADMy54e6qTHivzQ704HRs99X5Sy4
+CMoPVmOb0iSH/qA1/Q2C8mPo+sF0EJwf2dYPcYPgx6RFcQ9Lfho4Tg5fX3tIng6XlgtDW2iT/OY2J9jp
+ndISQXa8nOdp165SXXi8Lr81WK4+mOAdY/hjfSkxJ7Xz/feGVjw5ReV8rzTg6ek6p08y53JVtdmsST0H
+gYczGldukaevwAF7pul9jna/bM35BXYkszoOq9ndWd6Tcp0pDj+Fg/iMsEuyR+IcDVuatnwGvCFPqmoy
+BklCecYhee4YIxcVpYhyRl7p96ve5vQ+V5rBruW3u3rO3Gd39tI/4qVg3jp8EnWI1lGqk1q+WtWHatIM
+a0JlUm2h1QXJUnMhVFdsxvzFDUKWuDkIP8lvQo+9UZK0vbhrXmet1vE9XgnnIZDfEavUi0V8ecZs8IzY
+Ms4z5oO9Uhg5Y0bYq7VaC3MiJHmQXxJWBLUQ32a0Ff3tx2r17NwBGAhkSe5+Y2j6WcHzvr1oUOcOqsf1
+TfIzvym9KiP5prZUrsycFRS7+IpmngXPlC9j0C7tpBvRajpuxMsJ1aSrgop6T7c5TvnJr/OVuqJxvdk8
+e+1e6ylGs4qAtD0IkF4026t4oMyiWaqi0tOKZq7POdWTH2aN6xMyvsguAFcRuNb1ZZ4ByL2KkTEOgKYq
+UsI9AOgGXROAFgSEXqDdX6o0ICC0AuCKSIV/BZ94zbHCvoKyv0NX5n7Bufd15heE2EJk3td5XxBCC5l3
+X2ddwcZY93XOFYSEcw3MXXaMQ/2+JMSWEutQxy8JraXCPNTzCkbOPtT1ClLaAB3QDXkTFnoDQkIuFBuw
+0NkPCaVQZn+hM69gY8wvdNYVhIR1FQznKO6cEOkFMTDla/9etzAlsTBlK8AAJqbca5ggG1OmGjLdyJSu
+37Grj9+SWJny1fUlngFAYmbK1vUVxgHQvYbTaGjKVENrsDSlG8iXbirNCAjJQG4G0IqAkAvUVgCNUDGa
+rE2Zakhhc1O6i64Jvt6CBaG3kFrg6w1YEFoLpQG+zr+Kz2ByylRDCdqc0l323EMSWBJ6S5l/SARLQm6p
+tgCSgYrTaHfKVENrMDylG3btWOitCAnFUGrFQm9DSIiFShsWegtUfAbjU6YaStD6ZG7eOQ0u6DXkdJLP
+Jb/BBR2HnE7yueI6uKDvoOFlLXFB90FDza6a1mHdPOgbBLWHTvp5ILcHag6d9PNAbQ7UGhVr1xqoMSpi
+4d5spS2dQ+ECHkVOnYBc8ilcwKnIqROQK26FC/gVGk7eEMC10NDSdvh6M5Z9M0CZUHcgX8oNAYVC3YF8
+qTYFlIqKt2sMKBYVNWsOJJfO2XABbyOnDkIu+Rsu4HDk1EHIFZfDBXwODSdvCuB2aGhpQzRIGlOyhmgx
+ZUNeE6oCHGmLBltx2FaCreBodQ9jZi3SwFMYOWmUAmxecM7wErExksPvCD8CEBjPMcBWAoSjOhCnIbYD
+0UIRXj0U5GEATnU41GPQrQRtCfhA7LawDyRgDP7qgfgPv+fkB6NABtxKwOZYEMRtiQhB9Ka4sLaHhvg1
+pz0UIDLYVoI1hokgZnOwCCI3hIz1UNSIATjt4diRQbcStCWCBLHb4kiQgDGarO0BJX7NqQ+FlQy2lWCN
+wSWI2RxigsgNgSYxLqZYk5qg8lWCAiNOBtnKkHDcCWM1RJ8wYigGJdbEGoZSw1O+SqDmYJSBtzK4JSSF
+8dsCU5iEMTwlZsUWoVIDVL5KkMY4lUG3MrQ5WoWxW2JWmIApciX2xRK8UkNUvkqAphCWAbcysDGQhXGb
+w1kYvSGoJcbFGtdSO1S+SqDm6JaBtzK4JcaF8dsiXZiEMd4llsYS8lKTVL5KgKbAlwG3MrAx/IVxm4Ng
+GL0hFK6Ho2ECws3zmJiYV2jlCrbI2EDDGh8byJij5HowUCYQHRvD4TKHb2V4S9BsoGALnQ1EjAF0PRRD
+E4COh8FImoO3Mrg5njbgt0TVBhKm2LoeDq8JSMfDiCCbV2jlCrZQ20DDGnAbyJjD7noo8iYAHReD8TcH
+b2VwcxRuwG+JxQ0kTBF5PRiUMwjOxIjQvK/RqjWMAbqFijlMtxCCgnXLtqzMzWJztI7fEc4EIDBaZ4Ct
+BAhH6yBOQ7QOooWi9SweiNYxAKc6HK0z6FaCtkTrIHZbtA4SMEbrWWyP1vF7Tn4wWmfArQRsjtZB3JZo
+HURvitaz2Bqt49ec9lC0zmBbCdYYrYOYzdE6iNwQrWfxQLSOATjt4WidQbcStCVaB7HbonWQgDFaz2Jr
+tI5fc+pD0TqDbSVYY7QOYjZH6yByQ7ROjIspWqcmqHyVoMBonUG2MiQcrcNYDdE6jBiK1ok1sUbr1PCU
+rxKoOVpn4K0MbonWYfy2aB0mYYzWiVmxRevUAJWvEqQxWmfQrQxtjtZh7JZoHSZgitaJfbFE69QQla8S
+oClaZ8CtDGyM1mHc5mgdRm+I1olxsUbr1A6VrxKoOVpn4K0MbonWYfy2aB0mYYzWiaWxROvUJJWvEqAp
+WmfArQxsjNZh3OZoHUZviNazeDBaJyDcPI+J1nmFVq5gi9YNNKzRuoGMOVrHYPZonUB0bAxH6xy+leEt
+0bqBgi1aNxAxRusYyhqtE4COh8FonYO3Mrg5Wjfgt0TrBhKmaB0DDUTrBKTjYUS0ziu0cgVbtG6gYY3W
+DWTM0ToGs0brBKDjYjBa5+CtDG6O1g34LdG6gYQpWucZ58zROoPgTIyI1vsarVrDGK1bqJijdQuhkdE6
+P7yUuenJHK3jd4QzAQiM1hlgKwHC0TqI0xCtg2ihaD09DUTrGIBTHY7WGXQrQVuidRC7LVoHCRij9fRk
+j9bxe05+MFpnwK0EbI7WQdyWaB1Eb4rW05M1WsevOe2haJ3BthKsMVoHMZujdRC5IVpPTwPROgbgtIej
+dQbdStCWaB3EbovWQQLGaD09WaN1/JpTH4rWGWwrwRqjdRCzOVoHkRuidWJcTNE6NUHlqwQFRusMspUh
+4WgdxmqI1mHEULROrIk1WqeGp3yVQM3ROgNvZXBLtA7jt0XrMAljtE7Mii1apwaofJUgjdE6g25laHO0
+DmO3ROswAVO0TuyLJVqnhqh8lQBN0ToDbmVgY7QO4zZH6zB6Q7ROjIs1Wqd2qHyVQM3ROgNvZXBLtA7j
+t0XrMAljtE4sjSVapyapfJUATdE6A25lYGO0DuM2R+swekO0np4Go3UCws3zmGidV2jlCrZo3UDDGq0b
+yJijdQxmj9YJRMfGcLTO4VsZ3hKtGyjYonUDEWO0jqGs0ToB6HgYjNY5eCuDm6N1A35LtG4gYYrWMdBA
+tE5AOh5GROu8QitXsEXrBhrWaN1AxhytYzBrtE4AOi4Go3UO3srg5mjdgN8SrRtImKJ1nonSHK0zCM7E
+iGi9r9GqNYzRuoWKOVq3EBoZrXcpPjK3Tc3hepuy0FoAAsP1lh9JFgHhcB3EaQjXQbRQuN6mA+F6m7KA
+WoA0h+stP6MsQlvCdRC7LVwHCRjD9Ta1h+ttykJqAdAYrrf8ALMIbA7XQdyWcB1EbwrX29QarrcpC6oF
+OFO43vLTzSKsMVwHMZvDdRC5IVxv04FwvU1ZQC1AmsP1lh96FqEt4TqI3RaugwSM4XqbWsP1NmVBtQBn
+CtdbfiZahDWG6yBmc7gOIjeE68S4mMJ1aoLKVwkKDNdbfmRagoTDdRirIVyHEUPhOrEm1nCdGp7yVQI1
+h+stP0MtgVvCdRi/LVyHSRjDdWJWbOE6NUDlqwRpDNdbfsBagjaH6zB2S7gOEzCF68S+WMJ1aojKVwnQ
+FK63/PS1BGwM12Hc5nAdRm8I14lxsYbr1A6VrxKoOVxv+aFsCdwSrsP4beE6TMIYrhNLYwnXqUkqXyVA
+U7je8jPbErAxXIdxm8N1GL0hXG/TwXC9TXkoLQJbwvW2O8YtVbCF6wYa1nDdQMYcrmMwe7jepjyYFmHN
+4XrbnfGW4C3huoGCLVw3EDGG6xjKGq63KQ+nRVBjuN52B8AlcHO4bsBvCdcNJEzhOgYaCNfblIfSIrAl
+XG+7c+FSBVu4bqBhDdcNZMzhOgazhuttysNpEdQYrrfdsXEJ3ByuG/BbwnUDCVO4zjPUmsP1Nu0DaRna
+FK63wllypYYxXLdQMYfrFkJguD5vUNu4WZEXJJnf9VjkjXuMsiR93f7yrz8XeeH+Ozpd0qia/YzytJj9
+XOTRoZj9ucjrIo3q2Ye/JHtURU1S5A4G/zD78OfiUiWocv6KXj7MOtQio4QoS4l8JT9oMlktSzIDJUn/
+9ZyDGlxeAJDqDQAEsqku+SFqkJrRc0fedoUoTZOyTmogAyNDhHtVbIKas5S8IjIWobSkpeQdyw0swGlp
+f82JBkgtnth2iKEuue0gT/2t2za2LEcqSDWe6HaIry7Z7SBf/b2DE/nim0eo9E7j+OqS3w7y1d+8MpGv
+bpmM1OOJcIcY65LhDjLW5562MsZaUbyg6hDV6MpGS5TXx6LKtt0LDf+lLOEq3Qtd36MyaaI0+btWp38j
+ViLG6YWkb3RT0mqhZLvwPCswqiRwVmaqQi2MVGNpJrAv0liCXdthFV5okVaBdMGBQtbNa4q2tES3j9g6
+XWkayY/H41EDKKski6pXDuJ5670EFUlgNE3sTCk8Y6vYYwhX+4VuLdChyGOB0uqwDtexTqkDlGn1xRK1
+5fPyGC51apfDAdU1hwo20XoZArQomEKJFUp0/OfVc6B3b5Ifiw5kHQX7jU4Ew8gUSIncacfVaq034yWq
+8iQ/9fI7+N5ap8DAZCK8UKKzjzZ7Wf8IbBzlpx4oPixCqLcolEyFlUlEorUfB5Fu/8i45E3ZHJ+PkU6D
+AMkkaJFE4bCPF3EENKP6ykEWy0W09KBGVF/VJlRfFWEH/tJfaej3Rdxpb+AHYfCsgWSXBsVGDWdo0ujw
+Fefj1ZK7zsMnDZq6FzJ0EIYz/j+ozjmJqb+29T57TrSjVYkNLaMK5Q31ZYRsxnrOWRG6zyhNOwwdCurX
+0czISqGWHpm2ukLR1+tLUcX0cUv+dXFBD9sllqa3KUEgDF2FasSVKcnPqEqkeY3lpb6Sv0maNK88VbUI
+leQAnJa/nU3IZZXkzfUPM5b+d7bd7tGxqNBV7UuhOeac0dE2L5rv5/smf9L675LHqEqTHN2i/b76tUma
+FH1hZK9dnmDn+w9O1DTV9+T9k/Ph6cOtrJDk4pYVchUnd58Wh6//+1I0aIahmWj9snXqIk1i52MU78N9
+vCujE6KScpO8TmK0jb4VSXxrziiKr3FSl2n0um1w7nAXF6HKxfpT3pLsNGuqq6n+OZidF7PyWlTlOcrr
+7QLnGC9e6u2CvhIrkhazen/E5dc6+TvaRosbGYqK5yZKFvdSlOSosgHl0bd9VHVtwRK6zfdRfIK6xfOw
++uPmspd4nKRRWaMtf5CUFEM6TTzjT+erNsS0+Zh0JsWO4r6yUHQGOIsRCtBKRyRYQzZCxDdOg/vwB/Lv
+TCqP5Z9n5SeKBD54SygPvNlzAsUryDzswIq3/+/zHz46dXGpDujnqCyT/PQf//6XH/dF0dRNFZXzLMnn
+h7qeZ1Hp/OHz/z8A0f8V025gAgA=
 `,
 	},
 
@@ -653,1690 +678,1701 @@ AA==
 		name:    "bootstrap.min.css.map",
 		local:   "pkg/uploader/assets/resources/assets/bootstrap.min.css.map",
 		size:    625953,
-		modtime: 1553451733,
+		modtime: 1622811395,
 		compressed: `
-H4sIAAAAAAAC/+z9C3faSLYojn8V3fSc/50+lm3AGNvpufM/qpKEBQGCieO4J7MmQpRBRg/QA4xn9Xf/
-rXqq9AKSzsztc09mzUrj2vXau3btV+0q/fPNBkWxGwZv3l6ob+IwjRwUv3n7tzdnZ+dnZ+exE8fn0zBM
-4iSyV2f4zzeqDPtHFIZJZTmaSpCZGyfn+b5KTTYomIXR+T+ip5g321Pbd1/cID7/xyLcoKhqAsluhSrK
-eTvPjZO4qp3r23MU72lJKuyBT8NohqLTyJ65aeUITjirav+PeeTO9vR7ADyNkL1chW6QxAc6OX2KbB9t
-w2hZSTd76u3Fn1Q4jcJtVeunMPL3No7sIHYTNwz2VDrUyRzTFlUj+o9pmiRhsK95fQ1pepXgWRSuZuF2
-39wdO0LJHnhgb05n7sadVXMtndvpPArTyu3mBqs0qQc7aZyE/mkdAfHoNcVTu3I+jh3NKucZIXvmRKk/
-rYKu7Lkb2AdWeW+lf0zt2f5dVgP/x3PqT8Mkqu7V9lC0b3Xq4P9YReE8QnElUX00c+0qAJYxtWslC6I9
-C+qFcSWaSWjXCDA/nNledZPQS9x9c4lQjJLTBL1U0yBc1Qlbx47CNEbevo3hITt6cl+qmscrNwhQVIVO
-mriem7iILI47D/ayhLPEhAxmpxs7cu0g2d9fVv9APSLQD0xu5sYrz97tr4T8KTow2JOHXg7VCO0DmOFl
-evIqJbRUaxXWSmKpVuxECAV4u1fTgFOf1julFQ90ubBn4fYAQWP31Q3mB+okEUqcBZqdem6wPFB3ZTsH
-O6xhfaG90EtymkRp4NgJOlQP+auFHbvxoXoLt9IckGa1cWN3iv/aVYsml3P639U3ge0TA+7v6hvfXq3c
-YB6/eftG0zTtF/w/DWqaOtC6ljrSNE0d438mFb8y6GBvvXFFi1Ee0M+XPdJflqbeF8s0uWxUUbZnXEtT
-5wD/NPA/9Ofg0M+R1jfVHflp438g/mcJxM978Wsgfs0zcF/8eqho4oOqNi6QK0BN3ZICp6LTbMxxVfNs
-xvcQmqoB1wDX1DV1oj8Dg87pQeuaal+DBqXxAx7xkQ4DNYY6+dkGdCEGprTsD4LAhX/IJC06NWjwFvds
-qBFdBz7U/xXSGnPToi365o3RozOxxJzIL8bakA1C6EA76XcfTTUGt7cGZ9CJqXYxbg/0T6hJWDK2tHEV
-Tav5h7clLRyK+MjEf5YAffirqTaAngI8lGEwJO8zdrkXKzGX/4ZieXhXpFM3Iylf8a5JV84R1R9EF3Q3
-wmdgql09BJrahRH+V9+C8lRxTwO4AhgdQlJTUwf6Dhh8/C4jHCM7/5NRgA9H6j1IzMP/IQMYZAIjPQWs
-nxEZ1ljxNbbMjtlTLdgEmmrpbWDQNZkY56bBa7hdk48FNRVRedI1MRsPOGvDIf3TYuszpOuTMcocFDjl
-0brsa+q4d9IvV7vX7nvqNajswXp5p6khWZgQ9lrvqgYZ9nCNe4vyoAEvQY8Obekh/hcGUFP7esqp+/uE
-iBH1BK12vZ5qYc5jy22I5TYYu/e1samOcJXSgo0xTaFBSUw4yhYraWldtjYjsQoj3GKAW1DEJ4I7H4sV
-azatLXiii/t/oFVIrwbbwba07QZs5oxDRyaGsnqWGCj7k+0cuDFMdaQ3DE0dwBdCCB3Xxb/0tlGSe2Mj
-ec8oOja3702u5rqmOoJN3AtpNSmUjkgpogOTqULWC4abqkMxxujoJ7i+ZhlMIo7gjWGqHcAEOtTUDtBd
-01BjoPW5yofP5lCdQww9IXXmUF+bRDRYjAhGtmhwZZrqGuipScS3IfQKp5ChWabqArgxNbVF+lsCLARc
-oLtdPjGC3gQjMhFsNBJrPdEGDEPCadkURsVffUaOsZAeA8EE9+LXuCBnDW/C5MTIDCc9vg45ZsrEykTw
-7qSbdE11B82upu6gvuuyJe53N11TdW5PumKdIFdK/e4FaXPr33LkyY7oEDHQF+OxBcPMl2FkC84fC4E/
-ytOPmlTdl1tTndxec/2E4OaWSLJ+9T/W6uEV7NNOhpDIhlbWlZZJN0L3dn47YJTsnneHvPSky0r73Zus
-9JqXjrtXWWmnK3q4zErbWd2LrLSV9fvaHaqD211WbdPtSdN7uPWzus/dCn1v4ErXZja4WVdpl1Xa1FYK
-s0peRaV3O6w9+wuuMfuSxswx9oOQMoQXUc//ZKoj6+JBUwe3A67eurdMeli96wdTHRB4Xwg3SR8RsYfg
-uWHQDZwN0td901RtuDIrQLFp4n2bMaJuqj7ULItWHWBN9nj7DMWEfGhS3r/H5H/MbIlbZPEl+jQkNi7f
-rrdXXJBZ3RNo8s13a2K5MWBazjaC2576AC2Db+guN2p0HQ/JuxtJ1pogaUbIsfGJzWikD8xyXw7eaIa8
-7x7rzJI5eLcUiPcXYubdDTTV/l1qMNhgHBlmUT890DEzE6Dfo9oxs7IHdw3d4F286KYk09ia6r/ymcFx
-Tx1rE24S3C11LvjGC50z38fdoKda996Aj3K3g2KATY7yk7sGR2089srqdYTnOwfCSB1kEPiKf0JNlsFO
-XiUXflHHA/q6oe6AudFN9QE+cskMW9D8yuJGsXisaz2si3792jnpS4Bn1iv5o3IPmqxj7DyjDPSRRjnI
-zhsBhmZi9fhxh83pBrgPnsFAMs1p7e4z7BX95IKHe+Cf6c4DmMuNNeYPbUMI4nfxUj2Kfxa3uPTh4D8w
-6aq2Zn7Hrpbfo6sAd/X9ELQvPWAxPyFvSZQ4xgcG9Wy3WYghc4SWWeG9KGScYuBF4WaPbCTzf+SyKnPG
-wkaLOSx2cF/Rwb0Iqtwb5qCy2UNFswfR7KGumVXRzBLNrO882v/wSY6/bbTv2KyaO3mzvtElVsEWUAOg
-KxRZVzNeABZ7aQWoGwE8Dm9lCJChaS//I0H31TT8NtAgAeq9+eGOap17YzKW/nzI/2nthf7uyg95qPQn
-fMCaH94Ag3o53yJO7d8rTu+/TZzef9v+uv82IfAdR/sfPsmHbxvtOzY7QpzOQb08RfXy9LFeuPwPBNn1
-QvPrQVie2kJyGViQ2XnxaufFnJ0XgrXQ313ZyUOzPx9/iNcf4vWHeP0hXn+I1x/i9Yd4/SFef4jXH+L1
-v5d4bfwQrz/E6w/x+kO8/hCv31u8dq+gKR1EFw666LHvbHcCTfXeuTwhQnFsSUftSGQ4FFIWxtpHckTs
-WFKWllOs89RTfaD5wCo1x4P2VNtZnJADt67Fj8Yn/OS5eLQ/a99AU/WBc3VDM2l4bpNcp3MDe+oOON4J
-TXR9GKghUR7W7PoGDtVrkqW0Bs4Gd7IGsy3+bws4wQ20eILGuKfuoGZY7CB+Dj6d66baBg8tw5KOyinU
-BTAweyp6OlnoJJtCx/oKNRf6gB/kPy1dfai2yNBLgFaubqhL8LR2dVPdAvS60Mf0zHH867uhuoSPLhBt
-tXdDdQ2f2rjzNUTBQr8XoEtwp7rgaf2smxhLXVNDgBbP0sDbZ32odsjAIUDNZ91QQ/DUwg0aAJ27uYHD
-0sApfFriuilEr25hYPTUWsoIJ0tp3OuljPDCowi7HkU4Wh5GeLukCJ8/F8Z9fHJ9khKiaypCl540aujr
-Q2xAmBiS+LqhoqcUV14DdOHlxjwBxTGX8Okaz28JUeSVcE0DGVcvkEZtBDKulwHFtRNQXJ+Dw7iGAcX1
-wi+M6zx1Qt3ERTpmNPQSSuPOV/pQbbCsO+StdAMzg7/C6wXQJsyN65bGDeFTA3ceQvQcFsa1n/y1buJ9
-qGP2Rzcradx4rQ/x1jIJ6GWtG+ocPO1wgxigq1Vu3HlpXB8+zdckzwhtVqW13UXZ2q4iadR2JK3tTUTW
-9iSiaxtEh9c2jujaXq1LND6JZRo3YzGqC37t9vKkcwGj3TqmtLuMRH8u0K+Nj2oMtO6gnM0jEgvoziKi
-qShL5Er9Ic0sKXU0m2N2QY4XYCrNfPyfNXAufd3ibd/3MEM+CIH2qaeGujYXWMTgU2T11BQ+tK3yVFNg
-Lsye2gaG28VdY0lYlXqZpbi12BWLd7fqGmqTPh23aza7wz9YN53f3Y02+4gFwWh8ZPOPd+oSas49Wwvj
-qv9RdeqyWUkdmk8rZQI9rW/5rQbk3R661SBlx81B/t6CpCnvpjxRbsKzvGxhlD7k72H0aS99rAj0dz3V
-gYMBSwzTh0QGxYArS+mShLEARC/lEuGl2dmikKXu9kXmee4f0jgE5gIMsV4+AUIJZ2nDz7CnzvfAwj2w
-9R5YC9TDUoLKFmgtaIkNnFWxSM8jqwL5PjaLFsBvEJU9B88NvYIf7k1mCT1SAgh7jP6k6WRPv/Jkc+SY
-0nULP2u5zFouwVMoMv+QB3vVLfyshQ+eOkC0uACixQSrtzo+znKtkfjl5FPkKrk2456B+NMWnGtoH011
-S/hyC7HlWcyo0x57uCNuPDayjUT7fPokMLE5Incbi6dZjltWrv0ua09RewoyUqwFKe7Osx7CHrn7QQfn
-9+36mBfirF+SGi4lz4pbMONCsiWudy8Ax+YnShmOWs9U0QJsdzpm1DlY7HSLW+kZaUlyPA+hTCScs03a
-5YnCWZ2HfC7kRLs11Q7UBoLhrR5rk90JkhKD+RUBvsxILNNj/gaJId316GE7CXso2WWSh3ydSZaDaVwM
-+A4aV4R/KImfwZaHgiwXJBlPdlnacYawVXHVYFx3EWGUX8rBM/D5paSxC55Ns7gLJAXsg48XfIFH93Nd
-Y6LGhxpP8B5rXUbXNcgatkZZtIxcIwiBNBcqrIRaiPm/H3rYRcD8DjXbksTyHmHsgdaJ3oFqCjUY6WoM
-l6B5rluqr3tgd6731KW+BMmJLrTkqKe2yM49IftCmjrzz9j0pJkOeqwgrMI7hWKKjZ7YBYM8vlB0bYk/
-W6P8xhnl28EcbYZiO5MrWUfSZn6iX0Mso2CiqylcgssT3VJDnVCtp/r6ElzcCNp4wD3Rl7rahhps6moL
-EqilbnUPNG70nppiWmb1MWE6ZJo7HdOSMqwH0hvdxc01+KqrDd5LynuJ9SXw8r2sdWK3Glkv2m1P7ZDS
-naENBgxhD4Q3uq+rJ1CDV7p6zftu8b4b+hLcXGd9/9pT14Qkrqlh45l2A4PeUCS3Ly1N9UmdE0PbgsE+
-imovYIi70jS1Y2pt0aE2HuI54UUllOCzbVwX6HlzzejZuWb0jKTZ3kmUsAfHLbJ21buT7058tXBxwR9G
-utDroetD0mV9943S5YQKp6+RLrFv5Hk5CgzKy2FgUF5+9g1ZutAd4cKvkC4NOsfvKl3Wd18nXeakpP01
-0qXlGXnu3vgG5e7YN5i08IxMurQ9I9bVua7BG7yFCdRS27oH1p7RU1uYlll9TBh5T3HpsvSMdU4CkF5a
-vBcsAa6WuV5Ssqf8gnQ5IaWtnHQ5WRqprvq6Bp8Nqjlw3ye872t9CV6lvn/tqSkhib9XuggJdIx08Yl0
-OSlKF5fMtpOTLutlgZ6vS0bP7ZLRcyHN9k6ixLHSxUyNj9L11CrLz8rzWSY+SobULXntYMIWod+7GeAt
-b60HJDiLBpVjVJljTuH++Ugy6z1AgkIpqO7u2NO5r0QtG/8CkCDcHA6km7+DYksPDrGcS0WtBXDnRk9t
-gzl4cQ0Ti4Q2FCGRS0iQWuq/k0aDvIFuaIk+xFJxpw8kDSCdcj7mb+rJJnB2xeiKxnZdY3DkTDxjiG3I
-tcHuInWtxf2QXnFhNDP2PJRQuJEdArFKbvZzDqSAQeHOfEUY4hHGZi7UsQIN7mL1Q/AifKyp1y3FKwYV
-8QppKg/56+6HAhvddz21fysCGzOtpz64YFx1Q/+px2/5hcCS1PU+GROBHp2/o7V4I0tDPRWtgNswsEoK
-wflrdgd8oqk2jHSOpqkZXLgZ5MibyiJsRIEl0Hic0ByKOKExlr03n+l+7J/tm2e/l6knw9gbxhyZ6pII
-tqUegXXLMFUfroEnDklyzSzRDONKmm31CCxbRk/dwTW4ahrjfRPT+0MVHUWP9VH0WANmI+yjx5rQY8vj
-PIfpERLEQj0CadvAvuoarNqH6dEgzRp6BMI21iBwDc4v9tFjTejxb+cP/9v5Y9dh/JF0voY/4g7jj+fO
-Pnr4hB73R9FjfhQ95uzo74j9Ikh3mB7UsDqBEWhdG6Y6h2vwcn2YHtQ7WesR2F5j6xeuQXB9eL9I/DE+
-jj/G/xL5MT6CPzonjD+aJxWHrLX80Thh/BGdHKbH41H8sTyKP6iyXh6zX4SoOcwf1Nx09QiczE1TXcI1
-uJybB/mD2pepHoH23OypW7gGm7l5cL88HMUf7lH8IY7HDstTajmEx/DHnCA21yPgYqfWhWtw45oH+SMm
-zWI9Ateu2VNTuAavrnlQnv7b5cfy2+WH75lUfiw88yvkx9wzqfy4WO6jxxLoD0N1DWBPNgM5ETxdU1tg
-Cdb6/gl3TXYK1gLSq0KV26RrsjjbdSVbPOIKmZbcLU2TaMlkWYk8lJGf07fhDNKsp7r6GqyWBzbHJRiq
-6R7sO8dhT8Oqnf3Yrwn2NA4wh7XYtwn2bYy9b5pqB2PvH8Z+SQZaGqRZT/Ux9v6BrYCx/+OufcjXPvyq
-tQ/52odHrP1yD/bb47CXrcZ9CrNAqErsM/m+W5smke/J+jD2HRa8IM166jW2RtfmfjX5VWs//nevfczX
-Pq5UAnVrH/O1j49Y+3AP9o3j1r7BTwAPYy+LiErsdzQaj7FPTVNtYOzTw2tPw2wntFlPnWPs0yOw9/dg
-vztu7Wk4eXeM1KPvT7brsc8svd3WNImll2wPr/01aXZNm/XUE8z52yOk3r9r3y+/et/v+L7ffdW+3/F9
-vzug7p/BHT1qror/sMDHMwjwvgIuiI2qdw/f9zKz59GS369k0ZapZOwsgbyKLOjxwCuM4Tv5saS+FBca
-V8eFBo+7btb6gyEflBzTennbKx7CMzKbJnZmuB03WIH41SRHpjpm3RAsXk2ygy2+MEZi9dTBOx5Lkt/D
-JaGm/icMfuShpv6vJG5tWXLmSJagRLIHCufzhVdIR+8+WdKzrSy6Ssfq9tTHd+ZAHBS1r80hXZR7zdTU
-hxQkNyQGZ4kBxOkUDSbCEBhyroctvZyXCwmOaYLUAvak8NpAykvI5XUU8kFkKN5m19kMDL0Jh/Wn8f2K
-87Jx3X2PQT6lw5Ae2xP7IgFxlwchY7Dq9iqfnyy9Hrs/42oOni74+6gjFNIIai6/ry/SJNYZxBCQ/qdH
-LFsfAkDyAWqabquaDv7vNW18W1NtRHIkDUta51Ge9NlTj2N4DojtfCyHvoJeMbBexZz9fIaLXOUaGmoM
-JQ69J3lwfL5GnvP6FTNn7+1eQZLXcezMPZDfW7kkKaM4cw6Vp59CQ91JM9eugKn6UCfp0iwPj5x6fAUu
-7ADuRtfU5fG49Excew6EnOuaJVLY9c1vwLCeFNnSlejhQoOkZggCfMQroLu6xvEfDdkbvUvI0m7oyXLf
-KqZqklS+JTBuukbx2dJBQURX3aEwl7fy44aFJDr5Gbhydl3VEc04T/FSRuYKrG41EY8KgW9VHG/0bixh
-V1jhrRTPm0OZALx61MtXFwZQCis0vDStcY+JQIa8xdXOuEJQS2d9CVjpXJvHINZ75QddLTPfdQ01x93m
-O1N9aIJ23MXyuAGacbeUB1edqdYEc/JOLGiAVdIl5BjJD8sdc+LZBH7S7aknoOslXUONQQqEFdAE66RL
-nuzVNfbvCWiARdrl6WApX5clP3Q1tMHwwLFxIWHQKbyN/6DdEveFZ/H1myDGU9zCBvAwmkvyPOijPP02
-bIANhqW6BxbAEnc4RRa0cddOuz21AxvginbiAdNiCJB61GMylmBOE2t51uBESuwzsa/YBI2YPMyLCRLj
-WWFdnT3bSUWbLQypBk864z0WXbUifCSJnVAkMPtZqgvKi6SBoPRD9akrOX5vgnbUpSsYRd2MuI2oi13T
-BnjGWLUgtpsfZNhab4AEw+aGBzzT4hSViHbXeen21FhvgEvaiQcW3Rx1t5y6qYWp2wSNNVmFBtisebqM
-0QTtNVvN8zUhcRO4EVlWcrVIb4DXdVc+sO7T80yxueFrbyhdxs120BGMiIqfhRAS7wLMm9SnJvZ2C6ya
-GEtwAXz8Yw1b4KXR/dqtp3XFvT4hWC7AttntqSnhLcphW+l3i/zbgFqPlzdgC9w0Ca0uwLKFacpohf/1
-9Ra4aHQHbLdcgF2zO1Rjg1wigi1whRu4UOtkh8hXoKf6uraEvOQCrJtk57TA5qKrqW0YAFS5wzqtLnb1
-WuAK1+vAAOB+y1vMBzeApD5d80EOZTWcw556rV+ARhuT2xhfXHSrZuCDBeipJ3rdwHfpRRdL/BbYNLv8
-PKQ4gQvQahKp2gJXuFYDiGzfjJ2MC9BoErnZAgEm4hxqjwMBO8FkvibZXy2I/WG6cFudkfwEXIBtixAT
-Q9t0ifUWiJpclPSZD6alQORUbwA5uYgBrzJOgJwBeERyiPy6q+C6JohFykMDrMBRX60YVWn6cdVFjwBc
-itSJu/Y14e8WuMT4h5B44Q8Z3ShNoyYjjsiDJzvDzIDXgHAz2RkmOW+BZGeYWFq0wKLZzXnvTTDlCrsB
-EEcwAK8icf5ueVIzeOPbB6d2WBPcgGz0OcyGb/b48D647vUIY6ybhENaIGhyso0ZU25N8nq+od0SmYg9
-OKMFzhtYRMIL0ME/Qn38clKzPfx+T43hBbjGFX1dI7uJJP6YuMsd6TI2xslJt2r/hOAC8LuxVZn6D0Xt
-1TWZJq20YrKvM8RVXXalTNRbcvowsIp5TOMPCRC8N0E9taGTfLx94e5nMFS3BrkwxJRwgsWRoTXA/rjW
-JSBZuksors15cKh2dG0Nx6L6/V0JGyf/8Q8LY3NdsNWyzz/083eSqq5n9fPWbEat4rPk2QawNCwaO/Se
-1O8ZNhvsQajPbjEVddJTT+AKRFyzj0JAFLuhjfgFwPY77T3JeRQJiR+eb02xlGG3h5lyKS4M7iyS4BfT
-XEqeR0wSJ9um1pLLJkO8e7vimsltT90Z2lhcPbS0OzKyI7W5Z9EdttQ6uUjSH1CyTPLij62iIWzXD2RA
-m32+hV5d6mcJwAN2Ls3p6AKZv0Q3n4J3jKjfkEL2UPjWUV+yCXNPifzORLXv3GHNtEnc8KHVw7bgp2aP
-hw4fGr0h3agDmdWXIJMu9+znBRiqoam5UFrjx6Hk6dpHXHuUlOUFeBHfdWqBlnlIRaK8x31MBnf/wIcF
-VuBiaIj9dD00xWc+8lmPjLoLQKJ4PrDK1xwt42A28hwS4pVvDno0I5Wm62+hVfzyU+77CE1IvPFrWHVe
-ck5vN4a6HGZeFi/PVYVv6CP4F2DR42q1BcLeUG68yxoPJE9JqJ0L8CI1dvvVbkM9lxRvlBUNxNqGo3ys
-2NCGJpYekyOT77WPJDneye57zrDnpfncA59oARhiPbjlRv0SYML6Vbc9smkaFXFso/5CcOXnWuouAZc5
-+fI+4+STD8QZWJYVU2F2o4rZZcpqlAncgpFbNTtKqg/Nj5n10P7ILegVuPooVjj3vQMjBNuHHr09XVhd
-H+RnJoW+9u7Td8TBHwsH7I5EDB6vQSUXFO561PDIrzdAjaFm/65OpjdAPQGa87s6md0ALErw4o54mj65
-Cx0Dq9K+oFHED+efsoVxH7PooffInXeRhP0teuxh/ZgTnxT0afGIDemH5QOe4qfnB6F43Ieh2oIfNg9i
-203SB3LjJ4YlPDLb6VGyxCpMyscVCH7l+FDOwmJXL1Pmw+uvGT1av2b0uPz1+9Dj5FezzJufmr8KesxL
-9EjL9JhDbW78Lnpc2Xl67IDWMvZwilH1TZQPyTSj1naaUet1+n2o1Z6anESbqSDRDhRJNC+TaAe0jvm1
-JMrcpUcJ36BLroZuu2KEFVjM8vS7BlqHXxyS3ZoVSAo1O1ALb7M3S1a3Q6xWtrcDPpuXW/L4QVuqdEmL
-5paotLCG6hJqoZVV6g/Va3AJ3JuuuNbvAu/6dvDtC8ANRNQjXW9v6AffaNfB9S0/KH98L18uOfb2upF3
-2h6Em3pfdzTQbwJxHDEHDSA+ndnX3hHNe199DsGINu7RrkuPV9FXlSyLz7XXY7qieIklAheAh+NHWbx1
-9A5mqUANEhWzi9btr+QKzxwMqp9CoFx3Bfyb26Hqww6ITm6JPbAF1t5HNTJaNwFh+xMe6OlHJJulHB1e
-g7beU9cgAonBPcc1iMntqCvQviYDd0CEF7jsqE8IcQzr6JteLFxh9dhZ4/EPP7KWkx47IWaImiRi1+fP
-usBVt0eflPzGYHTVdapxZkU4BSbUiEtwA05cC9u/1yBwLes7jzjpSU/H2NLTC9mnTYWJfQWW3Gcad8DC
-lD+A+Mi8qoW4xxWCFvBhtnH4kxBV8688C9mzd9+RENO44rmOD8QFseXnHAZifLsCU9mZeOTHhduSVVfJ
-Od0KKlu5ByKopXkFfJAR7pnHY40DN9kScCHirO8eTHFq2gJhN6ProMeSOwoOziCvjEqH3bmIT3aKKRkN
-fQ+86IbaBkuwNKDaATegMbduVR9eg2RujYvU73pgZQ5JTt+JQeh1b0kycJS/fjmqPiz8dIt1nNNnPGEJ
-nrC02Tus1Fww4vvvGbxXt1Bbg5H0vT2mTBPwnszYd0szHkloX4F3qo9d4z6lS5zFNBFe3zInrOA7rBG3
-vMXAA/Q1qYxK4TMbc/H834RKnWVpxv9yKl17bMym99+ESmlQmvG/hEodiUrbkI25Cmup1PhDUcldl2b8
-namkXcGPKtLQ+KtxXYA7tYH5PbI0dY7nGFn3ZS3y8SPWIj64r6BJAD5ihz4Gj5woCfhVXUOtAR7LRLkA
-v5IB3YQNeL7mA8pE8eBH7GzF8P5YorzCj9ib7/AWfc3+SNKemSmFLoD3zmT5RkvYAut3HELfGxSvBaIL
-cPGOpzU0YAtcv+MQbXaHGWAJxqzgBjTW1h075myxk+xrcIXJ2YAkkMtaNgE5MRVPnSFBFETSOX2oheKR
-s3SkrWjwcAtF/RdIDjgaMJtnc9hjuVM+bIHOUIw2uiNJz5Y44E9GJvtcvQtbYDcSBiZ5L1LgvgYX4HxE
-HmclrxW1wPI9A8UAY9+WsI/BDYgx+vQMuMGO86/BK0Z/J6HfJ+h3JPRpIMtmPzEBljIBOpQADYkAa0Ao
-0JYo0L8AN+OetKbuHYfAq7s7+u3z/bZppaVVeAH9oTID9aHCRHHBBrznJkoK9J460R6t8qdLpz21pW/A
-vVXh0qTgU0/d6RuAHZBWBm5wsNVTH8TX3o9wA5irkvua8Eg8vvVQPMYTx5X070uwIdi3QVsyXs0eS9h+
-FB6enTfy5AfpDE2881vxhrELsP26Axtwzp9O86UTD4pNCk7IA0uaLQ4/+8PKMeWRHkoj3ZrqFmzAs2lJ
-8U9DHjMF8y4P09t54ozz/kAxRF54HW2CSb48Iio9qotPj4VTTpl6Aza3BqdQCtq3uUjtBqxuDf6OGcg4
-CldNb/mze7wuNqVbUt2GqOvm0vQP7hfyGV7sFFKnKwAb8s17HyxN3M/EOvDiSDG4pX0iU3UqHgQxSolH
-3Qqm7+73oPoVmfED7X74Nd4Y/aj3SML5luI8J2+HPuzF+RPhrjkYHI0Mmy052AdaCMaSv8R+ReBO9eEG
-bHqVqUtrv2eqc/0EnF9b5A70HD7yeufAv7I+EmhwY5EXFtbwUWTBRfBXNYQb8GpVphz5uGdXPwEXuO1S
-107gI693Dua4Zww9x9C1roX6I2va1exfWUCDiqQABO8oFd13mLEHFsujNbLzU0MEz4xsZSQHfiwsgnHF
-KQ9xh0MgMplk5/Ce3BAxhKNs9tRrfQPO33NBkYKT9z11q2v33OzcgNdxLwc9oevKwS/vzYpkwhSko556
-DTYgeV+VQ5iC9XsS2NzxrrA228OfVdGOsXRVJfcgffFOxfgF6FwpbEGfaNOu+Dr9LbGDBjK9nHw8N6Oz
-pX0YqnOdvNjCLyatQA8XbUVRX3sB5Mi0LcJrMIE1oZBL0NVKn9Tvany6OwCZpKpK1ikkNj8W3+089jBP
-uyMC7sEqHnzmHqypTKh5IpLMz71gc9xbNhZdB5bYaLwCaMqmArMFduBdT3XhK/jIN/0o2/SjHZgQg9Gx
-Ko9sy9N9BoSD11XXxgyZm0rBUJiQ5+4LYZ5n2LoVT+bB11vxZF7fUF29CbaAIzXIkBo0wAoY6lxvgr4p
-HpTLXntugACUxxr8S8ZqkdjUKB9MkgKSHnQNNqi1hOe6WTwyNuqyCWko8QLc/YufXFqCYU8deWAkHl2y
-+CNJc9jNNuUM21Jhdk+kX7oPx5TXKP+srpRusOKlgxboQFNF8Fmv4Ldn6GTP87nwY1Wk+9bKbkSMe8ds
-GR/oa4h3255B+a2J+kHFvYrjBl3TQf9vIPq4Z0xuyNaPKczr48YMS3iOD+M5/i54Onvw5Jds6vEUz8J8
-1Zj2Hjx5KL8eT/H0ylfx0L91PZcAToeSid8vmgnCXxtfAbGrrZTcT9+BSx1r3bElNR5o4lnvYuzoCnzg
-hdfYIR5V6OuCw1ilhh9vAHd9Rtdg3FORdKWbu7ZM+o65Q8Z6o7qpfyj9pjAV1vc9lmQ3MEvt6YtXa0Jw
-a8rvgJAx+bLQy+fiYX59DbEZcVxPYbGnTrGn7zalx+M6QoV+tv+yCTnHdeQWO2oUO7KP62he7Gj3LyAR
-vDPZhci+po3khx0NDb7Hjl+fFTKnz3zPLtseWd/6UP+K/Sj/ROhoA0P+sYtRCj0aGcAtFlCYI3Noyweh
-xwTNDjzLuedOxQYE/Oh8IPnJxIBJwainOhsw5AZMClwwVGMQAYeT2+2vwa/5kzrN4heil1wHcAd7RIUA
-95bKEIO8TXxL7EXLkhN3aX4r3MChZBRWXUqRb6x1TcmUyiJ1BjTNrwk5dCsOgCc5LutXXVfdwKEm3n9J
-4b1xrBfzkfgCTpWWm/RKT2tXXQLNUr8ljdKvjJpiH6sDPHgFqiKiS9gGJPCLuC/uwRugVQRHl9DpZU/D
-VDoykvuyyTzR4xwkmzNV+RREJxvcssoxpnc9NdQ9uNK1Cg9Oe8yuDsIUkksr/MKA4cFzY1jh2GlxFkyw
-NJoK0QIaE4GVc2vVzi09fm7x182t+2Nu3z63xh90bkuY6uTMySgfa3jw+ZZPYwmvyWVJbcRjWNr7odqS
-4/fTobqjed1OQbDBFc1Zqvis0fgFPpji9cEtfF/1ia/3QzXVxZlWzWaeDnPGR3EI/ixS/RC7Y4b4N2AR
-7xnCp8+i7xki/INgsd0zBH9csX6I+Jgh0j1D8GeF64dY/wHWAi7AnfSt0cEFvBamXAtelj9cZlQ8a2FI
-X3vK33p7hX1NtXcw9wQXsw1eoWWy78u04A5C8cD2g3wMV5nCZRy8IzDgiWbslvuGf1I1e1CI2q6XsMMR
-HrdhU9iuMdj/NBDKO8QjyboSdsaDnBghIrURfMeN4jV8RwwG/lmskTbDfugysx88ksoWA6toEBnE2HjM
-HiYnd4Gcr77ZXHxdIyN3iQJO8XEkYYnSnuAK+zbYi56IQy36ydeH/N0uEg3M3PZB3n8+9mmooheCDVpG
-mpFJKTHJ30WUs8cNYb+RG+tXIIJsnstMNU7yt/dqy8aFS3m0Ygek0Ky6a3cFPIhdgg6Yk2zjK3Au3JUO
-8GmagvA05HMYocWq1pjPnrw6J8hOD2MpiW5g6mFtDq/hlfeOeCUPVsU3DD6Rz+Ahnn2sWT35VvIRrMWn
-cmtim39kFW8dSVd/uuRrM+R+1vFe4Ijf2Oup11Tjs5kGgGgH8UmLvjYovUJ2tAM6EAyCvu7dMJTAV/FK
-XQw7XVPcIzTl2+BHcH5Vpimfw3tyX/H+gXf+8RP+++GBE7b/6YBAqHoHpTJdul/xsQ8qLxJ4JXIEBpno
-G8QwvSV5sxMr14UhX+UcSOcTfZbwUfrkYN/MnRx0ta/LU+ljmn+VXMxQpGSY5FfkoUSBc8sQnw7NjoJi
-uLN6qgu1yQ7w3j+8kstd91vAL/xbL4Bt6UIscbxf2nmE7juwhK/kKxUdfjdzkL/L3teuqNBwe2w7h0B7
-7vWwiAj5PeE10J4tYs7EVlYrsYjHvuN3VWjY+17THpmB4MHL3kcyh3nfVO9v4BwLlftreLN8Z4g6Xp+8
-wQpTXEc0HkNvNMPolnZmv+KWogF7bIXsgqiFQjrC/A3heQbu54+zCk18UNXGBXIFoxRuKtziLDwxOCjE
-QB4rHiEoXHgeVXwvRlKZ53Au7JRruLrtSZlkbPt/wnxui90//YRnMAdWZVSlJFcCGruIwaDyWYnSXar+
-kCUKzuVHu8hEdP5EE413MZOt12OfDsAaYSCJPTsvCozcV1fJt380TX60hZ076OQWML24bFmS8CyhNuwx
-axxvxoE0il0hiXK2ZX+oxpwHykjSz5c1yPCWQJL6FkviqI6Kea+aPL0wGyXkTFdGkprI2+yt0lokqT8w
-J0j2K5AsDM/NizVgKvK+IuY2qAu6shXawOwKxQ6bs5O69LRau26QPfSpv/uxzcU2n+tBbpsfZ3q8wo/c
-8iDrMQfkGL5WBhSuVI4kRnkB0geT22XBMPg6EeEIli5Jhx0oiofREeJhDrQP/HNv10CzB5wYXydUHuvl
-yQ5U7LV+8b3I95J8ua/eepXyZQ60X4fZrp0D1tb6Ftn0ILAoiSVKXVkuWfVyKS+cKIkFdvzFFuuIpn3t
-acge9W5BEjqvlQpWHV8+VtypKt35tHo58WnXS86jl1OsyX21uqiUpHQ5BROLC6LWUVI4BsOSKpVeHFvo
-F+LFsbl+DXpyLKdIkle46HGjfCkl7i7BjhilcZZ9VggbDArhAHjZYwnD2TvTW+mAcyCE9YB9QaXG7aZW
-K9yId4VrX0/t6lvQq/TZCgGAQUXGbzvz49iXTp/hJSh79o8VXnxV2UOVZ89SoVzo4ok+wwXInuoO/cGQ
-few9BEv9YjkgkRTxtpqnd5YDU22Dpd7EsA55NW0JMmV0T396+jWu2OKdXEsVl1nFJdDG5HEsQz5zCjPe
-KAURPL2xHPTYE2ypvtTPcfdr8jXTUlbhp57agJ6+9gZ4Fy/1aDko13qGCX84vyvFRBiR5jrto+MNDLUF
-XXAZinfZn8FyNcCOx1J/wbNIgQgfFeRE6SkTQ/sO33e06qwbyonP8Mq0ig/Cs3PkZ5CuByaZ+qVHfjzD
-ppExQmc9GLIH5zug+7IeGOK7ESdwa1YERCveaelqXR56YVqlb8pRZ8PTQ4+x0xUmYDt7odGucyCrniHj
-3jn5vIPY3ct3FSUT/tZ5YZvvv3nQ3XuXu7g4tlhju94K+5WIIh6levyaT5YOiifHsHqkx3zEaVxjhhla
-5avWVc96c8ZK+obEWHKCggvXFnbFnuHK4uzkwtCiHw4Vr4xqk17pLkjG06P8299Wfh2q7NTiLjDO6eU2
-aNDsECpHkOCdOdD09yxQWVeh+15Wafbeh/ke82p4IFr4GZEdgYs0WL8iB6MEEFdqqsa3RSbqnOErgq9Z
-lxO8FSyBTFejofujq1uldwD2k4KymZ1He1x4ZcGuI4BdQQC3kgAw0OlDNmtAR8d0isgLRrzEIQVOVuCC
-mhJf6iemRfmuoRkAGs5j8gQE+n32pcJbvzUgu5p8uyXWN63BQNTELq5TaspTMm87uCl9cbgBYv35otB0
-z6jpRW7Ui2LTSakpf7nk1m0PyBx0PIdYj9rHj9pq50Y9LzZ9KDXl2v92fUk0jkaO5GP99bLQ9L7UlH/r
-7/YEN41J0xjEetA5HtddJ4frldwUwqE8KsqsQX6aBD+RKPgSFF1WLMU8EiJdgmJ0lcEcArMrYYjAnEqY
-TWCPJZjhAbxPfMBNBRiQLnwglElAWdcHwq8NAMHFZ4PREkQKHtnnf54BQ9pmA+mmfPGPFpRq2IcqoMLf
-zr4OuKBhV4Fz+fX0zG4i/nbJ36R5CrKXNrcgA6LsuQ4KbElAOtOWBG1IUKfYrwwsTkqKETiisQR/EH8/
-vnsxtNJnB4pexGBEoFvAL3lrL2TNeQFeyhe6xrwopUWTrGReV2JnJSEtcbKSNS2RRs/dNscwlME6GSwG
-V9DVm0DdgQ581omFkEfALiHglxF4LE23XCLjzTBwQQmFhz0oLEElDukPHP5YODT+O+Og3d/gidIrnets
-+plhIeYfAdLvWpp/RDbaWpptZYkvNQtpUSgVrWmRNIH8/CNAqqyr5g8j8ApKdnqt1JK/PfPOZC/pl2Kt
-H3rqDjb1+GWoMfthR2LXOya8G/pmO7QOuNH9ukdX+1K9MTGJDYvHRsye/De503oCxN9W4e8+fAXkzG8O
-mdXjCOPc0eACYhYUMDcz6bFsX0DCFQIcZ+CYgtcyeJeBMTEWEPPbNZBGZI4BJAwlQLmYCwHi/jg0zaAp
-hdJeC34mqvAz5ZGWQDi+98x0OABDRdjjYZhxA8iEQyj0pvgXe+wrSjIOlur4FLqVoK7U2KXgnQReSuBt
-ufVaAqdAM1Z0mVxISTXP5o4n9gzJcBzqCKBPgaEEdLOmLoXGEtQHxbZrCSqRDNuADLqEWTiej45n5dHy
-ZY53+WMSGBrLUDer41JwKoOXGXhb0XqdgdP9rYnxSuk1hyzOh0SkEsn7JgRy/hnDagFJiQA7AupTqC9D
-3ayxm9t0oZTVJLcOZfAyAy/BOXxcYB46gZ6u5TZ+USjMwV6psN4vFbb7pYJTLxXmYJ9YiPeKBefrxAIb
-qnrv7wUuwR7JUAvEoqGxXzRs94qG1n7R0N4vGlr7RUO8VzSE+0RDulc07PaKhu1e0bDdLxrivaJht180
-NPaLht1+0dDYLxrCfaJhvV80+HtFQ7xfNGz3i4a0RjT4P0TDD9HwQzT8EA0/RMMP0fBDNPwQDV8lGho/
-RMMP0fBDNPwQDTIYNjGcBqr5bT0R8OaX16CIZdMqCz3UyWWMuZ7Q74rnW7Mot9wcySV/kPaNb20P3zVJ
-SczEDtK0hAbyeYkLIGRFO1Gkaa80ZsqL/NqiuVQ0p0VyX7sshcIFWv8CSE/xDY78AFdfHB1O8jH2/dlJ
-fc1qA7Wla/oNZgr+aCMSExrlx2efq3gFPXUCjT0pOFZlaD/3HeZCjpGh3fOD0h2QLlPuuw00zn/kn/YI
-L0EPbwhbuqaa5fW3gAan+O97CZw9FdcAGvxYbJ2lU9HWtqYhJgsg/IRbp4ybHE3bgCMKRlkBIgXjYgFt
-Mir2sa9glBXInbIC6wJQtLmi/ihRAWnWR0w+R6QwwRn9m9/lNWa0uthGrDnLYINPH9kXTr7xhV5Dupma
-nfiI104cOCSp6XMoMhcWUB2sjDh9f6cOQmOVvr/nOQyGiSEpg0QYYsuQLYMkGOLIkB2DbDCEqfwnUx6Y
-iejByrje0LqXGz6yC1g3Jwx0teFDC9B8S0E3Gz62ALkMdL7hg7ugMPpcjB6/MLxf+Ohz3k/KQNELH12A
-tgyUvPDRBWjHQJsXPvq8ODoSqO8Y6rv3goEY5gxyteNjc8j8lSG+40NziMsg5zs+MqoletxgaDdKRE8Z
-KGqUiL5loKRRIvqOgTaNWqJnaDcZ2s0S2gxy1Syh3WJoN0toM8h5swLtcZ7JLxjWFxlbMqQZJLoosvKW
-QRIMQTkmZ5DNRbYx8gNLTN5mKLcl8jCcGeiqXSLq/JIh3eZjZ0zOQOdtaZXyo0tM3mF4dySeZIgzUNQp
-cfKWgZIOHz1jcgbadKStkR89W+0rhvpVtj4Mcwa5uiqu6fyaIX7FhxarzSDnVxmH1BE9vmFo35SInjJQ
-dFMi+paBkpsS0XcMtLmpJXqG9glD+6SENoNcnZTQPmdon5TQZpDzkwztsYkVwpZppiU1yUas/xHrn19c
-MEwMIgOM2AB2DuQy0LmYlQAtGWhxzlFZErSl0V0xejofk8rRfJyTLKOVsWWgBIPsHGjHQBsMcnKgBgO9
-YJAkWaTR5xnuC1r5ajHOiXOMu0tBN4txTpxj3BnofDHObQKMOwMt3HFOnFdSPn1muD+Pi5TfMlDyPC5S
-fsdAm+dxkfINBnp5HtdRXsJ9yXBflnH3GO7LMu4MdL4s485AC0/CfTDEjO4LewgGQJ2Quj11QuoOJJk+
-WRk+gzxjiC1DQgbxMMSRIWsGCTCEbp4X/YOp7sBW9+jXi/MWzGRlxKvxELdZrcZjyYIpQuxaiFMLySyY
-cUmZTlbGbk3rbtZ8ZMq8RZBdD3LqQRLLj0sWzGRltCNauRnx0ee8nxzIrgc59SCJ5cdFVT5ZGScxrXsV
-88H5EuYgdi3EqYVkqryS6MuU1l2kJaLnQHY9yKkHVRNdoL3e0LrBpoh2DmLXQpxaSBHtcY7Jt1taNdlm
-bEk7yUGcWgiqhdgFJh/n6d16oXVfXyTy0G5yIKcehOpBdpHe4zyTX+9o5cudxJO0nxzIqQehepBdZPJx
-brXdBq17/pqtD+0lB3FqIagWYletdkb0sEnres0S0XMgpx6E6kHVRBdopy1aN2oV0c5BnFoIqoVkFsyk
-pEfvV4aP696HxnOLby+qEYsgux7k1IMkPTopWTD32EuglVcXeclSBNn1IKceJEmWSUmL36+MXZtW3rTz
-4rwIsutBTj1IEueVlG9f0srNyxLlcyC7HuTUg6opn+F+0qGVrzol3HMgux7k1IMkC+ahaME8roxOZ2yq
-j6Fx0RlbkkwvQuxaiFMLYSMfNGFOTpj+OymaMDmIXQtxaiEHTJjl/I4qv/ldSZvKILse5NSDDpkw6wWt
-HCzuiiZMDmTXg5x60AETZuvSuol7V9DlOYhdC3FqIQdMmNYzrfv6XCJ6DmTXg5x60AET5npJ614ui2jn
-IHYtxKmF7DdhXJ9WPffuCiZMDuLUQlAt5IAJEwa0rhfclbSpDHLqQagedMiESUNaOQrviiZMDuTUg1A9
-6IAJ01jRui+ru4Iuz0GcWgiqhRwwYTprWvdiXSJ6DuTUg1A96IAJM49p3ZuoiHYO4tRCUC3kgAnTju6o
-3ovuSopUBtn1IKcedMiEOYlp5av4rmjC5EB2PcipBx0yYZYprbxI74pqPAey60FOPeiQCbPe0MrBpkT5
-HMiuBzn1oEMmzHZLKyfbEu45kF0PcupBB0yYeHtHjI7V9q5gwuQgdi3EqYUca8JsG0z/Ne4KJkwOYtdC
-nFrIAROm1WTKr1nWpjLIrgc59aBDJsx1iynAVsmEyYHsepBTDzpgwrhtpgQviro8B7FrIU4t5IAJE14y
-DXhZInoOZNeDnHrQARMm7TAF2CminYPYtRCnFrLfhGlcMf13VTRhchCnFoJqIQdMmM41U37XZW0qg5x6
-EKoHHTJh5idMAd6UTJgcyKkHoXrQARPGP6d1n8+LujwHcWohqBZywISJ5xMaC55PikTPgZx6EKoHHTBh
-dgtad7OYFNDOQZxaCKqFHDBh1rgu1nu4bkGRyiC7HuTUgw6ZMFuXVk7cSdGEyYHsepBTDzpkwrSeaeXX
-50lRjedAdj3IqQcdMmGul7Ty5bJE+RzIrgc59aBDJozr08rnXgn3HMiuBzn1oAMmzIk3IUbHlTcpmDA5
-iF0LcWohsgnT2GPCuGu6R85Xk4IJk4PYtRCnFnLAhAkjWteLJiVtKoPsepBTDzpkwqQxrRzFk6IJkwPZ
-9SCnHnTAhGkktO5LMino8hzEroU4tZADJkwnpXUv0hLRcyC7HuTUgw6YMPMtrXuzKaKdg9i1EKcWst+E
-8V9o1eeXScGEyUGcWgiqhRwwYeIdU367sjaVQU49CNWDDpkwu1emAF8nRRMmB3LqQagedMCEaTdo3Waj
-qMtzEKcWgmohB0yYkyate9UsET0HcupBqB50wIRZXtC6i4si2jmIUwtBtZADJsx1i+m9VlmRyiC7HuTU
-gw6ZMG6b6b2LkgmTA9n1IKcedMiECS9pZe+ypMZzILse5NSDDpkwaYdWjjolyudAdj3IqQcdMmEaV7Ty
-y1UJ9xzIrgc59aADJsz2ihodyVXRhMlB7FqIUwthI8NznTz2Kx4ncyoeMc/eOB9XPVeevZAeAs2ib6S5
-QCwVfCa/XSDdXyE3IAolCH6QU8wdkbVMk8h1xB8OeshuEjxmBWzJ7KzEBa/6GpKLDDt9Q7vMN0el5uxq
-Apv7H6R941vba4NL8XYcYgskPScnF7lS0RpoVkDvTaSCLeCGPi7ES/DKbujzRPlKy1KJXMelzWxSwiWZ
-tQE0t52m2xsb+Cu9OsFeyVsZ0vOKofFuIJ7PG/KnquWaKbuBVax5dJcPxYr8ucNv7tEuVuTf0i5WfCxW
-5I80ftMczRTe0bsT4i3CqUTpJblFgMhWFM+SPwO55ARoFt7A3Y1uSCIBSlf/oPyq7GPh+0TSp9JuU528
-94gZdM6ucBkv7BFOcVUru4tGPreTTdV6NthnWjh387f4GBtBLIsujJGpGnCbfDDUsaZr6qRhvCQfLGEt
-iDtj5OU/w2JTudcmPfnbGn1xac66ME7eDVWrZSzeDfgtu6aRxh966kPDiOIPhmpphlF8TFJ683pAM2x5
-37i5i5tbDeMm+mCo3exB7UHTmGMQeR23y+CGeLVbvrLRNdVu02hHH0xcsRl9sMRT6Hw4gkX3wriEQ7Xf
-Mk7ggF8nWRgbs6c+zI2GafGyS3hjDNVxG/om/2jVBp6bQ3WUwmV3UESwf6kH3aE6autpd5DdqdRN9b5p
-xHha9w3jOfrAn+ZETWMdfRiqPnBeNh8wZ7f1hjEuP8J5qT/cqY9tfci/Jdg0ltEH8sitqakIJbsPmoqe
-UvyfNWgYF+sPLB90fKl74E6NQVtPgfwlQgx9o76JwzRyUAzDIEFB8ubt396c/+f/+hwo/6mAMEziJLJX
-yqZ9dnHWVP68SJJV/Pb8fI6SKQeeOaF//jNpAMPVLnLni0RpNZrN01ajeaN8WCCpIy1NFmEU19feukmC
-IlWxAueM1HrnOiiI0UxJgxmKlIH1QZqGmyzSKZlAsp3G52JO51MvnJ77dpyg6PydBY3hxCBTPP8cfA7+
-y/VXYZQon988pYGTuGEQf37zi1y+sSPXnnqoWO67L26pchSGSbEITcuFyW6FCkWub89LgzjhrFhvHrmz
-Ym9V83sKI79YNk2TpIxhEtlB7FbhPovC1SzcBpXdnM6jMF0VsQhWaVIJcdI4Cf3TqmkF9qZcMrWjYg92
-VMR8GiF75kSpPy0AVvbcDWyMU7GFPZsXSfqc+tMwiUp1bQ9FxZVbReE8QnGJG9DMtQtlnhvXkMIL4+Ic
-ktCOk1Kv4cz2ShVDL3GLXa7CVbhBFRQL0xgVu4hXbhCgqDhamriem7glTlpFbkDJ8EZ98xazuPLPz4Gi
-nJ8rkCyqwveIsrG9FMVKGHg7JU5XpIOJHccTJ3JXieIGsTtDypef/vnbF7yllf9CtrNQ/uSEXhipyp9I
-c8UNWElMx1GU09Of/kmLfnur/PRPWu+3XzDwN7yL93SULJCPTr+xu+kq1xfeeqeY5Zar0A0Sqb+sEHc9
-XdX1e36u3MdI+eIG8Qo5yRflKYwUzCexEodKsrATZZ2GCZopboL8WFkitFKSBaKl8RnrY4KQUiH4YjuO
-6T9uHKcoPm9dXF/8RH47oe+jIDm9uOhctG+aVy3c0+npUxgkp0+273q709gO4tMYRe4Tnjyb4Z//VF3l
-Z4pWvgc/DMJ4ZTuotgNRg7T/jXDU+bkSJzsPeZh6MzcmjGQnp1HqodMgPN2gYBZGp6sIPbkvqjJDjmdH
-ZGtjKOVSO0hUJUYecpIwwsXr1Pbcp50bzE+xrFWVVRSuUJTsSj3ihTk/V+6omA7Oz8mfwzDybc99JeMo
-4ZNy+2HwTkEewlSMVcW3g9T2vB1ewCWaKU9R6ItG6MyJYyUJlQj54QaRDgmKsZLY0RwlbjBX3ChCHtrY
-QaJMo3AboyhWtgsX475aeXjmSoC2rN1ZaWJIcWPF4wpxYH04q+KIADmhZ8fngTwzjDHpSw+dFOMjOm+e
-KXBhB3NE8fkyDV9OY/fVDeZvFYcaBafT8OWLYNYvW3eWLL7guQRhothPT8jB3DvdKV9W9mzmBvMvShjh
-nqIZir4QPJSWGAaz9gw92amXKJhPFMoneLfZnicIQ5tdnCkwjCLkJKSd5wZIWSBiNVTWb58p7yO0QUGi
-2LPnNE7I2uHFJEPFmIj2U4IiJYxcFCR0rR0ysxh3aRlKGCgPbjALt7HyfhEGSLGDGQa5owkd5LISl8Re
-KQt3vvDI7JJQmSLFCf2VhxLk7RSicVd2hKcmOvsc/Kf6OfjPt2+n6CmMEP1N50fkjLwYlJx4LX7B8qBJ
-9tLnYJH4Hq0sbbq3SrZtSW2y9zH1Tin13irNs+YlAV2QTb1F06WbnCboJcEjolNKvrdKs9H4D1Kvnatn
-r04FslTUvlWi+dT+85+mnu0sVaXxM2l1yaaJJdjC9Ynw+/wmQNvPb8j+ulTiJEqdJI1sT+w1TL2ZG688
-e6c4dPm9nfJny2g2VCX0sCHIl/1n0vWHkT56y7Yepu7mMtuAsow5DTB+hIuE5CAqG8tK+zRAW0IjsgKf
-AztKXMdDqmJjFaYqT+7csVeYY8jvNMJlYUgM1gWyZ+S/RPljYeEGqhLYGyyjiJFJF4mh9VaZeqGz/CWj
-DghnO3lT3lFkMIf5djTHLFPF8K0zRYsVW5miOFFWke0kroNnjOWJYgvm/DK1nSWeWjCjq/VF7K8JShQ7
-UNDLynMdFzOnm7i2pxBWsD13HlAVLwTAFimOHSiejdk0jamsUxQy1S9usECRm3xhbcJASRZuMMdya4mU
-L39JFn/9ItaZ7IBpONtR2lA83yoNzuAFns6plakdI6KP/ssNHC+dIVoX8y7TP4SNcbWffxFdbRn3/0n6
-K+sqt0H+JP2VVWGs/ic8a0pJUpwR663ioack21lFwvO20znfmGJ/pKsVNjMJIZ9CJ42VME0Is4aBtDfw
-Gjh2gIXvFCm246AY64ONaytLtJuGdjSji/th4cbKiorDGK9xGmztAMvqfO9E8NurFbIjrIJsMtv8gKQ/
-n0i2OHE9T4lQvAqDGd6pxAZCkULHydQWjNDMTd5WWi2pmzhxfI7J+jn4W2JP3WCGXv7P5zenzc9v/v6W
-zo8wBZvkW6Wh/C+h+Pm+oeNQLaWQnecGc3kXabMZISeTIligKlSg4u1kuhF6Cl/ETposwi2pju3qJy/c
-4krGbE5VgGUQfl1UyGZJUWa8yxlJYmfe71tl48bu1EM5HhAT/7BbhfPIXi2YRGDWChUI4YptlJguHJY8
-eIeJ1mDHt72qfPnLovnXL6df/rLo/PULER8RcpDL+sFYTcMEm/OsyzPlASlBuqSCJwlXdOGpAMKyG9mx
-iyKCcBR6ytbF21vB9pYSOzY2d+xYcRPF3oTuLOYtndDz7FXsBvOz7yGYF01VWbRUZXGhKou2qiwuVWXR
-kYXIaRKuMOWlEoroW+VPnGCnOYAkiu9QjBJB5DBQVnZkk/XIiDxxfdezI2+nckpxXMNA+fKX1V+/xMoc
-JXifoORMuQ23aIP1wxYptheHtBw3Jb3lFgHvqRR7DBHyvyhp4CbYMokTZM+wIfMF+Vh4r45FV0y+Fl9t
-Oo3QxrVpOEDaPXqKdYKdIGWKFvbGDSM8N2L02Il9+p+KnSSRO00TRHgjTDGcuKrKykvnbiBUVGEbYnGJ
-rfqQGvV4m8FFFPpIJdtNVSxDVUYrFNkqYdKJ/WRHrlBZuDuhr5w0iunE3GBGZ0vtuZmYtbANJaXKSE6t
-KkkYKBc3p8LM47YkWWLModKcqYUXL93VCs2IZLCn0+hviZt46O8q+4vQKYzcuRvY3imFKf8U8qDQ51sa
-5cKsnllt9XWUWZgkaJZVpaR4qyyQt5I0EDccKUM0Mmuu0PUpRubUDZZvlSBkU+D2mz2bEd30zwoWa0bI
-z1Qs2du4B+x+lNUqMxA4+4We+jlI8T8z70iOZsOx5grrQSGdhOy/KS6vnGyDN50lktks7IJZcioViLqz
-yr7OLjnmDIBVP6fwfTALubHGBTLrjth+xL0vGD5KI4fdVP0cxEkUBvOqqeZMGGIVk3GLe404PlvhM+X3
-Wba52JCxj5XEP2sMq+vGf/xcPwjxrgpWqpAyZEvx/fQlTqdfyOBf4nSVGYTMFCEuJdbRZZ+PdFMyg8nM
-U0KulAnGVUjjm9gt8OzE3dRai1eX//FzmU8p620Q9gFsj9t22GAh25OTK50q/1Q4P5yetS6R/4vyG5uH
-Qpj49IwXSpR45wbLmE/dplPm9qHnBsuibSkLAFohK/ml2s6UPE6yZpLwm0f2TmqBdRb2HDaE2kvuCDcb
-Zyw0xom2wAYMD4Hlpksg0qRrp00rFib/m6SMAhLrJ2omRjyGgtXLyrMdtKDuH5nmeWD7aKbYgbMIo1j5
-MzZGwjRRFhF6on7hduE6C2Vhb5BCzWUUKL49Q0J7eDthM58So5NEokRP3DD9mWoEK1G2YephxaL4YYRn
-F9mYXZ7CaGtHxBrGXjNR3/bf8Dz+jmmJbXA3TGPq9KnKFHfNrWrHTmMUK/EKOe4TntNOoaE83NK3g50S
-JgsUiWgSNv/tCJscIYsvvOBxn1xmx9YECgsnJCxa2LxpN1qEBd8GYfJnOuWf6W+O/N9/zrFnJsAr1pjo
-jSqmOZVM+squ6jsTkdT/39tcJ5lnkOOhzP8IZ4jvsBUJrjjhDP9nOZ1hSWH7q4rASXXssk52NJFPBaIc
-pQpnM2KlCanI3I2C2PqNTUxE1tkGLegMybasVo/CYi02xMZiE89DNijjfQqcBAiD/53gqYZb7tYQUydC
-9hKTPHYxVWVXxk6T8Jci8U0SHxESjoZLBKYaC084YRC7MRmD2b6YOxM03yl/9u3EWaCYWpXEvyAb4Oez
-fRpTjG+RozWiYhgWfCquz7RpUbb77mzm0ZVmJpMwZZgxlDMfid2IbVc6EjvnyMvPU77M8WYukH8IoyXz
-sbFYw71NPnYzn3OazmkHzcZ5s6m4sXC516kbEVtz34lA9UZvda6vrvPrtnBnMxT8spcUBb+UHjoyQpIj
-SO4LE3oxNw+95Q4fJRvX+rMwxS1oZW4ZsIgaU9o0fky5+09kgFMHed4pA/wiVxIeTk09rp8YmI6zL2ZD
-GrFqeDHfKnlXKVmINRww5hTxtb8kM+Jgu/MAWzLKdMelG978xKwh8bG/TMPZ7q9fVIWuPOuOnA7GicLi
-w1j9KLaC9/SXbJb0+EyedcGWzjYfOXBly+TZU+RlO49sbFIWC7lAdxM9mcLijvrpUrDSDYhtxGOWZSeT
-dLjHoRb7JgtIUpaJ7Jmbxl+oWvNtZzRhFqpiz2ZSMElHie16sWInX8Hz7cbNxeeAHl0LCuyLPYgTIxLE
-9tw4kbibTlXyINhe5gEzm7uQ55ZBNvF2gSIWSJGC/1/ofL5I9hfpKkJx6hFXX7EVL4zJqUWeZKwh1YLF
-U6Ijw23nnCCVQbbm6oV7lbnyy9ULkfPiAIA0Po3wPuQ7itCEJAOofAysZkk4B7tmq4TGxj8HmIftCNlV
-Yd/KuDf3zWV3paC3ZVuiQlEz8M8HHVIxcYIJI09l2K42WljsiZKAdkW2L2GHpzDyq3ULm5EdOIjwAHpJ
-FNFEIkflBis03obR7HQb2SvcTgqj/I5NdXPTyOMkxpC8/iw825SO5JQHNO27ibQ7/tz6WZkh7OTuYiUg
-fpryxU5nbsj8w407Q+EXfsbA4o5kl2jBLArdmdLOThglI8wN7KnrYWs6CeleURzPdZZEaWF7ImbHcPkA
-k1i0v+E6/4cnvnx+83eVRW04gETwcHlWFKdT38VllC58r9DQOl6Rtwrtrhj6H63cwA0DO0Gzt1jwKZ/f
-LOxg9vmNFN0KwoBLrBnrRj5I+S/3SfkTCohAYxH5U9r49CmMTlkaEFPXHElFKaMpl0o4ZoUFLLFdjv2F
-t3xyP/PyLCDFJsQM/d8KLt8dP7cLUMQNK7woTJlT7cl4njpPM2KkRihOQiZh+VkGOWbKDuWFsHt76oev
-TGqRgaqWeG8tTou9lQRtyrVyFg633SsMTVmQirHtmRvSdciVOwvkLKfhi1iMfQfGh05DqMVamKRCd5Yk
-YPiqSC3YZs9NbWYnqGLGietXFePaGHTqhY7tVVTwwyBZCDSrbYpsn1Gx6a/CyPYU0g8xdsihhGIrg3Dq
-ekjSJOfnkkiKUUKMNluh+WuyusiO04hUJpw5Rbg2N6G9HbfpEGZ5NBNnJEQordKkyn6fpvP4jIqLszCa
-n8eLcPuPaTo/c+bu/9+d/Z/mxc11+5o1xHvjeA+/2ep06qQRtnAwf3ADN6eXCx5eDdE53krsRKHnTe2I
-cgbH8gPrM1biBYmdRIgk0ZB8sQiRmGFGO3rIjHZsh1OvM1kgN1L+vAgj9zUMEtv7megB2w2oI62wft6K
-jjhCTy7yZtiM5lwDeNoNLpSOx303OCV5Ldj3wU4CcRl/+YIdPN5JrLJO0oBIGXQ2P1O+/GXmbv76JVbl
-WJPcXeOXL9gZYCOJtQ+VLRKHQDZxsVEQYx9ZDEePL1ikiYxoK3FiBzM7mtFIEpf/3+QRNlsXlzcVHLVI
-fO8sXiHnbLuwk+2c8KOfeom7sufo/KdkgU75HE/tYHbqoTkKZqdcFxGTTsY/F6OQWYfLbHG4FD5J2Oc5
-gcaEFTwFxbN3IdtGBXGamZKZcM0b7M28lUB2MTZdVkyk5U97y2YFMXZzRhZNm+LzlmLZfBtQ+tSkfygK
-oxPJs6E4vJzKZeKwqBLXirOIqjAVge41fktBOXGqtF24CTplSX7MwJMzkHh6bHVURUTMq44NhPDIHGfp
-iEKofLwe5DTwLFvI3KJQI4laeeGT4gZORBaBtJwh/hc3gsQYZ5nmDlJ/iiKquZmkJGr7NF5hKhfNworq
-YZrkq1OCcFLLYTJhLiA7cnJqjeRsYMkbuTNEU0HQSxLZCnEU0QyTDos9LJhoa67fmDXLs3TCNGJdfjnD
-fsMpM52/KI5nxzFJ4aHuLpa6hHLJAvlnyjBMEJNJeC4s0yR2/ZVQbVPinSMS7y72To7//zfNDeQxbQUF
-YTpfnClmGCkz6nSoSox4+OMrlNnldedM8kxPw6enGCVvldPW6oUbK7lYLJMxFEPZB6rWiLIJxryknHOF
-DTnJAuKxCuFC4HUhYQxxLlVa64xjaJF8qPzPr5hX87s4PLncTBKwZkEQFxGbKcvoKjqQn4MMkyfXQ6fp
-ygvtWY75cYcVIuWAZ9TMI8qxZJKVC1ER4QrTRLjqdSErdlrn+3a0K9QkeSZugvxKEcXzEEvnmlXeDbOi
-/JVn87NdMYzw9PeMYBnSQZi3tXexsnBniOTnMeRJbJCEEmn89gtNU84yMf5MFNL7NEJwMmEHV0OEZoiG
-m+nq5gdlkeq/0R7/XjHxUu7VG/X/8XtC0qWH09Opl6K3yk+NxtX06YllwbvBzJ2Hb5WfOp1m46nFSldp
-tMKu3E+dp3bLafJSklTxE7q+QNcOK4uwr//TzLm4bF+yojDCu/Ct8tPT7Ao126x0hzxihf/09OQ0G1es
-dB4hFLxVfmpd21eigwTZHi5rODc3vKKzs3G95pXdml6zMqLSSY9Poju81D91nKvLq5lUdjqzIzz1i/aF
-3W5wdCIX76MiRWLkhNg6LXUUpyRJsThbN3gKSzOzo4AYOXlsZ5gwUZFeHtWsPz1dP9082aJqecLSXY2X
-mBtQueLYf6tcXnVWL2WQP3urXHWuq0De/K1yc9OqAr14b5Vmq9EQsLp7F1gIeug03sUJ8lUFeG6wHNjO
-hPxthkGiKp/fTNA8RMq99fmNqtyF0zAJcekt8jYIG1DKEKUIw7TItT0MGoZJqEzsIMal2XAYpOHxFEgM
-WcMPn11cJRuismyy86ehRwtJ17nm+++FTMxBGISnd2ieenakKgMUeKGqDMLAdkJVgWEQh54d467fuVPE
-NCFuQ8eDYRq5KFKGaIsL5DPZ3745hf7o7PnqxPkjcuaPS5dvqAr7/898Tv++tPOq1OsSLf5f4U/hDRUT
-1nhCVbvRqFrtS9k5+qnVbF22burPD8tpOEzIMrv/WzOdD2Ud5xOOqw8teFe/J3u2wf1M6vp9Q8re0VmS
-BxIkS1twb47kcZmUpSTKyvzJPSMXUyiPyLL8n5VgedVofGtS5fdMnmT5kuWkR0lKXBMh/tvxuYVS26vL
-//hdKYWU73JZhQLMJiHlFnIWyuVpybZZfWrV/rRB3vFbKfEv6/6yM7345eAu/e37p5cd7pJOWFX2V5KE
-7/cdvVKq/5KlfB2Zi/YvtJzy+lCS6BH6OilRlwkmZ3zVbdFvTMUq5lR9Q0rTUblLBzOUGmdXQlgVs5Jk
-GN8yklP0zclHe/J/pEyfozN38vpcDt3UZb38YbNH9qaESNwuF//+TJCvTvbg3HtMAsWebITvlIiQX9T8
-OX7lEXltFXE+XlsjOxyvyheoiab9OL9n1n1u5N/+veftv/fs+Pgz2tLJ4ded8P3Os7avOWaT9WdWeuzp
-Wt3B2tedqRWOk/4IZ1iVBzPHnGp8/3OSrz2eOE5UfrfThm88QuDk+opgfZ3Pr34OzjDkDIPOMOwMA88w
-9KwQEigZDAWP6rI6etKSZ3C2aJa8rFbOAKETaZVryXXIPC9KdZrC4qLVCCbtimq5WgTVy4parVy1jkSR
-ciSJ1DnzkD3b01GBYBeZJ3zGlu+0TJ7Onrb1xBYdlil5eXb5+7osE779e7ssL9LFN3bJw2Sy99IsxBNK
-DoysRYRKp01XL0oceu6sGKw9a/6cCx7gnbQvilAZaiTNfTta4tb4v0UT46zFZ1gRVXSertFFxntYrKQB
-MURmeVcli58oVPpUWCu0PZViv681kW1HSMdSG2oUenacnDoL1+NmIVu0iIVQcpv3jL1V4sZ+ie43jO5F
-GzxdrVDk2Jl7d1YdUKoLvlVJB6mLUxqZr7VAKjij5B3W9MmPOHjAggR/3yqf33z+3Go0258/NxpagwS7
-GXH8+emTl7oizFa2dar0OmmXLFJ/GtiuV2ZJsTFrQ93Zjsr2z08zhFqo80uVZyn1efQk5fDCfj6jNU9F
-rKFen+WFSrGDXBiggtGql9EJZ6gsE67OLvONpJNh4gySg8S3NP/xFJdkMb6/KlmfJaOS1FlOZ+Vli5Cv
-NM7aFcxcno1YxvIKS+cf5WWUtgSeQ8U8ShupUSUepSDxqrTI1XuphIOYqOimcikOmejSYuQt9LNVhE5p
-zmsWS8Lsyxnoos3PfrkzdLp7y7JkRScij5UFAqp9ES79mpernC/IhLMozstK4Xnl4ujyLvov8oSl8mfJ
-6SJn4Ez0FmeX256XAr3f6nsjx+bH9HbVOqK3/4+9P2FyHEcSROG/wsnq3MyoEpWkJEqKyC/L+vhm3rZZ
-V79nXTvP1qyi1poSIYmdvJakIhhVlvPbnxEEQRyOg1RIWT07XTMZESD8gMPhcHdceKXdBtv92gJbtzhv
-g873pbYO9VkTe+Pum5f5szA43LRyDwlq+qQB/dAWdgEOqUHyTe2/3WACyniGXJHRjqO+uGMpy93jua5R
-WYHTt2ZdhwX9vu0Q7FRxhT/hrYvffnp8s88Tl4acgpi9j7D/0ndc4rahSPtzQX4uyc8V+RmQn2vyc0N+
-bsnPe/LT9/pfeow+QTkjlNruIp+qlNKtUkq6Sin1KqUMVCnloUopG1VKOalSykyVUn5aEh7zO0PPHwj2
-vFUpy14aUfbSiLKXRpS9NKLspRFlL40oe2lE2Usjyl4aUfZaEh7zO0PPHwj27KURy15ypOwlR8pecqTs
-JUfKXnKk7CVHyl5ypOwlR8pecqTstSQ85neGnj8Q7NlLjix7zaBUzaBXzaBazaBdzaBgzaBjzaBmzaBp
-zaBsDaNvDaNyzaB1bkMVr+l0T7teeZG5oqOJ5GJ6s1KU6IDKEkVkgvUG67ILq3jYfUUBMHNPqHO5SNVj
-mT/3BbJPOIzkoY09vm6ViRpPoIjgYuyrGr8PId/Ol/h/bwUKfDmDlv0w4F5AuP31fN3+byMiFz6wTLNf
-BvRLCP0iEPH2JQzCrmjAtIIwLZcKKQgfGLzclwF9AKFf+Qo5CB8Y9NyXAf0aQh94It5ATgkHgjJsQEwq
-bQiU6hDA+rCF0K9V+rBW6sMa1od7CP1G0oeNrA8bQR98DxwVKoXYKhViCyuED466e5VG3Cs14h7WCB8e
-eZ6kE5p1gg5bFwEd4rKqBTNIAmC3M2HsXyxkEqoA/SUL6C8FQA+G8lggT4DxFZQ4QgLMAoZZsDALAWYJ
-w3ANEtuzgmFWLMxKgAlgmICFCQSYNQyzZmHWAswGhtmwMBsBZgvDbFmYrQBzD8PcszD3Yp8qFMHnNMGX
-VEGlC7wyiNrgK9TB5/TBZxQCLwL1msf5/bINJJUXQGVgiiO1l0Btdv4i1VZANWA6IrUDoDYwu5Daa6A2
-O3WQahuomlIKW6D2WimFe6D2RpZCry18RyjF4EP9JttUcxahjTXowwl6RxFyFTXOIuAuwknELwwrjNeo
-9BtBz1H2HS2I+TAl3jtQu5FKR1Igs4DJCG6jxqNU+5QCpSVMqXckIecScC8FpCsYqeBMavxMtacpUApg
-SoJfqXE51U6nQGkNU+o9Tcj7BPxPAelGgVStT4FGoQKNRm1hSmu1Rq01GrXWaNQ9TGkDaNQG0qgNpFG9
-sTP4qRpPVe2riqQUY/xerVP3Gp261+iUrxrnHqBVTKHaTJEdTCnrxiodWd6VlVAM/qzSo+V9WgmDpwL3
-eGgPAvaVtAXSEPBCBbzggRcQ8FIFLDQabPNKBbzigVcQcKACDnjgAAJeq4DXPPAaAt6ogDc88AYC3qqA
-tzzwFgK+VwHf88D3oJIoVcwXdMyHlUytZaKagXrmKxXNFzTN51St88nYISLl0eW6PlgXMvoDzAKEAT2C
-AWgJAvFT/VB7BdYGZ+0BKACBwAl4AFqDQPzMOtTewLX18tqCQGu9vO5BoI1CXj7c51u9wHy49+XpxXL5
-LnHT6DfjxpPlgdu48Wl0Ezc+jW7lxqfRFdz4NLqVG59Gt3Lj0+gKbnwa3cqNT6NbufFpdAU3Hq8Y3saN
-xwuSt3Hj8Xrnddz4NLrYjU+jS934NLrAjYdMrbUbDxlQazcesonWbjxk+6zdeMicWbvxkIWyduMhS2Tt
-xkPGxdqNh+yFtRsP2oURbjw42Ee48eAIHuPGs0PE5MazI8LWjWcHgrUbzw4AsxvPary1G89qurUbz2q4
-2Y1nVdrajWdV2dqNZ1XY7MZzOmvtxnOaOtGNF/bNJW5y/M248WQbzW3c+OR4Ezc+Od7KjU+OV3Djk+Ot
-3PjkeCs3PjlewY1Pjrdy45Pjrdz45HgFNx7vrLuNG4837t3Gjcf7Aq/jxifHi9345HipG58cL3DjIVNr
-7cZDBtTajYdsorUbD9k+azceMmfWbjxkoazdeMgSWbvxkHGxduMhe2HtxoN2YYQbDw72EW48OILHuPHs
-EDG58eyIsHXj2YFg7cazA8DsxrMab+3Gs5pu7cazGm5241mVtnbjWVW2duNZFTa78ZzOWrvxnKZOdOPF
-AyuJ2yS/GT+e229+bT++SW7ixzfJrfz4JrmCH98kt/Ljm+RWfnyTXMGPb5Jb+fFNcis/vkmu4Mc3yc38
-+Ca5mR/fJFfz45vkYj++SS7145vkAj8eMrXWfjxkQK39eMgmWvvxkO2z9uMhc2btx0MWytqPhyyRtR8P
-GRdrPx6yF9Z+PGgXRvjx4GAf4ceDI3iMH88OEZMfz44IWz+eHQjWfjw7AMx+PKvx1n48q+nWfjyr4WY/
-nlVpaz+eVWVrP55VYbMfz+mstR/PaaqNHz9nbpqUbx+DLxqBrzAgmOrTbPhdvudhuHRSvDSszgvN/TbD
-/Rw8MRRGTn/1pHQNWX9BpXRH8sKEF988/h35+avIlgHcrVJWDPhPWRJL9oKWrmJHo78fR3dJCQjF0hwK
-p2PrZDtTfuIwE9lSd585hMtCJ6iqAKRdcQQXKxjpPqp6ij1fRrqhLuOi5R5XrcuHrD65+cGtXwr0Po/6
-C33kS0XEu5W84E5A3V0/TBEDtxGz95KYCWwkCuRJi5lY4HzPSYYpjVTN+Wa3jcLhzncBEsLGdsxQT+wV
-+kU5eHoGNuFOZqATGo+LlSTQkPvDPhqD53uuJfqKp3FU6dsiM7lI6COuXN1L0TraRjsVGQVGtn1sXbGv
-mG/G3totd5vdTifl4WEVfX/tt/vd/jAGk6bHgKqncZTJsy8zsUDsr6FU3Vv7JVrvdzABEBvXU7Se1E/9
-F2MvbQ+Rf4+0siWv3Oj7aOdHB0Nvc3h0PSRWPI2jGmeHfMb9JfRMX6QxdQgFCMIr42GbQWqIvdEVm7ti
-vT9EoU6E+GUhfT+EuyhCgSUSTSfwtU7j6JHXjmZigdAPTKm6Kw4HhHYhTADExjZpqCf2Cf1i7JbDITps
-tCOkf9xJ3zOHA9qGvj0eTedIFU/jqHbPTs2Ev4XeGQo1nRPsZfNFAAFcbINoLbFn+g/GjkHR/Vpvusjz
-WoZ+8XfebmONRtMtYr3TOJr4FaUZ/6fQJ7RM0yXRITogCDWAiW1KX0fsD1JuHie7w/6w18mxe9dM3xto
-j/aHtS0WTWcI1U7jKEbk+tLhL2l0dEWaqX293+5DAK+Mhx8XuIY8KtpiYyfcB/f393u9MpefTXP5/W63
-Q5ZItOOBrXUaRy/c1/ETmgl/C70wFEbWUdhGEYZxKPUSuginRmBivdN0Bpw51qFenfioUnMRJvO+oKBa
-q2AVBQFMpbcdPJnVfeAFGxUldI/26ABRAhMMEb1IeEwrLhh74PiChIDBtKkYTw1wWXZhEQQzZ/gHyjEw
-tMzpBrVIFeQYvaNL+8yC4SaY3zNn7To2SlQVeVbFT4i5PwO4B1XOLDJ3jjbcujq957//3N1HilNmdX7e
-n5iUJ8TE9w7Yf+Id2l/UTd2sN/qmDmcMv2JT0+gVmnp/7+ubOuzD/opNTY6v0FTfv7/Xt3XYq/IV29ok
-Y9sq4bB+WwTk34Z7BV0F47LpZN9jt+a2v7V4Hyb79/48QKnzXb+c4HznLIrm7qOYYO8+smsOr/PGpn46
-hExvEhcPzAtcjeoS8j2KVlFouoQc3xZPLsxkpzXHm/tB5aCwasN+Nz/Xs+7BnlMY5c/yV9Hgd3uzKrdE
-0XmPIjfN+0s527/7kSN3H88RfbrvC9ThDw9uWrmoKcJM6enxL/yZVAh4Jc+2h4S0jbeLyBfuUTyHEWK3
-w8LrLyzv3Td/saRT6YKZuYWW0zdvinPtFkm4Ryf8ziPPPPMKW16E+7h+4W57F4SZ//IqmNpeeVW+XhXd
-ayDpn/Oa8Sr8U4nCKM+Sl5/VgeXg4MpEunfSAI3shPAUJmc0Xjll/vEbQTOxED/IbmlEhwsmMQrmNT7u
-0UBsYan1/K41T3fgC4LKisCDq3YP3HWGFuKTuiIQq5aMitUUj+DYctS7vBBHC0uWFhqevPl2Y2aK04Yi
-CWP80Jn1tCo8F7nUvRfJfAR72PZdanuD382K8Ffq2RWNYmIYxMEXV6lgAlQVRZ2zur9dIMW/TyZ4Lxrn
-ZUF8F8h1MWmG4ekLuJEgi76aQcyf/u0ZW+bYnRCAOf2pxfvzzIE+peekjgv6HDf0FEv/8B/guMAvt7TV
-8Fueuud2mLraAcc9Uyu8yoOhb/xGAvxEAntlOuVqeOSAKbJ74gC+mV2igp+X1F4BD0hUuO0dkinG6zLP
-og7Yw12VJ+caAZ2zBN/1dk0UqGPh/IfDfmTmV8ULSnBl1XPlPOHhISxOb8gLQ6D6MN94LSLbpfaIPPHn
-OLgEv3pVceXQo1viA1gbtbQw08YuquqwjvcftY88U2pLf2F4j33+FCZx5B4QitqZB36yULn5TRi42gey
-FttwswoEunWetyZKq4fdXree8i8ufqa737wrs6p/FVSeOtimzP1JE4plXnHVxkLrzcxZ37eh0P2dPpTt
-5PQcYjc5jsIaRfzM/IDLhel6HpP6YGKXdoJklKDUwZ3YmjgNj+jBOZfJe/wKbfiASz5UT8fvmjSZvV3u
-q6ej06RJVn16d6rr4uHDh+fn5/nzcp6Xxw8Lz/Payu+cpxg9/zFvPr3DZwyc7bu3S/R2uS/C+uQc4iT5
-9O7tYtlx+86JPr37YTFfOuv5ZvmX+dpZzYPl3p2vXH/ureartevPV44/9935NvHnvtP+uZyv3OV8u5+v
-3fl66fjtz8WmtYjzTeKunNV83aJYzgN3vsWo/Ln/y7sPHR8tk2+X6PGNJIISFSisW30jv4oVBi3uTIOD
-BczEA0TC/haWcad0pDqt3bmXd6pye3Xpwi+V0rDpArXq6CN+Qc3ZiN/IXTtL8BZpNg6MGBTloADxG6py
-Rkqac6GWgV5VP1zBj8KwnTI4B8Wr80Ktbxb6CHbW/lzVeep2fiY1PVzpRbbnvc+1zvnWWTofnFXrZ8MN
-fn07tHIC0A5162zEDjneXzxncVr9knpO8BfPWZ5WgNkY7AORd5+N7YzCh23ROL5XNLP/o4wpniIZ0XAW
-knTzh1cyfYC+UtsHa+1tjR/An5X108IN5k8xMEEKprrjDaCcnJto30FY2MjjqiMsvar+5NYyUQ9FKUYx
-M7kyx4I+RBJ8aGsuLEWuBhUlruBeJXBt9QnyJgrbd6TAsvC1lzsExLEDwY2R/3iu+keBp3LHPyqsslkT
-mLW1QlpwyRipG6e0SSaQV1WeB6yo6OLussUDdttytUfqBRD6dWzTurnt0oZZYemb9arzpLJN3cvjROJ3
-r9PCcTinj752BlLYCfxJMF1DdcgyDBCvy5CtLVDDSoZA0RClFdDWn24CRI4l9bbsBA3c1bzJOLtZ5i7a
-LwNGc3rK/0fl7hYLb+YEy9fL3REpAokK8gXUHNoVXzt/R8PKjiMmBnUXjrtwNs6GjUKrusw/Iw6gjUM9
-x0uWzjL13GUbSfcB4z4u9wlyyk/v5oFQtm8+vVu+gz+9qD91UFCNLjj91/9cmT6iQspcH/fdpGh6EyUM
-DNtsX6/k/+FIlmw2HlSd9TPQsaj+Wtk/OuCV+T9u4P/mM4CDAZPSBxdYsP/KAv6XSR5M8tfLF4oWVKXj
-tzWhII+WNtQIq8kdGijZ1H+VHOL0WUMJb8gljpg/dDCvkVNk0FpmFTUQfF5L8PBHcGLdDXpwfX7RqhOM
-IK+VKmJQj8w0GiDH9MkUzixyIJYY9PZuEsP2FsyIwpx3tLNnNmCvrFSvkIEciQk+c75ae+tIeaSx/zq+
-eZdmIUfhsclDjp57Ne16tUzkBVinj0wmGSZbAXMiTAfz2kzZ2wo9vCEvaWcljDCvkp9UKb51x4zNUb6G
-94onZuVuTMtdvIekpVvmz8JOXqB89J5NmU1p6y1wSsT0ZD6LjxGwpvmQALTNUW9CZS8GD/efhW//OFd1
-fHjBtgNltfAVPLjwRWqTtAl8csvsLhjXqYJaGSbLb5QUhKN+4nZi4JAs0zDxSsY0jqIE2dATD7PoKAOo
-usNnuAdn8lcunOvxi9wrGRyGzm9f4eUugfZwa5vK7tJWbtRneMzQMexu6+cu969OZZx9Hgrlvd3y7u5h
-8UZz7y3Us4LS3ljsRr5czmiqhmNnu3d1Jkwtkv6DR5iBk1ftaAJEoBmk/ZHVc4XKPrEyLGbh06eqT5Xi
-C1xqfyhsOC4t1hh/9tvy8BR48lp95FpoCnws+9bHtqkamU5r7+rMcIEpVqMI7fMy5JAM8H1OcVe3vgdN
-H77WseoWLXOct6VID+H8yh3T9eZr6oCHPNxjdohRElWIOcETDmIq8nb2KV30hLK6kttIrzu1vcrG8zY7
-+OA5/SIhH3e1yzeet76P7mES68V+D5Jg+qovm3MpX10nLbczx18NvcR3EsXHdxYlzXfatWSIo8ue1B3+
-cz78Sa6ncuxB5sONVtWpOyfHtbXMiyh/bme24zFBYzqv6yKgbcF+N71tUA9btZAA2rXz4bV0Zria1lZy
-zCUAguSEA3cc+pFjKwjXi/UWIhKsgt16oSDCyp6W2o8vf9mawU5iWmGJQ2zgYPQgmyjOEcPMEkgx0Jg2
-TxxqtMfka8FQEAS7C1oId/gFw03Z2ofXUyJyybCt+LjNXbodeAzykaNt4W+3S3C0+WiDliuQBCf6rsx+
-pG0WM8ffejPnfqMWkTTKCOXRY2ySCMeMMBsQ1fjq2zpxdNEekvtuv1ktvcltgzr4kpEFt/PhlVQGXxZt
-LbNNuNjB+t5/4TGPHE/+cru6X4P4/U243cn4WVm3BfYjKWgNzWY9c/z7QCEXcRxhiqMH0XihjRhB5vqK
-4dO1b+rY6XtDbpK38TaHaU2SOvOCUQM17+G1dKO/zNv+6YxvDoe9720+gvd9ky8S/tEPdHyDvHDrgbep
-Rst75HkgFVbqpMx+FC0WrXXZ4ChYLSlxIPWk4bF0BUmOGFFWIIpBRZurG1f65tGOkpq3X98Hii4cPbrG
-NBIeYIqmPryW5pBr2W0tErdKplveHHCPnJv228VyuYQo7KKFL/gLhAIr8q5ozMgKZs52qZi2CTZhXBGy
-o6eoKdIbMaRsIBQjqm/nxImKdo3caQv/sIimNgzo2QvGEtzIh1dSle6y7DEWdnu4P4Sghe2/CNgnzFQL
-tEYgjShEHgoAGqzIccmIseSvZ87C37T/3KtEJA6mjuqkKWq8AEeMJgsAxWAizbxgcur7BvAvovvoMLFh
-ctdeMJjARj68mqpMuxceOFzK3RjfYx6bf1guNgvYD48W/mIl4+ftVvnZfgxtFzNnu50590uFVOTJqPw8
-fioaL7JRE5GpvnIaats3NVrq+wIIAP3Qj6Y1SerKiyYguXkPr6MZZDENXocatVAiYBq96HQBKVbUwjf7
-ASSsFOrlJA4mkSN4XLGNNKxUqxs7YjyNAlUMLanhkxemLujg0QNuSsvhsWdo/8Mr65di3WrUaoqEa+RY
-vJAY1A9TFq287czx25neX1hJTDUmTYtYbHNHj8pJK1cjgQ0j8/KVrAs7fPLofI2VLaMUHl5f46CVrlFr
-MQKmsW7lJaTAfhi7zCXct6CXk3Jcape92EaOH5Xj17pGgZpG5IVrXxd18PTRePFamKH9D6+sX/La2Ki1
-HBbN2IWwyXQgwY9bGGt9CH+NFw1XBuGoRp5moYxt2ehhN3Z1zB7OMOAuWy2b3JmTh9qFq2e6Zj+8tiKB
-q2mjVnkETOOzkRdRg+Q/fh2tddr9NoLemEWlGnb6ZTW2kaNH3oSFtFGghvH3CgtrF/Xx5IF4+UKbQQQP
-r6xi0LrbqOUhHtHIee8SSpD4xy+6cWfotDJSjULtIhzbwNGDcPzK2xhIwxC8cCXukp6dPPouXpnTN/7h
-lfUKWKkbtZTE4ZkwC06nBQl/7BrdajtzFqv7mbMIPJOQVGNPt2bHNm/00Bu9SjcC0DDwLl61u6BfJ4+8
-S1fxtI1/eHWlklf1Rq1DsWhGznjT6cAGb8ySXrCYOcF25qxXBtGopzrlEh/brgkT3bh1PXs44yR3wTrf
-9K68YIK7aN1P1+yH11GjJM7IyNIe/2RWb4zn9jBOaJh5XrDeLWEk5yxCZdtYGRO/+yDjBpAWDy8YmE1p
-U0k2jCblMoHxVF9yJPjw0XHy+PRwKPDq7991O9NTjonuXXOIiWu+EtjSx0eMRz0vOkB954go+It52Xtk
-8Qnzn+qXAn16fFOdd2lcP775eYCfCVVKVCF9jd25rvOMq6K87WJ+CCNijNijseQQKTmA20owLCc+mUzx
-G59KDiPU2ZnWmtyJp1nZt0iTsKjkuuKNw1x1mgxSHOLvFcTjHgh/cE5xFKFMOgHdVW8VmBxQniYdkTmT
-jFpzim91wL/huwL6P1or2/+eoEOtae2AS5qRnk9xjdyqCPeopU/u2wABHh7CQ91bS/UBff7ugvkiCMi4
-FI/es9/ozQKPbx7fsAO2f68Qpd1BeG4w04fx+q+q91P56wZIYc+hChoWAkqL+oUTheIpPgqYouw84lpt
-9tIHesW275GpTr5l+5DkYf2AwTr5D/fY+J7wwi015uwDg22hT6yr/IAxNfuGOxYo/SSuareqXxKkvfLg
-krfj+50K5P/mfmB1gTfXI+4wZkruZVS2ByC4coivuUdyOyj2eVXTzUI83iplWJKZ4hXjC4yA4U3gTuBv
-MDEAk5v1VsVkGl3IZBq9EpP39wsVk8nxQiaT4ysx6S88T8Vlk1zIZZNM4ZJMKQ5kn7AhohD0FWDwsQHw
-bey5L484jtZXn0kunD/gich+JoGEMWpG6Xpc2Xld+2CDZu7Hvh1AL4p0f0MugarTuPGg7kgVuNSpNvK4
-qC8BmUoC4hBg+2GnC4J7QVXD/OowoAwC3dvrwiRWuChBA8tevWlsB3N9102VGjZEZvU2tX6UAlsIUK/A
-VG1/atwiCfcoRVn9vz49vqnz4vHNzzNHXwsLw6JeJxaLim2D6DvvohXthcu9oE/RRfFTHPUy44PLwc1m
-fG8w4mSVYXB3v0H3aI8OMs24RqllxgJIpfhDKmWfoLB8aFt4mnzJWpydUBl3ygpHlWNuPvPg1nYJO7Yb
-cSmTa6O7Ytb+1t9pc4H6dQ6A+pzmi3nyXTGc4h1FXNhkzhNnkoA8+amZQNukOjdkutSv7vZbWv2Ewkiy
-woOCSglG6SUoPmxX5fuABltlNVrZucOlm3oO5SEjjoYhJ9jfxMmkNXszqE1JcfdrkgkHvFaX+aa/1FDg
-iaRWFaxxeVeYw+GmV9/xB8soFcF0yeDVUWcT8kPyQ42RzbpzH7iVHfYLs3aj5oKuc6irWKCZs3ZB0Zo6
-z5NdWE6/ULnV7QfhMmWhTLjas6rDsjO20r2e3eWl5LvMJHvTLJdh1nc7Xlo6xGVVu/tTnER3M6BW94dc
-F3BCXL9oDNSSkCIgS1m8k2JkgUHADYxhhu5csSHZJPthQI2rSEjBXyssLXtSBTDj6VZFEtfgM1bePFgP
-C0DCZbvMNw1e4mrO1PExWA8Onbiq07zXHgXrwwquvrB85XynQKJY11JVV0h4uVEKmH5il/Ts2BnW+kay
-o+ZGZgaa+qgtiuIS7entsuc0G8wX/Em813gwY9ylxpwJ011rrL7UWNkCi+kTMI7SmiE8oehtAEzHYC5x
-HAFbS5j8OLOp48lgP5UG0tJGXV2Mo20qPCOI3JKlN0iRuE+c4ef6lPWJtdgdfhU5jOK8jYBtAfB16Lu8
-0cPwfI6lqIFmyGvXzrolo9ZeMAtCd+b9D5InY+OTX8Elo8asRPX+JJsz9gNkT9h2fM+/KjDTfx4eHQAq
-co8GqL8f4n4d+cKAYWjc249aZdc0qJ3KRrS/rW5qpqJ+22xr6Y5kiwccwyAIqWCVfxTCzKFU31JBJAA9
-P1ifrLnpa49R1u8A3VUHFxppDzGiruWmWpgJ4IEg0/M+NJpcqni1IsDjWlm1W5zejSKQ/IHXC6SMfIqO
-gJlR2Zl6hZDKZD2nzDLjXkVScyD3D/Aa1cUYuKDsGn0v8cN1pOGZrFfqW7coUYGySBCXGxZt6ciuVmKn
-viNAwpjBo4N9YSQB2i6WEGhElka8ZLfkzFCF/QZ4KUN9seLFwAbxWtUwMdBXn8K8DlYMF/STGpUDkOdQ
-wrDabEcFSLJfwdCZHsUxLSrwG5zF1S9wVzG9Sv8+8IKN7t0h+xWxYbUP2vr2zR5Fqyi02e4m9YAyPNPX
-lEIxcc+ATK5LL0nTcf9WvjgVk/rAS2W9wLkH89uOcr5zFkVzZ0ndgh5cBRor35tHqAxMBs0kWIYwYHh0
-tG65mZ/loMt22vd/V9+2/0kbNBog07egCFeZqAEysL0GaAkDGqCjdeuTFCNHmbLjhcSzL6SXBefPWlbT
-exQWsSrZOR14GhsDkvH53LHYRQ5vGOApdXxmW3ekeIl+KBPEl4BPZIVBo815j0cFd+xVAmLo6UpTBpbZ
-exdnrFmCV6R87tSV/Dq34h3jMfsueIfZ15Kjz4oqEtk0gHL9j6qDUNCb5aMehpfOXijuuLTZnARy82ov
-uWteRFQTfoXH1bfeLjI1UjgqS3Z5TOsA4Lb5ZbSBu4d+0fBG94L9h+4dVsXFiZMwCuLUxDMgEYYrhQEA
-4zZxy1OdF1oKPJe6g1jsG6h9XDvYGM1Gy74KtUzULqm23wG7gTXnpEgs+E0Y7YJdROJBNuSG283k3W7b
-bF3rWiG47SwU1k7gvXU+4H8DZmGrbwwJPkcMZVU8bMJoaU/ZPObQW3EaHtGDcy6T949vorAOH3DJh+rp
-+F2TJrO3y331dHSaNMmqT+9OdV08fPjw/Pw8f17O8/L4YeF5Xlv5nfMUo+c/5s2nd61J3Drbd2+X6O1y
-X4T1yTnESfLp3dvF8nA4vHOiT+9+WM+D9Wq+CRJ3OQ/uneV87S/ajltu23+Dv3jOar5YO4v5/WblbOaL
-wNk6i7l/v/zl3YcOcUv17RI9vrkbKal2vqpRmcZZWKNJxnbSfPMqTN20E1fOiu3Eqi7zz4jvRs9ZnFYX
-90hvqsc5BbLoDTdBj2Rmip5MZAkntSaYCsDwwKh+azbCXTnuirES+7jcJ8gpP71bvuOthVG3dA2+rWJV
-z3G9P/F5gG5KWsAmnQBYsERmtgUz2fXzGLu1TZiwwySBUx/jmWE0A0+7OLHVp2RoRqtnFH/tuWU/E6a7
-tFj7zXVW/Tc+ZyZ8BOxs509oG8hfU3DtR99n9Cl+TPWQlyn4MjzPlabuTd6p/w1xYyvAKRdYjFRy0wUX
-enwXmVvRgZZkQnopCWv0P9+TlaK7oSd1VeyZv7HpZFKo6rOL4tEwRZZ9I1odeX2NWE14we3V19aU51z4
-0OL1/bcAdMK7C7k6B27heH/BTtwvqee0bvfytAJcbCbsKcmFMp3cuqXCD9uicXyvaGyDwZELg8MgCIsC
-hWWY7dlbOtw0/wX+AhQCesff9gXnVRyH3Bc25AsvywOxpB8e3LRyn8LkLORbGCVSShXA+lN6Tuq4SNDP
-M2GV4qdWr3/GqSD866fHN/7jm5/v+GUrurtRs49edhA1Ap7wNogi/0IQYoGhpgil/SlqLtwqHbE6NzRe
-yjMMN730x5Y1p0n0i1UQm8nRehFZYlLDoyrfrF1S5fgzbpt9fdOt3FArbQ203z50KTf08i1lqnvEjki1
-vbnUwDBMALlQiQ/bAcigTcLs+B5ldyBazr1hcmp/LPPnCjHXIcg9CaH7qZ0X3R0G/hnGHdZ1+Z6pBksE
-TNwCecWPipuSxBuuLvREJMdjqpPx+rOuQnb2qVlZhHwynNt8ByZrdXK1kait8GD9tNvmROwoe4+AKFJm
-88KC3qUgZDGyI4KOX0FxcSuCFSABy7sKruRL4SawrhR1mAy1Hx56jqokxiu0p3O6U622tUrcavZsklHk
-ybZtxSU3JFldn1bXMEyxjZj57csPCuXT9YJxzYQ7uMe6QzZvFYrXW5FR43+NHI55gKiGwaR8hFHylnkI
-DR7ulg/duq0FwvKcZa0j4dZlCF/WytwhI905Idqh/bms2nKStlQ+noDQAq2BhWXVVUO8/ijHhzTwjVr+
-z6nMow36RZoMSnakFgs4LtNgBtk/u9JWk20yfEXaQqhKLzf7p1b466h1dYk+V6+iyNUtNVihp/qvhCl4
-gYlpxyFOEjfJnzXZb3kAWQ4RjPtcFMJNGv1mt0CdpJxCkqbJdT6sZu1qDEpo9u07M0KH8JzURoywxzuZ
-QdC4juapmsQMuAwxgyLWGbzQcGPLNN0IqVrqwEceHXBnuslmZeHTVW9zEhKj6i1ywEXclEPmRQ2bm9nY
-YdtD06v4aIHuxQuQgfn0m+tUY6NFXYe7CrhthMvbUBslgOHfhnsVBcFyJ9x4mEGgcpZIYeXlDdRswKfc
-Pi/klWQ2uJ4RPqlXaLp8zPBTKyG73rtsNlTSHa5ek7utu55Q6JEpWT5hNht+sotFA33gEl7gEp5XuEum
-JVnESaJQO62aCHCCIMnX/mkfWIQH6/16LXzrQ4hDyv5KEPAo5YC8u8ApRpGKgttNDiWK+vWiwajuwiqm
-Yh8AcBb2CfXpaFx4LPPnvkDDUh3u+rukWvG1fxahdMSAs4MCCOvVKu7WzMInelvg172xxuI4rnD3Frlw
-C758C5+MdXeofkbkLlzTJNTK4Xtn3mII46y7Gg4odg/JOY5uc7/ibUTDSsDdlfLKrbRoKKxtLn3dEiz7
-FTzgYndo1HwdsNwOduIaCse4FS3URQ7Y6GvpXsUd69kWjK64a0BBUEajfXumqsM63rNvx0DsAGf2TXpl
-XjPnKPTvOhmMNo3MWbtNC+1N98SLU3px4POSpfIsLbzrSXmieuRt2EZXUjPrM9yL46svHjvCCJwb7/PM
-dq/ZPBDza3PVhfnMti7bMyRdp7F9ygh30CPu7jT6XkvYDM/zBPN75vEb0t5uf0x/WJmZcExV2MlHMZxh
-C/LF8gkhiTohRZW9u2K+zJ/ZSyfI6FB8U14XbLowuH+mRmJKssywwS3zZ5Y94IsFfsj2KZfXbfDxJhm6
-A7cf4ppNS1+uoE6CiyL3r1RuaC5vkOH50/mXOC3ysg6zXie4yVT+rLTrzEtHrGVnHyzSMcsZZOhdtC/q
-Yb5ZbzTDPI2M/SJUucYw5x7hkqj/9oZ5Gl13mAv4Lx7mIr6rDfNL1el1hjnT3N/+MGeYvWSY39/7mmFO
-bifR9YtQ5RrDnHvGTqL+2xvmyfG6w1zAf/EwF/FdbZhfqk6vM8yZ5v72hznD7CXD3Pfv7zXjvEmMHSNU
-ucY451+ClMj/9gZ6k1x3oAv4Lx7oIr6rDfRL9el1BjrT3N/+QGeYtR7oPAohRwQOAfWXix7A4fmAM87Q
-V7bXpyTyCEppEOqGIFxuQmv9VLURkT59OTcd7nk9gasHGVSqaxc/umzGlnZkmccVMKp0/HEDCl5mIjWT
-7s0ceeFAOGHav6p9f2eBQUwpQlXk1/nGkgKUS4EpGINJzzxf1dyIzRjS8OK5iHJpg1JcsJ1pa5NFRuv6
-lOGWjGVV4D3EsT3OqbWur8X1eulteDtCTC57/I0d3GnhZft/7ywOGQNXwsgtfEe+dX7kp3cLWpDEGdqH
-xad3mFVanMY1KpM4jetP73xyOHnlbE6LxQ8rxw+6n4vlabHQXAkCS4quCE0cexhBeJlaYBT6cUvqTLE6
-UVh+1lhIce8HDCJyB9QA3is1oTaYQHyUZfgH6gw1Oi3HVmZQIr8ZQ19jDCXECxvEGpMoV9ZaRA3TgkHU
-1FS/D6tuh9IAwj0NmkGpqn9nQ/M3ZAuBxn5Vi8iLCzSIY0ciYBXNygGaQaiKwdLswzJ69d1Eo/dQMHmS
-LjR6zsuIOOm7EoWf3bbAuG+P+YRfBaO7PxvFYrrkKyz4saQ8yttK7XvnVKreh1S+O0kg53gzSPf2HHsJ
-L1NOHrZmPv766ltHAW6Yq54lZphv5rf8ZH7AG4EBjtxdHr2M3StIt2T4EMY6roXHnoatKRu5enXeyRD9
-mcyl9kUJlihqaklmepBhMrbZjUFB8KtW9A/5YQ5QJuzr3MyGlv5en4X+3Qz1BUX9YPKWdx+1e7AVo09i
-UTkIeiXi7lBzWwp3YJknipuI4DtW1UeNQakDD3leWwl1tPwwtTHC61hRDVrmSL2t/CDZMXvthddjvPla
-qUIu8CwLuZmPAxNUB+w9sm3ZlgWZDIMxTo9uO/iS8OUVbmVgVwb05ilOj/BhNLOiyw2o80KLTJo6YKya
-acSKjU4WWk7AicPADTSJGPmJ0P7zzbaEinQZH0sYBuQMndVGL6EdYC5cvVDEfBEfeerP8UlvOfUfvgj0
-mfZo5AlJlJ/PPYdowzCfM0UG6WvdSeVZRcVcJs6WTMuZzmTeir2tFg1P3L2aIjFtmapJX0Rs3/OaYd/V
-YJ8o0X/Hk5H87LaQu09Gj0/xeInx+RKbl6ztaXLWezYSjHXkjHxP4alr4li2WFfocmnJr5CYzlIZXyIZ
-RXJEDwFwyi56LaZsuggA1fURzBpjlzpbVWnskhBcWZmmHmtvRsjR7a7Y3efnrO7vuSJ3csCfFAA8umNY
-8Lv0eZTAZ/WXvCxOYVb1m/ux15M/07+/yE2EZ1JpB73sP9F+CPf7vIziPOOtb+vDdib7FEcRc2hIqs9q
-Rn5w65cC8TprDL68ccgZa0Hp/arw9UfR4siYUWoMkdUDRjIDbJtGvJOkO+spd69sTnQnlHclCqN9Sa8E
-uO6JdCbQVcR9/nAYRjoLZXlHnCpxNDS1Owj6nSMV/WrceWBGwt/SbD4ZVaouE2GPSTOHXD4w9zlacINT
-RTxPUsronEWobNl7bcRcLkpAAC55CK8NFeExzjCu0doJbb6DdUqvNUV4ROyGFesXyMTDqeqERn/wW7hB
-ccFfoah/CUVz/6RwSp82iM0iCpe2DjSD9W75Ude3loNSuvhFZAZ6KHrxqpdBY1pSuk7sYCBaGJFQt0lg
-D3ywmXSBDYsEvW12XaDajzuRonDN6pjT/OpXe0TadBVXpD7lYg+a2R97UwNgX/BOY3Gsy9nZYPyjuGpK
-Rl1UK97SSu+WggLAHEzTwqWdEqpYqFKduC95flZN6gJ5L+zGuamxl4x6y0HPOSthdLT1QzqxY6mvZKFv
-grfytckb8NrkKU+ci8d7d2GFiD+ifaqAvWZJfVfUb/K+KaZvDNdFhV3dfim//9P6RHZXHaVF/aLbArqr
-M44rhafD3lXD4MdrLIo9vWvFlt41r9H0BjhP0mG3KOM0LF8mXjQT8lgESdJi5UYIBYn1Yr9XkcC4JBJz
-6MrmCW4N49V0+Cu0z7NojIR4TzsU8YgyGj6Mk1KwCnbrhZqMICf6YbKkfG87c3x/0wpMIavzfo8q+yYs
-tuFmFUhN6LBIciLF46Tkow1arlQkRBl1xZMltGqVab2ZOet7UD5xdsjtOd+Ei91W5LxFIUoGl40Ui78J
-tzsQuSCTtmyyQNqB5a8XM8ffrkCJPIdlFmdHnu+FvwgW92r/c+97G5F1gkgUTV8MSEdPJVreI89TURFk
-RIqni6m1Pf79cuZsQClFYXYUdiPqOjfaLwN5WHVIRAGR0nHas4sW/tJTEBBk05VOF83CmznBUjmkut3H
-Y9Rne7g/hCLvGI0om65wvOqEyEMBTEEQDi6cLpvVduYsVvft5OUpFKe0v0Kue1FL7tXys6w05eexBida
-+IsViFxSmPLzZJEEi5kTbGfOWjQ3/zinu7wu+3QXdc0XupSp7gppXXZ0OWLdRWCMYW3VsrZgTkMKLZlw
-xk2X1E9QqX9+aMw+NZ+PqS64RAnzhZPu4jwxXHLG1hxCPiCiYitGcZXGVRXv6HNQvAxXMhcsyHyf5BWa
-tFNJK0ht40Bn3fNW3jZQ6el+jwLFi/bbKGRSSTyFUynFzT3c/WEfKeGkXhi4XGyChQClcK6X22W08pUj
-b4GWKABzUOtoG+2UNDRt2m/3u/1BCals1cJbLBdrEQ5yg/0g2GADCM8ZKxTxLxlRzpZovd8pKGhatPOj
-w04Fp+6l3QL5SwFKdlu9fbBae8rG+Gh/8EGVQyhAOwi9piXhLoqG+ZQFUjdjvdgvxWaAvuY2WK+8lSbX
-udxHUEsOB4R2oYKCpjGHA9qGvgJO2Z5guTx4Ynsgr3Cz8PdqLTtsow2sZYdgL2sZIaBrjb/zdhsYTNmY
-1b2/8DeS2Zb8uK2/9bcLZVtQ+x/YlugQHRCIX9MUtEf7wxqEUrZkvW3/k5ov+lz+zkcLpTnDRuseHPrr
-/XYfQuh14/5+t9shCEg9WlZe4NEB9vt+m8Zn9HIowxRVTlHmxxJVlbsLS7eqy7hAxLodypxee8e0bZgQ
-/f6hMLIJo8611T3xMo/fAhPznvTopVLpORNgc4iQgt8EE51OYVGKkdYNL2QVLntgEtTSbQ/MNzF+GJnj
-1i+gsRlg7IA73nzdpZmn5Zdl0ZpeJQBUN1Id8WtjnbB0j23Hoqx+vwoidJypjhQGd84ieDtjnWq5IPDe
-6hAYPm9EdGLBneLKT+neaE4MYRanYd3LgT5WhkuxGMHx7ldEQE6cHeIsJp72JLALu15oAdgGqgoci7KC
-dExMHqZkqAxXq3B36gLXqmB68FEv9owXrc+c0PnNXe4scoib7Yb7YW+LuIMPeJGAtThi6AWjpuc/FZ+h
-XRe+lrztFhA+g6WiD+0B0uethJdyBbwXbNIxZguGBxrGbL/RnsK67VlO02FN+BTf65/gFPigm0JkNX2Y
-/vqL4Q13kQdWERWboV5jJwxD9pSX8S95VofJZZc2wSjhgSGe7CEKbWOvNLgvUlu9wljtgbXkUqHuwvG8
-V9t2ZbdL2JR4BdsG3eI94bY9JW5YeZTqoz2fY01E1iI7PbLWJMuDL/YMiwoFq5SlUtmr1YhTGKZLo+G2
-ApdHv5p2pdENtCuN/sm0K43+k2gXd1cx3FbgzuJX067keAPtAoj8trULYvifUrv4G3LhxgI35b6aejXJ
-DdSrSf7J1KtJ/hnVi2X5kJyrk6Jr+wwm4CVarVgbyZjCMG6vpYht8tVAOhZH3fBjDhp1CZAJS8XCgrAC
-49h8iAEM2Eai51JYfh5HTnMbnCL8HFgRw0/yBWZj0sq2sH6txDm2C4yAQCfoORXWy8cSHNsNLDN8N9Av
-CkbGL8ULC+4KjKO7QA8GdICeS2F5fxy5seJnWeHFT7/AbIzdOSDsD4DQjRW8DgayPVr+hK0II2iNNjwM
-H4Lh6b/APEza5cDtZVBgHCt4AxggeyOX7M6JceTGip9lhRc//QKzMWFTBr/1AkY4Vvh6KED2Bh75fR6j
-qI0VPcsJL3r6BeZi/BYSfqMIiG+s4LVAkM5rORQ2pYwhNlrjGUYEje+/qDp/3H4XYVcLhG68tqthoJlV
-y5+wgWYErdHTKsOHMK32X8iNL8NWU/JoLA6a5OOy/KlOi9OEgzfrDQuRw/Zqv2gcb2hDXoT7uH55cJjj
-oJg39sA7iFJ9jSYGx/eS9CtS3TUl8+HPvttt6rIbxym3G8ru7lzXOSfQYZ1y5LOw5PXyftG/KFBYhtme
-vQABX2cDfgEKycZ4zJpwJ7diKY68Y56HVd1HjPRdpmXgkUSJecvRdmO6GVPaGLIN7uBrf4fXh63v/b2D
-9vXzb/uqgHrpt2xEZV64hzipW3K75Fy+9z16f6HhO1UVz2ZvFZa44u4yww23GBRfXk59NErbB2op3qPX
-QJ3iSP/sfVuLu8Tmgh0nFo+ta59rBpadr6SBFlfgesz2gU5KwwYZcTfDsJMmj8LEzQuUGW5/Yip2v/P1
-3YYbn7T4RXiHhgEd9mIc4gZF4pEDNoc27EDxAu8jpBzyLhk6UfQFoCHhTuewLY3iMMmP2l0jhCK9bKIb
-O/xbRTrLhwnND2GEHIAouyWvtxO47JCXrRYuyVHz7pw5X//CejMrglIdgi8Ja9TqpRtQ+2SsMmEvm156
-pi2NHTQ2UQA00DSKQi6T1cat9mWeJCE9mzPCRrWTYK+7+HJa/Pa4i7ci3hmpkQ9kl+owrYoYn04MSuOw
-V9Lp7PDMXJG9LJBa4Qwdw+7ZfW/YzFKdyjj7rBqQAOrByumtTo+hM+69b3Ld2SONs7Gd2bOnuC1smEIN
-XSq+fq8hpdfdW++mFgz3FL5No0A9cE2o+V6h7AMIOeJf84EMcWIU5ySry5Iu95MXd9rDppqpuPd+L3Eb
-Vp4gi6dnUdmeTupVFBVL3fzzq+SDg3UHh3ioy0SirEW9yV5qaYCSUQmPUHxuwd2h+hmhTLiXnjuErHRY
-hzu2xlxgpbliCpAbGBr7wCHpB8fFxey/wJyhefxDf82UODcpLMCUR0rkxrOz63UnNEFjUBZp3kLtv4qs
-q96lABREf3nYmFvOOEl976huhuZu+RMCZxCFKoQm+wJAFN18sgtLN0VhdS4tzmG79/f39yQl0m8H7VMk
-vf4BOZOOku2eUsiPZpIygecJu1dane8yHMIbvlqPQu3kLnHodGeBCJplNa6uATHvE6r9Nks0vJegcNVU
-uKoUEP2Sit52j12HLDnOmL+GLVAM6q0Nan6DlQab769EdPM6z5M6LrRKzszVG091KING2EMS8BCmcdJa
-u7AoEuRWL1WN0pnzxyTOPv8Q7n/Ef/9bntUz5/HNj+iYI+ff//z4Zub8Ld/ldd6W/neUPKE63ofOX9EZ
-td/+UMZh0n76a17nzo9hVrWlVZhVboXK+NB++kNLz/kTvhfuX9P8H3FbZSABlv34ku7ypCvEqDlwJrPZ
-30RbpmEiJ8RXnnLy4Y8CtVZMKhymf/WxHS6PzpdCMXGC6tajbF0EbOcZxvFrbPgdNrkYrM8fkqTl3d2E
-HZ5hmlSkgdVPwAFeGtFO2D27F6s587As+4oKXQY0t3+hbr7teez7zZuv+AwrS4Y3JApyYqQnLscobiwh
-Soan3uHencolHOC3Chy2oBX7T41bJOEepSir/9enxzd1Xjy++VlKMq76E8sQVtI2a+SsxOWNYyDuXm4j
-aQiXVg9RBREZ7cWufUMzwaPsbNjAcIEdAzNjuBogW1FhRMzW0qUEWPmy4RPXWkFntxYs2HeCwArfDdAG
-S7gjgP7oHEdDj5AHMIxsdvXG6Tt5ysq2UwYSbK8I2zJV6O0FLpLhJQ5u2exFzksbcMINwm41zMxgW2uc
-8rcQ1mLu0bNC5vRsiu4zHNj3BM8J3w/ANmJB8dWdgUMhqCt6ZuIsG56BoO7bgjqDukuXLS88UKZSzNc/
-5MWwIm++nkqR9FlrHMmhxW0A9F/+5H/5k5P8ya+aM+XHykWOqS+buUBMmHl6yozNEz+Eh9owmAHOXtmt
-JTwNbm1fYHJrpS0RgWD/GczO99wUZEHie9C9xYmC9+R9kO+6V1W/dVzmCXYFXX7iGUF/hAcwZ6YDpfcr
-qXJgwTvWkwmsM/rFpJ0n887dB8DwwnjuOvZ4z51/NkSpPJ3jbK8+lMj3gP+uUx/GtwnEQS/lyLsBz7ue
-MMf2iidxbu/0cJ0H9CHn7VtqoNgMSx0EWsFoIXkM1EYHTc1QKSMbtOgYFYIW9kFytTaSsMJeHQcq38uR
-yyhrJpC21yuZBdtwHuwSdWRjqVhSQyw1C2oHo1r9mskrNESvWnQa71/zm9ATChTj/PqALFxbeC/iQ91M
-xKJwJoBlysOm/Q8SzBC76prPxa7C6VbliMPBo/1462l8D4WwNzH/HMP2uiEyPiLXE/Qhr0K52ZDXcowK
-jbAcoXIbmPHZvxuttf12jeDGJz+SpAyU6iU53pNjd9tr7mmkI0A3TNCu/c/mdfjlyMfhl8Bb7IIhMT5a
-M9gyYA+sciMvvWGMPlWbnyuUaJbw+YpzbnPNsH+qzs/7U3+kwynCzH0htg4q53GymRr9TlTdVlcIJ6e3
-UASWoLB8aF3qk2ZL2YDT+po1GuiT4yA0ryA2RLw8oS9nN84fwj1yn+Iq3sUJXrVhtveavps22a7F95zU
-+2cnV53ZUZ6wRRboGdO+WA6EHAiaieVuhppaLi1K9KTQJQA5RtIdf+HLW2W4mz32x5GEr+VwSk63Afl/
-4hV79e7j4TvEWtsSiDVMXM0bnk0sWHNNvLkK5rrdzkC3CgdAhj52izIvUNl+InU+Tt3grOMC0hSgGu5z
-QGhGuLZD1IogXFAJnC0RkCt7b2ZZFyLufdT2xIPjVd24HjrikjFtbollbaYt1tahnQnKPMH9MoM+tB1t
-6+XzLorQmTfdzma5Q7mfpYK3Y1ZDhE2fvKzJR+YNwQvVQ+gh6NPQR5P6fTjXCH7tnsVR6QYBVn7WvM6j
-XmUQntoBt3GAzIqZJ0XVQWBMnKJB68b7PFO2EX81vcFJl+WErX60YPDeW0G4JSpQiK/Mdj5gN4rfRq9m
-U3Wf97lM3j++icI6fMAlH6qn43dNmszeLvfV09Fp0iSrPr071XXx8OHD8/Pz/Hk5z8vjh4XneW3ld84h
-TpJP794ulofD4Z3zFKPnP+bNp3ee4zlbZ/vu7RK9Xe6LsD450ad3PwTzReB4ibtyuv/8eeC2/7/o/t8h
-P11S/su7Dx2CltbbJXp8c6fvwN9WaxfzDW6tPw/aljpMC/HvffnKxf9ZtTbOongf1nlZaS2wEGtzu5rB
-9dRgskEeZV0192b3sXXwVs729IXAU+5q+SRxv2rQtGExDg8JT3SZbtii7clbtLkiuldTGKxL/jbdEizt
-WtEXYiPXCr4Vjjts/e1fuCZx5iutQZKN2J7pkSuaBVDX5M/Yqya59avMcUBH2sc3Ayx374HOedyHxXAr
-u35U9dpIn2LrdYJXVmazAqf7uD8WwlYMV0Jm5XmoXkWpCpwEcLu+7ZPL4msATDRQ5nVYo/fLtReh491H
-RtzK78A7KLckO4eImSdbMS9KC8TXqLG8u17h71boHszuRsj+XLajA2/NUK7yGF6VC4S8B/Mag9DE+SYw
-vjFhBwJJkG5OV20bEE940LzngnlSUK2LR5rK9t5qVKLahwl67wHKwH3Bu+qDARM3trXK+VX4mMvUr6mr
-0qyhVFRBCYUQV6OTuCFjNFIHIMvIVhv7p6TaGLB/QL4DVD0v7/xLnBZ5WYdZLcDX/TlESb55oYZK4yhK
-FDTJNyUsWY2D+e2+qbkdOl3BNVNBj0Xd8P4rAL878hd5Kl9IAIBDBpp5wfUovKROL8WRakNfmLhS+XK7
-oiHClZjKl9NVTYFeUD9KD56zTIsQ8Dd9k7pn1lVNYq+XVD5wrmyQ9ND5UXiZnGOYqw190Tekewld0ZDh
-okblS+SqVggvkh/ZV8RZLpl6UrGBc/xYuYJz7r5D5XPhKublZ8OPwiPfLK98beiLviHdo+KKhrA3Byof
-9Va1Q3rc+8g/x83yytUFPujb0L39rWgDcwWf8uEiVRPEJ7iP3JPZLJ9sTbnc0AX4cW5lF/RX2Smfx1Z3
-APdM9pF92pqXMa0nFRtGAX5BWzUKTnGNNGPgoIBj33X71Xj/GYiEiQzkDbnkRLIacJgWtUeaNQiY9Dt/
-o/gYJOwUbzp/r0EzLGBxF5mPQeHxovT0ouOrk11pJmEJQH0eyygeAY6mvAwCEcBIWkoDxHs80KtPGmDR
-y+DBlR4GBedmdB5YOZv3wMwsKtznqJpBe0h+FhOfGlbMYD0wN3MIL2KrZg3aQdLoMVrrgSy1lsKd3CpL
-SVvLWCuxrWDfYoOEIhqvyKdfSpRqAFVQgR4MMk7gUw88DvPjCzqasj2zRGP1mIOOstIITqFuFhNPXDKd
-I2U9lexRoRtLA+A+LvcJAoED760OsoiTRAFnoOnBvEIgeOvRIW5eZ4tS5GZ5Bl0rCZGO3C7ZAmZgdABd
-dkaTuIGBIShN9Rq4Va4rU1d35YTSUK4B26O+qwU4/AEEPCSoUV9EIyo/t2akla0CL/NVh55FIlMxXYvS
-Gm5Gg7Q61N/lgWFYRTKoEgDHaoaVRnE4YGATVM3e0qLTLxlqUDKzmgHQg65ZaBsHP6iGndJp1Q7qByUB
-vfbZ6J/9i3yRm0bjlTCNpilhGl2uhGk0RQnTaIoSptElSphGlylhGl1LCdPoayshd6lQ63CMV8LkOE0J
-KdwFSpgcpyhhcpyihD3UNCWk0BOVMDleSwmHfvhaSshfPxW5TTJeC5tkmhZSuAu0sEmmaGGTTNHCHmqa
-FlLoiVrYJNfSwqEfbqmFRRn3ucV55OK/xqteBzZJ+1jQCxSwQzNBBzvACWrIAE7TRBbBRGXsUFxJH7me
-uYlKzlG6Q5FboqrIs4puWTKdtpGPS9KrgPnHQ5THhkS6xrup4TBcYl8qwVu0Z1DVGO/QAD/hAvBLvvsH
-2tfgp6c4Qvm0HerAHVnqVwfY51ZgKbgLf/dyLxyNZfeBrRbzbbDxV8u3ahz+Wo8jWM8XgQZ+tXtZasA3
-Olh/9+JrYLkNyHg7KDUHiod4Rf03VmFwdxdfm24uN1GAavENcEv0hMoKaRtCK5kbBFeVGmYmK9SzaqiR
-+HMZFgLJ7joc/AGiofrMIM1yJVrySY0YqiDwqxDVwJhWRqZqDLEDTYjKtzlDuFVfGZR4d5MncN7ZKfxc
-AshyC6RIpw5IfSVSX4PUVyLtnmuQeGWedgDR0lceTIh9JWJfixjkWNh23t2ELgoE71XvvggE4Fuugaow
-NZRFIK223IqSVBGm0+38BUmRTyZqUDWYFrkPHiRG6hqpcTfL2xAN8YICSDOKq7qMd+caWZIluJR73/CZ
-MVBR2OvPRGKKS/dNZGQNIUQAFZFJIHM7QNXgNoxryaj1giXCb7EUycBbLAVC5n2Yfb+UqN6fVD3TfdQR
-AuuwdHSmAjvsGnvBYbDTA7WtYIgptcHKXPCEQI1gaOm0wsJe8MRga8FQU5gMgZzRYPBUQXPBEFXbDJCu
-yWIMGgPpJqczGv0ciJo0tELJAV9TIlDqXvbshgLkigzQD0pnhKEADQCWhGYEdDRs1B9TknWfpaNUfoaK
-VvMxDVDtWTI6ve8omZQe01HYQpaS3iB2tMz2kPQRpHJ8L2l0rqOlVjjTOmnXwymb7rGM8CxivD7x0hNh
-A70xoZ5dsCdSkyK+0THfiKgPbqoNfX3wNy78E7kYgjWrKNAQB4rY2WDQMhw0BoRQC5RSNMeFlpGhSHUI
-D20CRH2IKOJm40S7SFEXK4LYfQ12X4vd12PnI0e72FEfPSoo+BoKvoGCog1SIJmy86NdNDkintSQpZOl
-TVhpHVhqCLIzp2V8aRlhaohyjqNtoDku1NRQZx3IERHnqJizJ89FNyqt0rlclsGngh6kTmrHyyoKVVBS
-6JHW/bIIRxXUeD/MPiq1ikuVfcd4Y9bhqU2AyhM0GiFTnGodqSrpQmqjD1gtQ1YlRYX6GCNXq9hVSVVl
-h8wh7JggVkleYYisYtkR0axGvWCNNge1dmEtT7gLaVImth0R3ZriW5gUPH6MYa5doAuThIaOId61iXhh
-YopRYw58zaEvTFBpd20iYJsYWNWPsKJahMIWwbD9pk1MNI1uEBGn0S0j4q5JXykipk39qhFxGl0zIsZ7
-fa8cEZMW3DgiTqPrRcRpdM2IuMd+nYg4ja4dEQ8UbhARt8S+QkScRjeOiFtjdPOIOI2+ZkScRl8tIlZq
-1ZUiYoU6XSEiVuvRNSLiVoFuHRHjvrttRKxUlytHxAq1uWJErFafa0bEGjt0i4hYbYhuExFrNPqKEXHb
-6htFxMrxc72IWDF0rhMRq0fNlSJind29YkSsUdQbRMTcCbJudBxvEBEnx1tGxF2TvlJETJv6VSPi5HjN
-iBgfPLxyRExacOOIODleLyJOjteMiHvs14mIk+O1I+KBwg0i4pbYV4iIk+ONI+LWGN08Ik6OXzMiTo5f
-LSJWatWVImKFOl0hIlbr0TUi4laBbh0R4767bUSsVJcrR8QKtbliRKxWn2tGxBo7dIuIWG2IbhMRazT6
-ihFx2+obRcTK8XO9iFgxdK4TEatHzZUiYp3dvWJErFHUG0TE/HUWmGqT3CAkbpJbhsRdk75SSEyb+lVD
-4ia5ZkiMb0G5ckhMWnDjkLhJrhcSN8k1Q+Ie+3VC4ia5dkg8ULhBSNwS+wohcZPcOCRujdHNQ+Im+Zoh
-cZN8tZBYqVVXCokV6nSFkFitR9cIiVsFunVIjPvutiGxUl2uHBIr1OaKIbFafa4ZEmvs0C1CYrUhuk1I
-rNHoK4bEbatvFBIrx8/1QmLF0LlOSKweNVcKiXV294ohsUZRXzsknuN3/5k70/HfD/hmLfhCmLY6c7M8
-qd+VKAGGe/pIfcWV3+ZzzS22in3gXcPy4E4TKO6BbTXnEhx7zaCyAWP2ord402hKK9JoWiuEK4untULY
-P4A15zilFclxWiuEO2+ntULM+bSIm2RKM5pkWjOES1MtmjHvbwRkJpPhkkDVpREUqLtFUAQjpQBgfxFf
-OwvU8V68nY+U6gD7CxBVFyNqgfvb/1S3AmqBD3HTv9MxQHaF+pbG+88vIlz/pCL5KvQOKxH5OzGALeXh
-/Q+BJfF+Q+4paPjxZ2/pCcjZ5zYg/KPfl2ZI/L46F22TKuf9e5VY7py8dN6LwrjrR1j35yACtXw/ip+5
-YkZMHLMLT3i8s3TzLHnRXinZmwLhfWiff+dXcx2n43TPOJdoX7/3Zg75v+6ZUfwsjYv90z6xp7iCkvDq
-4he7wl2CHronkGcO8Il51ksci2yj6CvYfatowdCOp7iKdwliGjK8nc0zX6ZhMrB7CiPmtU/8WDcuenA8
-x5v73Ssp/Wsp5XEXMrKZe5vgDhoiHQoYJUbkQ9h8HTLmORYWH0a0BLFpeWPMNYtQ9XDJs7sIuPdQF8Fb
-uF7gcfUUD748uxse30aFz/d4hL6nwjjMI4zaQFVPtDH0ZVuY+om2pq+oaM6JNqevuFFhpO1hr3OFqw4N
-YvUeqpsyckrDxjXIKj3x1U2stK7GE0uCcT087+lZCXPiYRg6T+CtU09gf8MEnmBZwohJ0ICiNjD+zL38
-Y76id/RsQ6ZS/Iy+i55QVleMORIvMIZeFhQG890gVpf2W3mMM8Utl2nterP25wtfXfMKXloSkEYA0b2B
-l+4UdLQP4KWJgpTm/bu0XzOg7VY/YJXWrk+48oHWawBLAtgIgL0MNKA7BU0qCQ1soiBL5KGBdBeiVDRC
-WRAGF5BQNDJZEOYWsEw0IoEpDiLRSAQm2ktELZAlLxBfLY4lYW4pi8NXC2NJ+FpCwvDVooCp0Zc01YKA
-CZLXM5ViWAli0OnFirC2AgSh04sV4WwFikKnFzBFKgydXsBEiTg0ehHwAlmqxREQ5gJZHEu1MALCVwAJ
-Y6kWBUytF8VSLQiYYCcIFRh9FHUIB8BqZPooXoT6mvmjIPNH0Ygwugmk2KkoaWeQIlER00whRW9dh8ar
-LWtB5pDiRYAyTiIFmUSKRoQ0zyLFTkXVYhopEhVh4zxS9GaWEY1GMgvC4wKUjEYwC8LfQiEYjVwUNM1z
-SZGoyJomk6K3uVQqvlomS8LfEnqmQC2RJWFtCUrEV8tDQc80oRSJiqR+Ril6gzvIQqchK8LdCpKGTkNW
-hLkVLA+dhihommeVIlGRNU0rRW99qVSWapkEhL8AkMlSLZGAsBaAElmq5aGgZ5paikRFUj+3pG4muOiu
-3kfPeoc5A7x0V++mZ73DnIGOuqv31FWUe9G4emddRbyTjxbYzRaSjHQi6h3obAGKSCeh3oHOFgoJ6QSk
-oMsISCcfBWkqH414BPfd1fjvWe9SZ4AH72pc+Kx3qTPQiXc1XryKJhWMxpFXkSViUfvy2UoUilZneuc6
-W0Fi0epM71xnK1gwWp1R0B1Eo9UZBeleODqdEVx7V+PbZ727nQHevatx77Pe3c5AB9/VePgqmlQwGidf
-RZaIRW2Lh9xhLxZl7rDGlQmPIlwnGSVsOcA2MmxpyFrutJR7+SjBEy3xTkQKYNOyfOpW6bAPVpFr65dA
-U7yk3gYk7R8vACgYN1HwkgVvIHA4hKIIdkb6imiKYkiMLICBFYVvIXxJXPCr9KzQfJZpHxSaFknJImkg
-JLqgixeglhdt/MWLUcuOJhTjhLmQhWmS5YLlfwHL0iTKBcv7QiVKkyS1nOgiNl6QWmbUwRsnx6UoR98g
-xSXL+xKSom+Q4ZJlewnL0DdIUMuFOsbj5adlRBXucdJbSdIzauGK5XwFys+ohSuW8ZVCgkYt1HKiiwp5
-KWqZUQeInBwDUY5LgxQDlvcAkuLSIMOAZTuAZbg0SFDLhTqO5OWnZUQVUvYoCm4qUmUtaW12Ii5eIFjt
-TFywM3HRgPD6qbjYmTkwzMVFYmZCOxkX3LyjT3bykvNZvn1Yclos7HRcNCAWm/m42Jm5sZqQi8TMkMWM
-XHATkDZHygt0wTZhoRCoSZ4Llv2FUp4mcep5sZmVi8TMjnlaLrjZSJNa5UW5ZNlfgqL0DYJcspwvFYL0
-DWLU82GemovEzIppbi64qUibkeWFuGKZX8FCNOrjiuV9pRKjUR/1vNjMz0ViZsc8QRfcvKRJ5PKiDFj2
-A1CUS4MgA5bzQCHIpUGMej7Mk3SRmFkxzdLYx8mk+M+1CAAzLurKwBDQtYgBMy7qyhRRoGsRBho40ueI
-edfHwJQuX8yLdgGI1ihZLgrLFgrJGgXLRWHZQilYo1z1/GhTy4JY9Sxp0sy8VKXY0DUFhxkXl2VgeOia
-4sOMi8syRYTomkJEAy+ajLQgTT07yuw0L8uVLEuzhnIRWraCpWnWUC5Cy1YqeZo1VM+PNpEtyFTPkiap
-zUtVihtdU+CYcTFbBoaOril2zLiYLVNEj64pfDTwosl/C9LUs6PMhXOyZI8sqlPivDBJepk2QcahSo/z
-8mTwNAo8ylQ5L1ILjtRpc16qFkypUuj2B8NSN43G5NHb2rR9Mqgpj86ANxC4MY9uom/Oo5tYMOTR02h8
-Hr2FYZmelEdnkDQQEss8uokX2zy6iR2rPHoajc6jtyAs/1Py6AyOBsJhl0c3cWKZRzcxY5NHT6ORefQW
-gOV9fB6dwdBAGGzy6CYurPLoJkbMefQ0Gp1Hb0FYzqfk0RkcDYTDLo9u4sQyj25ixiaPnkYj8+gtAMv7
-+Dw6g6GBMNjk0U1cWOXRTYyY8+jsVGSRRx+mseIFgjXl0Rn4BoQ35tGNHJjz6EYmDHl0dt6xzqMPM1fx
-AmGwy6MzWBoQi2Ue3ciNbR7dyJBVHp2dgGzz6MMMVrxACKzy6AySBkRil0c38mKZRzeyY5NHZ2cjuzz6
-MJkVLxC4RR6dQdGAKGzy6EY+rPLoRlbMeXR2KrLNow9zWfECIbDKozNIGhCJXR7dyItlHt3Ijk0enZ2X
-7PLow7RWvEDgFnl0BkUDorDJoxv5sMqjG1kx59HTaEoeHUOxPsbEPDqLpwHx2ObRzRxZ59HNTNnl0VvI
-0Xl0DMS1Y1IenUXTgGgs8+hmfmzz6GaWrPLoLeDIPDoG4dowIY/OImlAJFZ5dDMvdnl0MzsWefQWbHQe
-HQNxLZiUR2fRNCAayzy6mR/bPLqZJas8egs4Mo+OQbg2TMijs0gaEIlVHt3Mi10e3cyORR5deB/OKo9O
-YNgmTMqj83gaBR6bPLodR1Z5dDumJuXRuavJUjc5jsmjt7Vp+2RQUx6dAW8gcGMe3UTfnEc3sWDIoyfH
-8Xn0FoZlelIenUHSQEgs8+gmXmzz6CZ2rPLoyXF0Hr0FYfmfkkdncDQQDrs8uokTyzy6iRmbPHpyHJlH
-bwFY3sfn0RkMDYTBJo9u4sIqj25ixJxHT46j8+gtCMv5lDw6g6OBcNjl0U2cWObRTczY5NGT48g8egvA
-8j4+j85gaCAMNnl0ExdWeXQTI+Y8OjsVWeTRh2mseIFgTXl0Br4B4Y15dCMH5jy6kQlDHp2dd6zz6MPM
-VbxAGOzy6AyWBsRimUc3cmObRzcyZJVHZycg2zz6MIMVLxACqzw6g6QBkdjl0Y28WObRjezY5NHZ2cgu
-jz5MZsULBG6RR2dQNCAKmzy6kQ+rPLqRFXMenZ2KbPPow1xWvEAIrPLoDJIGRGKXRzfyYplHN7Jjk0dn
-5yW7PPowrRUvELhFHp1B0YAobPLoRj6s8uhGVsx59OQ4JY+OoVgfY2IencXTgHhs8+hmjqzz6Gam7PLo
-LeToPDoG4toxKY/OomlANJZ5dDM/tnl0M0tWefQWcGQeHYNwbZiQR2eRNCASqzy6mRe7PLqZHYs8egs2
-Oo+OgbgWTMqjs2gaEI1lHt3Mj20e3cySVR69BRyZR8cgXBsm5NFZJA2IxCqPbubFLo9uZscijy68KmuV
-RycwbBMm5dF5PI0Cj00e3Y4jqzy6HVOT8uj84xip2yRjEulNwiSyZVBTIp0BbyBwYyLdRN+cSDexYEik
-N8n4RHqTMMlrGYFdIp1B0kBILBPpJl5sE+kmdqwS6U0yOpHeJEz6Woa3SqQzOBoIh10i3cSJZSLdxIxN
-Ir1JRibSm4RJYcvQFol0BkMDYbBJpJu4sEqkmxgxJ9KbZHQivUmY9LUMb5VIZ3A0EA67RLqJE8tEuokZ
-m0R6k4xMpDcJk8KWoS0S6QyGBsJgk0g3cWGVSDcxYk6ks1ORRSJ9mMaKFwjWlEhn4BsQ3phIN3JgTqQb
-mTAk0tl5xzqRPsxcxQuEwS6RzmBpQCyWiXQjN7aJdCNDVol0dgKyTaQPM1jxAiGwSqQzSBoQiV0i3ciL
-ZSLdyI5NIp2djewS6cNkVrxA4BaJdAZFA6KwSaQb+bBKpBtZMSfS2anINpE+zGXFC4TAKpHOIGlAJHaJ
-dCMvlol0Izs2iXR2XrJLpA/TWvECgVsk0hkUDYjCJpFu5MMqkW5kxZxIb5IpifQmYdPWAA7LRDqLpwHx
-2CbSzRxZJ9LNTNkl0lvI0Yn0JmET1wAKu0Q6i6YB0Vgm0s382CbSzSxZJdJbwJGJ9CZhk9cAAptEOouk
-AZFYJdLNvNgl0s3sWCTSW7DRifQmYRPXAAq7RDqLpgHRWCbSzfzYJtLNLFkl0lvAkYn0JmGT1wACm0Q6
-i6QBkVgl0s282CXSzexYJNKFt+itEulNwqetQRwWiXQeT6PAY5NIt+PIKpFux5Q5kT6vUVO7aZ7l+N1U
-8tR5ntXuIUzj5OXB+fHffsiz3P0bOp6TsJw5P6AsyWfOD3kW7vOZ86c8q/IkrGbO45u/xDtUhnWcZ+3n
-/PFNW/in/FzGqHT+ip7bgoEUcNU7ZuYf56qOD+QVXFxCno3vP6gAn8uQPNQLvASrhOpeuQXh8AcVXF2e
-s33YP/UMPrSL6w1fUJLERRVXmpd2GfzDq96sDFTP2+M6zJPeLIzyiXtcaY8y+kYmC0XKx9+pj5EIL93r
-m9DrdQ/JPU1uaIkIyzbH3CD7G466cRJNbZX4+v2YVqXRa7aKO2/SadpxaqvE1/DHtCo5vmar+NU/jEp4
-GX9Es8TX8cc0q0kmN4vIJX9G5T6sEDMk6zLMqkNepg/O8Fk1oM9FoUMwfFZahLCI6zCJf1FgYL5Dz8a3
-k8czfqjXTQYpMsUPztLzrEB7KXLA/RcDAmL2ZfiVmfguTyIAcmMHCXJNPqjAsUD3DFxVvyTowSHlyimv
-nUU6KPKi8DeHw0FZvSjjNCxfeADP2+xAmJADeji1M9nMEUqZp90HhMF6t1QyUaF9nkUSG+v9JthEajYo
-mMDIUA6wsrpfHYKVmpXzfo8qAWaxDTerQMNIBySyQUoBJvz79f1C3YdxdsgFgE242G3VHLQQAnlcBPXF
-Yb3eqAXwHJZZnB1FDdr73kZNngAJHPSlABO7cLuDhw6GjMLs2I+ZHiTaLwNdJ3QwAgukEOAg3PjRIlRy
-wNgpKoTt4f4QqhnAIAL9rgwgv99FyyjUCKD8zAMsV8tw5emaX36WGl9+BrVv4a/8tZL2Lo+EgbjwF8Hi
-XgmQnmsUWQ5dQiIJ95/pE/vwy+fz4E5v5ED4RRDMnOEfLZZTHDHhzYPjffCc8CODEc9wRVgiMi13w/oU
-Rq3vnuUZUrzhLsLt8jJCZfdaPNvJaJ93wZHbImOm1uFLR0ctyBKFRFGe8zLq/n5w8A+3LRE9iz72wKGR
-uiIbQqAK8SMhzk6ojMH44Smu4l1CmoL/iJO4fnlw+g8ATJwpobrISR1xFGWcEd6+xYHvtw8PO3TIS9T/
-xTz3D/SfKJ22qxptDezXhQ9ZXr+f7+rsjkPNdts5i1CZxERHOrDdrvypjusE/Swwts+zGrUq+PjGef/4
-xgnrunyPa945j2/uHt8MSIoS9VBcwFiUyJWj0wEIC2SX5PvP//uc1xRHr5p+0ThVnsSR800Y7YJd9LFf
-LziiTqvcOKviCD044VMeRwPu+oTCqEcXxVWRhC8PTh3uEuS2n1DptqOjYCBKzEycHodVCROVAoOcujTx
-iWY087I4hVn14CwJv89xlD/Tv7+AQAw13AsSsd+3NfraVfxLyw6DcDCQjiOGUXAc0PZvGGdDh9uBZeHT
-Liwl2VLL09XahdFR15+e57EhSUhHWl+7NVtJWFTowel/U4QzGLaOZuxfJ4pMsoOy58lhcjvyKHLq0wws
-jjTNihBaoLUO/TCJiqYLrspz0RVFchHWd6gu1ovvyE9Jwp0Tg5mWRDrHSHv8Gp4NCL88Zh++/cap8nO5
-Rz+ERRFnx3//218+7fK8ruoyLOb7qpqnYeF8++HN7M2HDw4OKpI4q90orjAvRZkXqKxfukk6iat65lT7
-qvoQtbpRuk9hGXeNRofwnNStOf7wwfnxTz/+6Pzt33500riJcREu/sO5ztOw9Q9wDFOiKv4lzo70+48I
-Oae6LqqHDx+OcX067+b7PP1QP++qD+WhIsj/lGeH+HjubCsp+2MbsLZI8Qh9zH5XHip3F1bI7aIlPGz9
-fiGOMPuR1KNV3HMW1w+OUKcj0FqIIm9nmLB2nk+oRA6Fc6o6LOvKidC+RGHVeszxwan2JUKZg0e2E1dO
-lYZJgsqeOYqwz05IfA1VCGN8HczX3xBmYGClbXTk5FlP/oQDTCfMoo4Tgrt+zt0oTlFWxXkWJg/OIUza
-kS6g/7dwX+elkx/6tvWyPeAPD47v8TC/jw9O/VIgNz+8ZyreOf/yyXl8k53THSof3zh56TBfnf/fJ4cs
-nv4elWVeOo9v/v7Nr0yNL39vJZjltRM6T2E75Fn4mRPXTnquameHnGOJwnY2rU9h5vhzPF1+Ic35v1CG
-yrBGDsqweuel02v6PgmrClVz5//JK+JzxKgicpk5j286kI73xzcE7PENkQcGV0nRxyr1qWO5RKn7FCZn
-5BQNAaZFD07rigvQP4aHsIyd+FCGKXLKrsN35+ODbqx8iKvqjKoP/orQqDAWt8PSDT3k7s5H9xA3Kr7/
-/0Q27UjevTgVqutWt3/XiaJFUuRZFT8xo6xy6rzD9php67WO8Vkm+adwf0IOMHyddgiAA5sMjvbf98Dn
-u2GopPlTK3UM4BzKPAUJHfLS2YfJ/pxgC1N1Sq0i7HxqFbtoHt90CgxaHojMBwfi1vnW8ZzvHP+u09rf
-o7ZfjORLlL4yfeeDoK13zDBiO2mwi5idOm/9yyeUtQMyqeOiHVphklSwTXP3LSqu82gFsePEXhso63oM
-ogf3GWOQRQJUVkORtp/URLue6s2fpqLYo5O40/bi3+iwZKYPMmH/Hv90ykP1/neHaub8jjpWxFaQWGdQ
-hkNFRqjT/k7GZHx4TyeDQ3XXtayfBGak1w/V3YwgxT2Osf4hYoNQpzofDnHTCjhDKEIRlUv3AVMaeGzN
-NQPdLTQ+vmHQ//mAOY6r7F07pXQsOe+T+DPqXaw73EuHyjmFlRN22ve+nYGKpv1SonTm4Pr+PEBpV7ud
-pLwZXokk4Wh9Qg6WPJ7cWv7zmgqIUOh+xxNkG+5lkVCIFVUuHlTpULWSpbuImYH/za+/O1RfyFTayeoL
-E9pgrSVQHz44/y/x5io8oqo6L1tzX9VhHe8xA4fkHEftFLQPE+y4tYAdblzpwcnOSfKRKccQtJhSAm3x
-oQJGcguAR3PfdmHsYkqH6gGDtyPhULFjs/38hWChY5RDxdhODS5wJBHktFU/otoh89nQCUwTZGezZaH1
-DQbyrDBx70mUv5QoBXoUaCdMr2h05L4UjRk5hZfdNY6c7LZ13U3dP6723Pn3Cjl/L5q/t1r99xKlf5+T
-bAcv5/87S16cMIqcLvHzv8+ofGkbPJixuHJ28fGISies8BhM4yxOz6nYLcQUMK7op0+OP3OyfNBy5zlO
-EqcOPyOnSMI94nTS+R6cXPFo1bpJQxcw4T8zehzndzS46owpN4aI6e1GCtA8LONj/IQyscmdfU7jDHYL
-vuvU3oU+3vW62MlKxUwUHw6oRNkeOTtUP7dRiMAJls9onlu83dh0+zbwLNAokKJLQ+yyMnX+muMppHVU
-9ie0/9zqTasgWDHjqlPTmbND+/BcIee5bU4b4YdtcHHISwT1W9wHO9DgnnUDq2UXHMvM9y9Fc8c36c8H
-RwjVZk7LVxdMVnUbmnGBZivZLuZT6VHPrIT3KY2zmfP0fKfq2G4y65ARcn0He53K826IzAGRV9fgtj+d
-bx3f83q5DKDtnMXx/YVnqjW1LTv9ZNHnFFrhzznrRmagtuL7b34deu2L853DEukK7xyV9WPmrixCJdWq
-wbLjyfHTJzxQh/Hd26sSg/WzKWOqWp+AzKycAQufwjjBQSbBJDr0HSqtff5d+r/7yFI2Hzis47xoYbio
-vOi2kShVebHSnMaxQcTLua/CmJCqUAXosH0ZZh+N1y1OdXouvhSNCj+DQZruRB4N851QfZjwZs7fUQpN
-e4zqMf2Bsw2d+0ITESyfnQPdzl1h5qCmLsMuv+HEWZfFqZyqQPv4EO/j+mXGAT6f4v2pD+LI5MlMs3WO
-l43KOOrsAZN065H8NwbhnPAHzoJczf+mr8q0zjASuC5U96UBByR2wWJymtHvOQobxsj1CvflbtZ9OpHt
-Htw3vm1QJ9PcE1fTceYaF4MTbidgXW0BsywfbKE+8rW+sH9yf0jitkPJSJ2ThS57JVKBEtlu1i3AZ8hJ
-UHasT+4vqMzdLHf7oHX4HzOve0/PHxVNtNAwk078V7//J+j3L+wWvQ8fnP9xYl3Q/+aAQu+yK+fWDreT
-cN1HG4w5rWgShoLpUzG/j7N9co4QlLS5G5bKu7wOwNTF2N/M3nz49l8eM+db54/9EpPztJov577znqaq
-Uc2sP+XphzsM8Ke8eOm2Ti4833cXnn+PJTkg+sO5PuVlpa79HNc1KmfOn7P9HNf6S7xHWYWibvXd+eHP
-/4NhQ8iYU54+7JJ89yENqxqVH/7y5z/9619//FfM4ofH7KHMc7LBwHV3nR/R7Y772JXFWRQf8wfnm/Xa
-9w4LUlqcyyJp664Pq8Xe70vj7POD8w3aLtF2T8pKFNG9TaQoL8Ps2AIfog3yV6T0BSV4yzjZi0VKj20Y
-QHeokcIahUlb5u3v7/uK+5cwo/vISBneP9Ct0lJ04QvdvcOUdVuJ+k1IfXPIfj9BIsPuOwFRvx9O4Bbv
-UxM5IzvHhNaS3VyCvBIyv3b7s2hVmWHGFWuqbjeOUFylZOe6/CmNyPZv+VNyJKv4AJ2kX+oj35iDFG4V
-ZpVboTI+PDhuWBQJcquXqm7Dwz8mcfb5h3D/I/773/IuwfkjOubI+fc/P76ZOX/Ld3mdt6X/HSVPqI73
-ofNXdEbttz+UcRvcPb75a17nzo9hVrWlA7n20x9aes6f8iQvnX9N83/EXcq0JwGW/fiS7vKkK8SoOXCg
-hfRox/UOjfRm7tvZY8bu/uH3/uBtPXjl+aFfQd/lTQ97qtMEOOcyCAw3rZ1SqDfnz32if89o9zmu3W5v
-UTtXhdE/zlVbxfPe8lXCwj3FxxNWWBfe9UbNdljW8T5BMyes4gjNnEN83IdFKxT8+7lsy/Ic279um83M
-OeGNNjMnDduoPgufZk6F9liQuHV0BwneB9RTGrYr0IuJPkqy+M+in+zegBKlH6G94FBvBx/lDZEfoaM4
-ii2BxMhigf9Uh7s4i1Dz6fGN6z+++ZndpJmf65a0dBVIp6iAOpO9Y0SfHafn2fvIn0Iim+woKn/mnBYz
-57ScOafVzDkFM+e0ZvWAXGbxkSnhr5ToURWWUD4Dw+yFm5G/orAO3byMj3EWJm73TbEnkt9cp/7sRHld
-o24C2p/Lqu2JE0oKZlfm0CYIl1t9jgsXz9tk11XHfRSVdJc43Ep+03539EBWrGFvD8abJ7PH7Nz+EyUT
-hJonDsHgYCQ5+Xluy0Fm6Y7UqIZPN9DvEQjf64F4hxe1LcKGw+HaPY/jfDd7zKq6zPuN58BBib4qToOy
-xyLwYN5iW9tVOGNsZ6KWRV7FnVqUKAnr+AmJhmATvJV7phP2E2rNcJj0I3wXVojoXU+rH5Hk5Ot8EQzN
-okx0h3TnzKcQOnLxUbkD2GqncYe42wUOHcD4aBxMBEOW1+9/OpXo8PNd93tvsX6+g3YCa9k2o6Tb1rWV
-5I3sr0QdNL7UtmFnYp9H7Y/Pu6jVrDAtbngqVpq2GMNbonFWgp0PwnOd94g6h0I/ROl2XXFMpHEUJYg1
-qdToMQPl6ag5FqtEiWGZLavKDat9XeIjkaHP38q0ocZKviJp+MYfYVDP8YSS221X7jBRjk/y6VnB1Cfh
-DiWCWxZn2AT13plh2t2d67pvKZFLGUbxuWLtL64DqrhfNOz0SMuDounOh/duKwZ2y1ZeWDZUIbLiXM96
-Gu3AQAnatyV5UXee6GPWSiAsUWh0MNnBzGg7W6ybOSkTmCtR1QTfR+AYPtPIaS9TD5+z6E5P0Fmdx/pT
-/VKgT49vuoLHNz8zZfg0BV9UnXdp3JaRPAMRe1gUKCzDrA2eOkxCp7Z27IEkvaI7iK66Ss+GugblSqhC
-LDBxpnCUO8zNhLMHN81/IXoTZxkqQeZ0tSh/ukoDi1Itbvz3+qa2TlhpKO0wivOui7hyvHa8yxvaU8qg
-UqAsk4jCGgEU6jiFitva7Sc3yfdhAlRI86w+aRUoiauaCXj5USnNCQ7ZcvpADfMwUaAkoueB2PTpR0Dg
-/HCXTkEl6IiySBGaOk5/jrsPo5mcOi0DCQLeKbclnJYqDAroYAAXSAzTcH4cwgGTy9h3Wr8nrtVe0mNY
-dd2qaJsgGhOgen6u+eodA32D2AmeDhkUlvtBVYjRd/PDoUL1g+PSJBagQ+yAkfANXHVFjC+m1koW44Dg
-ECfIPRdJHkZcu7pDemyfmE1lfq7pdKCaZomnng7noGnNdti4cd17BrDRq1FaJPTaD/GUTieuztf5GagC
-RflgaD57zObtl3n7ad5+m7cf5+3XuRC5Sw6DEFEFcJJjwXIwP/lSlLXgHJCOkYVci62D+VxKdXzqcXXV
-cEtWQDWuFm5qANRacNXWjETkhE936jChp9ZARPL1BBSSdJ8ri2etgVULmyKUJRnMg8tQyoJfXYpS7qTl
-RJR9Nku8iVsbwLCzCJ3SO1B6Nkw8SezfccmDdiTpsghgRhCDp2H5uYVO6cky9i7enkMg+bc/bNFy0L3W
-rJwz7IhEfKgy5E+czvoA3koH31mxy6CxbbOwjhJM5xQmYVW7+1Oc9G4hdKs8c843ruMwiatUkvs9kbvy
-ZhKKBE4oqZJvkHVgULhdAl3pgQCaIUWHCpz9SkSfsKDnex8fF56/enz0vD94w0GleZweyW6yX1W+DjSv
-Y7j6dE53WRgnskrSganMSGvOVn6EIksGpzWTbHpBr2ddTZfmGtTzGW9URARcGgBQNLgb9zl7IUDf85t5
-wAMxC7jwofshx/e9M+CUnEpc5/MukrutRKnjzVeAMsvc0G6Ue5hZppC7kRkSLQ8AH9JA8iDzyCSJC6mT
-4bEktYEyStGAXWFy0ZnO4D30eVEit9qXeZIMuSR2c9Zy1S/R0gsSXh6cDoIiEU5xK2IRel1yUHCxYH9v
-cV8s3xIo59HZUWS6ZE0+Yz4Mz4A2z/ZyMx22zcICG3epmA7b/doCG3+Zlw6d70ttHeqzJvbG3Tcv82dh
-cBwS1OFqfyEpJXLrn3zVqUC3v2+0K+4oZLl7PNc1KitwNtYs07Cg37fyxT4SV/gT3qP27afHN/s8cWkE
-Kd5Z/xF2R/p+SNw2smh/LsjPJfm5Ij8D8nNNfm7Izy35eU9++l7/S4/RJyhnhBK+DbP7VKWUbpVS0lVK
-qVcpZaBKKQ9VStmoUspJlVJmqpTy05LwmN8Zev5AsOetSln20oiyl0aUvTSi7KURZS+NKHtpRNlLI8pe
-GlH20oiy15LwmN8Zev5AsGcvjVj28MPF9NfF8Oty+HU1/BoMv66HXzfDr9vh13uGhMf8ztDzB4I9e/2b
-YV15MyhVM+hVM6hWM2hXMyhYM+hYM6hZM2haMyhbw+hbw6hcM2id21DF425eVSw/XmR96GgiM2RrPnZh
-FdNNTbjkWObPnWsEuWrDiBx4bcG6hR9qzwgQY9/UiHwRy3a+xP+T8nfshwF+IcL76/m6/d9GTgCyXwYM
-SxHDIpBAu6IBZiXCLJcqtrkvA4ZAxLDyVXxzXwYMaxFDIOc8A0HaGwlGKe4AlvdWxLBWynsNy/texLCR
-5b0R5O17kpooBb6FBe5LmnavlPg9LHFfVjZ1nrkD6zzoQ1xW/fpSFze5vlClDYu5Gv5SqOFxnz3hq88D
-C18X3NeF8HXJfRXprrivK+FrwH0NhK9r7uta+Lrhvm6Er1vu61b4es99vRelwQvLl6QliEuUl88LzGck
-hrPevbg5z0geMKTyAqgMGCNSewnUZu0PqbYCqgG2htQOgNqAXSG110Bt1pSQahuomlIKW6D2WimFe6D2
-RpZC39F8RyjF4EP9Jo92c9jUemP0HL00lQKTKZz5+MKg425eB2ZVeV79/9j7Ey7HcWNBFP4ruNVVrkq3
-qBS1pDJVU/V5+ey5Ps/tmeO+Pm/Oc/a8okhI4i1uJqlMZvep+e3vAOCCJbCQUrXtGd++7k4RiAWBQCAQ
-AAIOOH0VoWjxtVOshGipIpJmWv1cK6Faqai6KReYdCXgtQoszb362VdCtVFRSZOwfhqWUN2pqLrZGJiP
-JeAtAGzopI2hl+5VVHeGXroz9NKDimoL9dIW6iV/AeidoZvuDd3kAzr8YOinB0M/+ZAaL6CekoZTewog
-5adycTJXag4zujinKxUXUq0FVMmXUUGVllKlJVRpJVUCeVpLldZQpY1UaQNVupMq3UGVtlKlLVTpXqp0
-D1V6kCo9gMKURe7DMleEDkrdl8XuC3JnMw/fz0o8Ra0LvxEFjfwBBn4ACbTEAxD8zo9oeofa8As2oJEd
-gOCHWkBzOgDdgUCi/Rxqb+HaZnndg0B3Znk9gEBbjbx8uM/vzQLz4d5XDZtjVDbx0uiqzkob6rmqs5JG
-V3JW0uhqzkoaXeCspNHVnJU0upqzkkYXOCtpdDVnJY2u5qyk0QXOCo1xXslZoTHSKzkrNMZ6kbOSRq7O
-Sho5Oitp5OCs8ANZ66zwg1TrrPDDT+us8MNM66zwA0jrrPBDQ+us8ENA66zwyq11Vni11TorgnrqnRVB
-8/TOiqBUBmeF72ebs8J3t6uzwve+s7PCa4PdWeHVwtlZ4dXE2Vnh1cburPD64+ys8Prk7Kzw+mV3VgRF
-c3ZWBL2b6KxIm76Jlxyv6qy0Gz9XdVaS45WcleR4NWclOV7grCTHqzkryfFqzkpyvMBZSY5Xc1aS49Wc
-leR4gbNCdzyv5KzQHdMrOSt0x/UiZyU5ujorydHRWUmODs4KP5C1zgo/SLXOCj/8tM4KP8y0zgo/gLTO
-Cj80tM4KPwS0zgqv3FpnhVdbrbMiqKfeWRE0T++sCEplcFb4frY5K3x3uzorfO87Oyu8NtidFV4tnJ0V
-Xk2cnRVebezOCq8/zs4Kr0/OzgqvX3ZnRVA0Z2dF0LuJzop8pizxmuSq3or0Au9VvJUmuZK30iRX81aa
-5AJvpUmu5q00ydW8lSa5wFtpkqt5K01yNW+lSS7wVprket5Kk1zPW2mSS72VJnH1VprE0VtpEgdvhR/I
-Wm+FH6Rab4UfflpvhR9mWm+FH0Bab4UfGlpvhR8CWm+FV26tt8KrrdZbEdRT760Imqf3VgSlMngrfD/b
-vBW+u129Fb73nb0VXhvs3gqvFs7eCq8mzt4KrzZ2b4XXH2dvhdcnZ2+F1y+7tyIomrO3Iuidi7fCv9Km
-XoOGbzzBdyn6V9pmw9/qhZMh+4V8e7nOC8NFu+GikEiMvZCmuQ/dZcpQMjAtbXjVB9Z4tizgXpXyYqA/
-VUms+Jti0nN0P9luS4FQPE3lZbsJ2PrX5zRFAuZWtr37xR0f5qETXFUAUvY5gj9rGGGFup4S3kBl3VCX
-cUG4p1XrcpfVJy8/ePVLgd/lUXezUL3dJF/yXGxuJNQsD1KPGEiLxF+QshPYKhTaFJgz+QP6KEiG+xrp
-mvPN/j4KhhxxEiSEje+YoZ7cK32JdvB0DGyDvcoAE5qIi5ck0JCHQxiNwfNRaIm54mkc1T4X6Uz9JPWR
-8F3fS9FddB/tdWQ0GPn28XXlvuLKrL21X+23+71JytLz6NomhffhPjyMwWToMaDqaRzlNk3sTP4g99fw
-Vd9b4QrfhXuYAIhN6Km+ntJPXYm1l+4Pkf+AjbLl347XGwc/Olh6W8Bj6iG54mkc1Tg75DPhl9Qz3SeD
-qcN4gyG8Kh6+GW0NuTfYZ3tX3IWHKDCJcHhEX8t5sI8ivHFEYugEsdZpHL02O/JM/iD1A/dV3xWHA8b7
-ACYAYuObNNST+6QvsXbL4RAdtsYR0iWDNvfM4YDvA98dj6FzlIqncVRZmuqZ9FvqneGjoXM2oWq+WkAA
-F9+gvpbcM12BtWNw9HBnNl1tOm5Lv/j7xX7rjMbQLXK90ziaNOvyTPwp9Un/zdAl0SE6YAg1gIlvSldH
-7o/2u32c7A/hITTJkeVBN/cGDnF4uHPFYugMqdppHMWozaPCvZ4tjw72yTC134X3YQDgVfGI40J8wtvy
-gLfUCQ+bh4eH0KzM5WfbXP6w3++xIxLjeOBrncbRC8I6fsIz6bfUC8PHyHkVttUswwSUZgldhNMgMLne
-aToD4IvtDhk5uPcIJNVab9bRZgNT6WyHSGb9sFlstjpK+AGH+ABRAgMMw1v5Y1pxwdgDxxckBApmDMUs
-9ACXRReWm80MDf+CYgwcLXu4QS9SDTlO74CXhTbbzfyBOx1ey0/+DPfagIQsamSRS37SCFuSfcLBrpgl
-RqEhszo/hycu5Akx8RGB/Scn8/qib+r2bmtu6nAq/u/Y1DS6QlMfHnxzU4czdX/HpibHKzTV9x8ezG0d
-duT/jm1tkrFtVXA4JzkF+XfhXkNXw7hqOg95mXphntVlnjhz26VPoi9t0kex0bfddgL6Fi3Z26ZigJ0V
-8nsO13mTwzwdQqY3iYsdlwq80WVDC3G0jgJbNjSatq5N9cFPa2gx9zcVwkFFlv1efq5nLHPwKYjyZ7VU
-NvhFiQ+4rLwSR+cQR16ad+lEyO9u5KjdJ3LUvyHwBerw3c5LKw83RZBpPT3xqQGbCgHp+l17SArbLPZR
-WyJk50ecENmm/6LLnNa5b/5y1U+lS27mFltOH3g+0ScmRHa5BPB5EYRx/SIkmuORdNmxZ2JH/LXEQZRn
-ycsP+uXR4KapRFjacUCurL/om4LjRazyT1PuzuSP9BkyR1MwJNagKLjk9kIOfmonehvwLRlkN2BCfm1F
-4P0St3zxzFxAfPYTKsSqI6NyNU1OWVeOOscN4mjpyNLSwNNifr+1MyVoQ5EEMc0b7jw5SK8vrEzPL3CF
-YA+7vsbkbraYbYdLe/+kaDTmbRCH+LlKJROgqyjrnFP+NImUmO5bmoMNU/CynYGhCdimGZZMknAjQRZ9
-PYOUP3MqV1fm+P18wJz+leD9YYagovSc1HHRP0IFZTbt8ugD0y+cCJVUo09jmLLXcnWNA0549UVKckuh
-L0s5CGcc5DOQ9USGnIHcJ7eMgXCiM4UKe7jflFENEJCUPA0SEcXrcY+GDNiDfZUn5xoDsl6Br155Ngq9
-n4D+F+ILuelSk18Yrqx7zEskPKSJlnPv9tpAj+bQxM7VDoW4zW4P55WWczxv9U2mlK1y5l7h1r5j1FNb
-+UvLk2Nz+gq7d8A4IrMBnJVfe6xKGkzGHND9M6Y83TrPidkwKhM7RdVR/tGjL1F1Zy1VVs0PX6jmnG/K
-3J9k5B0jVmviZd9tZ+jugTjZDzfmRRKT03NAXdc4CmocibPljn6XptB53NYHQ4bcW7KSZYEWpTdya+I0
-OOIdOpfJO/rQSrCjX26rp+O3TZrM3qzC6umImjTJqg9vT3Vd7G5vn5+f58+reV4eb5eLxYJUfoueYvz8
-m7z58JYejEb3b9+s8JtVWAT1CR3iJPnw9s1yxbh9i6IPb79bzlfobr5d/XF+h9bzzSr05mvPny/W8/Wd
-58/XyJ/73vw+8ec+Ij9X87W3mt+H8ztvfrdCPvnvckvM2nybeGu0nt8RFKv5xpvfU1T+3P/x7S3jgzD5
-ZoUfXykiKHGBg5roW/unXGHQYmYaEHuIefDRWwn797CMmdK11fvazOW70X13Vxe2JNIpDb8Q1auOeS0p
-qTm/lrRyR0y9aJFm48Bag6IdFCB+S1XBSMGvwkosgp5ON1zBQmnYThmcg+LVeaHXNwd9BDsrPFd1nnrM
-9+tNj/D1Itvzzhdah36JVugWrYnvCzf4+nZojTagHWI7OK0dQos/LtDytP4xXaDNHxdodVoDZmOwD628
-uzgfMwq390WD/EXRzP6PMqZ0iuREI1jItptvr2T6AH3tbR+stT+v8QP4c7J+RrjB/GkGJkjBVne8AVQD
-ZhPtOwgLG3ladYSl19Wf3Fpu6dKjlJciM7WywIJ5nSP50M5cOIpcDypLXMO9TuDG6hPk3Sps15ESy1Jp
-J3cISGAHghsj//Fc9S/wT+ROfDdHZ7MmMOtqhYzgijHSN05rk2wgV1WeHVVUfHF3ueIBu221DrF+U6Iv
-Hds0Nrdd2jAnLNx7n9ebJ7VtYo9rtRK/uU4Lx+GcPvro442wnaBFkukaqkOWYYC4LkOutkAPqxgCTUO0
-VsBYf7oJkDlW1NuxEwxwX82bjLOfLXIXhasNpzkd5f+jYnfL5WKGNqvrxe5aKQKBirYE1Jy+K/7e8bt+
-Wck44tag3hJ5S7RFW34VWtVl/hkLAGQdukCLZIVW6cJbkZV0t2AM4zJMMCo/vJ1vpG9h8+Ht6i1c9KIv
-YlBQDbY4/d3/XpG+VoW0sT6h3KZoZhMlDQzXaF+n5P8LKZZsNh5UH/Wz0HGofq3oXz/gtfE/YeD/w0cA
-BwOmhA8usGD/igL+yyQPJvnvFy+ULahOx39eEwry6GhDrbCG2KGFkkv9q8QQp88aWnhLLHHE/GGCuUZM
-kUPrGFU0QIhxLcnDH8GJczeYwc3xRadOsIJcK1TEoR4ZabRAjumTKZw5xEAcMZjt3SSG3S2YFYU97uhm
-z1zArqxUV4hAjsQE32Ze3y3uIu1lua50fPMujUKOwuMShxw99xradbVI5AVYp49MLhimWgF7IMwEc22m
-3G2FGd4Sl3SzElaYq8QndYrv3DFjY5TX8F7pxAweqRRP1h4SgrrMn4fTtfAZSxWtct4VuGlhe9SQx8cJ
-BGJXf/gTof88V3V8eKHjEme1VAoe1P+i0FcOPcNcgNlxdbI0MT2KLenGlHxAFrhryHEnZ7ZL4yhKsAs9
-+TaFiTKAio4EJtKZWiqsXTr8MvdaBge9u662qOKDDhcb2eKPD2uPgbdKU53KOPs8JG9WTxarZ4uHrQND
-Pk9I1JIWTRORFb0njGSdmjODsq8z+Mj3oFfgDUvgSg3R0k7DOXYNyn+ucNmtyoedEPerOcPVS7nG+Huk
-jldYwFuc+uubUlPgK54/9xXQvs9tNz/3dWZJhkj7PMJhXgYCkgG+iyLtazJ79QGja13RJGi5S5WEYn93
-4ifhsuRifte7XIEI95gdYpxEFeYuXgSDmIqcmODSw084qyu1jX3qRNe0GIvFdg9fYu1LFOTj0kR8s1jc
-PUQPMIm7ZRiCJLi+6r7NhSCfqZNW9zPkr4deEjupxyd2Vk9a7LSvJUO6nuhI3dCf8+Fnm+oGuYPMh+w4
-1YldbxLaWuZFlD+T2eR4TPCYzmNdBLRtE+6ntw3qYacWtoBu7dxdS2eGNJeukuOuYkuSk+5JCehHjq1N
-cLe8u4eIbNab/d1SQ4SXff/VfXz5K2IGmcSMwpKH2MDB6EE2UZwjhpkjkGagcW2eONT6HlNTDOHNZrO/
-oIVwh18w3LSt3V1PidqEpa7iE47zmM5ccchHjralf3+/Akebj7d4tQZJCKJn39xH2nY5Q/79YoYetnoR
-KaOspTx6jE0S4ZgR5gKiG19dWyeOrr6H1L4Lt+vVYnLboA6+ZGTB7dxdSWVo4llnmW2D5R7W965ExDxy
-PPmr+/XDHYjf3wb3exU/L2vywX0kbYih2d7NkP+w0chFHkeU4uhBNF5oI0aQvb5m+LD2TR07XW+oTVps
-F9vDtCYpnXnBqIGat7uWbnSJgd3T8H9zOIT+YvsezB3clij4Ryf7/wYvgvsFmJkxWj3gxQKkwku9/eY+
-ipZLYl22dBWsl5Q8kDrS8Fj6CpIcMaKcQDSDqm+uaVyZm9d3lNK88O5ho+nC0aNrTCPhAaZp6u5amtOm
-eHa1SMK+iGlDa8A9cm4K75er1QqisI+WvuQvtBR4kbNPY0bWZobuV5ppu8UmjauW7Ogpaor0RgwpFwjN
-iOraOXGi6rtG7bSlf1hGUxsG9OwFYwlu5O5KqsIS746xsPeHh0MAWtiuRMI+YaZa4jsM0ogCvMAbgAYv
-cvplxFjy72Zo6W/Jvx50IpIHE6M6aYoaL8ARo8kBQDOY2mZeMDl1fQP4F9FDdJjYMLVrLxhMYCN3V1OV
-aTmmgeuEQvbpDvPY+MNquV3Cfni09JdrFb9ot8rP7mPofjlD9/cz9LDSSEWdjMrP46ei8SIbNRHZ6mun
-IdK+qaulri+ABaAf+NG0JildedEEpDZvdx3NaDfT4H2oURslEqbRm04XkOJFLZW5DyBpp9AsJ3kwyRzB
-44pvpGWnWt/YEeNpFKhmaCkNn7wxdUEHjx5wU1oOjz1L+3dX1i/NvtWo3RQF18ixeCExqB+mbFot7mfI
-JzO9v3SSmG5M2jax+OaOHpWTdq5GAltG5uU7WRd2+OTReY2dLasUdtfXOGina9RejIRprFt5CSmwH8Zu
-c0k37M1y0o5L47YX38jxo3L8XtcoUNuIvHDv66IOnj4aL94Ls7R/d2X9UvfGRu3l8GjGboRNpgMJftzG
-GPEh/Du6abi2CEc38gwbZXzLRg+7sbtj7nCWAXfZbtnkzpw81C7cPTM1e3dtRQJ300bt8kiYxkcjL6IG
-yX/8Phpx2n2ygt7aRaUbduZtNb6Ro0fehI20UaCW8XeFjbWL+njyQLx8o80igt2VVQzadxu1PSQiGjnv
-XUIJEv/4TTfh1pRRRrpRaNyE4xs4ehCO33kbA2kZghfuxF3Ss5NH38U7c+bG766sV8BO3aitJAHPhFlw
-Oi1I+GP36Nb3M7RcP8zQcrOwCUk39kx7dnzzRg+90bt0IwAtA+/iXbsL+nXyyLt0F8/Y+N3VlUrd1Ru1
-D8WjGTnjTacDG7wxW3qb5Qxt7mfobm0RjX6q027x8e2aMNGN29dzh7NOchfs803vygsmuIv2/UzN3l1H
-jZI4a0eW8a4mt3tjvbdHcULDbLHY3O1XMJJzFuGSNFbFJJ4+yIQBZMQjCgZmUzlUkg2jSbtNYL3Vlxxb
-fPT+dPuQ7XAp8Ku/QsZOpqcCE+yNZIiJr/lWG6FP7wOPeuRxgPoWySjEVKx85lB6dfuv9UuBPzy+qs77
-NK4fX/0wwM+kKiWusLnG/lzXeSZU0eZLmB+CqDVG/NXY9hJpewGXSDAoJz6/2uO3PrsaRJjZGWJNbuTb
-rPyLkElQVGpdOcesUL0PBmlux3cKshAeG96hUxxFOFNuQLPqRIHbC8rTpCMzZ5MRMac0tQH9i97P734Q
-K9v9neBDbWjtgEuZkZ5PcY29qghCTOi3eSVAgN0uONSdtdTfphfzBcyXm007LuV78nxZnwbg8dXjK37A
-ds/M4ZRdhBcGc/8UWleqe8VSzA3Qfuw41EHDQsBpUb8IotA8vtYDpjg7j0ikzCda6JMq+4t2qlPzKh+S
-PKh3FIzJf8iE4i+kd0Z7Y84/KUc++q11VZ+R7c2+JSFCTz+Jq9qr6pcEG1MeXPIOdXdSof3/ub9xStks
-9Ig3jJlSeJ+S7wEIrhzW18JTpQyKf+TSlptGxFulHEsqU6JifIERcLxJ3En8AY/RD0xu7+51TKbRhUym
-0ZWYfHhY6phMjhcymRyvxKS/XCx0XDbJhVw2yRQu2ykFQfaJGqIeon+LFUwvD75QPPfVESfQ+rvPJBfO
-H/BE5D6TQMIYNaOwHtd2HmsfbNDs/di1A+hFme4/kEug6zRhPOg7UgeudKqLPC7qS0CmioAEBNR+uOmC
-5F70qmF/ZxZQBonuz68Lk1gRVgkGWD7ZorUdXMqsn1WpYUNkV29b60cpsIMAzQrcq+1fG69IghCnOKv/
-54fHV3VePL76YYbMtagwHOoxsThUJA3qn+eWrWgnXOEd8x5dFD/FUSczcXE5uNmc7w2uOHllGNzdb/AD
-DvFBpRnXOHWMWAChFH8IpYQJDsodaeFpcka0ODvhMmbKCq8qx2Q+W8CtZQE7vhvpVy7W1p+KufPv/b0x
-Fmje5wCoz/t4sUiefYZDvKOIS4fMReJcEFAkPzUS6BpUF4YMC/2a8p321U84iBQrrLxJPwQYlbd/xGW7
-Lt4HNNgpqkFk5w2ZJ80cqkNGHg1DTLBLR8mFNTsz6PZuv/wovTbDoESzDZ1qSAtxVZgDlnrUR75o5GQC
-7Sg0keEj60MUQ4+RD58LBcIWDV/CbcLoueg3LPRVHNDM+QGuaU2d58k+KI25cIke7obcrUoOTJavsw7K
-GkTNZzsVArzmzqI7O4e4rGovPMVJdDMDarEfal3AB/D8orFQS4IeQbuTJPoIVhY4BILeDhMk84SGWI/q
-BgE1voqENPwRYRnZUyqAAUevKpK4Bt8NWsw3d8P+i5RElisz4G09vZl+eQrWg1cuQtVpzmOHgnchJU9b
-2j1C32qQaLaVdNU1El5ttQLui/gdNTd2hq22kezouVGZgWYeamGiuMRhn9j1nGZqVm7BEpmy9WrJOUxF
-gCVT9tdgm20esDAdi22jPjds2mDy42yciSeLsdNaM0eD8tXFONoAwuZb5rbdpoIUSSgSrLTQp7z/aMSO
-xB3XIIpzslp0BaA5ufd5Y4YR+RxL0QDNkTfuM7HtFTL2uc2TG/tZAcXtsPmvRq9HsDNVXeI6PGm32HnS
-H8XM8TNz8ZBYHqgoJIbXlx/ibpvU1V8eWvHmvVERDZyTOWFEQ0l1W3s09Un7nMU4ki0RcAyDIKSGVTHD
-v51Dpb6jJigAZn6o4jhz09Ueo5XfAkqq99IN0h6WSKaW22pRJoCnTWwPk/SLqZWOVycCIq61U7vlqdcq
-AmWuvt6KxMqnPEnbGVUdnSusTWxmUp0BTG+96LGpsgbexLkYg7BS+Rr9qPAjdIrlsZ4r9ZNXlLjAWSSJ
-ywsK8hXuNi2S3hUDMFmDS/34XFpJgOaGJwSO+5UVb3tQb2apwpcBHsRQX654MbBFvE41bAx01acwb4KV
-vW/zPNTLAVjja2F4pXWjAsR3LbbJ9raKLTYtnpOVN1GM79SvHzaLzdb01oz7xsqwaQSdoPomxNE6ClxO
-TSnS1K5czDWVVYq89aySY2ESZTbsHtmWZ8K2PvDqUydw4aVt0lHoW7QsmhtH6g704CqQ3n+0jzYVuB0A
-k2A5woARMdH6Oc+E8xywqJ17/7P6rv3ftsGgASp9B4pwlYkaoAK7a4CRMKABJlo/94H8kaNM2/FSANWX
-wqSSv+Ysq+k9CotYFwecDjyNjQHJ+FDnWOwyhz/j+kqr4zPXuiPF2+qHNnZ6CfhEVjg0xnDweFRwx36V
-9Sj06qAtOMkd4Yoz3izBOyu+cHlHfdYXeABV3r4XnVvfiK5/1lETw+0XO57/XndfBnrMeNSL0coRfU0q
-RJczLCA3V3vi2fBwnp7wFV5dvl/sI1sjpRuV7RmCaR0AJCVfRVu4e/oSA2/9kSGn5+ql/HqTMEriNKxX
-QCIcV5oBDq7L5JMzdV4YKYhcmu7r8E9ldmvQwYYYzuN1VXrL09sd3Skt4NCo4TpNu9b7Joj2m33Urvf4
-5THcbi4U9vM229Q6IgSPzDJBjTaLN+iW/nvDbRB1jWkXlyOGsm69a8PoaE/50OLQW3EaHPEOncvk3eOr
-KKiDHf1yWz0dv23SZPZmFVZPR9SkSVZ9eHuq62J3e/v8/Dx/Xs3z8ni7XCwWpPJb9BTj59/kzYe3xCTe
-o/u3b1b4zSosgvqEDnGSfHj7Zrk6HA5vUfTh7Xd3883der7dJN5qvnlAq/mdvyQdt7on/978cYHW8+Ud
-Ws4ftmu0nS836B4t5/7D6se3twwxofpmhR9f3YyUFJmvalymcRbUeJKxnTTfXIWpn7UT12jNd2JVl/ln
-LHbjAi1P64t7pDPV45wCVfSWhMEjmZmiJxNZokGrCaYCMDwwqn80G+GtkbfmrEQYl2GCUfnh7eqtaC2s
-umVq8M+rWNVzXIcncZ3PpqQlbNJbAAeW2pltyU123TzGH8GSJuwgSeDQxnhmOM2g0y4NXHUhlz5i1TFK
-Sztu+eKWaRb2ImUeWndlYkxMKgTsLPMnjA0Ub7PTvw95mf7zPBw+tm9s1/fN+C6yEso9g07areCToMb/
-4127cXHzfgxHP/Mw5sJ1+utW8m0WTUR3K48AdS+nHcHuD+dftI+jPbovurnX9yU2oEPIcggxZ2KJFn+k
-DsWP6QIRF3B1WgPuHueCl20ODCY3ti11e180yF8UjevCZOQmFEJBUeCgDLIQy5k+1BMq5sX69V7lB0jv
-dl5aeU9BcpYW8Zw2aMUDYP1rek7quEjwDzMptP1XoqA/0PgC/fPD4yv/8dUPN+JeR38izXCIWPU6DAKe
-8C6BZlHfIqQCw00RKOcQ9Fx4VTpiS2dovLJ4HbJMdFcmDUfpzTscEJvJ0XnnUWHSwKMuSGnchxP4s55p
-vL4N1h6CVI5zuZ8fuZSbPvGPNn464hSb3t5camA4JoAAm8KH6wDk0CZBdnyHsxsQreB8cIGa35T5c4W5
-q9hqT0Lo/komOG9PgX+AcQd1Xb7jqsESAaOBQLDqvSZLi5xd50KXQvEgpnoL158+NbJzj/epIhQjrMLp
-KzACaJKri0RdhQfrp9vZmNaO8neYZZFyO97L/h63tDTOjhi6zgIttogI1oAEHO9J25wiygvvE/Wej6X2
-buc94/3nuPaqJKb7c6dzutftxRBtJCo6m2TdRLJp/iP78jOSrL4+LdYwSpEsTMXzpjuNFpl6wRpRF240
-8X6Ny4Nnco6cVv19aIX/Myzi9Zo+aWVvFa7jit6AR8gGYNq4c0BYnrOMTPpeXQZwUkcu14RyN122GeG5
-rMj3Nm6lTbKO8RLfATuLupQkoopoh4Aytq2K/C99NcpvpK5KOC7TUw7ZP7tqVpONK5wwaSlV7VMd/Uut
-zbIfq8/VVRS5+jk1WKOn5tKWKXgfgWvHIU4SL8mfDdFidQA5DhGK+1wU0sX+7kzTRh//m0Kyj0CbnFHD
-FsUYlNAc23VmhA/BOamtGGHXdTKDoHEdzVM1iRkwwj+D1pAzOIb/M1um6UZI11IEXzZD4AFjm83Kgqcx
-KWGkyKP+YBOQZbcnyKXLd0m7xI/CDrrPs9V/MKWzBxmYT09LpVN1groO9hWQHkEIjPQmRwKjfw1J0yTB
-CneIRJhBoGoYRmO01WOt/EJMe6hZCtyobAg9IxXpt0BYwGP4r1FCbr132eSmpTukY1K7jeUek3pkShhN
-mpyG//K7MQN9IMMmkDXkCskvCMkiThKN2hnVRIKTBNmWdu92wCI8OJ+yIvDEJZCHFJAQAbzJNmBhqWVi
-HEGovH1Qxb0A6ZdjmT93EVsD6jrYd9lqSHvJzyJQTmoLhksC4b1KTaa7LHjqU35dLycGd91PybtDb/55
-e1w/4zZlpM2cEwY/ojnBEMQZS+EEfPYOyTmOxkxVk3nmWfP2pbr7p2w8SftjK9+0jceXgifv3W6r2dNZ
-qu3gbfPwcczMSaBsLgOcvukqnkNHXrIP8g6yhqCKxvgGQlUHdRzybxhA7AAXeG36Yd8/FSh074uoZqdf
-BiqWx5TWoGOcXo0qtdfm4EMn2suTI/OnWv0Tw1TCcS9rdPd5rE63cF4c5pnrUZ/5Ro7BzHUplrlTNa7H
-yVmn8YaLE27bGYvFGyEdUZ/hP2iGBx028wfuuYS2vexUQ3cvkbO9tiq8HdYMPHisf3F8dEKh3pKiKs4S
-Epf5M3+33JaosnudQMGsGDTVgJX5sysGyJho9y5d8Ik2Dsqu2I1Ew4mQL1+h17nZlusGS5NEKyZNHujf
-4rTIyzpobYBo5vg3IkwkBIsGPUXzRT9OtndbwzhJI6vEpCpfY5wI754o1L/SOEmjS8eJhOHicSLj+2rj
-5NJedx8nXJO+1jjhSFwyTh4efMM4aa/CmyQmVfka40R4ekeh/pXGSXK8dJxIGC4eJzK+rzZOLu1193HC
-NelrjROOxCXjxPcfHgwDpUmsIpOqfI2BIj7/pJD/SiOlSS4dKRKGi0eKjO+rjZRLu919pHBN+lojhSPh
-PFJEFNyKFtQvlwT0IkI4kgSV8oKdEj9oUSqaDOuxDdT5NUYrInNkZG47Q349oUK6auJeVFOjkgIqasIs
-aCccW21rJixvvBr7k24MdQ87Ptw4YJBjFFAV9YGYsaSAztdg2ozBZGZerGpvxHYMaXiLR0a5ckEpbyvM
-jLXbyLpz/Z5hQsaxKvAkz9geF9Ta1NfyrpLyPKkbIS44Nv42sHD7a0X+/63DpTHgurnawrdtGfNqPrxd
-9h+SOMNhUHx4S1ntP6dxjcskTuP6w1u/vWy2RtvTcvndGvkb9t/l6rRcGq4bw5Lqg8ETxx5FEFymFhSF
-edy2daZYnSgoPxsspLxDCYPI3AE1gCezbKgtJpAehB7+BXWGHp2RYyczqJDfjqFvMIYK4qULYoNJVCsb
-LaKBackgGmrqnyjTt0NrAOGeBs2gUtW/caH5D2QLgcb+XS2iKC7QII4diYBVtCsHaAahKhZLEwZlNGUL
-Hd4G5VbfbJnxnJdR6ybvSxx89siH0W+S92eNnJ8kX7q9SU5b/xGdSt1bR9o3lFrIOd3PZU+z8In4uO/t
-G4lc4U9XP6gEcMOle1SY4crsT92o/IBZAQGOvH0evWhPpvSbsj4EWse19LDCsI28VatX570K0V3BWRnT
-R/NEcVMrwjGDDLOny35sD0JfkOh/qBm1QZnwLzpyW9pdYoWlOUm2PkNEN2oWq5v3xqN9mmGmsKjV9k5b
-hIQqHqFwA35byOJuRfAtr9OjBpvSgYc8r52EOlp+0juyduExVnSjk7sK6So/SHbcEU4p7ftifqdVIQ/I
-wd6m6RHA5JeQQQ7YaThXFlQyHMY4PXpk8CXByxVu0/KBZbN5itMjfGXBruhqA+q8MCJT5ggYq2G+cGKD
-ycLICThDWLiBZgsrPxEOP48+hiXDc86NpM7tjQmnIxsSP0pEl9svkN9R6O5lKM8ldAVfJAocx1DLh3l0
-gdqOMbpj2osimilCnoQ4NjnZck+RTeuc4YWWq/UPx5O+g77I9T+KAgeEC4pJi+dbEZ/iOpKPwo13Mz5N
-Tm5rVm6XtwvdaQp2ajYSjHdZrHxP4Yk1cSxb/KR/ubTU5Nq2w+jWBNujSI7oIQBO20XXYsqliwBQUx/B
-rHEmhpmdymBipGWEk5XpsLY8sZ9emJ+zusvA0X89kjUv7z8ilJfFKciq7jgpnWXz5/73F5UQPB8oZzbV
-+bqXRhCGeRnFeSYaO+IzMQt5iqOIOxiu1Of7Jz949UuBRc2xOvuLcci5MdvT+0njW46iJZCxo7z04VeV
-Ab5NV3qvVe1edVCbLlrtSxxEYdlfVBx1T45bJ2mWDf5wmlo59u6YGkYXYBg4Z7dWvkXKp5+sO8F2JGKW
-Rfsh+FJ3Y5m/vMWdkr7l0jg5cEMjDSJPSsThnEW4JOxdG7EQypAQgCFuKXN9ERzjjOLSKRt0wgdWHbNy
-FMER8+cEnB+lkO/z6Je93a0zKT/SUkyQZE6ebcguJV0R7BvEx5qklGwDzc3dfvXe1IWOY0+5RC4zA70D
-uLxqqkdKSwnqyB0MeNoj4qsu8cyBDz6wKrHhEK91DbZKVLvhJVOUkqiNuUqoT/Qu0+4352TqU24V9/Hf
-sddEATNCjzPKY12N4W3Gv5Omp2TVRb3irZz0biUpAMzBNC1cuSmhjoUqNYn7khfJ9KQukPfSbZzbGnvJ
-qHcc9IJPEkRHV3eDiZ1Kfa0Kfbt5oyZF3IJJEae8eilfA9sHFW7dDmNGYT5lgz7vxD9k7gqubyypJwJW
-t9uh7X4639xj1XFa1C+mk337OhO40ng6/EV5Dj+NxGuOUt5pTlLeiRrdZ5NZKDrsFWWcBuXLxFvugYhF
-kmT/Wbu/rSFxtwxDHQn27rFMYg7lcZzg1nBeDcNf4TDPojESEh3qQMYjy2goGCelzXqzv1vqyUhy6gsm
-S8pf3M+Q72+JwDSyOochrtybsLwPtuuN0gSGRZFT+3mclHy8xau1joQsI/Z5soTWRJnutjN09wDKJ84O
-uTvn22C5v5c5JyhkydBvI8Xib4P7PYhckgn5NlkgZGD5d8sZ8u/XoESegzKLs6PI99JfbpYPev8z9Bdb
-mfUWkSya7jMgHTOVaPWAFwsdFUlG7efpYiK2x39YzdAWlFIUZEfpkJmpc6NwtVGHFUMiC6j9Ok579tHS
-Xy00BCTZsK/TRbNczNBmpR1S7FDpGPW5PzwcApl3ikaWDfs4XnUCvMAbmIIkHPpxumzW9zO0XD+QyWuh
-UZzSPX8Ne/hC7dXys6o05eexBida+ss1iFxRmPLzZJFsljO0uZ+hO9nc/Oc53ed1mctvNC9NkVFTOkpT
-EHQ1Ys9CYoxjbU1YW3J3uaSWTLg+ZArFJ7g0Py4w5jSTL66pLki2QfmioXJ5nhjSz/A1hyUfsKLiK0Zx
-lcZVFe/7xx5EGa5VLniQeZjkFZ50nsUoSGPjQGd9sVgv7jc6PQ1DvNE8gnofBVwoSaRwKpV1cwf3cAgj
-LZzSCwOXy+1mKUFpnOvV/Spa+9qRt8QrvAFjUHfRfbTX0jC0KbwP9+FBC6lt1XKxXC3vZDjIDfY3my01
-gPCcscaR+E5Bz9kK34V7DQVDi/Z+dNjr4PS9tF9ifyVBqW7rItys7xbaxvg4PPigymG8wXsIvaElwT6K
-hvmUB9I3424ZruRmgL7m/eZuvVgbYp2rMIJacjhgvA80FAyNORzwfeBr4LTt2axWh4XcHsgr3C79UK9l
-h/toC2vZYROqWtYSMLXG3y/2WxhM25j1g7/0t4rZVvy4e//ev19q24LJP2BbokN0wCB+Q1NwiMPDHQil
-bcndPflHab7sc/l7Hy+15owarQdw6N+F92EAoTeN+4f9fo8hIP1oWS82i36A/eozfjmUQYorVJT5scRV
-5e2D0qvqMi5wa9UOZd6nReLaNEyEfvf8R3tkos6N1RfyAZGOtG4TUkk6DhyWkILb281Ed07a7uGEMiEJ
-nXKhnAviKg8Wjov1mjeS+EgodUTRYn7Hwq3T4qyqIGyZfgF9inQ3mIjPH5TekXQDzup3602EjzPdjanN
-DVpu3sx451L9sFm8MSGwFG9ldPKHG02KNCXlpCCGIIvToO7kwH5R8YGDz69awaA4O8RZXF+h7yQWBCbU
-PmRkNHov5PwDMiVQYPXGSV/O3Rf4aukdZUqUXS8IhyMP8jktIH0uPzJlVx1G3V8D0xRDu/S+kbzrkQEx
-4qGjDx0NMcc5pHfTJLwXHOqwri6HbMJjjmsY73b8vFfBbHe94LtB178AJvHRHyJQ1XQ3PVW55UVPmQde
-ETWHZ65xcoIje8rL+Mc8q4PEnj8FBoOVX75p0Cqti00y4L5INc1K4XSa0ZFLjUpLF3uudhTH7bynLRgH
-tk3OAKrNEKWFhtVDqyDGGw3ORFQ9cdMUZ11xvCrgzrCsMrDSOKqNu+KMOLduy4gJt1XKjDlSf9LoZ9Cf
-NPon0580+t9Ef4RMkXBbpYyRI/UnOf4M+gMQ+cfWH4jhf0r9ERMowo2VEimOVKAm+RkUqEn+yRSoSf4Z
-FYhn+ZCcq5Oma7sYGOCtOe0mWsnYljzCOTgZ2+QsHiYWRyXjsC/QTMGGCdt40madBuPY2IMFDNjiN3Mp
-bQ2OI2dIwKRZ6g2syEu9tgRmY9Kuo7S3qMU5tgusgEAnmDmV9jLHEhzbDTwzYjf0JRpGxm+TSpuhGoyj
-u8AMBnSAmUtp63UcubHi51kRxd+XwGyM3dWV9m4hdGMFb4KBbI+RP2mbeASt0YaH40MyPF0JzMOkHWhh
-n1mDcazgLWCA7K1c8rva48iNFT/Piij+vgRmY8KGubgtDiMcK3wzFCB7C4/iHvwoamNFz3Miir4vgbkY
-v70vbuKD+MYK3ggE6byRQ+nAwBhiozWeY0TS+K5E1/njziJIJw4gdOO1XQ8DzaxG/qTDDSNojZ5WOT6k
-abUraTNZDMcA2yfa6KJJvcoo3rhzuOk1eLOLYdNvOPrqFw1aDG3IiyCM65cd4q7qUd74y8ggSn0iPApO
-Mz10uz8s8cN8+Nl1u0td/lBvz+22Z3d/rutcEOiwJzjyabeF8T32gNGQ8tlq9q/ahy/zoKq7pV//wsZq
-s2gjHvbTJ/dbW5I65dTB/eYGTrU5PNrnnGvzBjo8LT60pwUKws9RmRfeIU5qQmafnMt3/qJLIdb35cLl
-+AyVpCZpkiWJJAWlCX17J6qn7QO1tO87a6FOcWR+/5TUEvJ2WM5AcKd2jO8bOj0efBUtccgYueD2xVmL
-hxMb8jb9cLQjj4LEywucWZLXcBXZ32J9rxHGUP/5RXpngQMdDhkc4gZH8tlrPmA1HK1YbBbvoY5Wj3/0
-Vrn7AA524ZoC39IoDpL8aDwO0VLsb92zcSC+lWGyTpTQ/BBEGAFE+TNZ9O9DXhLtW7V3bdlFWzSUtdWS
-oMZELbzNgkv9N+6ckZkz23kxBs1etNa0i/GrSqOt6VVhmSdJ0B/yV8crseddF9OUh/RNS48e2bqxIm0L
-ukeRf9JhfDpxKK2jQ0uHmZ6ZvSKfmIsdxDqVcfZZp54AhmHMm8dgh4GZOhyNtYtpnI2Vf0dKk/dnMPSW
-XpAfQjWQMmvVxPOekmWZQt6mf/qRYUMtCrdnH0AoEL9GhnLZAsvGzyk9yeVO0/LGeL3LYPM7l+mS+Wm9
-kGTx9CwrzdNJHxvXscSM8U+K4wbWHbyooS63vuBt0vgjoePebveFi3paX2bIQzMmyYshDQvQUnCJ4gMX
-CXfIo5/5fwMG1JBG3ZyKRTbUmrFnzOuutpKfOtzNOPxQGs4ikJ4u/zbQfeb0N2Py9AjN+4h0eUGFPFXS
-6gVEoVvHtLunIApmZ/dB6aU4qM6lw01C7+Hh4aFdb3aH17r1Z6cdwIKUUXI9AQc5afxb3ouFtMdPNJIt
-H6WX+owTpt7tWlGf98YBETT7GJwvC2LRfdG7JY5oxNlT44nocFUpIPpVL3rX00QMWXKccb+GoyAc6nsX
-1OJBEwM231/L6OZ1nid1XBiVnJv7tgvdMfF+aTREWA5BGicvO+QFRZFgr3qpapzO0G+SOPv8XRB+T3//
-Ps/qGXp89T0+5hj95Q+Pr2boz/k+r3Py9d9x8oTrOAzQn/AZk7Jfl3GQkKI/5XWOvg+yinytgqzyKlzG
-B1L0a0IP/ZZmNvpdmv9nTKoMJMBv37+k+zxhHylqAZwLG3W5FMs0SNSw4XqhnRrEywnEiikfhxlYf5FA
-iDaKX9WFF3FiauKhkQmc2nmOcfq8DH1YRv0M1hevN/XfWXYthqe3NboYm/5NG8DrabUTdnce5GpoHpRl
-V1Gjy4Dmdk/uzO87Hrt+W8zXYpiLJyMaEg05eSEjB601d+5bJaNT75A5ovJaDmimasR/IGL/a+MVSRDi
-FGf1//zw+KrOi8dXPyjRoXV3ARDC2rbNGTkvcfV4DYi7k9tIGlJ21cFLb0XW9yJr39BM8DIm74ZzXFDH
-wM4YrQbIVlYYGbOzdHsCvHz55YjQWkln7x1YcO8EiRWxG6BjaHBHAP3BHEdLj7Tpz61ssnrj9L19ssO1
-UwYSfK9Ih9d06N0FLpMRJQ4ebOtELkobcMItwiYaZmeQ1Bqn/ATCWcwdel7Igp5N0X2OA/eeEDkR+wE4
-bCkpvr4z6FII6oqOmTjLhvTjvfu27J1BU9pQx6vK2tCE/Zp1Xgz7lvYEK5ogyp3BkRxaTBZA//In/+VP
-TvIn/64xSHGsXOSY+qqZ28jhrIWZMmfz5ILgUFsGM8DZld3alqfBre0+2NxaZV96I9l/DjP6KExBDiQ+
-gu4tDRS8azPcf8tej/sl8ri3YTV0xYlnBP0RHsCcmw603q+iyhsH3qmeTGCd0y8uKDyZd+GGMscL57mb
-2BM9dzHxvVZ5mOPsrj49kY+A/25SH8632ciDXolgswEvup4wx+6Kp3Du7vQInQf0oeDtO2qg3AxHHQRa
-wWlh+zqbiw7amqFTRn7RYmJUWrTwD6/qtbFdVrir40Dlo7pyGWXNJNLueqWy4LqcB7tEv7JxVCylIY6a
-BbWDU61uz+QKDTGrVj+Nd69ITegJDYpxfv2m3Qh28F7kB0m5FYvGmQA2EQ9b8g8kmGHtamq+sHaV7gBq
-RxxdPLqPt47GR2gJ+7OYf4Fhd92QGR8R69l0S16NcvNLXscxKjXCcYSqbeDGZ/eQp9H2uzVCGJ/iSFIi
-ULq3kERPjj+TbMiH1o8A0zDBe/KPyyu4q5GP4K6AN2clQ2J9dmGwZcDhRe0JzD7nUf9QYX6ucGLYYBcr
-zoXDKp3BPoen7tA7KoLMe1Hg+GiM+Zig6RwihFPQTWiVleCg3BG3+WQ4FTXgdE7u1C/m24PxfexAboh8
-jbx/0DgIPx+CEHtPcRXv44TuyHBnLnUnG++u8IoI0F7bcUUBpL1wMJO/exluavVrUeInTQ8ByCkSdrxe
-/E5EfDN77K47SKXlcAsHOuz5P+ge942GJOEQIkmR6mlS+2og6WlosjOjQC9Ix82HLvGKMi9wSYraOu9N
-x0RNlKDOA6rRbgDaa4UjstT3jZT/DTitLiHXCn7mWBcivnhvlPYOLSo21AZhXzLM7C1xrM21xXnAEpNX
-5gntlxlUQDra1WUV51upMy84UMVnAG0N6ObNmGC8dIZPlE5byD3CdGGHSjKFigapTuqp4fIRWMreFdD1
-ZgusLTY8b6APcktvFYCnCEBm5cCHpuogMM5NNqD14jDPtG2kpbZHzPpdIemkWf9hcB6JILwSFziguVbR
-LZ3hxdPNejZ1iWDPZfLu8VUU1MGOfrmtno7fNmkye7MKq6cjatIkqz68PdV1sbu9fX5+nj+v5nl5vF0u
-FgtS+S06xEny4e2b5epwOLxFTzF+/k3efHi7QAt0j+7fvlnhN6uwCOoTij68/W4zX27QIvHWiP3jzzce
-+d+S/Q+1//Xa7z++vWUICK03K/z46sbcgf9YrV3Ot7S1/nxDWoq4FtK/u+9rj/7j1No4i+IwqPOyMtpM
-aaknHHkFt/M2GhNqMpWGrLDdOm3zRo0cdB+Bh231jU3iLgLdkCUWXWq0PPVbPuww7oI/jNsf8JOG2EpM
-GFmCXxm73Udqmoi4iBS84bxo97Bnuzi50sZVe3p3YXvbo1866muK11d1U9PdVWYmoMfcXfsBVrhSbHLS
-wqAYkgubx0Kndv0LNJ1OiFrJ7XALSk77Yynt33sKMid/QU4GXxV0NemxPu0WtoL4mJdd5nVQ43eru0WE
-jzeiHCEk9rlHjlL1H+TXLWlDWHPF+8DsAU6meuG5JGpHN8q1MXfLKzVdQJJLlS01bb7daHN1S2Loz/vq
-dmLlQ/N9KGnJvTOk9tSxjwou3gD9VIVBgt8tuOPHm6GeoM9ABw64v2b3KRZK23dSv0jLFqCbaAOcOonU
-dO2i7tEF4tJ3T60yQN1DrOjf4rTIyzrIagm+7u4PKWLKCz1UGkdRoqHZlmlh26g/zC8r03M79J2Ga66C
-GYu+4V0pAL8/imnVtLmhAeCAg+beOjtKb472KQqU2lAJt4DQvnGqaYiUoEz7xqiuKdBbo0flaVCeaRkC
-LjM3iT1IqmsSn+xL+xSotkHKk6BH6Q1PgWGhNlRibgh7M1TTkCFtlvbNTl0rpLc7j/x7mzyXXD3ls4Vz
-+qynhnMh+5T2YU0d8+oDm0fpOUyeV7E2VGJuCHt+U9MQPo+T9vlLXTuUZzCP4sOVPK9CXaDA3Ab2Sqam
-DVxCJO2TDbomyI9VHoXHJXk++Zrqd0sX0GcstV3QJRbSPiSp7wDhQckj/wikKOO+nvLZMgroW5O6UXCK
-a2wYAwcNHP/yy0/WbDQgEs7nVQ/+tTcf9YDDtGi8OmlAwEVGxfyuY5DwU7ztFq4BzbAtIKSVHYNiIYpy
-YRadWL09/WITlgTUBSys4pHg+tiGRSASWBuyMACJHg/03oUBWPYyRHCth9GDCzO6CKydzTtgbhaVsmvp
-ZtAOUpzF5Ef5NDNYByzMHNLbkbpZo+8gZfRYrfVAtreWUoZUnaXsW8tZK7mtYN9Sg4Sjfr2inrIvcWoA
-1EFtzGCQcQITb4s47KmwTTRVe+aIxim1tomy1ghOoW4Xk0hcMZ0jZT2V7FGjGysLYBiXYYJB4M3ijQmy
-iJNEA2ehuYB5hUDo8YdD3FznmETkZXkG5RCDSEcei5mAgRQTAAuyGOIvMDAEZaheA2mT2Dd9dU+NCw3f
-DWAh7rpagqMFIOAhwQ2QpcIoNwCGL1FBbfkSiKXlutzY6d0lfwrD97yl7wE4viudVEDAAQPboGo+fYNJ
-IVSoQSvsegFAD8rhoB4C/NDfRi2BJAyCapXF/Y2gyEuj8RqTRtM0Jo0u15g0mqIxaTRFY9LoEo1Jo8s0
-Jo3Ga0wafQWNEfJ4kLl3vMYkx2ka08NdoDHJcYrGJMcpGtNBTdOYHnqixiTH8RozSPiKGiOmZ4m8Jhmv
-Mk0yTWV6uAtUpkmmqEyTTFGZDmqayvTQE1WmScarzCDhC1WmKOMugDWPPPprvJ4wsEmqwoNeoC0MzQSF
-YYATdIYDnKY2PIKJmsNQjFYeQeZT9WeO0z1Zc+OqyLOqP/fg/sCvktRRTO6tPcwu07Um/YQXZgr7yhd6
-BnMGVY3pxjpYRD+AJfn+P3FYg0VPcYTzacdJgews+kTFfDp0WAre0t+/PEiXsvjDJOvl/H6z9derN3oc
-/p0Zx+ZuvtwY4Nf7l5UBfGuC9fcvvgFWOHtIMxL2Yxd4Cg9aOtJqLDepKderFrTMn70SP+Gywlq6fQUz
-fTMeqY4O1XMZFBwCltKAftRBZDkI03420QEYHuhZOT300R8xgaUWgB7UWHDEyAdN1GcA8BUAXwvAkigL
-NPq8yjYgHwACKUmnGVm+VAYMJ9lkFRww4Swy4SHFDljYCTENorbQAU2b5VWDR8gE64IuoKE/I7a2ivbw
-CT2Dz4tbk7vWhqEXswqP7fR58QJ3A2zg4qkjAYH9yFEngRLX4QnA0JVoEQA6KxS4iVHRVwiHUZSQrkpI
-bOIE9VTCYdVSERWvoyAmm4YO4lV6aMBk66MKJwd6qZUHJx93WvPKASo9yyBdupXCS33KQRs7lMKqvcnA
-bV1JgaGBwcDt46JtuSJ0Bq+XuC16zKSW8gsKq1vS+fUdLO+buHknMgbFRRnhpMDc2NCZfBUZ4+B8WFwW
-GZD3W6yeC0QVbIXNgZERDV6M2Y+R4XhnxuTOgHA+AOeb4UTXxuzcaGB9EFZDV/FyUt60jHB1DAh7W+Ps
-8RiQ8cbH0fExYBNmlXH+jwErP8GMcoM6nIIbIPWIkzekQcT1hINTpEEi9oDVN9JgEecBJxdJKx1uQnDx
-lEQ8Os13dJi0yBRZO+q8ggiSt5O+K5gkbXf3orQYRU13dqYMPQD0pdmnEnExFyHlHCubawXDA6pg9rBg
-NIoSmBwtGAXU/Xp/C8YBDzaT26WTCtA9Bu/LfTuWSiSNprtgaXSpC8aoX8cF67m5mguWRhNdMLrNPdUF
-a6le7oKl0TQXLI2muWAd3FgXLI2mu2AD7EUuGEFzVRcsja7oghHNvp4LlkZfwwVLo+u6YHKPTHbBxJ6Y
-6IIpPTDJBSOSv44LRqVzFRcMlvNEFwyS9SQXTCPvCS6Yqu2XumCKpl/ogun6crQLRhi7xAWDVWG0CwYp
-wUgXTNP9o1ww7WAb6YLpumeqCyacb6ISSY7TXbDkeKkLxqhfxwXrubmaC5YcJ7pg9NzYVBespXq5C5Yc
-p7lgyXGaC9bBjXXBkuN0F2yAvcgFI2iu6oIlxyu6YMnxmi5YcvwaLlhyvK4LJvfIZBdM7ImJLpjSA5Nc
-MCL567hgVDpXccFgOU90wSBZT3LBNPKe4IKp2n6pC6Zo+oUumK4vR7tghLFLXDBYFUa7YJASjHTBNN0/
-ygXTDraRLpiue6a6YOKBYSqSJpnugzXJpT4Yo34dH6zn5mo+WJNM9MHoQeypPlhL9XIfrEmm+WBNMs0H
-6+DG+mBNMt0HG2Av8sEImqv6YE1yRR+MaPb1fLAm+Ro+WJNc1weTe2SyDyb2xEQfTOmBST4Ykfx1fDAq
-nav4YLCcJ/pgkKwn+WAaeU/wwVRtv9QHUzT9Qh9M15ejfTDC2CU+GKwKo30wSAlG+mCa7h/lg2kH20gf
-TNc9Tj7YnKbB567vc2nx4cPDpDqX5KCtz75oAYbbPG19ze1z+9k0gq3iM7gbWB4m4hZKSMOt51yB4y8j
-aRswZnuX4E2jKa1Io2mtkO73TmuFFCGlmnOc0orkOK0V0p3Taa2QFxkEcZNMaQZZHUxphnQP0qEZ8+4q
-EmdBh9tJuiOyPRC7viSDtV8BwO4GELGodRzK14LarybA7uaV7kaWEbi7dqS7jmQEPsRNlzJmgGQfzS2N
-w88vakvpV8iwEYxDihmJlHxhSkgrrXkXdrWQkPMZXSD8o3NVcyR+VZ0L0qQKvZNb240M9mtooiqX923+
-1qGdArXlQsrTWnp5lrwYL5l1Y1RKO+0rz//qLughxLJDk9U099TSDfTsKlmnai6ltbx6NKtbsE/wrn1+
-AwFFXOo3eZDwjeqTa3et6j8M7aCvyiSYa8iQkht8M7Zllz5iy2VYavp3bRdoMfe7t5LZf+VnqBbbzQ2k
-4wwFjJIi8iFsvgkZl7KHx0cRrUBsRt44O8oj1CW3efaWGyFn7nLzBq63WQj1NEmBnr2tiG+rw+cvRIT0
-FQKw5mDgObWBqp76xvRJjGHqp741XUVNc059c7qKWx3Gvj38BU+46tAgXu+huiknJ+4tbB3q9CRWt7FC
-fIAnngTnEywWT89amJMIw9F5Au+0PIH9DRN4gmUJI269eRx5SZx9dnnBWDcHuUwXzOLyT4dVnDkC3i5U
-sk9Kg3l4lqHPRji84gwJv/YWM/LfF7G6IVNiWrYgjQRiypOY7jV0jEkS00RDypAjMe3CgMNbhtokZ2nt
-+S1XPvRKqh6wbAEbCbB/7lEPutfQHN6Z1sMmGrLdI8N6SG8pS8UglGXL4FL3dKxGJsuWuaX+CUyNSGCK
-4tPbGonARPlnl2GBrESB+HpxrFrmVqo4fL0wVi1fK0gYvl4UMLU+26peEDDBNsOqVgxrSQwmvVi3rK0B
-QZj0Yt1ytgZFYdILmGIvDJNewERbcRj0YiMKZKUXx6ZlbqOKY6UXxqblawMJY6UXBUytE8VKLwiYYPuC
-jAasT5w7LAfAau30UbxI9Q3zR9HOH0Ujw5gmkGKvo2ScQYpER8wwhRSddeVeDtVa1qKdQ4oXCco6iRTt
-JFI0MqR9Fin2OqoO00iR6Ahb55GiM7Pyo6qwZJYtj0tQMgbBLFv+lhrBGOSioWmfS4pER9Y2mRSdze2l
-4utlsmr5W0GJS/QSWbWsrUCJ+Hp5aOjZJpQi0ZE0zyhFZ3AHWZg0ZN1yt4akYdKQdcvcGpaHSUM0NO2z
-SpHoyNqmlaKzvr1UVnqZbFr+NoBMVnqJbFrWNqBEVnp5aOjZppYi0ZE0zy2pl0kuumf20bPOYc4AL90z
-u+lZ5zBnoKPumT11HeVONJ7ZWdcR75+pN/jr2VKRkUlEnQOdLUERmSTUOdDZUiMhk4A0dDkBmeSjIS08
-468Rj+S+ewb/Petc6gzw4D2DC591LnUGOvGewYvX0ewFY3DkdWRbseh9+WwtC8WoM51zna0hsRh1pnOu
-szUsGKPOaOgOojHqjIZ0JxyTzkiuvWfw7bPO3c4A794zuPdZ525noIPvGTx8Hc1eMAYnX0e2FYveFg+x
-w04s2thhTSu3PMpwTDJa2HKAbVTY0hK13Bspd/LRgidG4kxEGmDbfnnqVelwaE0Ta+v2JlO6171g7yKn
-LwAouG7qwUsevIHA4SVUj2Bvpa9ZTfUYEisL4MKqhycQviIu+OUCXmg+z7QPCs2IpOSRNBAS06JLFKCR
-F+P6SxSjkR3DUkwQ5lIVpk2WS57/JSxLmyiXPO9LnShtkjRyYlqxiYI0MqNfvAlyXMly9C1SXPG8ryAp
-+hYZrni2V7AMfYsEjVzo13ii/IyM6JZ7gvTWivSsWrjmOV+D8rNq4ZpnfK2RoFULjZyYVoWiFI3M6BeI
-ghw3shxXFilueN43kBRXFhlueLY3sAxXFgkaudCvI0X5GRnRLSk7FIUwFemiln1tfiIuXiBY40xc8DNx
-0YDw5qm42Ns5sMzFRWJnwjgZF8K8Yw52ipLzeb59WHJGLPx0XDQgFpf5uNjbuXGakIvEzpDDjFwIE5Ax
-RioKdMk3YakRqE2eS579pVaeNnGaeXGZlYvEzo59Wi6E2cgQWhVFueLZX4Gi9C2CXPGcrzSC9C1iNPNh
-n5qLxM6KbW4uhKnIGJEVhbjmmV/DQrTq45rnfa0To1Ufzby4zM9FYmfHPkEXwrxkCOSKotzw7G9AUa4s
-gtzwnG80glxZxGjmwz5JF4mdFdssTX2cTFn/eQ4LwExYdWXgEtBzWANmwqor06wCPYdloIUjc4xYdH0s
-TJnixaJol4BorZIVVmHZUiNZq2CFVVi21ArWKlczP8bQsiRWM0uGMLMoVWVt6NkWh5mwLsvA5aFnWx9m
-wros06wQPdsS0cKLISItSdPMjjY6LcpyrcrSrqHCCi1bw9K0a6iwQsvWOnnaNdTMjzGQLcnUzJIhqC1K
-VVk3eraFYyas2TJw6ejZ1o6ZsGbLNKtHz7Z8tPBiiH9L0jSzo42FC7LkL9DpQ+KiMNvwct8EFYcuPC7K
-k8PTaPBoQ+WiSB040ofNRak6MKULobvf2Eq9NBoTRye1+/apoLY4OgfeQODWOLqNvj2ObmPBEkdPo/Fx
-dALDMz0pjs4haSAkjnF0Gy+ucXQbO05x9DQaHUcnIDz/U+LoHI4GwuEWR7dx4hhHtzHjEkdPo5FxdALA
-8z4+js5haCAMLnF0GxdOcXQbI/Y4ehqNjqMTEJ7zKXF0DkcD4XCLo9s4cYyj25hxiaOn0cg4OgHgeR8f
-R+cwNBAGlzi6jQunOLqNEXscnZ+KHOLowzRWvECwtjg6B9+A8NY4upUDexzdyoQljs7PO85x9GHmKl4g
-DG5xdA5LA2JxjKNbuXGNo1sZcoqj8xOQaxx9mMGKFwiBUxydQ9KASNzi6FZeHOPoVnZc4uj8bOQWRx8m
-s+IFAneIo3MoGhCFSxzdyodTHN3Kij2Ozk9FrnH0YS4rXiAETnF0DkkDInGLo1t5cYyjW9lxiaPz85Jb
-HH2Y1ooXCNwhjs6haEAULnF0Kx9OcXQrK/Y4ehpNiaNTKN7HmBhH5/E0IB7XOLqdI+c4up0ptzg6gRwd
-R6dAQjsmxdF5NA2IxjGObufHNY5uZ8kpjk4AR8bRKYjQhglxdB5JAyJxiqPbeXGLo9vZcYijE7DRcXQK
-JLRgUhydR9OAaBzj6HZ+XOPodpac4ugEcGQcnYIIbZgQR+eRNCASpzi6nRe3OLqdHYc4uvQeg1McvYXh
-mzApji7iaTR4XOLobhw5xdHdmJoURxdyhqVechwTRye1+/apoLY4OgfeQODWOLqNvj2ObmPBEkdPjuPj
-6ASGZ3pSHJ1D0kBIHOPoNl5c4+g2dpzi6MlxdBydgPD8T4mjczgaCIdbHN3GiWMc3caMSxw9OY6MoxMA
-nvfxcXQOQwNhcImj27hwiqPbGLHH0ZPj6Dg6AeE5nxJH53A0EA63OLqNE8c4uo0Zlzh6chwZRycAPO/j
-4+gchgbC4BJHt3HhFEe3MWKPo/NTkUMcfZjGihcI1hZH5+AbEN4aR7dyYI+jW5mwxNH5ecc5jj7MXMUL
-hMEtjs5haUAsjnF0KzeucXQrQ05xdH4Cco2jDzNY8QIhcIqjc0gaEIlbHN3Ki2Mc3cqOSxydn43c4ujD
-ZFa8QOAOcXQORQOicImjW/lwiqNbWbHH0fmpyDWOPsxlxQuEwCmOziFpQCRucXQrL45xdCs7LnF0fl5y
-i6MP01rxAoE7xNE5FA2IwiWObuXDKY5uZcUeR0+OU+LoFIr3MSbG0Xk8DYjHNY5u58g5jm5nyi2OTiBH
-x9EpkNCOSXF0Hk0DonGMo9v5cY2j21lyiqMTwJFxdAoitGFCHJ1H0oBInOLodl7c4uh2dhzi6ARsdByd
-AgktmBRH59E0IBrHOLqdH9c4up0lpzg6ARwZR6cgQhsmxNF5JA2IxCmObufFLY5uZ8chji49qucUR29h
-+CZMiqOLeBoNHpc4uhtHTnF0N6YmxdHFVytSr0nGBNKbhAtkq6C2QDoH3kDg1kC6jb49kG5jwRJIb5Lx
-gfQm4YLXKgK3QDqHpIGQOAbSbby4BtJt7DgF0ptkdCC9SbjwtQrvFEjncDQQDrdAuo0Tx0C6jRmXQHqT
-jAykNwkXwlahHQLpHIYGwuASSLdx4RRItzFiD6Q3yehAepNw4WsV3imQzuFoIBxugXQbJ46BdBszLoH0
-JhkZSG8SLoStQjsE0jkMDYTBJZBu48IpkG5jxB5I56cih0D6MI0VLxCsLZDOwTcgvDWQbuXAHki3MmEJ
-pPPzjnMgfZi5ihcIg1sgncPSgFgcA+lWblwD6VaGnALp/ATkGkgfZrDiBULgFEjnkDQgErdAupUXx0C6
-lR2XQDo/G7kF0ofJrHiBwB0C6RyKBkThEki38uEUSLeyYg+k81ORayB9mMuKFwiBUyCdQ9KASNwC6VZe
-HAPpVnZcAun8vOQWSB+mteIFAncIpHMoGhCFSyDdyodTIN3Kij2Q3iRTAulNwoetARyOgXQeTwPicQ2k
-2zlyDqTbmXILpBPI0YH0JuED1wAKt0A6j6YB0TgG0u38uAbS7Sw5BdIJ4MhAepPwwWsAgUsgnUfSgEic
-Aul2XtwC6XZ2HALpBGx0IL1J+MA1gMItkM6jaUA0joF0Oz+ugXQ7S06BdAI4MpDeJHzwGkDgEkjnkTQg
-EqdAup0Xt0C6nR2HQLr0MrpTIL1JxLA1iMMhkC7iaTR4XALpbhw5BdLdmLIH0uc1bmovzbOcvpvavkGe
-Z7V3CNI4edmh73//XZ7l3p/x8ZwE5Qx9h7Mkn6Hv8iwI8xn6bZ5VeRJUM/T46o/xHpdBHecZKc4fX5GP
-v83PZYxL9Cf8TD4MpIBU75SZ/zxXdXxoX8GlX+h77TvUFegAn8ugfYkXeAlWC8VeuQXhaIEOri7PWRh0
-bzCDD+3SekMJTpK4qOLK8NIuh394bpuXge7deVqHe2ubh9G+PU8r8e/081DQK/1OOfUpEukJenMTOr3u
-IIU3wy0tkWH55tgb5J7hiI2TaGqr5Gfpx7Qqja7ZKuG+CdO049RWyc/Uj2lVcrxmq8TdP4pKerJ+RLPk
-Z+vHNKtJJjerlUv+jMswqDA3JOsyyKpDXqY7NBTrBvS5KEwIhmKtRQiKuA6S+EcNBq4cevedTB7P9KFe
-LxmkyH3eodVi4QTaSVEA7kosCFqzr8Kv7cT3eRIBkFs3SJDrtkAHTgUacnBV/ZLgHWq/a6c8MoswqPZF
-4W8Oh4O2elHGaVC+iACLxXYPwgQC0O5EZrIZkr5yT7sPCDd3+5WWiQqHeRYpbNyF28020rPRg0mMDN8B
-VtYP68NmrWflHIa4kmCW98F2vTEwwoBkNtqvABP+w93DUt+HcXbIJYBtsNzf6zkgEBJ5+gnqi8Pd3VYv
-gOegzOLsKGtQ6C+2evItkMRB9xVgYh/c7+GhQyGjIDt2Y6YDicLVxtQJDEZiof0IcBBs/WgZaDng7FQv
-hPvDwyHQM0BBJPrsG0A+3EerKDAIoPwsAqzWq2C9MDW//Kw0vvwMat/SX/t3Wtr7PJIG4tJfbpYPWoD0
-XOPIcei2JJIg/Nw/sQ+/fD7f3JiNHAi/3GxmaPiXEcspjrjlzQ4tbhcoeM9hpDNcEZS4nZbZsD4FEfHd
-szzDmjfcZbh9Xka4ZK/F852Mw5wtjjyCjJtahxJGRy/IEgetojznZcR+7xD9j0e+yJ5Ft/agSyN9RX4J
-gSssjoQ4O+EyBtcPT3EV75O2KfRHnMT1yw51BQBMnGmh2MpJv+IoyjhrefslXfj+crfb40Ne4u4X99w/
-0H+ydEhXNcYa1K8Ldllev5vv6+xGQM132zmLcJnErY4wsP2+/Gsd1wn+QWIszLMaExV8fIXePb5CQV2X
-72jNG/T46ubx1YCkKHEHJSwYixJ76up0AKIC2Sd5+Plv57zucXSq6RcNqvIkjtA3QbTf7KP33X7BETOt
-8uKsiiO8Q8FTHkcD7vqEg6hDF8VVkQQvO1QH+wR7pAiXHhkdBQdRUmbi9DjsStioFBTkxMLEpz6imZfF
-KciqHVq1/D7HUf7c//4CAnHUaC8oxH5FanS1q/hHwg6HcDCQCMnLKHgdQPo3iLOhw93AsuBpH5SKbHvL
-w2rtg+ho6s/FYsEvSYJ+pHW1idlKgqLCO9T9pVnOUNg6mvG/Tj0yxQ6qnqeAyWPkcYTq0wz8HBmaFWG8
-xHcm9MMkKpsuuKrIBfsUqZ+ovkN1qV582/5XkTBzYijTikjnFGmH38CzBSG1jbe//AZV+bkM8XdBUcTZ
-8S9//uOHfZ7XVV0GxTysqnkaFOiXt69mr25v0b+TKQGlcRNnKMgi9Ok1ztjgJQUeNbTe3864fPmEghKj
-CBclDoMaR/PH7PaW/A/9tzI+xlmQJC8oiCIcoehcEicyP5coSIpTUFHUaRCzcdBX2OM6qGaoPsVVy8Jz
-UFGUEa7iI6lZ58R8PeGsRp+Yg/MJVXUcfo4z4qjnGYr/2/dekKG4qs4YPZ9wiRGth+iKiaF7zs9JhApc
-VnFVI2Z84yyu4yBBdX4OT0Njfp+XVJefgzJCYZ4WQd1OSDP0jN8+YfQZFzXRgwozplnrzkVEpEIKUlTn
-FFeQPAcvFSpxfS4zUhKXqGRxU1RU+BzlXpgEVYUrFGdVTTQrP6AAVac4TXGE2DRHpT8w+AfS0B061XVR
-7W5vj3F9Ou/nYZ7e1s/76rbv6lsqkep2ufEfNmzWpCJmwqFK9otd+wP9qp2D3nMxlaG2x7mRLcyM/c0V
-oAGJGJuheIokiDNPxTa7ACeHzQvCOn7CWhbbv/laIG42KKjmJHFWe1Fc0SEa4TAJem/N663ODFU4wWGd
-l14SV7UX5mkaeBl+JnM/m1/ooGRd9+84iOLsWLEPj9nJn5H5CZ1WM3Raz9BpM0Onu9ljNiclc1I0J2Vz
-UjgnpfPTHfhW6utTi9oTCt4r0fuhIvf5vRKdkKqxz7QabdhJqcZ95n3ooQL90DlwrHk+Ubw4C5NzhNsg
-R/wjfvf65Hv9rxuqkUwSS139pVKfCm2lq79S6lP5rnX110p92hUbXf2NUv+u7Tq4/p1c/zGbJ71fBYGQ
-Uh5I7cChRt95bH64Rf/xUuDOn0CtAXrM5u2X7kAdRLat4mup9hW0GtNRERXmC0d/aaW/tNFfXkJ/ZaW/
-stFfXUJ/baW/ttFfT6DP26m8jH8kTmuCynM7h9JhW6pPRL8+lZ3NYXZEsUxSOb8c7h2aHlX7m3rHra/H
-fRaMSM/u71LiY8Q9m1UaJAmxovQPvTRpsXkUqQHkjnoalJ/pE9S9m9mfNHtNvnntT02QgNXZH5XG/DGu
-6r4lczqpnDM6IcnmQCjjhvcfMtK3iPgcFa2E4hqnxMWocxTTQo8uBTv87JsdO1/bIzgZSL8y4XG/J8yQ
-KZeslXdJQObGU5xEN/A2+WseNS+5L7J8voursBPP7S36zTlOIuoHfiIL7E80okAdu7hKtV3/sHhz8964
-GcLJ8zf9splYSXkRLas7XZCX73U6N4CLiseMvlCa9yGCXsKdaK24QdXuVE+p1w0r1mNtDAWITjw+Lhf+
-+vFxsfj14vHVe3R7i3CKoqA6zVC2rwrZj2p1mUrxL50Sf8a4EPSSsoMS/ISTGd3TRyVO8ydcoQgfgnNS
-o32ZP1e47EYY9bepwlCUvT8IDBbpFHNrD6t+O6VdTPcc/xlXRZ5VxEmM0+CIK/QOZ9W57H9Gefa2RlUY
-JBjt8UueRa1fzwJ+1U2vqP9BVjVxhYpzWeQVPpzJ+igviJajpzhAQYZwUyRxGNdsEkZlUJ9wiepTkKE9
-Jg2tT7gXwiEnq6kEffovcXr8+KmiKwL0f2O6Porzc5W8oLqM2RIEPb5qGSaLtnJo1f6lQ/j4CgVFUeZB
-eEJxhn7TrRvQ03LGVi9ZhIJ9kEU5WYjFtVRrhfY4DM4VJkU0nlKhJK8rsoSpT3EZeUVQ1i/oOY6OuK7Q
-O6axpFn/Nc+PCUbfBQWTF3o+xeEJPeMSE+nipsBh3bW/bcdzXJ9iuohKK5wQ7ahztMcozp7y5JzVQRkn
-L6Sl8Y/t2hR9jzEKkip3Xyv59/72npiQ9OgdknMsm9z+O2+20R8Ih6g+ndN9FsRJ1SLoP8jTQ19gmyOG
-ivujMHfyJcCEqZR2A5xrSltSBlF8rt6pIKzgRgbq4qMiRPf1pjUit7fo/8K4YIvhoEZpXtXIXyzeEG3A
-ZpF26/D4eC4H12N+oL+ZKMmcT0dlRTUkDAqyMHtbUXOO6N46U5hBgeammeoLR8HrA5OwZUe3aKm6Vb6M
-peVJ73yI9XSmWqrF+T/MXjHV+46GIajYPMWE9Z/rOAu6T63mQnVpAe29duQdyjylFo+FbDqbh5jK0dHO
-GcA5t0CXhtHtLfrvQVkjf4e+xzUKUBo0cXpOUYmTgC7M65xiZqhYHzT9iY7F4s17Hs1yh/7bEy7LOMIU
-ivUFwUFPwqGcWNPnuOJMSJIQk1HVJa7DE45abM8nnKEK19TkBG27iPVrMQZ1Xcb7c42Jm8E06ohwglOc
-1VSvOi0gdAXLIAq9le33p7wkcxwtYoIitr1jgLMDFI5yQlVDFi1DQVQpwZ7fzBD7a0n+oo1gHxl3nt+d
-hpEJ7NC5THos3BD+9bnOixIf4obMSsFnXKGQTCf5AbUTsfeM95/j2kvjzIvwUxxir4gbnHg0VMI4zzWl
-s5bK/lyjKMcVsfxhnj3hskZRUTQfPkZFPG8r/ccJl/hthbIcVeeQjGvaVxU6Zy2HEdIwUcVZSOYoCp1V
-dZBFQRl1iH/LR/gQ3TXvp4swyOJzhel88c0BB/WHsKrakGiJqzw5k0FJBziL1OVZ8oKqsMSYBVPpgaSh
-5g75D8uoiG9mhPIffvfg+X7nUJyLIm8b3u5t2HAtSd0b9BPB9X3bKjL3KeF4pZOXzc17pRbb6Oj1hlMb
-bmeks2N9FPjd46tPnCrefGJnPJ/W89V80f69eXx1894YXSvKvMBl/cJ2hol/xgZKnBE3oYrl6YobB9I0
-xv5LfFz+OxkZQZKQ1orT2w4dgqTCN52ZPqAu/E2FgqUdiB6qpcNJBifEzhx0hNC/fWCkdAhhMCjqOayY
-pVY7t4KAEocYbI5Qi67OdK0GmOLrT2JLT7Cvxubj0ax1YNN4sxKVa2rlC3PHVf9K3TmNLwn3dXkzKPbX
-0yOzdlxC9zLFvETKbn3beows2BPmxA8n/9a7qKRU55jSsmFZAR8/GVyJ7+sSBymLS50wmwKY38V2/FGQ
-hae8pIs6uh1P1vyfcTYcpGBbiHlJVw8B+oh+Yd7c7aI3f6noXl9xrlH9UsQh3aakx25xRNfhn/HLPg/K
-6DH7vI/kpdrnfdQt0rwX8WejjcOQWhqxkaJBasCSj1RoF3u6pZrw06tSwxqNYlNWZ307+avvrbUA2kMc
-785hEKOjGa5qHHl9e7mgM8xQlmetQL5I8TUaNmBK2Z9xcQx7mdS0KLEQ3SJebRjm54zFU6o8ZUMB5ee6
-ONdkPRnUqEiCsP1eB8cKxRk9eEP+ZsijYQ9RZahVxE5k4D4+P176uLIgld/RAU88wDxJ6J97SU5z0jiu
-/KduwdTH+sUK3lAmHgZ72bVkeC/tt/2hFeoSVsPyBdds1S1WmNEBmncLspgJmHnm7CQLleOwpR3jdq3I
-mbdjGUf9lvhP8OGZXuJp8Jl0blv87ua9sdzrl5LVO0UFf08XqX3dvq1/qeMk/rENMzCrneIg49vGVos9
-aDWjCxoafOgCHvWJ1KcI6Wp4hg7nJGlLkuAlP9fjRMEvqm0C4Vr55/y5b9mf8+eq45r2XJjgoKTtPCR5
-wMJ4L/m5JPp7TjMnBsv8GWaqzJ8Hdtpx+Gca5KUUM3xk638WcmERhy7uSbDOSDW29j4Ne1Ot8WrxUaAg
-SRA9OxEHNUZ0x6HEWdcG9I47TlKes+A5eGnnonZ4BlmIb+bs+FXuHc91jctKkzXrPZS+qrUzCH0k/ZXM
-uh9/pZL65YfHV2GeeI+vfuiQqok/3ssFfPC6XW9wvfpb1ra+Z3+bp2metadfmJWj22A0aB6UR4xIz3Ui
-sfeq2JOsnMG+45d1t+i/ErTVS1XjtGfmv+IMl6QrKpwGWR2HAvE+RtcfpeFDHJI6v2a9sUOvKQ/sFxvR
-raMkB4okwbYI+gCeKF65VL3GyX8eLlIK8Z7f5yXCQXhiPlCRx1k9I4rc+T1dwIsPnXGGlAxF/tCPRhS8
-KXs9/E29MrXKDL0euKk68XGfOjeTMv6a5xzCR5jkiKrjnYZHBizeuXgnIlVJs2HUR/ogspDy88IhFsau
-Ib1HcUgw89/IH+1p6PbKpXIrWqMZ7S1lsfSLqr+JV+IgenHgrsirmMV2uoBoH+9sbVY3bqit2+MwT+ku
-SZ6jLCiJ9aUudVCzIY9LNtrqmBix/UuLrD2V1sUbP/HD5tOc7Vs95+Xnqt/gecaI/OcTEdYn9BQkZ1y1
-yJKAND7PiOOeD9HYuOrP11H08yuPT0jO714T94tqLZVSJ+n2Zytk0oYdWqAFKnAZ4qwOjphBotse9KYT
-/K+jCAXoU6+Gn0g72x3BdmO025miw4fB03gmyvKa+GzPxLds0clh87b+HP26KJKY7Wn94Xf+4ltqrX8f
-l/iQN3P021NJHFXy7fvgEJRxiy7KKZGgKOjcnaMS/+0cl6wD5lL83NJcUHPzw6HCtZtgX2dnuksiolZH
-THx4R6qiDx/aux48Y9k5vRGChb/pjQV6ivEzDZJW1CWj60HJm2xnnQGoag+tHuix06Cimw4Fkf67LEjx
-DqVxNljkmxmia6t2y4NOm3XOZs1djx0h9K6pdoT1Km3vOs9QGrUXhGcoObbnumeoSfqrqNzGMKZMdFyx
-XU30STHMn9AxyfdBgp6CMqYuflyRgUgbwkCE2kF5PKdEI4dN3nk7Mf0pSHGncxluamGCykuUEYeU+Aqk
-QhJUfIU53/KPH7kSj6B6V6U3XWkaGevNJgnOHftr/mOQ4mpHCKIqRWmEkiNqEhHZrw7nLKTbdDK21wTc
-OnOCFNOg8D7jl+qdMNH1g4SsBCPcvFMgZ4gSbVfz7ZlhOljQv31gHURU/nWG/gtKcHasTyqOmxnKoO8E
-N/oW+aT8nCQ33OmW79oBMIC0Fhv9idcJNqFUNXp3iMuqvnHTjzTOLu92CqDpLELAra+6DkjjjPXRkRg2
-sTNh+acx7YHFjAIDEmydOosEqRUhAiTDS5FfaxUYJjrDksEeBkl4Tui58qBbhLLu4sdynmGU4KpCi/li
-SURFZpqczuEooOElxkCcxjU9wkwXd5/SOPM+sUP/ZJr4hNqdNGZaO2vbOumHMqDC7yb0ajhi0W2XPT8/
-z59X87w83v7Hn2+paW4ts7e+/Sb9G90HTIOmC8t1/ArnXhbzhV80MvsBCs9lSVdt5DfdJj0fieVk86HK
-zP58rOZsd5JyVJ3y5/93fz7Ow2P8/4ujD/72fnnnGzQ3aC7X3O3ddv5wr1feoBmpvKS7dy7GCrAiuKln
-6tChX0WX3EPzxVJV8z9jdqIwQPskyD6jqqZ3OOLDYBuESaXffS9bQKqvZB6iChXQc2OIrfa7QfAXek6K
-Dpk0+EzQc0eXznWcxLUw0SvdFmeHuHnXVBd23OOrx1cIvYObfGMifbHOPL7yqvTxlUZlGJFRSsMpgd1w
-3hC3jPT8jMqA/Nv75ida8Uu/jcusHtvxPpDVRoKJx8CbJ8AW5mxNq84osiX8jp40IFW6CxrEw01eutMh
-x/gJ80Kh5uo5jnA5HzxYaBU6fp5wENj7fouGzBPdalhNSEKKueWucPekW9e228imqy+S5OnBKj6y4CD4
-biK6itzZutMo+ih/Hj1JB83OwVLywg8aRfhcRCENmmsKn+4SVEWQVSg9J3VcJFiRfGWR6h7Xz7gNaqZt
-EJa0gmufXqYt8LvXNP3LDL2mB5cvU+4OlSJguDsAit0GUz8cBMeVNK77YBsn7MTLpA7stOGDRMoYoILa
-PoJcnLmTY8MBkp6FoKyEvAINaN5WvRFuNWpY37Yq+ae8r2IwxmxDJ8t7FLL9yDNsUXF6hkljPWY0dDF4
-mUH2wow4XY7arQrB/TVN+hgb9M+p89Ds/zVVfjw9Lg70+zJIMV0S0JDmke0r0ON33V5dhSOmcfsX7px6
-nXeVcRtxK0sc1ig7p3uibId2R6Ld8qBq2h5/f2kXYmSxJES8PinbFMK2iCZONkOGELCrEg8bPO2hubjd
-5AmSRJw6EHrDUe83/OEYsxqctYZnbRso7U6fsq1Ad4HBGEnH42vq5e4Av1e7j9Bvu93eol8nSf7ch8rr
-vDvvy++67jGpww4uS1JD6FdEnq9jFgX0UX0q8/Px1Ic0B6Wdh3nyzU+M3S/ET46/DIWk6U2Ns0joh35z
-7wun6BKmGfBNyOZpRP2lE8XFWzJ04yF/oscvgyoO0SfCkvfTvvjyqR8wRFb4b+cgabeHDglu9nkz7C8C
-ouKFRDdgCPqK3/psvx/L/Jkdru8+QwfCOTmapcZH//sdPfZ/LU7pq0yN7VtXuEY4KJNY2GDhOOl7yVWR
-rKqk7vIn717HM2nDQhIFx8mcxrUHAjSIh35C7X0Sz39vqk0Dwn3lnv1vkQgmNHdhbq5EoW1wTyJ+Dzbj
-9hZ96tF5yP/Ub1GxnQp2gP5F3GcJMoSzOi4xKvNnFNOD5kVe0aRDFtbf8cRuhLEdH6gD8461gMyFNGhA
-Z/2Ybm60Z7N/TY+z4TRm3J0rTGN1c8axt+A7uf1oVARAFfp9Go1GcDohqgc01bIdFDreuVk0oMJq7+Jw
-WWTksWi4DtnfaqIHDYyn4NpMMEduwJEOynBFZdjuuLU3Tlg86ZOMhk3SqMvVUqtn3jpOcCLexELoiUys
-YZB0eTrrvFAORw9sQjew+JKhqV86llgSmY4jmRyXvEE647lD75bolxDlG0fShjQ1tFEX4Bcv6v42zyKc
-EaeMqcvzLToFyWE4tcMrk9dd1h3VW16VKrTRb9gW3hMuqzjnzsX8OorI0oLdLW2bXFG3iYuRM1bJIO5W
-V3QPsN+pZQdU5ExFP4l388ZpBdjqqcgkFevSk51al2IgIJ8dbsexpvOhdagghKTPmtk3ZcZr+syie93c
-zy1y/x+8LwOvqsu46NXl9hb9/9tjYT8Oxbg77PQuSGpcZgE1EscyeKE9yeXj42xNdcNrH0XTCoexV5e7
-rD55+cGrXwr87pufXgtVPcr2lxt9+qu2fhCGOKu7M72CprLcS/hwwGHdt++/J0GII0TzGHVXl9ApoI5s
-SE8B0OxF9JpcTlZMcZAwYaBBVn3DuGQ/XbOU5RpXhzuv+ppDwKmXsa2s8v4IqQvb5GIHWYdOGHI4NEFY
-98l0qtZBb88zRMLpkk9ip7EtrPZUH8XFneUjgOyUMhva7QEztiahzM/Q63a7LUOv6xNO26lJPv1Wt+fv
-nz26G5/V7zp4Dsijt9j7kn4eY99vXOp290FI/eFchp4LltJoNqgb+entj2xJxIZMUH7msmGxvbIg7axd
-GpSfzwU7txrT+3c4YlqAqpDwu0M0NdnQbe0FfHrbEjf9IQPlYhfdnqKZRlCWe9G5SOIwqLHXd7PoS/B5
-0HrLpVNMUstVL6M+14Zg+qBKsjkdtJjjMBGSgWtZpHVdhw6pa2PRwB1vkpX0sbDIHMSlN+hwhjujKFsZ
-/gKcPYGJgK8sGGiNic6jiHPRza0TrbLYyb9QbadqPfX2E5Q6ZEZtPErWFHLY1WvczMpB52/LGLN9/7Z5
-w86q90txOR/mGb133mVgYLdQ2RCPf6TxMJZnj54Pb727OEn6GwW8k8Rt4P7kEA/ShL30e99CHEOBfm+L
-KLUb4BAgA/0FELWwRTqHD8KaEbjPIgQfhIAGdz+jUeIS3b3rvgq7v0H9ZJrP8P2wZhYOkEb5mc7AzEfO
-M/44PcOBojOdNdmiGn2SmP40oP2INCOZt2BiUMe29mQOAn+/F5h2qzqoaSC5Hz2z7r7vjp1aGLJEXMur
-oNgsfkWfD/SbnxiPvcb8or8G0HviH0VfXDUEwyc1rnfo2ivvLXCufm+x2VfODR/MmeSKA/ac/dRZoFYu
-/96m2wzq1oB84i3op7bWn/Ia79qjwexwbPAUxOzqErU6OEkqukLLn/t0L58o55/I50/1Ic/rT7ygBcv7
-urWXvdyY24LJeOQ0ZfNmGNu6/jIZd6CrZMLC4PvI9wNTAWmcOCF0HkHq7fo+Q2WWe387B0l8eKEvWr4U
-mFuu/wdu6nNAz96k9GhzmSdc9pW8TL3/j7134W6kuvLFv8qZpgELJFlucAhu0jOBkIRJAvmnmeGulc6M
-jqqOpIpLdSp1SraFl+9n/6+z93k/SiW7gXBnuHdN2qrzfuyzn7+tfs0G54VkzMShQfilG3pmhXr85IRY
-+j/cJRAssUQIX5mKDHRK5gDenCJZyDwsk0O4xK+DbBUWWcU4P3XVXjmpPe98kB9VLSH7e1+C+Ea8Z+DF
-L8XGhisJU1L7z/AsvIJQPAh+VNBaYqrpvvEUZCWcJaqgMeRboKsvof4Xr1/Ph+JUvUFqIIaFtZcm4lV1
-FTdi1ZXBpCQP5itd1P7i4JYoqDGFDdQxmHowePCynyvAs9lOzNhdKyl+VpwPUf0zDOv5OfliL3q+k4wS
-+GgjAO0S6aNcYXTDZKTBmLfv2OoPVa/ks3lwlO2tQ5zbM2eaoCnYQhoZNY/W/hIER+trZQt4zOj5uYXS
-UVEH7wuyb/ZCkgQdicdbWlT94SURjqNlHlOr3df1+cXF5YtfzBWkCdbXBh27Yr9BSoVyZSclIbDjwphV
-oIl+ar790x8viaAHFamrCRXGiRNK1hWrS8F68orUbMOa8gpMHQg+SG4BbGXFdPyE7rdCb1pTuRLm25z8
-Zq+2rUXIH0xoV1brdVXsa8SFNgEZsv0tb8BVgvaElRt5BgV7SW512DuCYtkeCG0Ot/SgDqP+FRGL/ypX
-Qy7G3wYUTbi3uqKVGCTL8s1rsq7u4IHdN7IxhDPWXRvY53FbqmHSLn5xeZndVNhXvGyJd0MBMuOlQ83L
-vRnu633bdkwoX1Hkr/Tpw9RGPTo8k5Ws6GgkttVmi2I5Opr03DQKIgwMh5WEIywXNHOrY0rPRC/Fl6Lm
-gpUTQyVJxwpW3QBTU+yFXVRJPtBdA49dwTqIoy14UwIxEhPyZblhU7nNVU9qzq8FWVE84AVtIDSIkR2V
-RM2OdEf7AjHLMKQHOE3t6Y3UAtH05nbFTtq3Tz/69JfzFGFwicGxB8x1RKLX8tZA/CyEz68Y+ACoichF
-1h/A/7BQIuUO+edbeGgl0Zv7pK6q2TT8saPNho3kPQJIVbpilpdRYZoQ11b1W1cCkrujwFBl12KKR+TA
-96RhClixlo2RM94p+oKOuzDVniP2nIWe85gpOc0CCHm3m2EzHkYlmF8KWhdnIHJ6fNED+ZCYX12O4GHi
-xcppK9Ejmwmsd4uX3ssAz9lnMPJX55/h9F8tDZ5kM4yGkGoq0cRx1gtVXf5KzurN+MWc1Zu3tJ4jWhrB
-l87qzWQM2zmrN7npa/PZqOmL3dua/vGWxkxfQ5gcmz5Y+1ytFz6NlgmgAvMMwHW0hj/wRUSIVXlFiX5T
-NSwMB0O6JNQqkrKurplN1CBbtJf8TF5uvu/BPwwnPHUfIqXOxyQGVVNWBe15N4mkqBm0C02Olae8zQ33
-IrlvyULJS44UWxFrYIcqodas4DuGmTbwK1A3/SpjY+JxQpNZgkHxKZdPC2Wh9FfjQ5mQoTRGw3v+fojd
-NPFrvQkM0SFMQwqk4SHABDBvABHV964xE9CrQTrxel3iGu94Wa0ryV8o5WzPScmKjlHBCO9I1ah/91tE
-+dR4lU1pr5vkH8JnSGOAsJZBbFnVkOV/w0r9NyRrmotCiKXFYnotj4HyOENWYh6fZ02CklK/ttRnBX9Z
-PZT9daW3TkNOkllV0w/xjPXZSM+43hyZcb2JZ1xvfphH47QZY9MPTzDr5dn/v8rB/01JNzp4wkg3AZKq
-vUqSStCO0USTKfRVfe/gOAvHecCmFdqyurWcGu82tKm+R9lOXiAh5ZpmI6+Pds9BpnAuW8brZjlHxS7u
-8TKStmM6utv1LnZuDQwsjTNsv0fJXJxDOPhyeAkaTPGZ/fllRKHkOK11uGVFRWvSMdAYQFC5FCH5Xnk8
-IszL+4Is5x2/XWJsgMrDC9TGhTXCARtwoMeBcKhVCdymc6Acg6VleQeeZwCcJ3SBPjKIyCX6SHnvnfhi
-y4rrFdfxt/Iqcntyv2pKuQlGAoHHQDtzq8LnhdOEIFvabOQJdgi/oWCy4CDoSOJQpeYGDc2QhuAUA1Jp
-v4fd0RVg2bLMgXVbTuUX8XY6OQxfn0L+L3GH5MhfjsHW5DP183Cn6w0LS5pB0gLTUnn1qWrJVbJpOEKk
-cnNVQMCE5BtCpw9/mUp04I1lJZkEp6v4fj2PxqEX/c7Vq5acxPuqZorHlpYlKpaXbgfKapM+Eu6hED3t
-q8JH1oJDEaBt5YftnZi7HEZXike7oXVV+jEl2v19zVgpGVNlGO0EKgaA0oHSUIHiANoItIP8GfxzTr7j
-3TXCs2OW7KrGaMyirhg85yVzegdUSlHwlpVkeaUaUYH6V/gHtuVnkFtJmaQWnKiHXtLmSsz8+vIX9bdj
-8cdnnnVgxvMH4/pLacNrSXsK7lKw+rbsTJkA72MldljIWHENEoNsdIo3cRL9XBW8mUziHDfw7NrUMKCN
-kj9pQQ7PxBkC/U/I6qCBy0H/gOcRy6j1mKuWEawPmlqxTdXIg1lcQ4CRZIQ7qtBhznZ8VdVsQhB4HO8A
-YNVtdK4dOCrQng1262BBP4OIcJcfB7u0gEewheB6lLgQug2oPvo6OzwEvr0YDGSSevG1yqKINOj33/7p
-j74QgGCN+44UYKwIPp6x+WY+VeIxslAT5/VIEir/MV/X/PYK/O3Ng56iXK5rgtjyTrIPClhfgVxtOn6L
-GFdE0J0B+acCAQbMWHE7Vvu+541wa6m0/5AWRH1XjUwMYfvcx7mSM5gi0L/CsEKxDaA37RMrBWSVMBUU
-u8YGgFj2vCj2LYYirPe1Do85w1QnlaQgtJc93mo8yTtho0kmGh1fcFlmzbuCoUVhyzoWUlOTEjhQGFgL
-yx+q4loDDVXqhB+JEBI747ziPZapPZf/5V4m+d/f96Kv1oeZySUUfI+e0sDlwdx4vMnGKRvSAKDPwZtn
-al7PlG0/ZLPzI88EBeUO8rHJjpgMhqiteX0NfNwHDe8/QIg192a7E/EkHm8qQS6TOJzJvWSKiiC+lSRp
-kEZhxW+MBr9qUoOIYxR2VVnWLDE3oML4jluKsmJbesN8xRpvmEhMMVSMHZus6Xuu+D85bBNCB+RNSaa2
-vTjay52BQib1VVwkyWb3eIk7hrhcgLaplnWqaYI2QgGYNbcQq4qiYKNoGXIa5qiSm3sr5F72H+IepqLg
-BlBIB1i6gUhTdbPEtquaa9c/LMXvDXN8CVYvyey5p0QdiehCPX7dHpItzwKqmScLxxx4UukxtHuc4xFh
-/z2fzxPY8fa7g9q5NlhijisFhK85PmXmi5SRzB+zFebJg1n46BnZOgl3W3eIbcfWrBOzjpX7gpWzHYeO
-nCzUYRR9uoY8c/LvSXpAJoV6KtDcVdeiFh9Y1dillirmCTgLp6TSv3ZgIcBLYdRMomVFta60g/3UyX5m
-xCjlhLM0Gr9iLzxfn6UBBLS843cMTeAHwpqC7zu6Afdc5IB6O1IvpRwILdrxH/NP7wXyvqD/lyO5ZfSa
-qIgIUI0RKmYNY6Vkp6iohEIMpUz0WwZEfwuW2kqA3ClZZjXC1R8gC4/uHT1esHvaghIRUspzzMHEOoIm
-X742Xkpz8hUmxyR7oR2TQOosCiaEzuIjtpDue8UgaVGDiS+pgn1H8KnmoIY5dyNQdm0tCTmky1GzcJcL
-fQ7Q8RJUhOCjBFhl4Jsg9xwN4NAgHCIqekI3VC4vQF4BgAE9OGYOi3GRcvfxnCbSTj14QEZZ8NVZ2iSz
-yWfPmyrN9718fC3pMsGteyHfMpwEMqwFbUgLxiMtX8D1wAVDelYfHHdWdf+xgHCD1LQrmGPL0T9NwyHr
-DzmilGgtV9VXEbgblBVlTSQPSKsqpMX4ls6MDkENx7zeDjWKHcFjxZhux9fmZnIRBFUCV0g3nUcQpeEM
-vOe87qs2VtZ4GjxCYIzO0L+fAdLlFbl8mZ9xKsJepR4ADSeG7YG2xY/ZtnYVf4pqtK5L6XCBu8RKzy86
-thu9qrrFaHV900y6UmghNXuCQWKH6h8qRGySvdzdZkVtHFm6G+WmNYmTc3jWoHTlREpIfUxSwsn8lgp9
-TVhJ3rsyZ0mx5e/NK5Hyug7okevYGlIK5xbK6+aBC4Rae88sVzUNy8TgqERpcH/TJTqwmMoDrP6ZLmav
-CPKJBEaSGsjsH3va9bkBqZxsiWoQSZ378DKBnBC8IAOrbT9bYrmA/+eRTFRneEdv/uJykur7/6bI4DT5
-NaA1A1Ez6VCEIVvloB88poLOWBifcKR/6DNrj1rP26ETdsIZTCxtWpD+Z7vo3iAtGZP1Zl7Z5FpGDXgB
-RrKRqMDGUoIjvWtL3flwOXnj/2de3+g1AS/QJ5+0H4v2ZBUhTxl5zl6Z5tx+Iqobqj3e2uyH1SnxCtgv
-UQ77UTfm4UdcRHuvYXv92MnM3MdMClzgWWPu9IVN3RYEtm0kN4cx0GepWscQpRIU6cRxP4k+eWORo2l4
-f6YXc/KUJRw8FwmWQ0u4FUomul/ItPu27wI0Gl2EwfH/qId54Eikh3508d/OQRkOmfydugpu3LF7PZT8
-FWtUdSERB+foqYAMSDtT9Ozil4uSbaZkB3iGvDzMVhurO7i4fHei/5oQ5CyUhBpoMxI6nhiMCYJzjZOa
-HsMU7T81W4PzM3BEFrapY2DE72+5BgHpeSumRPS0Q99SJhmi1UEpMw+YNR3LQtC05nXWOhuWbWceL/Ad
-KFC63kxj09HD7JPFYirXufR//iX8jOVV3pgrsnhXFTW/QMrKXLbzcEf0EpiGcbh+L+5gvM4modeylhGd
-zdN78Z/akTDYCcm691w5CP10O3H4J9gJXIMfeCvCiZdVx1R2i9OXoGSbK/LxZck2o+f5HCiA25Pb/Mhp
-RPdo1m87ptGLwnmsQNP/fFfZObT7rq2N3nImQPN1abZQF+tY+cSr5Pbr9jZq0q6qJTHrwz/BrH+sucr/
-lScUpfbECeX7Pvzwy8UiOw9sz86jqLoClsbpwG/1sSNXoBrqJdUaS7CaTMn84lK+ebTZ1OzkmwS19G6S
-F5fvTj28t+gH3Gks7f77k7Bg+IOXleyxIAufU8E8JK43zXzVN2mfT8tn+fgHq76J0A98UANT4jaOvAGW
-w3IK4PKqXDoco/aAt8desE4pLxxN/viwHRhdAtQgLG9V1OC0pTTvsrJR7U+J9/fd1Jm6Slkn/3ZU7FOv
-e1ehnQQZkGVjiIE0OEhmgdUSl6zgCFjuLJoGnEK+2YQfOTx0ZHPzTFg42YQBKxFaj1FciPsrOEGcOLTT
-KXMc6RgcTRyGH4du3ajvg7BvGISJPdcQASNTgGPbILrpJibw59z+qQDuRhSdq0nd53uH0WqMvCgxekKE
-yTYSrrs6Wcm2Uzkcfrvv9x2btR3naxWPr10M6wryfDdk+Rl9tTQOkW8aKomFuzMasMBuD7X0BDz5WDcD
-zy9hjp0fG/1rhZipr5mhSydgJMoupdwKvz3Ey4/31+A3Ka8D/F8/SfWpnarrcbxzXdAfxCSNXPvHqrkO
-l0M7tlHtQ1pzfq2AYq1rG5UP1DUSdUl2lLHXp87OXzMn97umH7JaSKBd6oEF7C+jiBLUiUHoMo1jQa+L
-McTqlNZ8ShZTRJ/8zEPyY4itWmdLgdzpZW6ASx+/5hjaj/46miyiH8+WdSw8G5/j7r+uvvefcBMMOOLZ
-mtWb8OWyP7kBfvH7ZX/MRuzBaHQw5pjRYOZMbzTmJzfAMjEa82M2YtIuHDju4jC8hUOP3nEx0GrTtBxd
-HyBMj8ln0knE5PQEDqYfkrCjwK/CfJ2pqD8VauSQ69fouFUAFJKKqxFvGtBE/VVyeb9680zsV7uqf/Ps
-b9PgQ8cES/2uVkPHm70XDzPh0W1T4+JRVCTNgdKloqoPpN3vWliY0N+KCnLL6lr+7xXczSlRd5rYxxYS
-oJQWKAZxU5xUI3p5DbMfkvkEPN90LEjbJ3PUg6nSinM0JVVjFwsopJ/cI20GpXNtvpBdTzz4VMcTwzYY
-cI2evjAslQfVy7IXMXMySOCdIYYrHLl8eEPNlQ6G7G7EyNfgx/YO81dtqnXFlldzNMW76u4svavOWUVd
-7Nzokgfdyd5+bz8OH3/8iI9DpxzCjbQu9V+8fk2s0hxRtw7vd4zgDpfxnkcq9pRWIOHP+4NIFlB0Lrb8
-lrySS9vxtuS38hXZbKytOl7SiEINrGxU9uVTFsVZe0jCpiqaXG6AtsXKc5fv8Zzag331qGdeano7dz99
-+yHDSlLUAjRU3jDfNhaQiKjWD0ApErTiB6YWaXBo/2WOZCAtZmFvQOITbofpF9ZUDt5Tz1YWG6YGHH+G
-BRhnjKffnSOHeMxrNnb3jA3y0ldyjKTDz4fduEMd2f+LhO5/OsUJT9ATyUmyubRKSgkUAmVbn3ig4Ohq
-Xl2tq6txDbStnqbVAym7sm0cnOayADiBe7XvXB26UYO+ptmDjFiyoqYdRJa0KqCfNhb5W8XbqBiTJJxd
-4CWdwrRFsWy+piULM4ckY7RmsuTkpXuB4cJNIg2rj1cw1zHHOjIkrhn72YeV5RUZQv/Qy4pda5T5K7Kt
-ypI1L8dMTw/Ts5t8u2VEExOMcmcdOVt+VlY3r5aTNw1QGojehH+hLVH9Ievof4PrQn4CONck1QKbE4j5
-wCKpoF51Yn7nJQoF5GC67/mOKm2DN2/47hj3vbntWLN3hyD/HgRA8YMn3NhFE0bx/Hv4x0w36uO0GHYP
-6NHqoM8xxlehUoE35M0z3rLmzTMNK4oDJRiRegUdI8qKk53WzkL/GCBbmQL2Pi8crBa3BOaDk/QKBstD
-uJR9nbv+pomADuiHwnxPG7dgZpAToBH7jgkNmQCfAeCoWutwky3CtmIIWKkhBna8BKQn3rNughRI9DMQ
-tQYNYXZkJyOA25oJEPDwo513jm6FNZL2L1cT4VRw1RGurv7ERB5jk5Kemgw2n8DDv4ROUktDQ+R/XYA7
-BuE7mQjiTIOdmxXItLgImvNjzr33V6MCdIaOQAjmRnvFkH1Lzug1nRIkk9A/4s/++170gL2jKKiTJUz0
-tClpV9pxK1xHydYc+F6K3wIghXVdtK8kaJciU84UdDRzJmItQO0xEGcBOfAiijLMpk93z/atb7yx78XR
-4etBxVuOOzRuLgoF6+kzgWFo3uy9qyvcufsc7sIimfjJfxTHT96Za3Q8R8AvPX32sldn8qF77qjZn5+T
-77as0Uok8mcu+Yr538WUgOZdZS82x18/wY9PWpZY3/f+ejczYHn/9as3z3reoso/8Q1dLnNflR9g7rNc
-MgejLj7G+ppFIIqgR5T8byfIGSwK8Me0IcvPtt2r5USnXekdXsadbom1A1Le0Bv9xXky1C/o3hr9qiHd
-XFCnP1bNNcaD97wRgJ9BdvI4JEalGBdl8lh+hpVeLWcqwr7QOSEl41+yhvesVOBJCgBcVxFLd4ZVz3aj
-jFFENWO7FhmmSDbphqOmP+DLX9SMdpAAdvvyZMuxaThlQlYXSMFx54afYo8J4JYp/Bi5Rei02tGm5Lv6
-gC8z+C40Lqr7ca+gzBhsXo1kAZ39Qme00gdCSylKrdyx9yH5iUk3ewJcPmSuePHJJ4uPjMd3gsV1QTLe
-89JMpLxHdIbdmBlDb7sES+bwHO9d1fR48yqX64k9OEgcrgZuloQc8M/YCI8CRzeftkClWlxtAu2Zp8Hy
-3XzSI1NKl7c5NC+15umaPb+x0/wVxiWk+SnsLIGoLaky6g3TNNQ8Q/rWCnS8JltGS+a/rfhTlhgPip+D
-RDYJ3Ln2ckIwCjCB1vXqiErK4E9Hu42zcLY5R2KpAvd7ReqKvCI0sVgIa+8/VsNAwE99jgZfFlTrKD0h
-sHOQ/9BNh60cLPCjI726xgATvRsWymG7+xBKJmPuekwjrl0Ey2qBxx3w4q2Mb3jajx6wI+UML3JufJ5k
-enTMuVZy40+O2comTxry8IqOnkM0vrPnJgTkisgznAj2Qn3gfU5OG8bj84VGHJ/yNXqZk3VUMf/nlzbV
-sIIie/PszbOXnvnCzEWyKPZGBjyDvbCRmSFqZN8ONLFvjzcQ6EaiNvyo/IdUxkuvPV95E+9GGmwmiFZM
-BIMO72Ik/6Y38tStzG1mtEpGO5tapPeu2K7tD9FS5ODwfGcyJ0xQiWgOan5aZtx2Rl60+iopszX0BnSj
-rneYJyPqZ8X50UbPKMEw+GzkRR/zP1Az61JDhguXAF20d1qb6j9qj4vxQBdhEFOrm8AxWHs+go+fxqi0
-f5izMQ6VPYQFz8Rr2Mwu4BZudd2YJM0mCdnS4hpxQA08vvEjH4ByROTUC3KhdQ2GEf3cZI9WLoYgTbAS
-pfo3z5CZfvPMQPYqK9y6402vE/PW9OBlccOtE5GLWDpXqzGcXPg4ja6V34oTJgbbly5yzTgqlW9aDB28
-Ir8DtFnriIr2VARQJj3fMCmXogsl6TmvV7RTZ0D9dUqChAiTElE1e9r1aiNcQNTAoTRSDdmTaJInJ3NG
-K+RzvWu0Y6RRuc/AHgCozPYEobeBIxtPpvYj9hcXCXx0NdJ/GDcUeHL/BVR9IK+Am1zXAA3zR2KlaO35
-4Gspc8Nz6gV3I7K1gHZGieALT1R8ypIMdyqXKO7T+C77SYf+wnbVnQ9saAz/kC9CAcNXWp3DG5XXmVFR
-QXa/pmr3tcWHd8iY2Jmhkn9TKYOUR/pLOSanaL3JFK03L4nvPP66rSszTPPYGDfyYBNnAop7CdE6JzTO
-yDjkAzL/5DKV3CJdTKVZhQd2apTc+zbxIzI8IWOSzkNgKgFXE/ElPs+xiK+t2JEPyaMXQVYftw5uyQc3
-8OEpvdebsb07JW3mhm+3DAOm4IVWJwS8G+U49BuklcXkNcMcKoAlXzWSYihPHopJR698T0h7XFGrkbZq
-nB5pJoci22u47h/zput4liURVVNATjqVxBkIq7mpNlnwe0Gs0YioO/9uGVAC77nyQjRSHAq8No7UVPB6
-v2tipH/vVRpCUrZEMKKHR/Dt395TA0zhz+Wl8bW9P9ZT46iwF5N8FiObw0iHjjipjAhi6PaciH3b8q53
-HbDeF5iNwkmIYrBqSMtvWcdKsjqYpHfLDtNmlEtC+76rVvueTcmt5GlvwLnrzbNtVUpWE5MfAMr9TUXJ
-sqirdjkn3zGdkleDHy994XFJ5N28qTTAsBEnlkg1qEq8IrtBKtLyVvKic/X87tr6QG4qge5o28qkIHFH
-o/y9luQW0ItrRm9UrmhL31QiSpNvmMp32mSUUEYaXB70g8Q5Yu4XX7u8nCv6WQlySyGJNWHgGkMo+c03
-f9K6T9kwTB2hmQ2kiDNNsAdZIOUTbCwXLz759GPEyT8/H13p48vLT4FegnGwauRxSTIjLo3OkRb/tJ+a
-0wnCSLXY48d8ybOvbbjBN43z71hxB3B1CUEvIUlqzxZTov6/deccMBuMQJd/PHJBJF4ck1qz8ow29OUu
-fvSqiL5jfbHNhAi+8pEEp4nfbJYJ/dWDZQx+dKEIE5O0wzcg8O8LLThmJGXttFyWOAFy8S4Cx8ulAWB1
-KAmCFV5LIDTqkb6t6hqA3eXyEb7vPTkZ3+I5ZL6tMJnEV19CRvIPoQ+V4n9Ovth2fMcgZQ20pkgpoL/P
-/Tf33ZeZG6JP/4eJRYdf44V1f3aXNhL54uyt6egoq21wNAtuTiOtXuh5S/iaiH0Hz3do4wkOiaMoCA9I
-8hPMJQaAU77qQ1hoRsnw0cvczBycOZVVAPO2mJSAp4wl7PbjgHlIXZ8kBOx7Mddyf1QmtpqYBEN2TLgN
-9/6LcF0EvvzwOBR819bsTp6r6307Jf12L9Qpl+taVus161jTq7Y8E7mN853nqUEqB0s6k0h2veJTMT2h
-rJEwn7TqqZM5eiMs9/fnjrVSjIecDK38p01QsYUNMjRGEN4wlUutpgfWyXupE5WBLmlKSgbps1kpWRvd
-MMYmQ+M2VxgF18k9kLqLCVkxUjMhSFEzUD6+mBAhubBqfYCsZ8aXa2pa/GhimFE5isvwLZqHT95MjWka
-/KyGltTkqWOGLsieEo3Wt/Qg/FRMhsUxrGNbMwp8nVLGzVWDwMVVkHZMpeZAcdIdl3zrHLFSbGmnuFUQ
-hl0nYtUqFbBYOJq5Bt04qhQ2ROVFPlwnoHcBoqzs5UPLr+m/w9lMQyUn2sJPKhnzfkfeHqN5SRwFch+l
-rU20Amqw1IkZMQLiaD7YXb+nNaFl6UpXr1l3g/lfKSloX2xntK4NM6/T0sD0eYcSmklopd6VA9+T20ps
-MREMN5dP1sWh9pxQlQ8+vhcJh4Sx6UvjLNlRiuy8B8evFQ2AjDGKQ7cUYmVwG8BVbd8F+b1mGn1xVPbt
-yUuMMLJJ80d7652eJl+dErnPx5G70gEuAzlh3NZ1gIKOP0hkz1fmskTdsVEIiYzjjlrs6/11mHpNORwW
-qQTJ8ijeWDgQlW8BzZJAsvJC2TGRLPZ/9t66o1r1dZz4/6huHZqK9evuaqPa3GNT5Xuu0xdMpsnyCeZt
-IG39w4heR/STLpIinK+SNPtIZUWMHlXX6RifmdF9WXytn3dmf296aLYZf6iw/OhDJXYjex3RT7rIIw9V
-XHn8oRrsOHGohvoacajELj5UYjf6UGkHxWOHKmxyxKFy4J5OpQjZczSc2sOa5j4MP1UNyAy804VeDgso
-sR5fEfRvt0ww0u1rJlgvyG4vegBfcUOrVFrgBnUsBoDFRDWKHeZuhmcikC5VxnAwp6P1s2o2c9kteOoC
-42+CGfotbcgte78EdxLMGqhzCnpsPnm1JC3tVNinLMP3Pan6qYqEb3jvRD6bOcxxhfyWTjjcj7+C6TuR
-s4U8vvLjhuF43Z9q3Dm59XCECc1K8mZGDgcPx7bSozvTsWVPXEF1BLIGpqdUf+RQ3BiNAQvZI5pK711s
-aTzquGEU9V/uVqyUdAmzHgEH/E3LGvJVwZuqmCums2aQY1OSsI786atvMY8wbw/ogPBicfEx+Y4eVvvu
-MPeMLHvBKmyp4LtzCAoPbXgeo+1bAjCCtKfXTIBvohS5dFLua3ZA37eKd1I+lUQM9AdvmmTy3WNWA+uD
-uasaN52eftpWckQfeBgQNjdt4FIQZAXZ7HtJzD+MPthnRKVkekiMP50JP/TNC31G/SYwctJi0w+k08lY
-iIwuZXaBub73vTUuyp2opMSutcREcFL1Nks9utmp0IIAdQL9XXSGmpE5VQySTW5BVXt+xtIAF+hY3UR6
-0nQIz/GWNpMBtOIxI/GRfn0oobGLpgw36CtboTEGMopvGQKX7CCrOpqB5E+V8IxjP10a1ewCHcms6izS
-43L3jD0ymXy2Oegk5f/ztg57Ig4tlbnlSPVcxt6j9QauyahTnoGzjmB69XocTduVGTmUioPiBn31T1lH
-0/JqcwwFSdmT9BNl2nBewL189R2bnCorAG6Vr0kr2L7kM617TD197rpkHsBkwvjQ0Ruz/2pjYbAi8Hyf
-3Tqx8RNDHZUvo7+sw7l9z0a8uWR25CmdkHMwDFjIgdnZI1/lSZhX2I3W0OA1x951WdbwFGMKD3hbpMI4
-TjmlwTUfLOrcbBPCcKSwa0A/jQxk7j+6PTCcHjmDvNeuj+z/Hqvxx2r47DgZVsjl4l1yTt65HzpH0PJD
-3j2POXoOyPD/dwCQIWt2S/pt1WxQKW1V7j4N0/ai+1QCyORDmdMnBS3GhzaVdDrFIh9jU/ORe4kM0PlR
-QZZW1dXLkckwryR/3rNuV0GiheMDDN+6zKufGJ3bUer1P8YmH21yM3k5mBpj/NiSCTLezv54XY3epZCX
-OVnyOY3YawEiZk3MSow6Nz/oKBy+6C++GmCIbKDGwCMZ6Ao8nl4cQeZBVWt/mK1qWlzXleg9/hiJhp03
-9J6jLT8JaQlH9Ci68s91Yr2HRtxWfbGNnpnoqNhy9qzgb74dwFffYAllkf4wo9V5mV66Y8Q25B5G9WWp
-YsAguLWzDru0rl86QLaPOfjZo68GMHT2vSDi8AgDi1bQujh75/7s7bFqkwfy4TAP4zodfEBePEwCID01
-plM36gn9prc2yc3FzN9w6ZOkhNSz/pMcmzQKLPwTnB/mF5eCMCrYrAL48alpXn4WQWq1cTTvbdDi8YIY
-IWY2CnGnpj37P+kzNxspWPysCftrMLM6bi+ATSdSEMrKzRItlVQrS3jDpmTHRQ85amo5y3WHaW+NcaLt
-qh3r5ioQpOLncxN0kLAV50EbwoCx6FKizdjxvLL297TF+UDOnmCLnuSbzbWaSADplwxTQaacARI17IH0
-vdYSRZ0skr73gF8258Tml7JkayDHpJ9pwKsf4MXnj7kuH7i0BV8Trm3JEsfd2pLVPIjwYZHJVPQFI9q2
-jHa0KSz+lmtfGFSGm01MasAT2SXHGxFyg57mOn+MUeFIEw7TMttBav098zIC/JZ3yneaFLwRlehZUxyQ
-HmE8bVvTHt6ic0W7xNR9UfctJEjx3PrAwVI50DZkJfu0h5Bsq822BtMrQmz03GkP/C1gTiajFjYEKlr5
-9Uz0VV2TouaClRPyGZZ+RTpWsOoGXAWLvXCarBry1Zeo50UbsIkN5E0JT6uYQOTP3Kk0LkKvEmLPxPnF
-px99+st5qL9Hi5HPg2RdTKPnBHFNNVCGxjmVJOlvYI+Bf/7qzbOLN8/+ZmJ0NdlxAqhGegnFqtgQ0C5r
-0EjfqCR631FSFD6vVov6exM5qk8Zok3B7l5cKJ2qPOTsrqVNOQbp3+ta5wMcfAC175deN4XRlX6wwsIW
-fGtc+UCgC3ZsyLMt85h5bmjBy7QZM3vlTjlu9kHho7MPyh+Z/YCzaG72jmcn5petama4tChGytPN2Him
-kbBD4xksiCdyeIKETeshHEra5cEbkBdi8thRmPQWscvD8YC99IML5bLPbeqJ82qkcjg7JGlwPFniA2Uz
-pEdh+Ne02Xjpdt2qNpwDYWibzdk791DlYZIcUiR5GdMG9pB4C1LN/LWkPZ3hs/y3oE3TIu377swpOEkS
-wXC5hnJw4JnwwFaS2TguRp20SJyAAjHX7/08wPOr4zKU/D0qeYx9x9UZZt6hjJPGa/i0ZXhu/JbnuN3v
-o/ltt9LRfBZ+nRCU5TSzZYCkvwjA1xcvk5G9GbPiwDlC9Gaf4XnMeTpl99P7r1JBuSTNsVp+DhfQ2C4H
-7Uxuc9ak5AGLaqDyQfPhguSPwdCnGCPrL7TZ2PfydX+oGenkbzoY0wRK0qLjQmhdh5iT/2RNyTsLPA8u
-46DcRj8QaNHEoSkf7BU4e7FyTn4tiNgX26ly7AJENY7hmaUGsV8bcAGOIUIuShGkim87tpbt2dDWwPwi
-p3I/9Fgqfepzt8as3+53K3UyfM2p+z18vyLtqTm2GKn3NWMQQ4jZGRyE9uMo1+PEYSPYOkUQ0873zEGv
-/hUjCFelttYA+Bnn/55jTDltCFuvWdHPTYtfc5fCTJQLn90wWhRMaOSYuRVYb9nquupnogZ4S1hHcp9k
-EYZW2gnqvprt+PduYXh7n9qkcNpS/z26ScPR4FCxFN87FNdC/wflk6uV9Mhwx+FaXUK6F5/vRNqTs6B0
-R4trVdrR9sZNoTeKPB7fwcBNIMcI2ui2FtLG9PC9ZLtHnsu4XpAQYOjd9CuHBvxkRrgj+v7MdY5R/k9Y
-tACnn0SHzz9M3b5BhRfs7vCpgv0fcaqcc+K/p2H6i++2h39VBfad4F26Jfx2VM3gVsl4jMZJA8adF2zz
-kedFVc64kMZ06we62P97637SW+fs8o980X6qCwYqaIRaMg/Av/7z3Drx472jyPZ9WW5Y8BKmY2OO85ZI
-PHl3TVHtDthYGu6blc6ykEoouL55Kn/bCT0+vsv/pTw/LeURPz+SEzd27CF3FStD19NxlH47VGRd1fVM
-3oHuuDIySUGfRgwTg9lDXuEkfPLFZXuH6Xa6VdV3tNP8108z5tjRY1DWGTFM2OmkI0euhzQDbE+vMkkl
-ra5pzu0tDTPDMYwbmXiLQ4o1yknnm2lK4zxN+6ycSjxNXLANvzXaqj9UxTUgLAO0U0Nvqg3CZxZ81/KG
-NcYFRzCITxKo3gLdxhzeNMw9pXIpNvTm1VIA6O1n+/rVUqmSGnpzSn4C39S1yIc0JdIoP+gOHWDrwexW
-uqirCo1+0whsQ3nu8knirLX2N/rSgvqNgLmfNcLE0NqUcKEV2Qzp1PRvqRNvtZfoYEtXFppVdtTTlfBy
-Lhkjpf6aVMaHH91YPGjXpsiMNnSWbtrzsPZ31eF0hkZ1XG51UyuGLfkgU8MHIDLtmcbc/ISxcc9Sn2j3
-x+7/OD7gOCfwkFpuN4Wi2UYFah9uSXrq40JZk3Vy8avpwplQ3XRWY511x+QBxeoqsL0lPV2pn1JI7/nj
-6qZT1MixAVQMBmcjoqKJyqZkS7uSMCnrWIBWnWb/VDx1BK2p6tq/2q38RUVThJuX40xMvaHIquRpgUPy
-aviYYMsnnpO4kjVP+0vw75A2oGIluaFdRQGu11kPyfU5y+FSqCTwcgY778F9ef5uusw3jEDN1tgHv206
-fmuzBR3pSpPuFWBx94aGK6cg+Qt+aqlCGYKXfkpgUwAeHpzHlmrHljD+nq50hgWDfi5/k40M+g5BSV8S
-S7y7LqD4F9iNHfbX9AbyGpl/klWnQVbVDw29cf/Ed9P+rXJ+Kf9i0fJGyPE0UcNeIm/d2JbtMJwkGI3C
-f+KybckK9bSvCtUowqsgkP4tI8rJquek6Jh84tf7ukbaMCVgZrO4sehHqJqBwwlOd4ZlMlmdngSO7uLb
-lghlDX79kHsZQzV5XdMWwGHVzg/hbUZpOACGZLZi/S1jDXQJv0BEO+wfLlHNNzzivFa0C/gu9xcH9/Zz
-VtC9YDBDHZJQYY7u5v1em3zl6t4yALJFI2HJipoi8oWsXHVC4zWv2a3bjuColdFHv2FCp/2uGp2PTaN0
-GVBn3vS0alTyHveH2breV+UQ0nOK780v+tFljwhDeIHgx/8QCt8Xfp7KCf2dFf1UMuyi6uVF2THvAM5w
-B484y/uOb25Nu7tpp7eBsoGCL3E20m5uXpOBw7rnx+D6CaSxT5/E8kd7oWiX61bXmC9a8DrT8DoaUH8p
-d2KJoGyAtg94Lgqrdmle1uXE27ZjElecfkdeCpvMhCzV6izlRdqo3EdL1fxS6PQVTxPWklxI4Jm7CBx2
-cxmwIvbOUk0k2Obicdof2Sn9rLicglzVBD7x8bsQSJfZexCXs+NKvmXuQXK8PTpb1qHs+CqpxFZ8rU+e
-IicCEb7+7OXI0dUZ+Vx7dpN/pzf0ddFVbU/aer+pEGUWzJGsMRWAtGAKQGSAN5uaAS8SdItPA6p25cBU
-lhoPC7dmdyt+Rzp+S3hXsaZHdFvyF40GL0vr+xCQ1SU+tI1/egnKPHO7sWamNkGV4s+Ms0vEoKkoAQW/
-jg8/A6hrhKaXL7+ar5jqTBz6fQFCL0jVC1avVWsGBNKiEs/JF1Q+P4Dxa+EhS9agXgaew31f1ZV8xeb5
-d1s7K32eSXOm9gUYwqqR47KbaTKa6VsA29mFqKP+18SzHn45SsJ1hSEifvHyuPePXNwO5bEwb6G8Np7D
-4RENwoimBqQod1JJBcPTHpvzc/IHxlpEcBespZ3kQDWAu+Bkzetr8CMLzhPA+qOir0GutCoUb8w7AhEP
-6AFOhcoSMI+OA8Shj42qm1+ynedCZn8ZiO2KgU7SMCcKytvhn5zzIc8RXmt9t/Xa/Y41DFZMsE7yhPp9
-lZPE6z37YOmRVwXJjUx0s642+04Be8ub1DFy4PvO0jy8T8JZOzcmQ3lUA18O+jx5EXe0nV2zgzh7vumq
-cmY/ChPc8rxhd/0VsZ8gePfMaWhK4trqNXxeNevqzqsNv5xBq5mKSlv1zj3WfkhY3nasrKhTD7KFu2Oa
-uHhcES8d/+xz1CoYPs0p+B/97NUOSIaX0js/8H2La+ENGB4DzA/dQS5Gh4dPcutRhl38L2LXvA4cJq0D
-1363RJrpMbPPpwQLZq6H4fFgmRV+bocb68dT9ZSpfFy1B2+L7B/qoZUimZZR4UiIqUnUZyU9SFNhklW3
-+67lgoknHzWHr4g2+yG1oz5HESWJh4f7X6pdy7ueqncljsIHQR1nBjR/1vCZqeNvISA00WYjyZFiX1Dp
-A6nAVkp85mtCITBsQVb7TTRBxfM46q7s/DwmYDhzvn/j0rHhgQrGdaZ20T4ggQ2KvJq1wncLAyd5R0ra
-XTvkXrOnv5E/y7MnCN3QqgHsGaxkC1u6jF+M9i4QhD39pSmuJE4fgHDYXjDQDJYPzASetjWgG4k7nGw+
-BEgcHmGmkXh03jFJGTIyLWXMGQ+BaSTSJKu7qzWOiS9GIS2rRj+GPiPJwSX00aldCO7C4LKnTRm2XMAe
-xqaMLNeVxAxKtg2IQX44VyzhjpgHPW35nnTiEluRxeH8DuKsw9seUAZ72eHD6LsuSz/9qjut/AA3HVp/
-4rJDG2/jnkND/6TXHMb2Vm65t+T5Sw7Ffpg77jX9uCseTYKetHJPOWkj7zdYjrJpZQvalY8xmaSTke+q
-RvvEoRfqCemJX7z45OPFO4CDUPCd5EVnHy0uf/mLX14sfgGCMO9KxU+CrDGTP2QDJGlnvIrcz5Db13ho
-3AWRk1ApFTLpfBgRK+mU9nUVr8g24ym3eJnwl10EeUJBHYyJxN2MCJrG2c+gy0qVGfbkSA88vNz+QJys
-GrlxxEWOpVcfMZIHc3pnK14esGXI9ghK06Wre1wCO1yy4hpzLGDSfWvAklcAFC6YZIHsW5uTcbcvtso6
-R4W8I5CcWyknJW+95rxnnc4rWW0aNKQBQhNMaq7vTGAYd6JL5Syc1AROQKj8ok+cM+W+6jWKQGA48Bo7
-+LXEfhVXVL4ZXjXjtZpHEIBRsLs+2t3hKvYN9ineaI+wD0nYUORkHq1m4Lv1DWDB0Bo6A7ga2voEcbZl
-tIzVtf4aJTpKZkpEtxYfcMQrCBkUt1+/ijeetkfCwHUpLwY8OAtjCZqK7IyJxiCdg3jpKBY492XhBAOr
-3bTU4jRCZumXpZ4J4oDX8+SdHL/YCrnk9JWOyWI27npBHrHYGf+m3+PRbuhN8sw7joxhdlVvlXIkYhYR
-oCjXa7qd4OwGdEMNznHFOml0Q12bVATyGQAGUXda7TYznbLlMVAWgTHXedODk+h0FCZSMyOJ48mRuIBF
-rzTqqls07GkTINgwIV9xs4Xc2/WBUJWoHs1egtVrk+L/KFuTOWfxGiqiahey5+1PNoWIyRmahh2yIs8/
-1ahTPNHQwO0OSFbHTkX+daJng4bLNMJBhs+QTc/wi4d0M2QaELuJ59VnbQKOkjh5u8Pe0tfbL6WeFHcm
-ae8iVJrL3dtvBHnn46uU3NJuq7pqb2nd8+Z8rQq/o/4x+1i3pLm9BVGW8JR9whGbUsF56QlneIxv3CSD
-iskIKrjcUdzuQ/KtgMOE3LL/Vjjv9YlnCnM9Enz3NHwGetwJ7UK2hE6W2pVs6fS5VG30XHccu5gdO7TI
-WfwQp/ZtTG5wetEEf/BTm2Dn9acPw6EkxVePWws/OIoxyZrQpqxZnCNUi4wWLlMXcbp+L5PBUf93PJOj
-Z95z361p/MUVEDyL0uNwoUOW1htdaKZ9SA8UtygxVpcFfttj1W/UuOF6ZrH3cmkbczsWpW/8Z9owZ3A/
-g/0aN9qjNkh8JIC8Bw8E0nwdNXGUJKviTyDK2MKs4PumD1uFH1965Ta0DUttDBnnXbuljbBhBbdVyW/V
-38MMhee+A+FrvHm/JzV6g+7c7PmipY1FPyr0Knro94bd9JqpOvxOii0CaeU359dFwbtSee3D9lD9i4lV
-cKeiQ+yvVEy9EzLu3Fa+nvWHljn31b1kaXk9L95OknYSh5zH3SXFxZP6CWJYvXlFSoa4l2FefTGJo2Td
-mYxSx8aNpClZWgBPhFj5NoG5vEpl0Zmw3ZMCTa/Q6wrru46LiV+TujG3oPcp5/ic1Mk4jayG8/O7JdNi
-n1PCRh4pjk55BvLOuFLY0oKcrQ6a6Z4SSta8uwU9Q03F9oq8eXb+5tlEabuSnUTOP0EpRyeQTaSSp0Cv
-Na42QFbXVaPcmXtvXlVDdrxkXWOw9kz+lMChKT8431TllFMxRoGpyiKVxkUTdofzc/LVl5/OLi7IlhbX
-Xgr4LTKO20MLE7w2U1WeMO5mrfZVrSNadMZ2HXkNe73Ui7sMUkuSjfJ2FABNhz+qlj5Adv4DWNVol1EZ
-wDq2OugQCtQHLwN993KO7emjx0nfVcU1+epLUjU9Nxjp2gfZzBOCeDaVCoAMxk31+pniekQNqXbwsPas
-Pmgv3QoB9+LTegWq+vDsRSp704uNczvCvDR8Vu7buipoz2YGU/FJg4jAxgO7duKURvZUSytbuqkajDbJ
-0EpDd4B07RuYbnk2GSJKlva0dMMck8b4JN2WHNshuuQ48etdSjHqlIteDh/J1CmZg7F1igyaL9xOAxDb
-zHi0Vj3+7OvWPZtSgF+dHGfstZE/UcfmoqLWM1HYccFssmTPCyCahYHddJs0MI/m/gVI2FHZGAjbOZL2
-jUpahsJzO6ACGBLmng9aetO2klTXgyL+86OGbUMi4rYDIOrkERoXiR1XGHFQjsTqOz5F8dATzZ2KhwE2
-06qpeEN7Vl7pd0K+NG+eybf3zTOFmQGYJ23Hbiq+F/UBHl87JB9dw3GZHV6nGCUmv1K2bGKtfNHodfW9
-ijzQ593QtU1gona+YbRNitjO6s00TXDxi5s9YOpncoMfvPPpZhhwh6ZTS5w4NLHLDQ2+uGkdgqHBD/7Q
-TOoHjEn/s2lWDvbfEJI3MSw1FhiI6t3t2u837FTJfvHxdh9A59XzneVTAVNBhFT4zIVvm/YIC8hihjCm
-6dNjqKCvfEnTwrG9jaCHWQeyFBSSCSfkDdMSBTD14FYxRdIjxYpqXbHOi/1ZwrelCexfhiRgibG5K1pq
-KO2j8aOSm5PFPXHU+yEfPoflBnN1OUVyIP/4OmRAKNLx0nH01ooKZl7vrDgLgxlC4nehrrC0j3EFhWk/
-6zjvCX3n/r2HyA0h6Ys4xBcF4tqXu7Y/EOjcxkYC3d9RpQXD551BwaNpds7Pyf+3l+LQurpDuQ6brhoV
-USjkiekb4h6bDC+Nbk8X7Z1jvf5zVdeqTXPGAe4GVHcYoqotDwrjyxxuiKiB7Hzk5qP3hWrGOcTgu+Cn
-We28kyW/Byc1VAvkyg2fEqiQN9nL2yYcba6+vw6+BjmTpIWVesE3rAffcNYR3hDktjGYXkXkwRX2kpxA
-mApeba0VxtG9c4+l4+OH3xUEzRm2NfEFM/XaBCVXG/VcKAYB/md2qP4Bn7K6pM0TbkW+pzR/A6snb+Zm
-Si4W7yb0hdDBVKNcBd1FueR8Ln8B/89QLMnmo/jUbVYUO51fTtJqwb/vdyved1rStbTVfNCHj5wlfjsn
-LyZJfV9U1BMZ7ddBidEWO6buSzBTp5gTzLRTc/yAvJjkJmTkJ/vZiY1Lhl+mwi6HdNhHfbppzbrhlE52
-V6Gs+2L6P6R1t1gmVtsa8R0LjICWy+2f10BMtH7PaGmyWte027COQBWhpw9qcrlZWo37GqGQ0TtXQy0U
-vFnXVdFD5DJYU2SV56quwHPnnFOLf2LeDIVxBMwNDgeCaMCzeEf7YhsOzHJpPn9hvwZMhnXu+U0ldhV6
-IJtW1Tn4UqEobRn6oOktBk0fLcAEhhgGkkmruWDqzXxfmENSNZu5PUBSljK9Jd8taMYyTeTD6PTIq2Id
-P35dQgZ77B2WwWJKETLH35+SDujEY53Y1iDG8td1z7oGAtydK+YGvlt+N83oQg8gacLKwzBOfilx8PmX
-Er/r989pYFazG1afmV7Utdrg75MpOV5WCyojyzufJunH2h/sc0vljcw3Vcviv+LPE5EXHlq1bWniepSa
-910DeQOIQpAFBQxv4dusBnP5rg/bF15jM0JdUa2IedddMUrBmRLaVDtUKFdrh1fHOAKtxXjTuE4vbilU
-Alyzw7qjOyZI2/FNx4SYrWg3E31XtcywCwAYc+8+p/ZyPTcVFV71wmSk6Xmu0sKUstpC1UxOPW7Vx35/
-mBMwNDoD2hjvisgXiKwOpOh42wLyTZ+T5kwngUCX0jOZhRtmKGy5kdnPnAounre/XDMDWHdSmFWEleCI
-mfHEqKsGO1E4HVwx2kWr5kqdXsEIYDlYBXVoy0C9ZS64+nw2SYOTROc4cdCg08EL5Y8Ir6j1MjNX9ip9
-3Z4nale8mfXVzjXe/huwnuSs7diadWLWsXJfsHK245pRk387zg5OvwlZW7Ht2GjmKHkARx6uBm6EYoZN
-HJXyBDyChP0lAuLskaDxhny2r19NyWdc/l/ekc/K6uYVMhRhkMfpTqNfc4NRKFgfOwgoZNgwdoRUAq1l
-BJ6kFLuN+ERObjTj0r2vgXvi9VBcEyzFV/IiKeuBHAGBtXZBBAltii3v5Loo9COTFq1qRM9oCeA5daWw
-wMvqZikcUMzK9gBN2l7m5Cu8LGiWBi2FASgNmBLEhdBJsbF6sEFo1KeFNXaGvki/BaxynMWrpSBnODUh
-143vGAIFWZcI0m/5frOduMTJ6Q57ylAozZgNdqsKTWyCZ7D+KQiuYcndMexAxjpQ4EC5cywHa2SxhauO
-SIbYyB7ogqBhkOWBVMn1hub6ZGOj06RnbDSWw7StOx7JOJtVtl4CQJh81YALh2SIMzeBN9E5V87QCDln
-u3Ng5+Iz+nhTeXjYHTEh9+lO49fhCcHoToS+bhRArJ4r0IyGbWAwimyQfYsKS9b3/jmJvLecEUSW+MSG
-DKpL3LYCA3umG21gjz+fHCboBt3EzSUTdMSmjSQs5tFQmXHdGavpdDgbvdPaqZbT4T3Jp6j/ykyv3yLw
-ORhNPqOS8Mnz5RDClHeL4yxgD60q8tYp2skEZMDonSg5ZMglv5fCNW96WlsNNjjF4qWkB76HJBxwPZEB
-wfmDUKRNL+RMvVUTSD5q2nQF9RNB5obR0RKgbnlYuaOodHHYrJ1CAnFuGCgtTWZTMUrDtOpo+EjWdHrU
-UDp8vXNNhGEVrkNwFJqQMrLmURvGmFsfN+jYXz8a9hGYsN/We7FNPcUq3QJ2hFTFjyzuOblmrI3vDgNr
-Ip/J/52TP3GBgPJ7wdb7Wr/lCIppkuIIcsbmm/kU0BbEJHrR1zBMlMIyJ9BdVjcFeCKyaEh9PmRHP+k1
-DnOfPRqTYyiUPev/NAZlI/N6Zv3a49MTmgPd3AO/LstY5yklFkWA2V2Pp8o8CMojgDekslyilkLOz8k3
-3YY21fcU8RnqwxRDaCFvNIgWmDEe2ES0Oi5VVu5TVaq+u6bD8Bm9pGolr/CcfTqoD/3FxPOS+aO8RL9T
-oZTGTSbbO8xqSnztqKsUjSS2d+6x0kPIvhx5m+1P9m4MioOnwBX5Dt+DhlBnopfvpkNCImgq2xHorwY7
-isficx4jYJRcC4XCRO+MEjOlhwwMJCmvEqfIsFeJGSdUCMRlmx8cvjq/oYK1pUXVH67I/NJqVEzM8Gf0
-1ftCwbDEkMyeK21uEGMcsDGgR/O8E/jTsOCTcWZ2O5FPLl+mkex+XZaVwndxklWsrd7lhnVCpzGpvnmt
-swagQ76vm3FVM7TRKpyebpBcfbUmB74nt1SBtqvvqoMpqXrb+HLbsfWv3jx7582zJdZ2obFKSTrkYOc7
-/n1V13TOu805a2b/8fq85IU4/46tzr8E+eK8qKvi+p3XdE276r//xFeV3DBoccjFXnvVzxo++8ee1tX6
-AJD7h5a9aXDW7vE2wvJiBFi4K1hi+UTmUb0/v933+47N2o7ztTJ0qIAUmJgA9YAUdIyC7KmzozixAHgv
-I7Wpm95zKnoNNHJn8nzCzzPzS956UWwZjVNY8UZHgStWLE02sJeAbOirhx8HxX4sEn5IY6HZtAoBGJpq
-JKEb8L44Tbtu5rqM51suOy873s7WVd3LTlb1vju7WLR3E59MDfpA+P37AGv5IPDQhQEb8dU7RjEgtvzW
-+A4447qIiw0kbspX3Eqye9TdDQ+hF+93RLXvGHSstsuf6SE9df/oKLSdEYdMlXzKWQv3JH/kdGf+yXPX
-ypowcvNX+Wjbtj5IXhUULEYNILnVraNRMNzbfMdLWs94y9RDNCPQlVWqX1e1yQwhio7XoJBQFZ047JkF
-4wZbBhRVMpNTY1ZWtOYbrOH4ZxCxZXVtHDlooT0PqNufSUcma7tlyO05WaFPiKZJ8t+i36/XyPO7M9Wu
-M3+o6tqZmFZ5yhVIEkBlJ8ceg8Di2Z1TzPtwcEICHlz/RLNcW4rvLDaMgxFm7dz+rFYYEoiFKE2usGhU
-Zc+/h3/MoBlfiWyupmsK8XIx6B8Sa4FqY+Vw9MW2k3IMb8h3VVPyW53ORfnqEOR3lHvfHGweJetpVYsp
-EYyp1kYial4sPr28gFi+wF/w/Jx8x0jJ6moFXiz1gZQQYA5pjHTGYrMzuNJwm3q+L7Yvl6TcA4g8Va1t
-GtrVB2CnkDEhq/3GYpGs9hsxx1aBsZGE879X+8282FT/WpW/urj85Ucfv9COWowRWgs+YpYAFirOLz75
-xaeX9sS8hitSVjfg6aVOgnNwwHVXYTspsmDOvbp2Q6YFdQbMWcWX5Yo8d1twkQxMRjnF4MhRSbLRbzu+
-32zRTQhzEkEJDGcFYsKVs5S64FmuRW/qljVkjX5vlTPlqbIhQ9ISSXVq+QCV/Na5qPM1LRl5L+LDXYs+
-zjBONA+/rHm3M6sgG5uZn20gqOoLXk7TV1xdfo+rPzgESi0zHk1qXNT8BxJjhi8W5xcXmpPTN7agdXEG
-lsoZeec+tXfkA/LiYeIRMz/NY7rBm+2xFoNRZYjGg9cxPn1T5xcfJQSN+tuuaq7DTF9Y3L6MR0iuv77I
-UbDyVPZjVzWPXWoAcYXMT8vkUJaE3fWsKVFgg5RRyudDhaDcVOyWnKkVnqh09X64cMCt6R3Bj6fvqBaO
-w1w7D9GMnN1YQlZRod6xFe2CMQ8f9CN4YkMeQtGzpTSKySPuH3Lf/yQLRmB6TfurKGQSl3VJdf42AKlD
-34VgH1R/iSOF7Kc6USjnmlq4JRqKDFxrwTcDy6YotKbGXgMu3+0Nx+Ghw9bMdU1w5H4bOfDrrMAXVE9w
-4ckSx/Gwk9WO+s+FtfSX2Z2qZU0YHtOEDJXkX1lp302PAdJH8E/ADnj5DdT1VILqW2ImTXvBkby5DZnI
-m+3w5hoJ2gSrnJ+T39KSqXAo/IrkA97ze1eoVtHUSm61X8LG1YeX7nXFtcJnCNMo85YIvHH6mhgRA1Gm
-pWShfMjNyp4gzDp+aoAfwV3X9b7RWQRFL/+vYvD2bcs65QCPAs7I/Lt/3vdq+GqExjvrLJrMRPbGWzgW
-jEiy4Quc7ky92JBQ3vUK5i9cWuwddrg4cucS/vbHh4+c7L7nxueFrXvlpwv5FhTsOS6/pFiYHNFka2+4
-JoCwpq5i5oqczZI9zw6T7Ke7yVCtlDD5LewlWKaUmVKfaHdgWSDzMEjYqROAYQQkBiRlZNABJl6L4/q9
-65ioSibImXLFkDfKvzHy/Hmc38RSK6v0SL+YWVh8jYCvpXkNf58HvtfAOXJfxZbvawVsQ1ZMSq+SPhpu
-rAkfzONI+FgcQW4DIGTwAASW9wwSlgHlEXYVXH54HJ8qJ+LgseGvSXoBq8aaEpF/YXbQoA47dTKLahw+
-40Rq3RmJzlN8y7truTxQwWiCj61CgDjuznqAePgF8sQj8KAaQT+sBzCGvSqOWANkqf2wSnxCXpEU5KOP
-GDJ/cdmx3UudHj+hzw18MJwK5tIxClye5axxYcC9Sfv4yuNeYgoHpU/a8tvzbVUyc6RM/dlOtXgUBXz2
-6aefftreuQ/95aL1YyDMD1buw56co/66oDXcRPOuvmnGxS5K1tqNsAZ3aWjhfeGsgwqak7JSyzvcn4QO
-xDd/4Pdd+dIn3SnJCEAnZpKUOGQ46iQh1pwmpJtuXAlynCwzQryLWo8SHQ0Iy6cKwZmpJMSrH2AGwUqd
-xpFDD5PEJosdXNfwAAnnug4e6loHcqv26o2rAbmr82e09j2TB3u5q/1eZLuJJu/ql1lAjHnPed1X7SCB
-iIQDVSnnsGyulyoX6BS/2XdaMNXm6gIzX9NuVfUd7Q4qKELVx9gyxzG/agTrelaq7MeW9UC30F6SCNP4
-XKtnuYqW4PsOjJaIJSdZKsfe3nNCb3hVaud82fAtq7oSs+Bj3m2zK9AgeC2EgGmeVRRXIbCLQpRmzW/R
-cVL2c8O6A6m57JF3pcrzw7SKWxNdlWYd2nxfkJWUtsRwninPPpkRpfQoXRkKowa7zloLs9GtSTOitT5j
-29CU54hmOFK/iGFHs6RkIOltrM6KvWZCLwCnhArOAWYkmZ5lJfQFsLkhXKtdPBGz7v5ixs6liYl6znVm
-kOmVVb2dpRYcAA7ihpzw0aBWKkdetACdTZ5qPS8Gd9NfAt/7cWhWwwdGn6nsMkaOr/5CDizZ09e4s9k0
-H7fKbj6PR500c4iy65N1tdRZ8U5eorTP5lOWAcT2x5+14Aj8gIctxBAcfdaevsrgcP2ENQY1CQIn/vVu
-BgKSfET/61dvnvW8ffPsb4bTQs0zCcihtZhF9WH9j7TQubhliTbwEB1pxAH2SLciF+lIG7JIqIT5rqOg
-ptNODJp3Ug+O5aZQBE55YWl+yPXDim+05/Pi/xR6veDXo2HSST8YtVqbI05LelFjSBOLjWAZIQchZE13
-VS15C+cvSFaet+WTr7/5VrFpFp6Dd5a3mZvWTRBtt1NuD76DqvPXzCmURcuzI3PXEM4BKqulRF1cw+7f
-gupoCUreJWoIe8A45pItDdvQMctDzqaeN6z/q2NlNh9qiMeDuHc4Os4EYamAAYx/Tpb3I+j9hVLtKFlY
-gwrz1vrWHk8tNmhnUG3lRQl7e1TR4Pb8DxQo9EK8TYFCtTlSoEihK6hBnWy+MxVTcMn+t+Mmu6DCcbAL
-U97Funhbco9ufUDu8Yt4b7oWoxcxxGrMfEzN3xhqMkZQSo79R5Gf9KyN/BR6uWaWRSX+SEpRoFQ6e+c+
-WfeBfEicT+5Ze5iQD8jsYvIU7jjZJzlLHYARcphfi+/7OGd8brejBUyGN/54gx/kOXVRR4j0c76dcAqw
-xls4A/n7mxYN8nfcqMHC26uwO3b0mhHQzoNHLMym5AyZCfBXDChzlF/s0QJIdmuffhZ8YfcJRzk4AyMO
-8k82r1Gn3BXi3RTWp5xyqPBDErpBbdPiba7wEfXAEw6Ov6ojzs1PN6/UwTHZeypBbqu6dlHrdW20rQOz
-BmgHAE+4YpqZg8YRF8qrEO73OKxCvIiXJrfiI1meMHFHev2yLomxF8oYxlG7oAQYp861tEqlIJHpCdey
-c8xa//v6HNe15qnuyTdxUP/1BCISnoKnPT8/6LxGvT6P0us5bPoj9Xoei/dYvZ7/gj5Or+de9xiN5Zv1
-WrDePfAgtwfos/qTIq8BcQ3V8wEJcrM8pL8kIYsXKmm3Hp7WWICz6F/YivMsmKRNjrGiIgjGDIYwnO0o
-SUtPoMY6VD5qyEbMP+ewAzP/RgFBfec+ImEP6DaQpqVHXQwTXdkwzJGI/nPbdxy4535L7bv3+11yX6CI
-EyiIlvuvec+My6gCrrgysBYXc0i0yPeC1XPP/1p7vFUlA8+aFSMtbWYHciZPOeiM5BnfC9YJJ7jP+phN
-oANCfOfIqtMlFcwCKDpZKUdIiR7KlKz2vYIU3rW0r1ZVXfUHcga+Z7+tOrbmd6aDW/Z+xzTMNYSWmeYN
-4CCUfYFJ78yMEe8BXnNwOfR/RxGzEnKOgMVYNSVkbUNVru5dhSZWN0yFGlWCKExtjH3/aE4UmIPfgSwI
-m7LvQA8JtbHKx5kqzliTn82QnXb1ONXgGlIBDKMT2YSwInPyTVMfnPQmghFeFPtOENoTSvpqp0Z3OQ+X
-qmF3/chVlduUGrUephr8vi34DmJA9bDteOc69S60MeAN+uAVDM73Paqc98VWIX5c4QGP6rmWmeEwueHI
-SENfiprRbl3dOTnx/IMxKiLE0BeFzmFeqXAwAZCV+V2S7TUt2OymEuqCpcfrRsWZgaYwbr1ZqBM6DX+H
-wxL/Ks9F4NCqZYVE49AIomrEx24yhdS/2fuBO28tJPDPmvbs/4DTWmY+MMJUl9Bovk8rLKS7nHl9WsAh
-g8buIPe6eaexCwx80ImnwzMUwQwQp7WZdt+90qXiCMcQ2CS3xWQkRUgVzVGEbD66NNJAfvWn+c/pjhYv
-M4uXuhILQVK3Ylbu0WCnW5mkM7P9ka37cx3E0fQdrzHGvqE38W6rErBk09SHxlhTj9vYfAV1sMoKvhS8
-tsEFva42DZjJzi5mH03S4acXc80ZyGo6i0DeIf3FPPZJ12K8GML+JvisWviCdHUtFUfLFKOMRkWOmset
-G1xU171Ow1RU14jTVWloXwXNOwrgd8RkhuF3o1Q7A5PE/t2p4tlOn1Ycop/1xYKTa+RzMxMrUlyBUZl2
-pszZp4uSbaY6vU9Ni+spmb+4nIQ/LRYXXlaGodviqR8ePbIXnzxyaIiwVEh+FVwFNMpDcilnVcGb7O2H
-r8eSyGUvhqzs3A6jMzpW0F2Vhs861jLak8vFu+QcIbIV1/EwOCkctiNOVju6YanuTRUULR9GrMaYZk0V
-06wW9lsFs6VkAN6Rtmp9eEDaEBAPWYkokiCrQIwtl0RRMrMIoQJZamQFi1QJ285osUX0J+B5D3zfGYmI
-bHldinnImKrBiEGa7x3uIMQr5WdxcZmJPR4KtE4hzXODusbrV1oN4bwuvSeMqU0wkRtU6DAbwcktczwQ
-anog/ZYLllDBDtB631A4UDCRkR9/tvbju5mARDdXxIkReGmD1a/Iwo36Slw5s3lpI3+iXMLSH0/bFgf3
-nC6lST9aGt4IeRrkJs9slI9NaKtkqTzUYqKLCA75mL+HPSjWdcBguIJAXnSMqoO0rXpCO0bJ6kAuFu0d
-6Dx4q7Be5aGfh0lwMstc9TPZkLbbpJN5xdqsJzTlYxZmIUkSPYT4JD4znAf1SpC2graOkBGxnvh1DKHR
-oT/Pw8pKcWZsNnrpXuggMTyeo+tbiuUnl4OtNY3aUxX0FrNLqpsjvJ/nxWiFeZ073DcLJC1fUOkKALmG
-8DS85Hd/4T3tMZqvU3HpEO1j8yKJFsMolYFPKTjIvSvQdbIVdvbRLySnMrGhSfNU5eMMhK6VYBn0J4dq
-hdlfYXVTKfX8wXiKYaXV+sKB4ott7eENO4KoqAXhGfBnkvq7DYMK2BoznQw1wZrNP7kUiickVbOuGoRp
-Ta2vyW2dXMiZ2A2tpf4cuOSkFg2LPjjy5u86xYpUXVGzgVO0McbKxbsJ8CJR0JppfG4wrVzachl6M4/b
-/tGPWPRSRQfqbR6WQIOQODuwFKNOjiz5Fs6NsQxEkyQlK2qKYuGs4bNq1/Kup02v8iRKIV4nbJa7fJ9N
-50z+xdR9SR7kin7e8VvBOssEqvbkC63/i9uTX72mTL1dVZY1y9VTX9NVlVNNpqr6mq7qnKbUaJ2vA/XV
-lDP1E1N+zHb5cOb21LuA3KuNxeBGYhngbr9zr35/iKVfH0vaHbIhCNTWBxUcAgjEjQ7DIOdhtJ3Ug2H/
-gVXbTtjkWHv8zM2cQ/H/4pco/u8gkQUvD2CuNMO8fHdie0EJeXaXWLhH7vhjAOGdE/Dm2Xy1sWk33zzT
-bUxS2eQCtcgJffu9R9uBwzA/Z8bj+itsZhCNEAn5Hlq6v8ympitX3B/Ffo4ayW1T/ERMj1xWTG/sMXZz
-xVVY6mjYo5T3kutrHVEfK/J4TSkZ6LHNodbaac7keH9kg5oy30ey1WNbBOOLO0SdXf7E9myLi3g/FgPr
-jeX9BV8MLqisEa7oYnjFZot4yRZDSwKjCtZkEc/5pAT32FaUtzfIPIBtJImeHaJ7of3qucvs3SDFhZmL
-pLzQDO8U8GqBq4jYpYiF9mQ73sBAbRu74BwQJ93NcFMkl9znlBE4Bq9HtRb6qD52HK5n82NbJPnEQacM
-xRpon7QrTxzEZszZrDdDbaBIl2xHymYDNduqrtP9eyUGWlgkqy9GP5kjnsf/6Ku6MgkuCr7b8YYslQC5
-VKFxRqD9mSQyU+O0pFXNx2GA56XJaybJKxR9IPdWdI6JajZDVmIhe77ZQOiiXcqqIW1XoesQss+QcBd+
-8xi+/LDn5QyKnzZil/v9omNl1V+Rr6uC11SQ39G6pputgod7/R9ffUu+eP0aLSJst5K0jYmWN8LoPcen
-ywxdZoKMIBnQ92NIu0eQcqNRg4UcJKUKdDHwTygE/+Krv7MCnRluqpLx0zzyw3C0JIqGWYAE2nuY9sTI
-VngUoslQ0bJCksK+4nBEBkuogzNcaHZ3RZp+ezZcakqUW/yRxg7jGnthojujgu/cHxvvw+pwtNDB8Eop
-zBpXpd2yrmBNTzfs7Fib5PzoWk6SybUfo2n4bc3uMGVar5INoQICcd7RDwS9SGy+InARsV4lHG0PP68k
-lHIOljZ3/NbJfUHuU7knU0Q6aAYBnQeaUQWOt9Tx21nHblgnWHZApsDYgZkKuYHlWky2edvRNpwqRo/D
-l+ODwvT9qQbUl+NNyGLhrOwYTligteSR/MlY8M8R9UHBu/DngvCli7G1L1K1L0bURjh91ft9ALE/vv5F
-qv5F5igE7gROYz3tULGQRifF76lBZZtkTamGlgU8Pa1B5W6WalB9Oqk5jSR6fwTA+bRWKWaizLeqCqT3
-x3Hbi/eG3OcQrJNDTLalNyXZVm5Hki3p3QhbGtiKZDvGgBG0kzZsDK9Tx/piG41I/z605AMXwiswctUH
-LkOqueGFH7gIQWNH137gEgRNHb8CmRbtBUi2mD7+wzuB2xc3OGJfBavXztjkq+Bug/x8lX8sUm1EtxHb
-GHUs/Jaiu+i0NHwi/Haim4jtHD0MfivRPcRWRlzDcH2CW4jtZDbrLZhffg5MK6fOgUYN+b0bRZJ57r1q
-So/opYYdU6/hDXO6gz/f/i5YvYQW3bWa2qQDcvUSVr5PKyaeYoMFLZXWTanhmTxdcoT6Dz1C/fcMohXx
-33KQVsy39eNxqqRgvOu3tClBOQJA9Q7s6HCGD89ZdBCGS1d/cHrxUCcTHZ3qipruS/RVcX2wU/o3BaMm
-yJntFAtNYi0JfkjpSKJunaKhO9bromOs6SAs1ZoaRDfjTX0ILrf61Yn3Ur+grdsigoc17PczL8E2ROop
-bZPJq4BBmPKB9EaFWeZsfjp6cXFoO/53VvSQZq7lohfnkIOMz7ZVyfSblqi73W/4purovmQ1VH6xuPjF
-+cXi/OKj80IIrE6bciYYuz53LO/eumQUVloHFSDIX/hufAM6OULQjVQKpGeLKVH/f5KCrJOiXZTLV9+f
-/xBAPAre/H3fYJgqOHOb7YW0IIktgCjXqn9fYJoeVs6t0lWw9R6TWb559vq6amUjO4rdABTkM1JXzbV4
-SYSTLPn29nZ++xEkEvz2L3K5Pzr/+ptvv5x998Wvf/diMfv2yy9+/3omf158urg8/92FVb4o9envv/3T
-Hy/J57yqWdfWEEQS7kp4Ct+7cuK63rvy/DHca0T7qvD1h46/s94+5ye7ZRBxWDPjDSl3zfWVzsALPp4U
-zw16O5g9neS9HrR7ZDPFL/k6mQpgzsn241tx3GrwHPoVUy/kIx+j79CtvtFZS5wHs+14OyXP6WrVsRt5
-9s/Ujt5OzU5uJ57uX1Tfsyl5XrNm02/hJZO/WAPAO/eqObBayG/yFZOPWsfbhytTM/P6v2nmu9vZxWLh
-49ODa3C4erutU9BVXMfc+Pk5+U+VBsLVRm5Z3SqAkvmuamY3puuqmblJrOK+ZemtU9rLcJXwIzBNDzZr
-mhxsznmOkKOF2JPm2r5H+ueZ/DntoTzOfhAAtAybE6KQzfNz8u97ATxxQQUjy1TmOEBFBXDMhlCFzqmH
-mMkMl0b9URkOyWq/WVd3U4+gip4W15oSwRv2jz0TwICdX/zi048/+fQXn5xX7GKhAtxawfYln0HkzgyS
-2vSzW95dz+SLwPdx6vGcoxxEgIWv0pOYyz9hdIy80H/WqV1/dpbPmPJoFKLd1L757cSaRPL0B0JYHMup
-R4U8I+oJ5ChqqI9bmiYLHlJd2pLE9K78sOIR2MLpoXRjh3I3eijah+v0waze/roY/6/TR1O//aVRvmOj
-xvIQoEWdn5Ov2QaM0CoWS5AzNt/MpxoYer5bzZoLRIbWJW9YJ1TOQfh+sZx4l2fEHQCvTYDE/pdfkYV3
-N3aOWO7cCX3/ZseuxK5PNOAu8u6Q7MFdXBfVcDZ6m3fdsZ7vxvasYTHG9716a7PWx/uEzuu3NnENqvfY
-4/ya7xgRLSsqWutAzX1f1UJnpQo0nfZgZTWc7oGShab690PYls1xZY9P2Kxj3Q6OjNf23ZG2OyvHDLS+
-euTI9RE40nz9yMHjJg80/vBUr6xv2Z3xE5pDBMOONxxzKd4PYPrbUike/dfaMcA2qyxYhNx7UXn655CB
-hjLGNnyfkinTdYxF+T6lM0jX6bt9A8hQ907gpvvBzuwv1kmJupP82eiP5bxC9XGwJ1klsl9X+Y/7dfOa
-ZL+yMijcJ0I08yKlPK86hE35qugtrPkt60A20W06wW72Y3L7IRNvrq79mKxb0LbqaS0f6Liu8zF1S77D
-qGIpAECxAgVYN4tFbXz085ku6tSSx63Aah9pJV78eZxTY3g0mbvpFlnxuiRHJgVljjWCdsvhRnJTwhV3
-/T2cDCPqY2rTnLAsdKC3Z1D5vZOst/vJbvk+NWK7dktFJdxoG/gwFPYzV2Ft5cEZmcUaTJ/q3b5npVPe
-+THWiGAHNS2uZ5cLW8mHTLmcZEg80Oi4HvyerIeycyUK2/m2KllqweTvZ8+rTcM7NrulXQPCaN9Fy2Px
-dLQOLwmzk528pLQqsNNJxWIzaHgVQb1B3iOfkS/LDSMXv3T14VHyjWS0BKKD2gFgUhKzhCrvSFrvKF98
-oh821dg/9lXHhBfES3iHfrCYihZCooDF0HoK1EF7z6QOok8q96Gk/cLqumpFJQa0+49XrXx7aPmmo+32
-EA41ukNjAwiPx0tigB22/71S2SktFIY8zqwDqCfN0bi3Y1GVZjxBMOXY/icpgeEhw1h+8fo1Acwd0jED
-vusva/qmrWktmM31OxSW7fKWDZ/tKiGqZjPbsIZ1VaG/XLODvBM689MVWZwvCHXRIELogHSSpcE4wciu
-5BGWkrUdg8P+5tnSzn2ylLT3zbObj+cX84X69yXQY39VJk8OpPhPg/cIAhuEAhgWXplmcM1dZEj9IREE
-UjXZWnh/nxwGMiWC1azoeSd//see1tX6AH7Rh5Zpqzffd4VjrNxU/Xa/AhXv9nLVnm/7XX05W1lL2Pmq
-5qvzHRU9685FV5wXQpzvaNXMCyFUo796a/9Bc3+GEAr0iEYw1a+AZJY25xPgyVrLxO+//fbPpGOgpAbc
-Xs802G7bipUwx5LV9DCrOUXoYL7vVASGnNVbn4sfDowdKUdvfEejkBFCPlBi6wdBAqIPIiR1COXjveil
-xAU6hnVVvISYH3AW3/dkCfduCczv0l7HpW3C8CqSgwgf0M/hRxieIGs4AiYXRHjjU6Tu/NwNCM8GCWs8
-9rsjDRrFCrWL8B6Cja76ZuLS7Yix2Dcl6+QhejmgrRlaTh+jTF80ucq6Ov3rtmPrvwX7dH7umV/I2Ztn
-hPZ9dyYLT8ibZxPHImNnuFp1f+2rvmZ/i/Y92RqU9Zt7ezMzzfwG0MUgxTGY4Um/pQAoRdYd3UBrVcma
-vlpXrBNTUw8xsKGn5d/pDRVFV7X91ZKg4UgyPj0veG079Bf1v3715tk7b57ptZjGX51WbTlnD9IWMLNE
-rRtO4vFJrXxUAp2Gp3dqzRUFRu4fe957Ca4GwtE3HT3MLheIA2e3qMCQkIKX7CXZCwg78+ovNWA34Wty
-0d7ZOJiNyiw4qxpRlewKyWV8IMy6AK2VPXwraZS4Mh8kAb06B4eVshLFXoh51RT7vuJASG+r6+pcV/5v
-rBzuXr+VQ7yPsqL1QA4VWL2kDm04wF5vcbXbuGE+46bXqsrbF/ofH9lGeNduaSOuyEeGEtxWJb91fjEN
-JRtwRgGHLLvGiUtHii1tNkwgoLurJ4YCB8iR+D0Dem2M6ugDc82QECNCIhesI7TouBBkhagxYm5a+07j
-AEp5RWmdwX6yYgWVF7HqUfYRZCn7g2DGL7Yd37G5rExrwU1j2JKstbRXYEl4o+tS4bRnG1I2GLlcdvFk
-jSvyHJ9CWEn5i3+hLPI/6Gitb4GqBmK1XZ2sPlheeVo1Lul8VGOJ/Sz4ruWNRtAlZN7QmxXtEmfdwY3V
-41rRcjOWQsCzHA5l3ls3JB89oKatYBBjBP/yp2Oe7tLYQvqt+2iOwwVJv544JgWrxZxb329tb6XXm5r3
-RXvn0cOPFosTOpQyl/s0upK5O2d3FM70JYFyv8HZ+1D9bzRYm+LXmayHG5wbJ5lDXzhcd9WjEZ/W30hy
-w5rSETf/9vD/BwAA//9003TYIY0JAA==
+H4sIAAAAAAAC/+z9CX/bNrY4gH4V3nT6bzOmbO2yndt5Q2pxZMd2HTdN0/G8G4qEJEZcZJKypPTX99nf
+DwtBAARISl7aubezODYPzoKDg4ODg+23Vw8git0weHXa0l/F4SqyQfzq9F+vDg+PDg+PYjuOjyZhmMRJ
+ZC0P4Z+vdBb2P1EYJtLvYMJAHDdOjnhaOZQHEDhhdPQ/0TRO0QpK++7GDeKj/5mHDyCSCZBsl+AwVuJ5
+bpzEMjzXt2YgLsBEBQrgkzByQFSLLMddSTnYoSPD/59Z5DoFdEvAkwhYi2XoBklcQqQ2jSwfrMNoIdWb
+NfEK648K1KJwLcOehpFfiBxZQewmbhgUFCojMoO6BfKK/s9klSRhUISuLsGIJwU7Ubh0wnWR7LYVgaQA
+HlgPNcd9cB251WLZarMoXEm7mxssV4kabK/iJPRrKgVC7orPE0sqj21FjlTOCFiOHa38iQy6tGZuYJW0
+cmGh/5lYTnEvU8D/58vKn4RJJKdqeSAqah0V/H+WUTiLQCxVqg8c15IBoI9RthXriAoa1AtjaTWT0FI4
+MD90LE+OEnqJWyRLBGKQ1BKwkesgXKqcrW1F4SoGXlHH8IAVTd2NDD1eukEAIll1VonruYkLUOO4s6DQ
+JOwFVGTg1B6syLWCpJheVr6kHHLoJcI5brz0rG1xIeBPQAmzqQc2ZSVCq6RmsJmmXrguLrUMlZ6YKRXb
+EQAB7O5yHaTax+VquGAJybnlhOsShcbuVzeYlZRJIpDYc+DUPDdYlJRdWnYpQYXp09ELbJJaEq0C20pA
+WTngL+dW7MZl5eauA4qlenBjdwL/2spdk5ta+r/1V4HlowDu3/or31ou3WAWvzp9ZRiG8Qb+x+gbhn5p
+nI31a8Mw9BvDMPRbyW8Z9LKw3I0E45oHXPDfPuHfxob+QfxmsN+uJd8K+I4NfWYahqEPDSP99bLs12vj
+YqRv0a+WYRh63zAMfWHSXz/Q3y7pb7MMfEF/+yhB8U0ZjmuyBfqGvkYfbAnRjOeNDD2T+EO/P9KH/XvT
+0G+MgaHfDr6YQyzTR+NspF8Y/SHW8UejT7R9AX/bmvTXtokb4nLENPtHqmDhBxJyjEXrD1OMD4TVNW6H
+lNUfotrhbDTGGBejk+E5lmRMZUK/EdPuEyZID5jIxdmnkR6bb98OUwO9HelnsG4f8Z99g6klMUsLFjEM
+xY8UF2HYuOLXI30oAVz0fx3pdXOwMiGr4ZBU8kNmLh9oS8zYv/u0eVJSiKibqTRt8bMRbjmbFv9ISeDe
+2P9ijvSzQWga+lk/gj8HazMvKqR02V+ahn6JVToy9MvB1hym/M+I4oja0z+JBlJ2qNxHxnjSH4jBEAlw
+PViZhM41Yjtcpm08HnVH5/q43zANfTxom0PcJrfDo9EwLeGejVJefUMHCA7JjA3jMjXt/hX+c0za5wq3
+T2YoM1OwlE/jzoWh35wfXOSLfTA+nOvHppTCePPO0MO+YcCf5813MiZX57DEh7F+YxiGPux3zHPMejwI
+4c9+0Df0i8Eq1e7jnMgwOqe62p6f62NjSJt7SJt7SMz9wrgZ6dewSK7BbqBO+0MdUIuyaEuOjTPSNte0
+Fa4hxiXEmJm0VW6ozbAFFZ3WojZxBul/xEUQ1SHpwRbT7S6J5MRCr0cQSsqNKaPsT9Jz+g/DkX49qA8N
+/bK/QYoYwLLwt0F7mPN7N8PkR6LRm9H6R2J9N1DM634DUkFYt8LXa/QVYMZI1D6hAuEj3Tb6aXUGB7C8
+MR4Sj3jdPxmO9K5JHHrf0LvmwB0N9dg0LtIhv/9ldKXP+hB6gMrM+oP7EawgJHRD64Abrb8cjfR7c7Aa
+Ifc9pONKqqGhMR7prtl/GBl6E9FbmMbI0F1z4J6lgqHq3Rp9UltkRte0rW+NS1JDZGmZCNfibxdEHTfU
+e1xSI/hAf7sR/OzQuyV+4noU3p6n7cAZU+ZWbqnt3p4lZyN92x+dGfq2P9iekSa+OHs4G+n224Mz2k79
+dFC6OGshnLf+27TyqEd0kRu4oPxIgxnXbI0savk31OFf8/q7wUw2b0f67dvjdHwC/Ye3yJNdyH+Mlx+/
+mkWj05B65KGRHyvHI9wRzt7O3l4STZ4dnV2lXw/OyNeLs5Ps63H69easl33tnlEKnexrOyvbyr42M7pf
+z670y7fbrNjD2Tkj3se3flb2y5lkvB/CQsejjPlIVWibFXpQFgqzQp6k0LstHD0v5umIecGMmJxhf6Re
+5hI15Ln/y0i/Hrc+Gvrl28t0eDt7S7zH+Pz440i/RPAL6tyY8Qi5PdA/Gg51i2dyMfBHI93qL0cSUDwa
+wX6bGeJgpPt9YzzGRS/hSPbp7Zc+Fcjvj7Dtf4Dq/5TFEm/BOG2iX650P6N5+baXOrLx2UF/lHa+tyPo
+Ny7JKGcNg7fn+sf+eJh26LM0qBkMIMuU3DUTrVGVZoq8Gf5CJLoeXI7ytOyRfsMMDmdsHYSwZGa+W9CK
+X8yp5GcP/ZF+8X41JLDLm2g4Esenj5hnFgJcnOPRMYuyL9/XB8OUxGYwYnwaadPBr6lk/Ztz/ca4TUOC
+94tB6vhu5oPU+H7eXp7r4w/eZcrl/bZPGTxwmr99X0+rdnPj5YfXayjvzKRB6mUG6X+Fv/YN1gfb/JAs
+/IYnHn1/MNS35uhhMNI/9j+lnrnf7I92/FwXP98MjHM4Fv26q0yDhQklO8/NR1kKBjvGWLyhXA6uDWxB
+Fh8EDI0RHB5/3sJwum5+CL6Yl0xojkuffemfi/NkYYZb8mOy9Uxo5cN7aB/GA1KIf6Z/NIxP9Mf8Lfz6
+sfRHPznTLWP0hKQWT0EqgKSeroJWxzPHZJ7ARxI5i/HNoY5mtussxZBNhBbZxw/0I7GUIWyUNOxhg+T0
+B/tNFs6MDf16NLoSCXyQEPhAkyofhqNLKdpHCdpHivZRhTaWoI0p2viJuf0fF/JmP25PiCa3zhTtYniG
+ooK1qQMaQV7i34YbU/9kGCsJ6Cwy9dsMa0hBQ8PY/J8EfZDrcD/QZWLqH0Y/vcejzofh7Q3z50f+z3Eh
+9NGFP/JQ5s/+Rzjy90/MoX6zrzu1HutOP+znTj/s178+7OcEnpDb/3EhP+7H7QnRKrjTman2p0DtTz+p
+ncv/QZCldpq7g6A/tajnGkJHZvHu1eLdnMU7QSX00YVtHpr9+ekv9/qXe/3Lvf7lXv9yr3+517/c61/u
+9S/3+pd7/c9yr/W/3Otf7vUv9/qXe/3LvT61ez3r9UfMQrSw0IW+3ThbuC7/we4cIKd4M2aW2gHd4SBs
+WbgxfkZLxPaY2aVli2Wm57pvGr45zqFDpue6Zc8hU8s4G6dL47fpyrO4tO+0T/oj3Tft3gneSXMxzpfp
+nvTP9a1pewd4o+vHSz00USHn+KR/pR+bxsjQ7037ARK5N501/Ldp2sFJn5AbGjfn+rZvDMdkIX5m/nI0
+GOlt82NzOGaWyjHUNfvB6FwH04P5AO2mGBj6wgSN+eCSlLiZLtzBld5ErBcmWLqDob4wp/fuYKSvTfB1
+PrjRLVTy13dX+qL/yTUprvHuSr/vT9uQ+H0fBPPBBwrqmO9115zefxmMYC0Hhh6aYP6FYbz+MrjSu4hx
+aILGl8FQD81pEyLUTXDkcozDHONVf7qAZVd98NUVGINpc8FWOFkwfI8XbIXnHq6w6+EKR4vyCq8XuMJH
+XwS+n6auj7aEDAwdgI7HcA39wZW+RlwBSOCGAzBdwcL3Jmh5HM8DU+S56E+PoXyLPoi8XF1XAVtXL2C4
+1gO2rp0A17Ub4Lp+CcrrGga4ri1f4GtPu+FgBD8NDN01wSZk+M6Wgyu9TnbdAW85GEJj8JewvUzwEHJ8
+3RzfsD+tQ+JhH3wJBb7W1L8fwC0wxgCaPzhZMnzj+8EV7FojBNrcD+AOzukWIsQm6C05vrMcX78/nd2j
+fUbgYZlr222Ute0yYri2I6ZtTyLUtgcRbtsgKm/bOMJt27vP6fggZnXciClX1/z17JxXnWsS3d3HWHed
+iNJzzcHx8Gc9No2zy/xuHrqxAPcs5JpEX8IWurjCO0tyhJwZNBdgewHUkuPDf+5Nu+MPxinuj+fQID9S
+h/bLuR4OjBmtRWz+Eo3P9VX/Y3ucF3Vljuajc71tDt0zSNoYjqVbL7Mtbk0Tg9+91e/7xu0F5ns2apxd
+/cnIdB9NxnB+1hemcX1TEf3n9/qib9gfSFsMexc/67ZqNysqg/fTMjuBpvdv01MNwHtbdqqB2R03M/lz
+C8xI+X6SbpS7TXd5kW0v3OmLLL79ZFzAgWDw7ly3+5eXGHI5uEI+KDbTwTJjNhzOTTQucRvhGeks+pFs
+3b2gO8+5Hwg5NEdz8wqOywcmHYSzbcNwK9WsABYWwO4LYE1TDVuZhmHoa9No9se0A2dFxojy9VhS+QsY
+Fs1Nv46G7Jn5BW4FzNnDhxGJhD5hBdB4DP+KWm84/TXdbA7sEXPcws8wFxnmwpyGdOcf8Prncgw/w/DN
+adekGC2TYtzC4U1lx9lea0B/y7a/qa02s55L+qdFLXdo/DzS132k9b5hj3M76oxPcCMgDR7rWUfCNKe/
+0JpYaUXeP4zTbZY3zTGHv83wcdWmQaaKe6qK90cZhfAcnf3AzNPzdhfQFuKMLtoazmyepadgMlWSbZg3
+ZCPZTvsTL5jjXucjHczN9XYADXVmzreDcRqlZ6pFm+P7Q2ZXXCYI6aRn6UbhrEy2M/QT/vZ2pHf7xiU1
++PE5wcnOBDEbg9MjAmkzA9pM2fkPi2/fa1id+75xM2YOk3zky9xSgYbD1mXag24k6R+s4i/mOk0FjV0z
+yWzyjGw7zio8NvJHDW4MxUGEa74pL7+Yfnoo6cY1v4xGYi9gBmDf/LmVNvD1h9nAIK7G7xvpBu8b44zo
+9d7MEJvXWbbsA/w1NBlZsLOiw0Kc/vzpHE4RDAM6V2vMuOUCZ+yZzYNBt6+v+kY/Guhxf2E2jgZj3R94
+5vZocK4vBgszORjQUfL6XG/2DQOdPjkbs6KT+RkRj5H08px8CGX1XvWpiPVz2gsu+fr2Kekx/bN5zXec
+ax6vz+nminZndCSrom5mB4Pjvr7uG/1koK/6C7NzMBjr4QBp7Vz3BwuzdUJ145nuwWAx0Nt9o98Y6M0+
+go719cAz6yeDc30FdZmVh4rp9lGDDaAuscF65upk4EJ0o/91oNdTKquUSjxYmB5P5X5gGIbuDjMqxttz
+vYu+bofG5SWpsGeGJwN/oB/0jX5voB+ntJsp7fpgYZ4cZ7R/Pdfvh4j2yHDNlEw/OL+im9sXY0P3UZmD
+obE2L4s0amzMK0jKMPTuyGhTgsbNlX6AGxVpIpW2fizo8+SY6LN7TPQZMdK+ZzRhXVZrZKN3/p49O7Gz
+c3HNP413wcdD78u8y/37Pb3LAXZOu3iX2B/ythwFQ2zLYTDEtvzFH7LeBfcIt7+Dd6ljGZ/Uu9y/3827
+zNCX9i7epekNeet+8IfYumN/SLyFN8y8S9sbxgN9NjD6J7ALI+hYbw88894bnutNqMusPFQM26dS77Lw
+hvecB0BUmikV6AF6C47KamAYqI9z3uUAfW1y3uVgMVwNdH9g9L8M8cgBaR+ktI8HC/MrQ/vXc301RLQL
+vQv1QFW8iz9Crkj0Li6Stst5l/uFoM+vC6LP9YLoc85I+57RRFXvMloNf2aOp8oivzFvZ5n7yAVSb9Ft
+B7ekES7OTy5hlx/fX6LkLLiU8pCFY7bBnz/PPg4Nz0RJoZUpJ1d1dW7HqmX8WyZKws36l8zJ30sR0+vD
+hJuxoqXmpjsbwlzHzNy4Q5gVNdp9mhLp9FGlFgNSfF8dXfIB+tBIBjBNZWwHl8wIwKxyZifWP4ohMA1v
+jR7O7brDy4qSeEOYfjPuh+Qs0tl4/uEKH3EhOhsWXJQgnMgOTdpKbvbrLPv1kzDuyNIQn/rxiEt1LM16
+OsW6CM0NnWNNvLNcvuJSkq9wTcnHSomNs3fn+sVbmthwjHP9o2veyE7oT89xoY9GaI6Z4brIx0TmOZbf
+Npop0tgA5zpYmm59aOhrMzSPvmZnwG8N3epHg7SaI2OYOrchWvLGvmhs6L65MI00Tzi6onnC4Q07e/PJ
+2G9cjgvlvDjPhqfhsDCNeT3SFwPDMPTFIDLvm0OY0703PbpIwqGNKRqsK0JbDyJz0RzCxZZ7s9cY3hQJ
+Nri40kElfdxX0se9SWKEIn3cI32s0zxPuT5CVLFwEJmr9hDOVe/NZbtcH3WEVh9EZtiGI0j/3jxqFenj
+Hunjxe3D398+tl1iH0l3F/uIu8Q+vnSL9OEjfXyopI9ZJX3MyNJfhf5CVVeuDxxYHfQjs3k8HOmz/r25
+OS7XB56d3A8ic30Mo9/+vRkcl/cXxj5uqtnHzbP4j5sK9tE9IPbROJAssirto35A7CM6KNfHp0r2sahk
+H3iwXlTpL9TVlNsHDjfdQWQezODZ/P692YFXGpXYB44vV4PIbM9G5/q6f28+zEal/eVjJftwK9kHXR4r
+96c4cgir2McMVWw2iEwXTmrd/r154o5K7SNGaPEgMo/dEVznuje/uqNSf/ri/mOxv//wvRH2H3NvtIP/
+mHkj7D9aiyJ9LMzBxyv93uyfs2FgqgRvYOhNc2HCpd8igc9GZBWsaTK3Ckm7ydmI5NmOpWbxCRbIRsnt
+YjRCo2SyGI0LRRga+gwxmg0R2rnuDu7N5aKkc3RMuESrrn23Wu1xWrVbXPt7VHucB5j1lbVvo9q3Ye3h
+jR5dWHu/vPYLxGgxRGgw9XhvLv2SrtAx/8xtH6ZtH+7U9mHa9mGFtl8U1H5drfZs1Fg0YAqKktY+8+/b
++9EI+ffkvrz2XZK8QGjn+jGMRu9HxcPkTm1/89JtH6dtH4/GhSLwbR+nbR9XaPuwoPb1am1fT1cAy2vP
+ughp7bc4Gw9rvxqN9Dqs/aq87XGa7QCjneszWPtVhdr7BbXfVmt7nE7eVvF6+P7Jtrr2WaS3XY9GKNJL
+1uVtf4zQjjEazGfem8t1Ba/3Uv1+sXO/36b9frtTv9+m/X5bMtx/Md/jpWZZ/ockPr6YAexXpmvGQ9m9
+hz+eZ2HPpzH9nG2LmTDBzsJkW5EkPT6mBW7679jLki6YvNCNPC90+Wl7lmH/NGQXSqpgL+AFVNL9DKOR
+Hmdx3OXSjL+O0JLpAJpuaM6/jlAPHqcNM0zG5/rluzSXxN6Hi1JNF79A8CcCvrz4FeWtx2N250i2QQnt
+HhDW54VbSK/f/TJmrm0l2VXMC96K9m50SReK2sejK9woH4yRoX9cmcnJyNBvjDFlQFencDKxH5pDdq+H
+xdycx6UEEfTMmPfPmfTaJbMvgdvXIewHYaGwmx1nEgwHjf6VejX+QrJeJluT+0DFyQwru2bwJusXiRmf
+pUnI2FyenUuvn8zdHlu842pmTlvp/ajXIOwb7NYoth44OdUfMgLiBv3lE/StHwPT0FdK1LUM9fKPQ63v
+h2pcwwDcGI6Zdr7mVZ9d9XjTPzJR7FzVQr+a52JiXWacF/wOF7bIcX+ox33GQj+gfXCpvEPe8i4kkpP7
+dnt9Q/erS+6ZfN/iNkkNRclTKCv+qj/Ut4zkRs+EiZcB2i6Nv1ygVY8d6kIW4E4Ghr6oXpdzmOAwZib1
+czDNJKBbavQT80qtiqzpcvpw+0N9zSrgZ9gCA3dgpPW/viJ39C76ZNsNXlm+GItbNdFWvoU5PDkbiteW
+XgouWnaGYrR4y15uKGyiY6+By++uky3R3PAaz+3IXJrLtwbNR4WmP5Ysb5yfjFPUm3H4lsnnzfqsAtLi
+0TlfnAZAq75khGfEujknLpBUfpwOOzcSR82s9SXmcpCO5rEZD87zF7qOR3ooIyVo8+as8W6kf2yY7fjM
+0EOzbjbis9w+OPlOtYY5Q/fEmnVzmZwhdVyzF8tVWfFsmH5yBvdHnHnJ2VCPzZVJo4CGeZ+coSt7Bwb5
+eWDWzfnqLN0OtkrbZZEuug6Ny6uSZWNhw6Bt8Hfjf4SrxXWT7uK7aJgxFHHdr5serOYCXQ/6iRW/3a+b
+DxAGt2fMzTE9w0l3QQ/ft1dncItG3exhIp45GuurrByeMQ0X5gxvrE13Dd4yG/tGcK7YMOsxupjXGBh6
+DKWKz4g8aDjBrs2igVQ93XSWUhSnaiL8mnE7Id3A7GdbXQDvki6ppj/KV13R8nvDbEdnuAWj6CxTbj2C
+JwwGdfMLrFWzb3wigVwKux/UzQTCZkPPhKm+Zk5p77ubM7glpm52MBHPnJ9x2l2n2l3BXf9Qh/eoFerm
+w326XWbYMNv3pDWP7pGKG6YboWY1BoYeDurm1/szdsH6wjAM3c46d//r+RVzGDfrQRUMERjCsxDU47XM
+WQPPqVG83TSXDVhLs2X68Jf7ftPc1M927XrGGT3XRx1Ly1w3zmC62BikFrZmfm+in/W+cZ5+r/eb5kkD
+6aplLppQp0RX8Kc/aJqt+tkl6S0tcwtPIMRDAw2TTbMHEdy+0c0WkXsmTNEZi376pWXeN1DPaZoPrTND
+b/cDE0h7WLd5Bqd6TbMHy3X7gdk1OSMg1uKbJyba+nScMinb1XDUh1twWma9DdU9vGm1zmQSwB3tcHuP
+ivH7VesMevym+dA4S9dDRAFaZrOBvGrT7MFSddO4YZs21Uq9gfxm0wygEmd949MlhR1ANcPpA2qyYdpw
+6wFR+YHZMtdNpEwIbeMmHjTNqJG6kgsyBzNWJt1T/WDqoWkYsZkWuUlMdgdghc0h7O2u1OoaZky3PNTN
+pVnp1Ypr2Uh/IzvoEZgdunXiffsY2XfT7MD6h300C/+Y6Q3rNGoQ5dB98KhnjDLgsYmsGfWMkaHHA9TO
+6PfVoGnOG2fc7L1hTtIBu26CtIKB+ZVunH+/OFAwr+/PHOFfNswTM+M+62fsG+cpe988Pj9HhnHfQBbS
+NINGqrYbYpTrkdFHu1XfQkbw93DYNI/q0EX2W2YX/hIObjYHiu7hw7XRfss8hgX9gYF6k9E39MUIktwi
+kvHwJjk4k/Wf0ISnL2amaqf+R3H0ggkk9X777HWGWEbyjP42hCNYe5CFBBT75qfEpLZ3C+AmQLQfryjd
+/cW80tdDdGAI0zAS6I6GRt0szmvBZzDuoWukx+bgZq7uwLjv39DiH97napOlGchZ5rco5czFatnzD9lV
+/crjWRd8NJtpS7yWPOsAYwO6xi4+J/UYthmzj3T4PBO3ot7ChOfSjNKR/To00cA+NK7TA4Dtd8aPaM8j
+3ZD405e3I9qU4dk5NMoFPTC4HaMNfjHeS5nuI0YbJ9sjo8l+u72CvfeMHjN5e65vh8ZNWiIeG+8RZ5vB
++UCyO6SpB+ggycWlfkFVJcwjxvRtkkvjJ8TQIs+34KNLF9kG4EuyLp3q0TVZ+6JkfgneEaXusYUs+7YQ
+9r99NLirRB65Ue2JCSrERnnDj81zGAv+0jhPU4cf6+dXuKNesqa+MDPv8oH8CjdjhiPD7TNt/OlKB/zc
+vuTYY/bjumVu6LtOTbM5KhsiAT/jrrKD+6LkYYGl2boa0v50fDWiz3zwux6JduHRx3t880HumON4WLob
+edZHysufHPTwjlS8XX/dH4svP3HvIzT6aDZ+3Jetlxzh043hgE0zL8TDc7L0DarQZcucn6fDatMMz6/0
+hXhy7hP1ZUDIBLfMDYPsXsinDWorEU+UCYhDJeI1nyseGlcj6D1uK26+h5deHKCjh6kaHTjzMvx0Bn5r
+BOYVHAfXaVC/MKFifdlpj0zMoSSPPVQfCJY+16I6BJy35M6HzJIPfkKTgUV+YBKku5ZIlw1W15nDFYJc
+mXRYVT81fs6ih/bPpOjN0uz9TFuYe+9gGJrrj+f49LTQur7JS8akvgr76Ts0wb+hE7D3KGPw6diUWoFw
+1kNhI7+emHrcN6xHEZmcmPqBadiPIuKcmNCVwMYlzQfzx2EfxSGXqsT6zU9Hv2QN437Ksofep3TyTjdh
+7zOOfbz/xLlPDPpl/smAA8/iIxTxly8f6cDjfrzSm/2fHj7Sbne7+ggnxUbcz9Uji52yxKd0ofjT0gx+
+TeuDLQu63UFeMz99/TXTR/PXTB+dX59GHwe/jvK2+UvjV6qPWU4fq7w+Zn1jNnyUPnoWr4+taTSHBZYy
+lL2J8lMyybS1nmTa+jp5Gm21J6NURQ8TqqKtKapollfR1jS6o11VlE2XPjH1Dc7Q0dD1GeWwNOcOr79j
+0+imB4fYac3STISS3b4Rvs3uLFm+vdLjgbF+e5lKs3mLLj9oM4U6+NNsTAvNx/CuEyMcZ4UuYCqkY7on
+Z/RYv2t6x28v92+ANEAE54j0+uRsmJEOjt+mC+WffmQPl1Q9vT7kJ20f6TT1g2pp4KJh0uWImVk36dOZ
+F8Y7NPJ+kK9DEKXdnGPSucur8K1K43Eq6/k5GSvEQyyR2TLTdPx1lm+9ftfPtgLVUVbMEqPbX9ERnpl5
+Kb8KAVtdz/RP3sKjO10zOniL4oG1OS68VCPTdcNEZn+QJnouIrSbJZ8dvjfbA5j0isxkmM4c780YnY7q
+me1jxLhrRsdvCWvWouGkM0RrhlVPepF0xficrDVWv/iRYN6ekxViUtHROdpfTP6+7MN1+5tHJKNlx6lu
+sijCFozQQFOCE/PAHRv61jw2A3c8fmKOt+fM1TEWc/VC9rQpDbF75iKdM910zfmIfQDxE5lVzek5LpiW
+9ftZx0mvhJDJL10LKei771CK6UZyXcdPaApisdc5XFL+lqSm7GTiU7pcuM5FdVLLOZNoecxdEIEg1z3T
+NzPFfUnzscOSk2yJ2aJ51ncfR3TVtGmGZ5leL8/1+4xhNsG55Aej3GI3l/HJVjFnWdUvPHMzGOptc2Eu
+hn29a56Y9dn4re73j81kNr4RtX/mmcvRFdrTdzBE+vowZnzgtcEdv7yWLxb+8haOcfYFsYkxtYmx4byD
+g5prXqf974v5o77uG/fmtW7z08FLIzF/RBL7bk7ia6baPfOd7sOp8QXWS5zlNIFxZkgsYdl/py/gTPmC
+HofGt0llWgq/EJ7zL/8hWuouchI/u5aOPcKz4f2HaGkV5CR+Fi11GS2tQ8JzGSq1VP9Tacm9z0n8xFoy
+ev2fdWCAm53rOjff63Vo79HY0GdQxmj8IT+K/PwzHEV884NEJ4H5s16H891PqVIS81f9vm/UzU95pbTM
+XxFDNyEMj+5ThqxSvP7P+gxOPj9UVcrX/s9wNt9NMS4M62e07ZmEUqBleu9GZL8RXBu+f5dC8H2D9LZA
+0DJb79JtDXD9+fhdCjGc99AAFuYN+XBi1u/H7/U2swi67R+bPajOeh8lcglmw0QrpvSqM0CVAtB2Tr9v
+hPSSs9W1scTJw3Wflt/00QJHvZ/J2bhCiIah+/2m2b1KIcb1e72Z1f2iZSbw6BvZZdU0t+mdaRf4vkha
+93uzZR5do8tZDViVprn4kYBiE9a+zdQ+Nk/MGFYfrwHXyXL+sfkVVn/LVP8CVb/LVP/epPW/N5ECFqwC
+ulgBdUYB9ybSQJvRwEXLPLk5Z9rUfZ9C+r337/Hb58WxqTTSEm5A/yjdgfpREqK45oP5YxqirMzBuX5r
+fBrnny6dwDssHswPY8mUZmX+cq5vBw8mnIA0M3A9BY/P9Y/0tfcK0wAyVeFeE76ml299FJfx6HIl/rtj
+PqDat802E7yOzsmG7U90hmfxQR57Id3QoPf8Su4whhd+wv0ID+ZRenWaz6x44NqszAN0wZJh0cXPiysp
+T5bTxxynt3A77IP5ZURj5fRHynNlzs7SNL3FK+eGnw+IKXLhdrRbqPJFhaz0tSo/fUMn5dioH8yHt8NU
+Qyuz/ZbL1D6Yy7fD9B4zM7MoWHT1Nr12Ly0LQ+kmU7ZOy7rcNv3S/oKe4TUu0jl8YD6gN+99Ex4F/Gjc
+jktuHBGTW/AqVdc0bMmFIMPcxqMzidGfFc+gLiQ74y+ND1e7zMbwo97XTJ3f4jrP0N2hHwvr/AuyLpiQ
+qFoZIi1a2DeN0Lxh5kvkt8h8r/v9B/PhXLp16d4/h0d9D8yj4zE6Az3rf0rLHZl+b/wzggYnY3TDwn3/
+U4pqRP1f9bD/YH4dS7cc+ZCyOzgwWxB3MTAO+p/SckfmDFKG0CMIvR8Y4eATQT0zrF9JQgO7pMAM3mEt
+uvAGVONyTPbRDrP10yFNng2zlmEm8Dc0IriRrPKg6XBo0p1M7OTwAzohMqQTZXiqZ/BgHv2YOoqVefDj
+ub4eGB/SsPPB/HpzzkEPcLum4M2PI8lmwpW5uoZ5vQcz+VG2h3Bl3v8I4cY2JQVHswL7lGU7MqfIFpGc
+qbjZmIN0UFibF2g0PaOv079FcdAlqy+bz+dmeh7DfQKzAbqxJT2YBPdazfBSXTp0wLNBa9No0/RaP+kr
+UiEd88zIPal/ZqTibs0+8VSyzTrCxubsrpzst0qLecZ75OA+jsWFT+7CGumGminyZD53g021u2zGuB3I
+xsbhV7M/YkMFEgtszXfnutv/av6cdvrrrNNfb81bFDDaY+mSbV5cmIg5MI172bGxIWtNuWRoP0HX3Qtp
+ni/95lt6ZV7/61t6Zd7FUHcHDXNtppW6zCp1CTfoDfXZoGFepEf9LrNaXdbNwMzzunwWXk2Um7rmk0lM
+QtLru0PCdLzoHw0o037ufqlryVL/bct8/8xXLi3Mq3P92jOv6aVL4/SSpFn/LOuUDoylwuycyEXuPBwZ
+vK75a3WZ7QbL9Otl0+z2RzrofxlI7O1L386u53P7P8sy3W/HtIRxc16ly/jm4L4Pe1sB0/TUhJppWqIi
+03vM9I+o6KcCnmkgq+aZlqjIM8zV86a8njdPUk+7oJ7pIRt1PdMSu/G0CuqZpvLV9UxL7GZDL9qeC7M/
+uWJC/AsxTKDztZueSXv1eIXOp2/NzgCOujdjBvnSoNd6i7mjnvlT+vHYHBBvKozXwoRRNgx/OjHTqc/1
+sXlzrgPmSHc6tSXe9yadkBFqeGy6KNt+I4hCaH+Anuykn23tuaC31oTm2xF7Dwji6Zp05tZkLuYf3Pdh
+GFGNUihS6oqUnkykT9UIAYHO+tkEsqsRckVCdZGQVY3QTCS0fQYV9d+PyIHIC8O4Zi92HBr9H/Uzw7gg
+H8mkb/SjPtul/Pgn9S32WdyGpzYP/TB97OJ61fdwZsAw9Jt5n4Yjs77FLoRWSZqVXMtZcKbiwQzSpfNL
+Zp6MApiVeX2u2w/mVRrArEwXbpM2I9NO1e1e3Ju/8it1xjg9EL1Ix4B0gn2NnUA6W8pDhoa+6sNE0Qpt
+TWA27iKEy/5D/4oJCmWHUtgTa2cjJpTKMnXD/mi0S8rhTLIAfMtZ2YXsuOpD/8qg97+s+h+GVWcxP6O5
+gC0b5W7Pc1dryw6BZlu/mRHlQpo1hXOsrun1e6YsI7rot02U+AXpXNzrn5iGJDm66Nvn2dUw0okMM315
+yGai1SZIVmpU+VWQAerg43E+x/QOvgzk9ZcDQzKDMz5lRwf7K9jwA3pgYOj1j4ZXkomdEVMCw7GBt0I0
+TUOvq2VrKmVbVZct3k22s79k21+2+p9UtkV/NUBrTsP8sobX//I2FWPRP0aHJY3rNIcFj8M02fz95Erf
+4n3dtuDY+ku8Z0nyrNHNpg93fpDbB9f9H2VPfP14pa8GdE1L0ZknV1zwIbJIr0VSs9hWYfECtYgLWPiI
+hV/AIvyT1GJdwCK9XFHNIq7CYlXAIr1WWM3i/k/QFn24Xp+9NXrZ6h/TUK7Z7+QfLhtKrrXIdrcLa4Rn
+X/sXhm5t+9wVXCQ2+Nofj8j7Ms3+tt+nF2x/ZJfhpFu4hqVnBC7TjWbklPtD+qRqdqEQjl07/W5a4Zt2
+v0Fj19gsvhoI8BPi7MADyOKMj+zGCJqpjeA1WWSrZv8dChjSZ7Gu4dEUgPb+32R7/z8ZcfohC4iGKNj4
+lF1Mjs4C2TufbBZv18jUndOAsMo6M2kkiin1l3BuYwyHdMVjRJ58zUL1jzQbmE3bL/n5c9WrocRZiHFG
+VXM9wprIwkkB7RLPr9A3dGK9Z0Z9IuciGxpvDe70nvLbjcEfysMFu+aqP5KdteuZXt/QV/2uOUO7jXvm
+EZ2udE0fb1OgMw12HYaOYrI2TqVHt85RtePFWKyik/7Kg6N5/7jf895BEYyPY8kbBr+gZ/DAOG2u8Tl7
+KrmCaaWivIVHwI3rsXjqiDn6c2b8lJ7Pqj4LJG0PT+ge4xGfSApPcq0G2ZMWF8Zl7hayyhPQS2ogwNjp
+3jCQ9L/SW+rifvdsRM8RjtjT4BUsX7bTNJXhR3Re8cPHlPjPv8C/P35MFXvxS4lDkN2DIt0uLST0spre
+JP0e3SNwmbm+y7gPdwjcozdMWBJDWhHuB3IJFp/uS9dbRtzKwZmx2z6VC6jznfxiVsWZyRvNhexCqKR/
+NE53PrBLQXF/C3OYfeN2a6bUf/qKDnd9WJvpgf/xxiRdWsgl3hR7Ow/pfWsu+l/RKxXd9GzmJX+W/cLo
+YafhnpPuDA/0n8PbgYwwPSd8bxpfxiicicdZKXgjInzwJD2rgtPeHwzjEwkQvH7n/Gckw+xipH846c+g
+U/lw3D9ZvBvSMt4FuoO1v7oYMcg3fe/a0W/5igsOP6vKsH9OWsgSXG2fese+wZ0QnmXgC345S0DxTRmO
+a7IFhrl0k3CKU7hi8FLIgXwy8pcQCAeeryXvxWTQi6P+jMYpx/3l23NmJxnp/r9AO7do75/8AiWYmWNp
+ViXnVwKcu4jNS+m1ErmzVBdXZKPgjL20yzD0IUqPIxDOd+EWNM7PydMBcES4ZNyexbuCIffqKnr7xzDY
+S1vIusMAnQJGs27I5YKPTtmqXZ2TaBx2xkv9UsX5MhdbXlzpcWoD+Uri58vqiP2YVhLPLRZoonrNsxoy
+rJjneC7Su+tcWSVxiLzO7ipVVhLPB2aokheSSgrs0/Di3iRD5AdJzu1SlXQlLfTQz45QbGE4e6vanqaM
+6y6ziz4H7/7q5rSbzwYB182rhR5f+z+nkQdqj5mJluGVPkA4UnnNGArcWEMfTG7nHcPlbi7Cpiad8w5b
+U3QP1xXcw8yEG4TcAc5FG9ZlqozdnMontT/ZmpK+diHeF/kj418+yLue1L/MTHiWkPbamUlwx/v4po+0
+Fjm3tDVFvzRW+yXeOWEV09qlN7aMK6BeGNMrcql3s49S50qvMFbZpTBZPZNFoMb4nHOfltpzVm5O2iYf
+5MOF1JPi5qRGTA+Ijit54RheISKMMsyNY/NBi944Nhscm+dsLkdUydf+/DwNyhfMxt2FuUVBaZztPhPS
+BpdCOqDfOScbhrN7ptfMAuclddaX5AUVxbQbfbvuP9B7hZW3p54N1ua5dM4mJACEm60QrXY2jyMvnX7p
+d8z8zP6TZBYv+/ZRNrMnW6HcvgsF/dKfm9lV3aF/eUUeew/NxaC1uDSguundat6gu7iEl+gvBg0I6/aN
+YTrTd7MkgWt6g2NYsJkSOWYKLrKCC9O4QZdjDdk1pzCzjVwSwRvUF5fn5Aq21WAxOILk79Frprldhb/A
+7Zre4N67hL14MYgWl/lSX/pJenH+GZMTIUqaDTCNrnc51Jt91+yE9F72L+ZieQknHovBBkoBDwfLo4fc
+VSZD4wnedxyrohtsiV/6vdFYvBCerCN/MVf3lyMkesdDv3zpN4aZIXTvL6/IhfNd82xzfzmk70Yc9Ncj
+SUJUck/LmXGWpl7IqHIxYrPOQ28QesScelCB7eyGRks1gZRdQ5bOziGprHcv3km+3KZ3nQvdvPjkwVnh
+WW6xcSzaxpY6CvsVuaI0S/VplydLL8WV476c0yc+43SjCMOGhvRWa9m13qlhJRdDxrDYDQpu/34Mp2Jf
++stxak5uPxzjh0PpLaPG7XnuLEhm01lrCwPsmSpOFXvB8AgfbusP8e4Q7EcAtZ2ZaQx+JIlKVYGzH9kh
+zSq8mO8TPwxfUgw/U7JN68Iwu5DswcgB6JEaGX+y4nk9IltkzrLka0by1hiO9DGtzJmBU/eVi49z9wAU
+q8I16fOuNt+EzC0LlkoBlkQBrlQB/WCAxm3j3sTcoZ4iU/+YfbHRBzv74JqKLz5DJ8afeNL9UWDidB7x
+J2Yw+JC9VPjWb16iXo3ebokHD83LS1rSuCIq5FDTLZlvu83L9MbhuhkPvrQE1AKuqxbHtSWi3uZQ05tL
+3rrtSyTDAMoQD6J2da7NNsf1SET9mENNR/+39x004hhoST4efO0IqB9yqC6JMN4eQNQYocKL2INu9bpu
+u1xdeyxqv3/FcgVZNJiuJvV/Gek3hrEwxSkr9GIetAoI+yiF2QhmSWEAwWwpzEKwTznY0DP1D4bhm2mo
+0A8QCd+kg0mATdc36bw2MFFdfMIMfwHowyf84eyLSSptEUaDkb4QP+RKWGUFgPC3XUQgdTQWbgdufz1e
+s7ulf7vob4S+IgaSzRYwEGTXdWBgkwFiSZsMtM5AbZEuCxSFYnIENkVm4B/p35/ebYZG7tkBcRZxeY2g
+axP/DQxjg9o8/QCbcoPbOP20wp9usy8z1Rcr+xLiL3b25R5/Ybhzp80hDGSwbgaLzV4fni3Rt2a3/2Uw
+1D+KFbByFfDzFfiUEzf/ZWbmauCauSp8LKjCwpTWYfVXHf5cdaj/J9fB+HACBcVHOu8z8bPAgsofmXrM
+fFrhTyD7MlN98Rm0EH8KmU/3+BMjAC9/ZKIi9zL5+5H51czF6Uqvxb49825EbtLP5Vp/gtcpNAbx5sog
+8cPWNIboJ3Le9cHD+mpcMo2+MBSXrl4w5W5QSDwcp7mR0Tn7NzrTemDSv8fC3xf9ryZa85v1SdRj0+Dc
+Nvrzvm4xMDcL6aFvn/eRVVBwnIFjDL5nwdsMDJUx7+sfDePYZDiSiUEfGRQFcTkXBPTNDLrKoCsMxVSF
+eSaQzDNZTguTTnw/4N/KYECEfSqHDU9MJHDYp+Mm/Qln7EusshTMlPExdM1AXQbZxeAtA14w4HUe+54B
+w5zOEjeT29dBBviUCvalr7sM1KZAHwNDBuhmqC6GxgzUN0XcewbKqAzGgAS66Gfp+JQ7lMrD3xec7aaX
+SfQ9zHjBW2+qOgheseBFBl5LsO8z8KoYGwWvWF8zrBlifh/xb0y/CU12/xmp1byvz1iwTaE+hvos1M2Q
+Xa7ThcyuJhY7ZMGLDLwwj/qf5tCGDvrewOA6vugUZmahV7gv9grrYq9gq73CzCxyC3GhW7B3cwuElbzv
+FwIXZoFnWJgFrqFe7BrWha6hWewa2sWuoVnsGuJC1xAWuYZVoWvYFrqGdaFrWBe7hrjQNWyLXUO92DVs
+i11Dvdg1hEWu4b7YNfiFriEudg3rYtewUrgG/y/X8Jdr+Ms1/OUa/nINf7mGv1zDX65hJ9dQ/8s1/OUa
+/nINf7kGFtxvQDhOVKen9WjCOz281qe5bFxkPggH6DDGbJDgd8V57JmZfSHogP3yJ8Gv74vff9dAX2Li
+doBhJDiRn35xzX6ffNrST4bxFedM00++8tOM+TTDn1ha22wLhWsaFy2TuYrvsuIDXBd06fCWz7EX7066
+MMZtU28OjMEJNIr00kZABbrm+ZPnKuDRm9v+sGALzlia2ufeYRb2GA2ND+lC6dZkDlMWnQa64R/5xxT7
+8PXNj4ZhMcdUs3398KT6BP79gQFf0vEF7uv5WcS+oD8wtmUYgPiCfv8X/dIwVsSabMN4MCt8uM4+APTh
+RvyAUa5FGkUfrrMPLFHyYdwycbXTgfpnRgvAGP+sfzIMm25h6jv47/Qs79DBxWk3IuhkB1t/+jN54WTP
+G3qHzMnU9NtZdtuJ3b8aQ5RZn+5cmPf1y+UwXv34Xr8Mh8vVjx/SPQzDEYSsCCSCEIuFrAkkgRCbhWwJ
+5AFCyJA/HbGMiYu+XA6PH3DZzkPK2TUJmQMC6j2krClotsagk4eUNwW5BHT0kDJ3TYH7jHKPN6Temx8/
+ZI2EK05A0SblTkFrAko2KXcK2hLQwyblPhO5A1r1Lan69kdqQKTmBNLbprxTyOwrqfg2ZZ1CXAI52qac
+gVLpcZ1Uu55T+oqAonpO6WsCSuo5pW8J6KGuVHpW7QapdiNXbQLpNXLVbpJqN3LVJpCjhqTaN7yRt0it
+W5lZkkoTSNQSTXlNIAmEAM7ICeShlXUMnjFj5G1S5TajHlJnAuq1c0qddUil2ynvzMgJ6KjNtBLPnTHy
+Lql3l7FJUnECiro5S14TUNJNuWdGTkAPXaZr8Nyz1u6Rqvey9iE1J5BeT2zT2TGpeC9lTVubQI56mYWo
+lB6fkGqf5JS+IqDoJKf0NQElJzmlbwno4USp9KzaB6TaB7lqE0jvIFftI1Ltg1y1CeToIKv2zUi/RAHY
+dbpjbWPq14T+NaGfHlwYjvRrwuCaMLA4kEtAR1QqCloQ0PworcoCVZvh7lLuq9kNKhzNbjjPcr0crgko
+md0Q7hS0JaCH2Q3hTkF1AtrMbgh3V+Q+y+o+x4V78xvOncO6uxh0Mr/h3DmsOwEdzW+4TgDrTkBz94Zz
+51LNr76Qun+5ETW/JqDky42o+S0BPXy5ETVfJ6DNlxuV5pm6L0jdF/m6e6Tui3zdCehoka87Ac09pu6X
+V/pNtvMRoE2Mt6jsuX6Lyl4yPv12OfQJ5AuEWCwkJBAPQmwWck8gAYTgzrMZ/ASvQlwPPPx6MR/B3C6H
+8fLmCuIslzc3TAQjQiwlxFZCsgjmJjeY3i6H23tc9uE+5YyNVwRZapCtBjEmf5OLYG6Xw3aECzeilPss
+pcOBLDXIVoMYk78Rh/Lb5fAgxmV7cco8bUIOYikhthKSDeVSpS9WuOx8lVM6B7LUIFsNkiudVvv+AZcN
+HsRqcxBLCbGVELHaN5yRr9e4aLLOzHI4ykFsJQQoIZZg5De8vpsbXPbrhlHPcJQD2WoQUIMsUd83vJEf
+b3HhzpaxyeEoB7LVIKAGWaKR33Ct7dZx2aOvWfsMRzmIrYQAJcSStXam9LCBy3qNnNI5kK0GATVIrnRa
+7VUTl42aYrU5iK2EACUki2Buc+Poh+XQh2U/hMMvzbR74RFRBFlqkK0GMePobS6C+QBnCbjwssV7FhFk
+qUG2GsR4ltvcKP5hOdy2ceGHNu/ORZClBtlqEOPOpZpvd3DhRieneQ5kqUG2GiTXfFb3gy4u3Ovm6s6B
+LDXIVoOYCOajGMF8Wg673ZuR/ikctro3Y8anixBLCbGVEMK5NIQ5OCDj34EYwnAQSwmxlZCSEGYxe48H
+v9n73GjKgiw1yFaDykKY+zkuHMzfiyEMB7LUIFsNKglh1i4um7jvhbGcg1hKiK2ElIQwzS+47NcvOaVz
+IEsNstWgkhDmeIHLdhZitTmIpYTYSkhxCOP6uOiR914IYTiIrYQAJaQkhAkDXNYL3udGUxZkq0FADSoL
+YVYhLhyF78UQhgPZahBQg0pCmPoSl90s3wtjOQexlRCghJSEMN17XLZ1n1M6B7LVIKAGlYQwsxiXPYnE
+anMQWwkBSkhJCNOO3uNxL3qfG0hZkKUG2WpQWQhzEOPCvfi9GMJwIEsNstWgshBmscKF56v34jDOgSw1
+yFaDykKY+wdcOHjIaZ4DWWqQrQaVhTDrNS6crHN150CWGmSrQSUhTLx+j4KO5fq9EMJwEEsJsZWQqiHM
+uk7Gv/p7IYThIJYSYishJSFMs0EGv0Z+NGVBlhpkq0FlIcxxkwyAzVwIw4EsNchWg0pCGLdNBsGWOJZz
+EEsJsZWQkhAm7OCyXiendA5kqUG2GlQSwqy6uGzUFavNQSwlxFZCikOYeo+Mfz0xhOEgthIClJCSEKZ7
+TAa/4/xoyoJsNQioQWUhzOyADIAnuRCGA9lqEFCDSkIY/wiX/XIkjuUcxFZCgBJSEsLEs1tUdjm7FZXO
+gWw1CKhBJSHMdo7LPsxvhWpzEFsJAUpISQhzP7/F4978lnSvbEhkQZYaZKtBZSHM2sWFE/dWDGE4kKUG
+2WpQWQjT/IILf/1yKw7jHMhSg2w1qCyEOV7gwp1FTvMcyFKDbDWoLIRxfVz4yMvVnQNZapCtBpWEMAfe
+LQo6et6tEMJwEEsJsZUQNoSpF4Qw7j3uI0fLWyGE4SCWEmIrISUhTBjhsl50mxtNWZClBtlqUFkIs4px
+4Si+FUMYDmSpQbYaVBLC1BNcdpPcCmM5B7GUEFsJKQlhuitctrXKKZ0DWWqQrQaVhDCzNS578iBWm4NY
+SoithBSHMP4GF/2yuRVCGA5iKyFACSkJYeItGfy2+dGUBdlqEFCDykKY7VcyAH69FUMYDmSrQUANKglh
+2nVctlEXx3IOYishQAkpCWEOGrhsr5FTOgey1SCgBpWEMIsWLjtvidXmILYSApSQkhDmuEnGvWZ+IGVB
+lhpkq0FlIYzbJuNeKxfCcCBLDbLVoLIQJuzgwl4nN4xzIEsNstWgshBm1cWFo25O8xzIUoNsNagshKn3
+cOFNL1d3DmSpQbYaVBLCrHs46Eh6YgjDQSwlxFZCCOf+0QBd9ksvJ7P5iziFO86z52WY68qzG9Lh6+74
+jjTXpE3V/4J+d03m/Ao6ASF8Af2f2C3mNt21jDeRD0B6cdDH7CTBp+zDzGROVpAu8nVw30cHGbaDB0yS
+Rwc59JmZfVr8WfDr++Iblx16dxwgDdQPpJ9c5tO9aYwDfG5iRc2i/4AvF0q/wJZ9wNcT8YUWuS9sGRej
+WehL6snGDybe244sDQwf+r/ioxPklrzlkLleMRy+u0wBxlV6VTVbckVOYIklK5P8KBZMrzvcm6IlFkzf
+0hYLfhILppc07iXjaNV/j89OpJj9CaPpBTpFAFBXpNeSfzHZLwemMYYd+OxhMGRcQp85+tdnb5XNLnTN
+PZX2djVA9z0aa2yByDNtyCWc9KhWdhbtYsSJOv4yJM+0pNad3sVHzKgPfVFreD3Sh/118hO8dG9g6Lf1
+4Sb5aUyjBXpmDN38NxwTUT7AW2yZtzUu6KG5cWt48O5KHzeH83eX6Sm7xnAV/3Suf6wPo/inoT6Gx2OE
+yySZO68v8Q7blDZEdyH6uD48iX6C97bSC7UvG8MZBKHbcc8IfEhv7WaPbJyN9LPGsB39NIIFG9FPY90V
+2KFanLWGnf6VftEcHvQv0+Mk8+HD6Fz/OBvWR+P0W6d/MryCj8/5o/TRqof+0egKvqW8OLsUK3jRGQRn
+V/p1e7A6u8zOVA5G+ofGMIZifagPv0Q/pVdzgsbwPvrpSvdNe/PwE7Ts9qA+vMlfwtkZfHyvf2oPrtK3
+BBvDRfQTuuR2ZOgAJNufDB1MV/Cfe7M+bN3/RPaD3nQGnvlej832YGWyLxFC6Cv9VRyuIhvE/TBIQJC8
+Ov3Xq6O//9ddoP1dM8MwiZPIWmoP7cPWYUP7fp4ky/j06GgGkkkKPLRD/+g1QuiHy23kzuaJ1qw3GrVm
+vXGi/TQHDCFjlczDKFaXXrtJAiJdGwf2ISr1zrVBEANHWwUOiLTL8U+MGG4yX02QAMl6Eh9RmY4mXjg5
+8q04AdHRu3F/eHU7RCIe3QV3wT9dfxlGiXb3aroK7MQNg/ju1Rv2+4MVudbEA+J33924ucJRGCbiJzDJ
+f0y2SyB8cn1rlmNih45Ybha5jkhNJt80jHzx22SVJPkaJpEVxK6s7k4ULp1wHUjJ1GZRuFqKtQiWq0QK
+sVdxEvo1mViB9ZD/MrEikYIViTWfRMBy7GjlTwTA0pq5gQXrJGJYzkxU6ZeVPwmTKFfW8kAkttwyCmcR
+iHPWABzXEr55bqxQhRfGogxJaMVJjmroWF6uYOglrkhyGS7DByDRWLiKgUgiXrpBACKR2ypxPTdxc5a0
+jNwAq+GV/uoUmrj2212gaUdHWh81qpb2Ee3B8lYg1sLA22rxaokI3FpxfGtH7jLR3CB2HaB9/ua33z/D
+Lq39E1j2XPubHXphpGt/Q+iaG5AvMeajabXaN7/hT7+fat/8hsv9/gYCf78LCgklc+CD2p7kJkuOFux6
+NWhyi2XoBglDL/sISU+WKrpHR9qHGGif3SBeAjv5rE3DSIN2EmtxqCVzK9HuV2ECHM1NgB9rCwCWWjIH
++Gt8SGjcAqBJHF9sxTH+4cbxCsRHzdZx6xv0ux36PgiSWqvVbbVPGr0mpFSrTcMgqU0t3/W2tdgK4loM
+IncKhScSfv83eZHXv7/JU/DDIIyXlg2UBGgJhP87sqijIy1Oth7woPYcN0aGZCW1aOWBWhDWHkDghFFt
+GYGpu9E1B9ieFaGuDaHYSq0g0bUYeMBOwgh+vl9ZnjvdusGsBn2tri2jcAmiZJujCBvm6Eh7j910cHSE
+/rwKI9/y3K+IjxZOtbc/Xb7TgAegFmNd861gZXneFjbgAjjaNAp9igQO7TjWklCLgB8+gLsgrWKsJVY0
+A4kbzDQ3ioAHHqwg0SZRuI5BFGvruQvrvlx6UHItAGuCd5gTDGhurHnpgHg5/ulQZhEBsEPPio8CVjJY
+Y0RrENorWB9KvHGo9edWMAO4Pp8n4aYWu1/dYHaq2TgoqE3CzWdqrJ/XrpPMP0NZgjDRrOkU2NB6J1vt
+89JyHDeYfdbCCFKKHBB9RvXQmpQNNG0HTK2Vl2jQTjRsJ7C3WZ5HFYPRWodaP4wiYCcIz3MDoM0Bihqk
+5duH2o8ReABBolnOl1WcoLaDjYlYxVCJ1jQBkRZGLggS3NY2kiyGJMdDLQy0j27ghOtY+3EeBkCzAgeC
+3OtbzKQjrUtiLbW5O5t7SLok1CZAs0N/6YEEeFsNjbhLK4KiUWJ3wd/1u+Dvp6cTMA0jgH/H8iE/wzYG
+VidsizfQHzRQX7oL5onv4cJMpzvVsm6LSqO+D7VXw9o71RqHjQ4CtSCotgaThZvUErBJIEdQw+o71Rr1
++reoXJsrZy1rtLLY1Z5q0Wxiff+3iWfZC12rv0ZYHSIm9GBz10fO7+5VANZ3r1D/6mhxEq3sZBVZHu1r
+UHuOGy89a6vZuPm9rfb9eNio61rowUAwbfbXiPRP14PrU9L1oHYfOneBzMfUAlg/ZEXUc6AhG/pKqxaA
+NdIRaoG7wIoS1/aArllwCNO1qTuzrSW0GPT7KoLfwhAFrHNgOehfNPhDZ+EGuhZYD9BHoSATNxKp1qk2
+8UJ78SbTjhk6W7ZTvseVgRbmW9HMDeQG3zzUjFiztAmIE20ZWXbi2kDH/kSzqHF+nlj2AooWOLi1PtP+
+dQsSzQo0sFl6ru1C43QT1/I0ZAqW584CPMRTB7AGmm0FmmdBM13F2NdpGhL1sxvMQeQmnwlOGGjJ3A1m
+0G8tgPb5v5P5Pz7TdkY9YBI6W6wbXM9TrZ4auGDT3LAysWKAxqN/uoHtrRyAy0Lb/f5v9FdU7PUbSmpN
+rP9vzF8ZKa6D/I35KytCTP1vUGqsSfQ5U9ap5oFpkvUsUfEp7mSWdkzaP1bLJQwzkSKnob2KtXCVIGMN
+A6ZvwDawrQA63wnQLNsGMRwPHlxLW4DtJLQiBzfuT3M31pbYHcawjVfB2gqgr+apI8dvLZfAiuAQZCFp
+eYaIno88W5y4nqdFIF6GgQN7KoqBQKRhPtmw1Y+A4yan0qhl5SZ2HB9Btd4F/0qsiRs4YPPD3ata4+7V
+v0+xfMgoiJCnWl37Lzrwp/0G88GjlIZ6nhvM2F5kOA5SJ/Ei0KFq2KHC7jRyIzANN7Qn3c7DNSoO4+qp
+F65hoaEzw0PAeIjsdS7xzcxAmdluakiMOad0T7UHN3YnHuBsgAr+03YZziJrOScegUQr2CGES9JRYtxw
+0PPAHkaxzW3a7XXt83/PG//4XPv83/PuPz4j9xEBG7iEDqzVJExgOE9IHmofgRasFtjxJOESNzwCIt8N
+rNgFEapwFHra2oXdW4PxlhbbFgx3rFhzE816CF0nTjHt0POsZewGs8OncMzzhq7Nm7o2b+navK1r846u
+zbusE6kl4RJqnvmCK3qq/S1VWI0DMK74PYhBQpUcBtrSiizUHpmSb13f9azI2+qpptK6hoH2+b+X//gc
+azOQwH4CkkPtbbgGD3B8WAPN8uIQf4eoiBrXCLBPreCMIQL+Z20VuAmMTOIEWA4MZD4DHzrvZdXqUuGV
+9TUmkwg8uBZOBzC9Z7CCY4KVAG0C5taDG0ZQNhT0WIlV+7tmJUnkTlYJQLYRriKNTFW1pbeauQEdooRu
+CN0ljOpDHNTDbtafR6EPdNTddG081LXrJYgsHRnprTW1IpcOWZAcHa/sVRRjwdzAwdLieM6hUtPYkBlU
+icpxVMU4A611UqNhXhpLoiaGFsrIjCO8eOEul8BBnsGaTKJ/JW7igX/r5C+kpzByYWbCq2GY9hv1BwLN
+U5zlgqaeRW3qMpoTJglwsqJYFafaHHhLZgRKA0dsEPUsmhNI12Blam6wONWCMABc/GY5DhqbfpOYWCMC
+fjbEor59quHpR35YJQFCan6hp98FK/jD8SpaNGFH0DVCQUNEQvLvCn6XCltPUZ2ECZtpXOAkNeYDLetI
+aR120poTABz6Uw1/CJwwDdZSh0zIodgPTe+FwEerc7Wb6HdBnERhMJOJyoUwKCpGfMW+Botpazpn4vtZ
+1rkIy9iHg8RvisDquP7tazWTGE1R+SiVehn4f9qfPseryWfE/HO8WmYBIQlF0JQSjtH5OR8ikwuDkeQr
+pK4VcYzLEOc34bTAsxL3QRkt9jrfvs7bKTa9BwDnAJaXxnYwYEHdM1XXaqL9pqX2UDtsdoD/RvudyKEh
+I64dph8ZTbxzg0Wcim5hkdP40HODhRhbsg4AF8i+vJHHmcyME7UZ4/xmkbVlMLQw0ODM4QFpe5FOhBv1
+w7uAU9ocBjBpCowTF0EYoZVi44KC8L8zg1GAcv1omIlBmkOBw8vSs2wwx9M/JOZRYPnA0azAhosK2vcw
+GAlXiTaPwBTPC9dz155rc+sBaDhcBoHmWw6go4e3pTFzDQWdKBNFKaWB6Ws8IowTbR2uPDiwaH4YQeki
+C5rLNIzWVoSiYThrRsO39S8ox7+hLmEM7oarGE/6dG2ySrKo2rZWMYg1mDlzp1CmrYZTeRDTt4KtFiZz
+ENFsEgz/rQjAkZbkFzaQ79QlcawiUSiskGAWR42Tdr2JTPA0CJPvsciv8e9p5f/9mjPPzIFL2hiNGzKj
+qTEhvZSUmhjNpP6/U45INjPgbCibf4QOSHvYEiVX4NKKfhcsJg70FJa/lCRO5LlLle9oAB87RDZLFToO
+itKoVyTTDcFt/U4Eo5l10kGFMYOJLeXDI41YRUQYLDagHGxAGRcN4ChBGHyXQFHDdTqtQaEOzHZDlcNU
+CD+VsVZJ+EZU/gjlR6iHw+kSWlODpCfsMIjdGPHAIqEelYDZVvvetxJ7DmIcVaL5BeoArw+LRkzKf4yW
+1tAQQ2qRiuL6ZDQVfbvvOo4H3jAhEw1lSDDEhY+whBYGGl7ES9c5eP9ZS5s5fpjRyn8MowWZY0O3Bqnd
+/nyWzTknqxkm0KgfNRqaG9Mp9/3KjVCsqWm7dvRm97h3zLfb3HUcELwpVIUwL8WLjkSRaAkynQsjfZFp
+HjhNJ3xYbemo74QriIELp5EByaiRQRvnj7F1/w0xqNnA82oE8IYtRGc4inLp+ETAmE9RzgYhkWKwMU81
+fqqUzGkbXhLjpPm1/04cNMF2ZwGMZGA2nHg32PlRWIPyY/8Nkz//+KxruOUJObQ6CBN4OD8Mhx/N0mCf
+/pxJiZfPWKmFWDrrfGjBlTSTZ02Al/U81LHRt5j6Bdyb8MoUdHd4ns4kK90AxUZpzjI/yUQECybUtN9k
+CUlsMpHluKv4Mx7WfMu+viURqmY5DpNMGoDEcr1Ys5IdbL5dP2ndBXjpmmqgKPdAV4xQEhsmIBjrxqIy
+MwjSl9OEmZVOIY/GQ9SJ13MQ4Vqzyf/PWJ7PTPyFSEUgXnloqq9ZmhfGaNWCVxlBxKOguEpUMd12lCpE
+mmRrLDfprJL73llukJ+nCwAIuQbzhbRHIZ2gzQB6ygMOsyido98F4TLBufG7ANqwFQFLlvaV5r3TuTk7
+XRHGbTaWkAzUBPy6dEJKBUc10X7jfSabtlNmC0VKWAWYFOq+yBzgrgj52EIksgIbIBuAyRKKwqhD2sEE
+5HUYObU13HvjBmwa5RGd6uSkzteJ8mBm/Vl6tsEsyWkfweTCTZje8X3zteYAOMndxlqA5mnaZ2vluCGZ
+Hz64Dgg/p2sMJO+IeokROFHoOlo7W2FkgjA3sCZwb8MWejnUVzTbc+0FGrRgPBGTZTg+wUQb7V+wzA/p
+xpe7V//WSdYmBaAMHvyefYpXE9+F37Be0r6CU+uwRU41TE5M/V8v3cANAysBzil0fNrdq7kVOHevmOxW
+EAapx3IIGXYh5Z/uVPsbCCC4RjLyNYwM99/UMALp7rSSmpavJvuVqaOmKWoJ43I4XzhNhXudfs8SUkQg
+Euj/Lkz53qfrdgGIiLNFjUIGczx6EpvHkycHBakRiJOQeNh0LQMtM2WL8tTZndb88CvxWoiRrIkLS6W6
+KCxEdZMvxUU4aewuCTRZR0p5W44b4nbgvttzYC8m4YY2RtGCcdlqCI5YBSE13LMYB0OALAbp7JxojpUA
+icSJ68s+w9IQVPNC2/IkBfwwSOa0mvKYIutn2G3C1SLL0xAdFOygRQnN0i7DiesBZiQ5OmJcUgwSFLRZ
+Gt6/xg4X2XIa8srIMicAlk5DaG+bxnQAmjxw6BoJckrLVSKL3yerWXyI3cVhGM2O4nm4/p/JanZoz9z/
+j+v80GidHLePCSLsG9Vn+I1mt6vyRjDCgfaRBrjcuCzM8BRKT+utxXYUet7EirBlpLX8idCMtXiOcicR
+gErC+8UigHKGme7wIjPYkh6OZ53JHLiR9v08jNyvYZBY3ms0DlhugCfSGqFzSgmlFZq6wHNgGJ1ajZlu
+u4EfmeVx3w1qaF8LnPsENTJlfPNZCwMtJRLrhMgqQF4GHM4Otc//7bgP//gc62yuiSVXf/MZTgYIJ9r2
+IVwJSheBLDTFBkEM58iUHV6+IJkmxNHS4sQKHJhpQqF46v/3mhE2mq3OicSi4IaSQ5iNOlzPrWQ9Q/bo
+r+CqjjUDR98kc1BLZaxZgVPzwAwETi0di1BIx9afy1GwppP6bLq4BLfp0NrzloBzwhoUQfOsbUi6keBO
+s1Ayc658wN7gowTUi2HosiQujV/tzYcVKNjlgiy8bSqVm8llp90A60ex/UPTiJ7QPhtch02N/UYXi6R1
+laxFyNJUCFoY/OaScnRVaT13E1Ajm/xIgMfuQEq3x8qzKjRjLls2SAszE2dmiYIO+bA90GrgYdaQXKPg
+IAlHeeFUcwM7Qo2AMB2Q/pUGQZTHYTZyByt/AiI8chNPiYbtGtw5W8uFhZLi4Srhi2OFpKpm02QplRhY
+kc0Na2jPBvS8kesAvBUEbJLI0tBEEcBsVgTdnhYGGsZOxzcSzaa7dMJVREh+PoTzhhoJnT9rtmfFMdrC
+g6e73pZoDu6bPdSuwgQQnwRlITtNYtdf0qFtgmbnAOW7Repo+f87vDcwzWlrIAhXs/khTExoDp506FoM
+0vTHDoNZ57h7yMxMa+F0GoPkVKs1l5s0WOFysbgcqSE7B5KPiGwIRmZJ3OQqABEbAaW5CjqFgO2C0hh0
+XSrX1pnF4E/sovJvO8jVeJIJD7c3EyWsSRLEBShmynZ0iRPIuyCrydT1QG219ELL4YwfEpS4lJKZUYOv
+aFpL4llTJ0ozXOEqoVN1VcqKrNb5vhVthZJonwncey11UaRYfl1TNrshUZS/9Kx0bZeyoTP9Ag7jIbMQ
+5q2tbQyztADtzyOVR7lBlErE+dvPeJtythPjezQg/biKQP/2lixcXQHgAJxuxq3LMyWZ6n9hiv+WCJ7b
+e/VK/19+Tog59FCrTbwV3OBer/cm0ynZBQ93m8zCU+2bbrdRnzbJ1+UqWsKp3DfdabtpN9KvaFPFN+C4
+BY5t8i2Cc/1vHLvVaXfIpzCCvfBU+2bq9ECjTb5ugYei8G+mU7tR75GvswiA4FT7pnls9SiBBFge/Fa3
+T07SgvbWguUaPas5OSbf0JCOKE4pOdjU33TtXqfnMN9qjhVB0VvtltWup9WJXNiPRI3EwA4DBwM4QvEK
+bVIUpXWDaZiTzIoCFOTwtXWgYiJRXx4eWb+ZHk9PphYtmheYOauxiU81yefYP9U6ve5ykwf5zqnW6x7L
+QN7sVDs5acpAG+9UazTrdQpTnbuATtADtXgbJ8DXNROuIF1a9i36exQGia7dvboFsxBoH8Z3r3TtfTgJ
+kxB+fQu8BwADKO0KrACEGZFreRB0FSahdmvB4106sxkcguDiG9D6KJAd+uEX9+4Vy0L67XbrT0Lv7hUl
+zaEXnwu5HV2GQVh7D2Yrz4p07RIEXqhrl2Fg2aGu9cMgDj0rhqTfuRNARkKIg/n1w1Xkgki7gpvGdY1d
+k/197y30lXfPyzfOV9gzX227fF3XyP9epzK93LZz2dbrnC7+t9gnnQ2JG9bSDVXtel3W2h12cvRNs9Hs
+NE/U64f5bTjEyZK4f9+dzmW7jvkNx/JFi5TUY3bP1tN5Jp767bFlr/IuyZINkrkuWLhHstpOytwmSun+
+yQLO4hbKCrss/29tsOzV6/tuqnzKzZNkv2R+0yPjJY7r39IClfYWMri9zreP2lKI7Y7bVUjBRAhmbyEB
+8dsI2dhMvbWqeNtgSviU2fiXke90J603pb3096ffXlZOEgusa8WFGOf7tNylXv1NtuWr4l60Z4yc+PGQ
+8egR2M1LqHaCsTu+VF10z61Y4p6qPbY0Vdq7VLpDqX7Yo85K3JXEwtIuw0yK9t58VLD/h9npU3nnDj+e
+s6kb1a6XP+3ukcItIYy1s58fvxNk580eqfVW2UBRsBvhiTYi8I3Kr+PL+KqL0PVxZYlscVy2X0CRTftr
+/Z5E9xzn3192vf2xa8fV12hzK4e7rfA9cq1tl2U2dvzMvlZdXVMtrO22piYsJ/0Z1rCkCzNVVjWefp1k
+1+WJaq7yyVYb9lxCSNW1Q7JeNefX74JDCDmEoEMIO4TAQwg9FFICuYBBmFF15NmTJivB4byRm2U1uQAE
+C9LMl2LLIDlbuTINGnHhYqgmbUkxrhSqakdSqskV6zIayWeSUJlDD1hOASFBYa1sJnxImq+WV0+3AFet
+bEowr8nOYedxJPOKbz+WZL6RWnuSnEec0aIovSHkE3ITGHYUSf8iqMuNFoee64jJ2sPGay55AHtSURZB
+mmpE6L4VLSA2/FcMMQ6bwFfM0r+Z2tNj0MpsD7qVVYACEYejw+RPNOx9JNEKxsde7HHYyLdV8I45HBwU
+ehY89D93vTQsJI0WkRQK13kPyV0lbuzn9H5C9C7G4KvlEkS2lU3vDuUJJVXyTeYdGBI1nJlXRiASy8jN
+DhU00yWONGGBkr+n2t2ru7tmvdG+u6vXjfrdK0rA9We1qbdyaZotH+vIxnWEl8xX/iSwXC9vkp2owCin
+U6YPsf3nGweAJui+kc0sGZqVhWTTC8V2hkvWaK5BPZ7xTkUkwKUBJIYmb0aY5sn7hN5hh0diVobRZBAt
+JJ7i/Y81+CXL8f1Dy2jmgkpUZjFx8s0WAV+rH7YlxpyXhjZjvoWZ9Y98MzJdAsogkSPXkeoy98gkiZe5
+Rpb3pVwdqKCUjLQpykJ0pjH4CP1wGYEa3vOa5ZKg+aYG1Gqna7/pZKgGVxYRBiVC97GSRIB8LpJ6v0Zn
+uXmTd870M+8r6cyLy6Ozveif6ApL7Xtm0oXWwInrFaXjumeHVu93NTW0bF6FWq9ZgRpaaa9C7aRbgRpe
+nK9CrtHI1TUrz7rYF26+wyhcC52j5se1qQc2adKAAuBH9CUtQfJN8CfuTJJvvEA1UVAsUfoZixSEtdkq
+SUAUS4fvgnUdFvUfsEE8Xfz4L7R18e8wcRJ6NTrlFNRcfyOPX9KG82pwKgL/bZJ/W+TfNvm3Q/7tkn97
+5N9j8u8J+bdRT39JKTaaOhUd/g2bi4Bin/KNfco69in32KcCxD6VIfapGLFPJYl9Kkzs104YFnXmd4Zf
+I2OYyhb7rHi+Q8XzHSqe71DxfIeK5ztUPN+h4vkOFc93qHi+UzthWNSZ3xl+jYxhKp7vsOJ5MyqeN6Pi
+eTMqnjej4nkzKp43o+J5MyqeN6PiebPaCcOizvzO8GtkDFPxvBkr3iYzqk1mV5vMtDaZdW0yA9tkNrbJ
+zGyTWdomM7YNY28bxuQ2mdXVNtTwNtj2CtcrH+WuaG/SfuPcCrxhFUQRcMgAW8+8y8SK3Wz3FUVAwj0A
+HHKRorMoXKcf8jFh1pOzOqb08CoTdZ6ST4QW41/V9Bsy4seHLfSfbwUO/HeGLAvIaDdltBvdwy78T08k
+LgBYoVlIRr4lI9/siHSbnRzBZoen1JZRarUUWhAADF0OkpHvyMi3Gwo9CACGPAfJyHdl5Dt1kW4nnxLu
+CMbQk1JSWUNHaQ4duT0cy8h3VfbQVdpDV24PJzLyvZw99PL20BPsoVGX9gqVQRwrDeJYbhANaa87UVnE
+idIiTuQW0ZD3vHrOJgrWCTA1PAOaulGcCG6QTIBr2IWxf7GYnqVCbLRYxEZLQKzLseosUl3AaSg4cYwE
+nKYcp8niNAWclhyHq5BYn7Ycp83itAWcjhynw+J0BJyuHKfL4nQFnJ4cp8fi9AScYznOMYtzLOCcyHFO
+WJwTsU0VhtDgLKGRMwWVLfDGIFpDQ2EODc4eGoxBoEWg1PK4uD/vA0nhpqSwZIgjpVuS0s1OrlhbUkwy
+HJHSHUlpyehCSnclpTv1XLGerJhSC8eS0l2lFk4kpXt5LTTqknLHSjU0ZO2W96nlWQQ418B/lAaKslCx
+IFiUhIvyJOLvjChM1KiMG6WRYz52rMCsIefERwfqMFIZSApsmnI2QthYEFGqY0qBU0vOqdnJs2h2JLSb
+HQnRtpyoEEwWxJnqSFPg1JFzEuLKgpBTHXQKnLpyTp16nkWnLqHdkZlTT0FUbU+dAoPqFFjUsZxTV21R
+3QKL6hZY1ImcU09iUT2ZRfVkFtWoy6keq03quMCkjgtMqqHo4ydqmzopsKmTAptqqPp5XWJVzEe1myI7
+mHw2jFUGsnwomyPhWWoKjRZPodGSUair0Os8dl2G3FDyFljLkJsq5CaP3JQht1TIQqWldW6rkNs8cluG
+3FEhd3jkjgy5q0Lu8shdGXJPhdzjkXsy5GMV8jGPfCxDPlEhn/DIJ1IjUZpYQ7CxhtzI1FYmmpnUzhpK
+Q2sIltbgTA3HZGwXyeXR82Ub0rIyp5/hNKU40oggQ2pJkZodeem2tLR01M6QOlIk6QCcIXWlSJ26vHRP
+XrpYX8dSpG6xvk6kSD2FvhryNj8uVlhD3vr54aXi8h3Myf9pwniyPPAyYTxcoXiBMB4uvLxMGA/XdZ48
+jIcrRC8TxsMFqJcJ4+H61pOH8XCl7GXCeLgQ9zJhPFzne/IwHq0YvkwYjxYkXyaMR+udzxPG+86jw3jf
+eWwY7zuPCONlrrZyGC9zoJXDeJlPrBzGy3xf5TBe5s4qh/EyD1U5jJd5osphvMy5VA7jZf6ichgv9Qs7
+hPHSzr5DGC/twbuE8WwXKQvj2R5RNYxnO0LlMJ7tAOVhPGvxlcN41tIrh/GshZeH8axJVw7jWVOuHMaz
+JlwexnM2WzmM5yx1zzBe2DcH9678acJ4so3mZcJ4uJPnBcJ4uEHpZcJ4uP/pycN4uJPqZcJ4uFHrZcJ4
+uA/sycN4uKPsZcJ4uGHtZcJ4uB/uycN4tLPuZcJ4yOqFwni0L/B5wnhv9ugw3ps9Noz3Zo8I42WutnIY
+L3OglcN4mU+sHMbLfF/lMF7mziqH8TIPVTmMl3miymG8zLlUDuNl/qJyGC/1CzuE8dLOvkMYL+3Bu4Tx
+bBcpC+PZHlE1jGc7QuUwnu0A5WE8a/GVw3jW0iuH8ayFl4fxrElXDuNZU64cxrMmXB7GczZbOYznLHXP
+MF48sAI3ef9p4nhuv/lzx/Eb70Xi+I33UnH8xnuGOH7jvVQcv/FeKo7feM8Qx2+8l4rjN95LxfEb7xni
++I33YnH8xnuxOH7jPVscv/EeHcdvvMfG8RvvEXG8zNVWjuNlDrRyHC/ziZXjeJnvqxzHy9xZ5The5qEq
+x/EyT1Q5jpc5l8pxvMxfVI7jpX5hhzhe2tl3iOOlPXiXOJ7tImVxPNsjqsbxbEeoHMezHaA8jmctvnIc
+z1p65TietfDyOJ416cpxPGvKleN41oTL43jOZivH8ZylVonjD5mbJvO3j8kvGpFfYUAoJXM9+z1/z0N2
+6aR4aVgSLgvut8nu5+CZAcvR0qsnRYr0gsrcHcnNMrro5vED8u9volgl6LXYZ9WA/sxrosVe0IILYh7p
+/ThFl5RIsVie2cf9qQFLRRODOMpEtzTcZw7hstgeiGMJUfzZkX9WCIKBqpZiz5eRZkgidwkcUjSJToNk
+XgunNXiv2/ehk17ok79URLxbqd55LZDG1w9TwpLbiNl7ScoZ9HIcyJMWuvhB+wenGearo6rON5Njx8ru
+fBcwZdQSR/It1yoUouw8qQA9a5IXACuNp8VqUlKRk6nt7ELnH1xNigvOd+NK3xbR85+ENuK+q1vJ6TrH
+zkTFRkExcaRfc23FwEpba9Ka9CaTIi1TaiXtZR/bE3u6C6WCFpMUne/GmTz7oosfxPbKvqpby26Brj2R
+M5BSSxzJt3w7pZDSVjqeOo0TUKhbTKukjSYNZzqZVKdT1EJiwfluXN1gGurcX0LLpJ8KXB0AHSCjm6eT
+OOKHXGvgz+VN0bWnjlWkQkiopB2sieOATkUiBY3Al5rvxo+8dqSLH4R2YL6qm2I6BWBiyRlIqSWO5Fuu
+TSiktFmmU2faK+whhFZJy0yn4NhqVKdT0Di5gvPduOJnp3Thb6F1so8FjdOx8+6LIEpoJU7+U65lUkBp
+wwDnpFvsujCpsnZpTOqTXmUyBc0ilpvvxhO9oqTzfwptQr8VNIkzdaZARlpCKXFyX3LtQb6X95PJ1J7a
+RXpElEpaA9jAnnarUiloDKHYfDeODrm+NPsr1zuiRXFD2F372LYkdPN0Ekf8IOkV0YIov6gRTjonJyd2
+sTFHi7Kx/GQymYCKRAr7Q7So0gJSfpYNl+504W+hFbKPjvbb3pMkTmhMslhDj6JZoDCx3Hx/AbRDZEOp
+OWm/VbwIk3lfUDCtdqftdDpyLqnv4Nm0Tzr1Tk/FCZwAG0xlnKQJBodeJLxLLR7R97TfKioBoRWmYupq
+hMdlF5qdjq5lP2Q5BoZXebpBrVIFO8bu6NI+s2DY6xyeMGftsBgRiJdhELsPgLk/Q3IPaj6zyNw5uuHW
+1ek9/ykY30eKUmZJuLLnTMpTJsQ/NGn78S0o2cXArCp2e8VV9Z0/QVV95wmqenLSKK6qN/sTVNWbPUFV
+G42Tk+K6brw/QV033q51zdGo/LaIVP4q0iv4KgTPu072PfbK0qa3FtuWZ3/fgK/KaQfpcoJ2ANPNr9+I
+CXYMZNccnuaNzeLhUOZ6PXd5yrzAtVFdQm4Dp+1YZZeQo9viyYWZ7LAGr/3vxBqwYlBzg1q4SnT8YM/c
+csJ1Hio6fLw3K65FwFnZwKn5YXopJ/w77Tn55uMlok/3/S5r8NNTuP4JNksrUEZ6/At/ZSYkeSWvagsJ
+aZv6xCEQ7lE8jVEi3mFRTy8sT8O3RrNFh9Jm57VcTubNm+UqqS09ywZz9M6j9pv0gnZNC5eW7SZb7rZ3
+QZnh1yehBFvlSeV6UnJPQSR9zkvnTfhfEbCcMPC2/9Z+Kw9w80zwO2kSi8RKeLC8FdjdOPPyozeCdPEj
+epC9ohPNLphEJJjX+LhHA5GHpd7zALqn19IXBJUFJQ+uVnvgDjtamZw0FJGJWlFQsZjiEZyqEqUhr0yi
+ZkWRmgUy1Q+Pe+VCcdaw9CwXPXRWeVgVnotsFb0XyQClLVz1XerqDh+PinIojeyWG61eog7+c+zrWqWC
+os1Vur9dYMW/TyZELwXBS5PELrLQpcwySp6+kFdSKmJDLSCSr/jtmarCsTshJO70X5Duv3VNBvJXXuIu
+6XPcsqdY0of/JIGL/OUWWAy95Vn03A5TtrDDcc/UCq/yIOwXfiNB/kQCe2U6lSp75ID5VO2JA/nN7Dku
+6HnJwivgJRrlaTZkOkV0a8yzqBl1axKH3ioBksZpSd/1rpVxoIGF9v/TWCAzvipeUJIXVj1XzjPOHsLi
+7AYD5ObDwHgrItulbECe+NM09AW9ehVz32WPbokPYPXU2kJClzZRnFiJa+cbSMqt1WiWvMd++GB5rlOb
+AuDAkUf+ZKFy85vQcQsfyGoeW712R+CbhCF0UYV2iJhQzl9r6JnudPNuXtTiV0HzQwdblcPGXgNKxbxi
+G86Fuj1d657AqdDJ6+KpLNbT2kJhsutYCXD4kfkUfReG60OXlJcmdmkj5JySLHXwWqyN61szcKqtIu97
+9AqtdYq+HMUPs4ON7+nftmz4rvfG94L4h+/mSbI8PTpar9eH69ZhGM2OmvV6HRb+TntwwdoMNz98h84Y
+aMfffdsC37bspZXMtanreT98922zhaX9TnN++O6yedjSuoe91rvDLnyysGXXDtu1xmG9fdju1hqHba1x
+2KgdHnuNw4YG/2wdtmutw2P7sFs77La0Bvy32YMe8bAHN9u2D7uQROuwUzs8RqQah42v3x1hOaCQ37bA
+3aucCiKwBFYC7Y38KhbIrBi7Bg0pmJkPEA03juU6xkZHitPSOLx8rfpe3Vzw9EtlNGy6QG06xTN+wcyb
+nR2kg6ME75H03dCIQ1F2Cin9kqKck8qNubKaSaOqtLtKgUK33adzZoaXhEu1vVWwR2lj2as4Cf0ajjOp
+6+G+Psr3fN/gaqf9XWtpR1obxtnyCj+9H2prHakfwutsxA9p9Xd1rTlvf/XrWuddXWvN2xK3kfkHom9S
+L+IUjo6XG61RX270/1POFA2RjGo4D0ma+eiJXJ/EXqnvk1vtyzo/iXyVvF8hXub+FB1TyqGs7O4OMJ+c
+29O/S3HlTh4V3cHTq8rvXVtm1kNJirMYPV+YE6F4iiTE0JWlqKhyNaqocYX0KoUXFt9D38Rg04YURBag
+qd5lSJw4Mrxd9L+7VOmjwPtKxz8qrPJZewhb1QsVoueckbpySp9UhvKkxnOKDBU8urmq0pE2W6ttA/UC
+CIXuWjU8tj22YpWoaL89wziprBN+eZxo/PXT1HA3mvv3PjgCKfwEAgmuKysu8wwZxtMKVNUXqHFzjkBR
+EaUXKCy/vwsQJc6Zd8VGKMB7tmjSDV4sc+fYrU67k+P8fyp312zWda3TerrcHdGiJFFBIFLLoU3xR+fv
+6LQSS8TMQWtNrdbUelqPnYXGSRQuAIcA56F1re61tJZfr7XgTDqdMNpuZHtAi3747rAjfLM3P3zX+k4O
+2qpBGEtWAk9Oh/+7Mn1uwPgltYkVTHgZQyt2UULHqJrtS438/6flPJm+O6o661fCp0Lxp8r+0Q6vzP9x
+Hf9PnwHMHFguffAID/ZXFvAvl5y55D8uXyh6UJWNv6wLlcpY0YeW4hbkDks4VSn/JDnE/UcNJX5JLnGH
+8aMI5ylyigzZilnFAgw+ryVE+DtIUrkZitGL84uVGqEU5alSRQzpHTONJZi7tMk+klXIgVSkUOzv9hK4
+ugcrJVGed6zmz6qgPbFRPUEGckdK0qYE7W696yiPNKbQ3av32CzkTnS0355h7C2o15NlIh9Bdf+eySTD
+8l6gPBFWhPPUQlX3FcX4JXnJal6iFOdJ8pMqw6/cMLvmKJ8iekUDs3I3ZsVdvPCg3akWhWthJ6/k+857
+NvNi5rbeSk6JlD2Zz9JjFFxQfZkCCqujqhCHtrTshQD7sooTd7pFvgMEiQCVHlz4PVen3CbwvWtW7YLx
+IlNQG8Pe+ttJC8JRP3E7seSQLFMx8UpG33UcD1ThJx5mKeIsIYUPn6EW1PNQbjqn/SaXXilg1nX+/Aaf
+bxLZHu7CqrK7tJUb9RkZAzBD34XL/eN55AaL7GN+b3d+d3e2eFNw762sZQWjfWG1l8pV45ymqjti3z1J
+AmFoydm/9Aiz5OQV7E0SFRR00vTI6ioGUZpYyRaz0OlTFShWQORfqx8Ky45LiyV2P/td8fCU9OS1+si1
+UBVpmRc/tk3NqOy09iQJSi4wRWbkADuMLI5Ihp/mFCdJcIijst+e8lg1JMsc54Uc0z+137hjuvXDLg3A
+LR7vLpi6wHNiwJzgsTI1LUM4+kQ18ACCJM7XkV53WvUqm3q9N5EfPKeQHPHdrnb5pl7vnjgnchbdpm1L
+WTBtlX475FK+RY3UOta1RjtrJb6RKD2+sShrvtGeS4dodpmyeo3+PMz+JNdTadVRDrMbreI5PifH1TUK
+l064hiPbbOaBXRoPN5Gkbh17sn/dZC1cqYYEsVo9T5/KZrKraatqjrkEQNCccOCOI79j3+pY3Wb3WMak
+0+5Muk0FE1b39Gv1/tVoQTeINVaoLLGLZRLs3Mn2VOcO3awikqKjMXXes6vRFstfCwY6nc7kETWUN/gj
+upuytqdPZ0TkkuGq6uM2dxXtwGOI79jbmo3j45a0tzVAD7TaUhac6vG36j2t19S1xnFd1056ahXlehnh
+vHMf20uFu/SwKiiq/pXWdc/eRVso33Z2r92q7103WQM/pmfJ63n6RCaDLouurLOe1ZzI7T2F8JR37E+N
+1nH7pCul3+hZx5M8fVbX8EP1ntSBjqbX1bXGSUehF7EfIY47d6LdlbZDDyovr+g+uH779p20NfJVqvfq
+vel+Vco15iN6jax6p09lG+ll3tWfzvhmOrUb9d4b6X3fBJKjv/MDHd+AunVcl96m6rROQL0u5cJqnXyr
+3ouaTehdemgWrNaU2JFS1vK+9Aya3KFHVUJRdCpa3aJ+VVw92lC56tndk46iCXfuXbtUUt7BFFU9fSrL
+IdeyV/VI3CpZ0fJmRnvHsck+brZaLRmHidNstOoyDqzK8addelZH145bimGbUBP6FWG78xC1j/Z26FJV
+MBQ9Kq3nngMVbZp8ozUb06azb8UkLfuIviSv5OkTmQq+LHsXD3s8PZlaUg+bQgTqe4xUTdAFUh6OBeqg
+I+HBqhx92aEvNbq61mz04I8TlYrEzoS57jVE7a7AHXpTBQRFZyLVfMTglLaNJL5wTpzpnhXLN+0jOpO0
+kqdPZir73QsvOVzK3RifUt41/9Bq9pryONxpNprtPH3eb0WL6n3ouKlrx8e6dtJSaCU/GEWL3Yei3VW2
+00AULSqZVt5DRwvRpqrPltK2kEwAG1bD2a9KuaZ81ACUr97p01gGWUyTr0PttFAiUNp50ekRrFhVC7Dq
+HUhYKSzWk9iZRInk/YqtZMlKtbqyO/SnnVAVXStX8b0Xph7RwDt3uH1qLu97JfU/fWL7Uqxb7bSakqO1
+Y198JDNZO+yzaFWHWR440jealTSm6pNli1hsdXfulXutXO2IXNIzH7+S9cgG37t3PsXKVqkWTp/e4mQr
+XTutxQiUdg0rH8NK2g67LnMJ9y0U60nZLwuXvdhK7t4rd1/r2gm1rEc+cu3rUQ28f2989FpYSf1Pn9i+
+8mtjO63lsGR2XQjbm49M8bstjMEYotFFi4btEuWoel7BQhlbs5273a6rY9XxSjrc41bL9m7MvbvaI1fP
+iqp9+tSGJF1N22mVR6C0ezbyUdxk+t99HQ0G7Q04g+6Vq0rV7YqX1dhK7tzz9lhI2wm1pP89wcLao9p4
+7474+IW2EhWcPrGJydbddloe4gntOO49hpNM/bsvunFn6Ap1pOqFhYtwbAV37oS7r7ztglnSBR+5EveY
+lt279z16Za648qdPbFeSlbqdlpI4OnuMgvvzkil/1zW69rGuNdsnMIFVL1OSqu8Vrdmx1du56+28SrcD
+YknHe/Sq3SPade+e99hVvMLKnz65UeVX9XZah2LJ7Dji7c9H7vB2WdLrNHUNbnXrtktUox7qlEt8bL32
+GOh2W9erjlc6yD1inW//pnzEAPeodb+iap8+jRl5bkB6VuHxT2b1pvTcHqIp62b1eqc7acmJrAIHRJ4r
+o8TvPgi4DlRIh1eMXMzcppIg603KZYLSU33ejNBDR8fJ49PZocBnf/8O8UYvFGZCxL5KiOd8JRDyR0eM
+d3peNMM60EQS/MW87D2y6IT5v+BT+T/cvYpXE99N7l79O8PXhSIRiEFxickqScKAK6K87eJwajnEGbFH
+Y8khUnIAF2rQivZ8MpnSL30q2XIA9jPQm7wWT7Oyb5F61jLOlxVvHOaK02SQ4hB/aiB17oHwU23uOg4I
+ciegcXFowOSA8n7aEYUr0xF0p+hWB/Qbuisg/QN62fR3D0yTgtpmtHIj0nruJqAWLy0bQP7kvg0pwump
+NU1AJOg+d0Cfv7vgsNnpAF969J6F0ZsF7l7dvWI7bPpeIfDxQXgWlj2Ml0JV76fy1w2Qj6mEKmy5EoC/
+TLacKhRP8VFEHwSrHa7VzugwV2w36mSoy9+yPfVCKzlFaFj/2T02jbrwwi115uwDg/Bjg3jX/APG1O2X
+3LFA+XtunNTiZOuBwisPHvN2fLpTgfzvsNGpdIE31yK1rM9E3MuobAvI8KJsfs09koux2OdVy24W4unG
+PiNSXijeMH6XE2BkE6QT5MtcjETIXvdYJaTvPFJI33kiIU9OmiohvdkjhfRmTyRko1mvq6TceI+UcuPt
+IyUZUjSZf0KOiGLQV4Cljw1I38Y+bOR7HMfrDx9JHjl+yAei6iOJTBk7jSi4xZWNh+snd2jl7ZjWQ9KK
+It8/UUigajSuP6gbUoWea9Qq+nhUW0p0mlMQRwASrWgLQnhBTaP81WGJMQh8X94W9hKFmyUU4LJXb5bW
+g7m+60WNWu6Iys27rPY7GXAFBRYbMDXbf23gHXg28EGQ/H9/uHuVhMu7V//WteJSSBkVymG1VCgIK0Tf
+eRe9aKpc7gV9Ss5xH1wn1Rk/uczCbCb2ls44WWPIwt1vwAmwwTTP002AXzFjIUmlNLJUiu0BKzqFNZzv
+fcmaG8xB5CZv1LPKXW4+q8trixN2uiZ8ZXJtdFdMt3HcmBTmAovXOSTcD2m+mGePP8tTvDsxFzaZ88yZ
+JCDPft9MYNWkOtdlcOq36PZbWnwOLAdEirKSBGPuJSh+2q7K90kqXCmrAXVXyy7dLJYw32XE3pDlBNOb
+OLM/aqkbLExJcfdrkgFHeq0uAyu+1FCQiaRWFaJxeVe5hNlNrw2tkXnG3Cc5X9J5i7izCfks+aGmyGbd
+OQC3ssNCmLUbtRR0nUNdpAKZQ9YvKGqThKE3saL9L1SGtn0qXKYsfBOu9owTK8LONnevJyqVwvNCsjfN
+chnm4mZHS0tTN4qTmj13Pee1LimF/8iXlQQhtcZyU8LNsygBspTFBymlIjAEuI6RjdA4FMuSTfk4TFLi
+WTSkkA8qq1C8XAFpxrMWLz03kT5jVT/sdLMFIOGyXQZWQJeEmrp6fiwtJ586cUX3i15TEmwMK4T6wvKV
+dqAgoljXUhVXaLjVUyqYgtglvWriZGt9O4qjliYvjGzoo77IcSNg09tlV36QuS85SLzXOHNj3KXGnAsr
+utZYfamxsgYVhk+Jc8ytGUoRS3yAnE+Ju0TzCLm3lLPfzW0WyVTiP5UOsqKPenY17uxT5SOCKC1Wo9SQ
+OBDn+Lk2ZWPiQuoav4psOW4IZ8BVEdB16JNwU4zDy7krxwJshn3h2hleMoL+glkQel2+/yEXyVSJyZ8h
+JKPOLAKJPc+7MxYg8ydsPf7BvyqgF4OzRwckBblHA9Rw+OrIk0wYssp9+6bQ2AsqpB3wf+vlxcuqqSgP
+q11ZuzuKxSPuIqAUUyEqR7eChLnyFQ0kh1AsD4RWlyYtvYuxHkhsVz25KNB2NkcsqnlZKSSE5IGgsud9
+6GyypZK1EgOeVrtSvcXhvVQFIsITTqRK5RQDgXJB88HUE0ypyrznPqPMbq8iqSXIt4/kNapHU+AmZc/R
+9jl5uIYseSbridq2tozAEgSOoK6atYRfd2xqJXUaO0pYlGbwaGdvlrKQ+i6WkdSJtErpkt2SekkRFiaJ
+UrLyYsFHI5eot1KJMgHS4vsIX4QrTheKBzWqB0meQ4ljLXMoJVwkSfZncHRlj+KULSrwG5zF1S/prmJ6
+lf5Jp97pFb07VH1FLFvtk219+8YGTtuxqmx3y7WAcnpWXDI3FRP3DOTZeTPpcJy+lf9al5aXvFSWKpx7
+MB82lHagwf1fFblX4CcvIusr/yjvoXlk0mn2wmUYSxxPEa+X3MzPShD7YgsUt3/s59qjoP1JHQosIM+/
+Akd5kT0tIPbVrbI7brEFxP5uFvCcJyl27GXKhhcSzw0hvSwEf5V1tX+LylWsSnbuj7yfGBmR3fO5u1IX
+JXzBCZ7SxvWqZXdUL7EPZYL4Meh7isKQKcx5705K3rDPMiGWPV1ZloFl9t65AeuW5CtSDe7UVf51bsU7
+xrvsu+AD5kYhO/qsqCKRTSdQtcYb1UEoCc3dHobPnb1Q3HFZZXOSVJone8m94EVENeMneFz9uD5xyiop
+HJUluzz2a4C8mictpydvHgopkC0VSyWOYo/YIygK6iyYz0iZMFIpHIB03iZueUrCZSEHXsqig1jsG6jp
+vDbzMQUbLdMi1DOlH5Tb7yS7gQvOSZG54DeWM+lMHDIfZKfc8nozebeXrXZR7aASanAUshKtU/9WO0I/
+O/Vvc5Uhk88durJqPlxGsaI/ZfOYWWu5vjUDp9oq8r6/e+VYiXWKvhzFD7ODje/p37bs+GGmbXwviH/4
+bp4ky9Ojo/V6fbhuHYbR7KhZr9dh4e+0BxeszXDzw3fQJR5rx9992wLftuyllcy1qet5P3z3bbM1nU6/
+05wfvrvsHna67cNex6u1DjsnWuuw22jChmsdw5+dd3Wtfdjsas3Dk15b6x02O9qx1jxsnLS+fneECUOu
+37bA3avXO2oKjlcJiHw3sBKwl7Pda7x5EqFetBHbWpttxDiJwgXgm7GuNeftR7dI6qp3Cwryqi+5CXpH
+Yfaxkz1FQkmtPVyFxPHISf3ZfEStrdXajJew3cj2gBb98F3rO95blNpWUYVf1rDitZvYcz4PgIekptyl
+E4QKIpGRrckMduk4xm5tEwZsy/PkqY/dhWEsAw27KLGVpmRoRisVFEFrzTyYCI3gKB1a09opjM+ZCUCJ
+n8XxRGEF+WsKnvvRd50+xY+4wjSe9GV4XqqCsi/yTv2fSJqqCtznAosdjbzsgotieo9yt2IAndMJaSXP
+SsAv35OVotdZSxYVqS78C7tOJoWqPrsoHg1TZNl7otfJr68RrylfcHvytTXlORd+avH08VtHGoTjC7lw
+ANfU6u9QEPfVr2sw7G7N25IQm5n2RORCGaw3vFR4dLzcaI36clN1MrjjwmDWCWBG1IqswGZv6aj54Vc5
+RPJRYnf8bV/yvIqmkfvCsnzh4/JALOvTU5jCe7C8lZBvYYxIqVUJ1X/5Ky9xlx6AJ0d5CLTrf6NUEPr1
+h7tXjbtX/37NL1vR3Y0F++jzAWKBgvd4G0SRfyEEkcLAZmnl9qeopYCrNtVX57LK5/IM2U0v6bHlgtMk
+xYtVMjG9WeVF5JyQBTKq8s2FS6qcfKXbZp/edSs31Oa2BlbfPvRYaejlW8pU9w47ItX+5rEOhhFCkgvN
+yVG1AzJkPSuYfQ+C11KyXHjD5NTMKFzHgLkOId+SMnL/guNibYKQ/y2nbSVJ9D1TTK4RaeJWkld8o7gp
+Sbzh6pGRSC7w2DfIePpRV6G76qnZvAr5ZDi3+U6arC3SaxWNVlWe3D6rbXMifpS9R0BUKbN5oUnvUhCy
+GMEMyI5fyebFUAVtiQYq3lXwTLEUqgIbStGAqaT06WkqUey5aIV2vvInqtU2aMTQsvW9nCLPFtYVfXlB
+lvHz88IVQxzhjJnfvnyqML6iVihdM+EO7rHhUJW3CsXrrUivafwROZzyDqLqBnvlI0o1XzEPUUCHu+Wj
+aN22AsFoFQQwkKglkSW/rDWzjXruzgnRD9mrKIbfSdpSZS8OgO9lSxaWVVcN8faj7B+5jl9q5f+Zxryz
+Q3+UJUs1u6MVCzQeZ8EMsf90o4339snyK9KaQlF6udl/tME/j1nHj7Hn+EkMOX5JC1bYaTGUCCVfYGLq
+AVORNS9cF2S/8x2oYhdBtFfLpXCTRrrZraNOUu7DkqbJi2LYgrWrXUjKRt+0MR0wtVZeUkpRHvHuLaDU
+ue4sU7yXMNJlCF02Y9XlCw0v7Jn2d0KqmmryI4+adGd6mc8KrIdnvc1JSIyqt8hJLuKmEjIvalS5mY3t
+tik2vYqPfih68UIqwOH+N9ep+gYknViTWHLbCJe3oT5KQEO/ZfcqCorlTrjxOJlC81kihZfPb6BmJ3zK
+7fNCXikvBtcyAki9QoPzMdm/hRqq1nqPGw2VfLOr1/LNhq8nFFpknyyfMJpl/7KLRRl/ySW8kkt4nuAu
+Gchy6XqewuwKzUTAExRJoOnTPnIVTivv14P4MIYQu1T1K0GkRykz4vgCJxc4Kg41PDhEwEnXizKnOrFi
+l6o9Q0BZ2AeQpqPRx1kUrtMPBSIl1iS9SwqqD/65tHJHDDg/KKCwUa3ibs3AeqC3Bf6xN9ZUOI4r3L1F
+LtySX76FTsbWJiBZAxBUGoSgHuDZsTBILDfAV8NJPtem3sp1XuZ+xZdRDauB2iTKr9zmFg2Ftc1Wo2gJ
+loVKD7hUOzRafh1wvh7swJV93CWsgFiPCsB2vpbuScKxVGzB6Yq7BhQM82QK356JEytxbfbtGJk4kjP7
+ZXZVvmbOcUjfdSpx2nRmzvpt+rG6697z4pRUHei8ZKQ8Syvf9aQ8Ub3jbdiloWTBqM9IL/av9POuPYzg
+1Vw7DKruNTvsiPm1Q9WF+cy2rqpnSHCjsW3KKDezI+7uNPpei7XJnufpHJ4wj9+Q+uL9MelhZWbAKSvC
+Dj6K7iz3IL9XfEIox52wosaOr5iPwjV76YSmFcGU1wWXXRicPlOTEyrnmeUONwrXrHgSSAX6Mt+nXF6v
+Qo93ybI7cNMuXrBp6fdnMCchRMm3b+57SXV5hywfP7X/cv1lGCVWkLwRSsESebDSrzMvHbGenX2wqEhY
+ziHL3kX7Xd3Ne91eQTf3ndJ28Z2Cdnmabs49wpXj/ufr5r7zvN3cd562m/vOC3Xzx5rT03Rz3/kP6ua+
+8yTd/OSkUdDNvRmndL28yHN0c+4Zuxz3P18392bP28292dN2c2/2Qt38seb0NN3cm/0HdXNv9iTdvNE4
+OSno5xuvtGE2XkHDPE0/51+CzLH/83X0jfe8HX3jPW1H33gv1NEfa09P09E33n9QR994u3d0noSQI5J2
+ATXkUQ/g8HLIM84yKNvq+yTyCMlcJyzqgvLvZWQrP1VdSqg4fXlYdrjn6RSu7mSyr0X14ntXlb5V2LPK
++5WkVxXJx3Uo+TITKenhN3PyCwfCCVPyv8OT1xUoiClFWZH863y7spIYl4JSZxdKxcLzRcsr0duFtXzx
+XCTZqkJSXLDVC0uTRcbK5anAkE3FopL3EHdtcc6si9paXK8XCzWqMWJy2bvf2MGdFm7B/31X4ZCx5EqY
+fA2/IzAcR/7wXZN+8NwA2Nbyh++QqPSz7yYg8lzfTX74rkEOJ7e13rzZvGxrjQ7+t9maN5sFV4LINUVX
+hPbse4iA9TizQCSK+y0ps4/XcaxoUeAhxb0fchRROkmJvGylpEtcIDrKkv2QNYaaXKHEldxgjn1vF/4F
+zjBHuFmFcIFLzBcu9IgFQgsOsaCk+n1YdT2UDlDe0lI3mCvaeF2F55/IF0oq+4d6RF5dUoe4a0+UeMVy
+45C6QVmREk9jW5Hz5LuJdt5DweRJ8NRoHUYOCdInEbAWNfihdN8eA0KvgtHdnxvFYnouVmh2XldZVUda
++4c2j1TvQyrfnSSYh2gzCH57jr2El/lOHrZmgL89+dZRiTTMVc85YTxLKov8Cue8PNIbgSUS1Sahs911
+ryDdktGQUUzcRHjsKdua0ssXj1eTPEZ6JrNV+KIEyxReoSzqrBglG4yr7MagKOhVK/pH/mEOqU7Y17mZ
+DS3pvT7NoloWXVBE/ndYb71+U7gHW9H7ciIqO0FqRNwdajXI4bX0W12ry+hrB6yp79QHcw04DcOkklJ3
+1h/itovysCiqTsscqa+qP5numL32wusx9cOu0oRqkmdZsKXyaILpSFuPbFuuKkKeDUPR9Wc12Pk8a/sE
+tzKwKwPF7sn1Z/LDaOWGnq9AEi4LieWGDjnVgmGkkhhYF4WSSAeOEmlkg0ipPA6wFy+2JVTky8RYQjcg
+Z+gqbfQS6iHNhasXihiI+MgTloGBpJc/p4DfBf5MfQr0KdMoP57Xtfq3jLTCpxLtF4aTyrOKirFMHC2Z
+mjONybwV+7JWlD1x92SGxNRlX0v6XaT2D94yqje1tE2U5A94Nrk4W9OorxCWZqX0FI+XlD5fUuUl6+o8
+Oe+t74jGBnKlcu8jE67irmKxodDjtZV/haTsLFXpSyQ7sdyhhSR4yiZ6KqGqNJEEtaiN5KIxfgn7qrjA
+LwmTq0quKaWauhFydBt/rtnhKkjSe67InRxykAKBJzezlvwufZ6kBKyGhNFybgVxurkfRT3hmv79e76K
+8pE0t4M+Hz/RdrBsO4wcNwx47wtjWOyy567jMIeGcuVZywinNfjiH2+zpZOv+m7EGW9B+f2miPV34sWx
+KSdZ4IgqPWCUF8CzFPz3P+uZb968Oyk6oTyJgOXYEb0S4HlPpDMTXcW8r5Edhsmdhap4R5wqcZRVFR8E
+PdByn34r3XlQToS/pbn8ZFSkukyEPSbNHHI5Yu5zrCANShXxMuVSRqvAAREU76kJc7kogYB0yUN4bWhp
+zdwA0drZOmWb7+Q2VWw1S2sG2A0rlV8gEw+nqhMa6cFv4QbFJn+FYvFLKAX3Twqn9GmF2CyicGlrxrPT
+nbTeFLVtxU6Zu/hFFEb2UHTzSS+DRrxy6TqxgSWzhR0S6lUS2JkcbCZdEKNCgr5qdl3gmvY7kaNwzeou
+p/nVr/aIvOkqrsh9n4s9aGZ/15saJP4F7TQW+3o+O9vZ/VFcNadSW1QbXquS3bUEA5BLsJ8VtqoZoUqE
+2C9S92Oen1WzeoS+m9X6eVllH9PrK3Z6LlixnFnVOASrHWm9nVd6r/Nt/trknvTa5H2eOBeP906sGJB4
+pPCpAvaaJfVdUX/K+6aYtim5LsrCZdOl/PTPyieycXHgL5Nt0RbQSRJwUikiHfauGoY+WmNR7OntKrb0
+doEva+BGPWfDtWXk+la03fOiGYunImiSflZuhFCw6DZtW8UC0cqxOJRd2bxHWMNENZh+DOwwcHbREB9p
+WyIdUUcZYDctddqdSbepZiPoiQL21lSjfqxrjUYPKkyhq5Vtg7h6FZrHVq/dyVUBU8npiXzeTUsN0AOt
+toqFqCP8eW8NtaExdXu61j2R6scNpmF1yXtWc3IsSg5JiJpB33ZUS6NnHU+kxAWdwG97KwR2rEa3qWuN
+47ZUI2srCtxgxsvdbDQ7zRN1/Gk36j1RdEJIVE36WaKdYi5O6wTU6yougo7I5/3VBH1P46Slaz2plhwr
+mIGocuM6dquT71aYiKgg8nU365k4zUarrmAg6AZ/3V81zbqudVrKLoV3H+9iPsfTk6klyo7IiLrBH3c3
+HQvUQUfOQVAO+ri/btrHutZsn8DBq64wnKj6FXL4Ra18q0aLvNFEi10djtNsNNtS4jmDiRZ7q6TT1LXO
+sa51RXfzZeVPwiRK0100NG8WpUyLrpAuyo62dlh3EQRjRIOPY2Qi/J6ryR5n3IqS+h6Iip8f2mWfWoOf
+Uz3iEiUkF0q6i+NEdskZWzKb8klmVGxBx419N47dCX0OitdhOy8Fi3Joe2EM9tqpVKjIwspJg/V6vV0/
+7qjs1LZBR/Gi/bFjTacKDvMoN29O8U6mtqPEy7VCJmWz12kKWIrgunXcctoNZc9rghboyGrkdJ1jZ6Lk
+UVAn+9ie2FMlprJWzXqz1eyKeLIwuNHp9JptVZ2cNnD4l4yoZC3QtScKDgU1mjSc6USFp26lSRM0WgJW
+Pmyt2512t66sTAPY04bU5ADogImMfEFNrInjgI4MSV2NbtNuidWQxprHnW673i7IdbZsR1aT6RSAiaXg
+UFCZ6RQcWw0FnrI+nVZrWhfrI4sKe82Grbay6bHTk1vZtGPnrYwwKKpNY1Kf9ORoysq0TxrNRi/ntnNx
+3HHjuHHcVNYFwP9K6+JMnSmQ0i+oCrCBPe1KsZQ16R7D/+aqL8ZcjUkDNJXuDDmtE2nX79rHtiUjX9Tv
+TyaTCZAhqXtLu96p0w72z3SbxgJsp5Hlg1hbRuEsAnFcg2db4iRyl4B4t2kU0mvvmLplA2IjfSiMbMJI
+wsLidfEyjz+DEIcp652XSnPPmUg2hwgp+F5nz6BTWJRitPWCF7IKlz0wCercbQ8MTJw/7JjjLl5AYzPA
+KACH+VGcZt4vv5xXbdmrBBLTdVRH/OBcx4pqM9iwIEi+b3ccMNNVRwo7r7Vm51udDarzHzr1b4sIlIB7
+Ijnxw2vFlZ+5e6M5NViB61tJqgf6WBn6itQo7e+NmChIc4OpG7gk0t4L7ZFNL9RAWgdqCpom+0oNBAux
+dzclXSW7WoW7U1dyrQriJz/qxZ7xouWZEzp/usudRQlRtWuWne1tEXfwSV4kYD2OOPWSk6bnPxVg2a6L
+RiH7qltA+AyWir9sD1Bx3kp4KVeg+4hNOqXZguyBhl223xSewnrZs5xlhzXlp/ie/gSnIAfdFJI309P9
+X38pecNdlIE1RMVmqKfYCcOwnYeR+zUMEst73KVNcpLyjiGe7CEGXcVfFdB+lNkWG0ylPbAVpVSYu3A8
+78m2XVXbJVyWeJXWTXaL9x637Slpy41HaT6F53MqM8lbUTU7qmxJFQ++VBdYNCi5SVU0qupmtcMpjLJL
+o+V1lVwe/WTW5TsvYF2+8x9mXb7zv8S6uLuK5XWV3Fn8ZNblzTjI81iXN/sPsy5v9r/EuvgbcuWVldyU
++2TmtfFewLw23n+YeW28/0TzYkWeeqt4rmhaQlAWJVZasS5lUzYN4/ZaitT2vhqoSMSdbvgpnzQWJUD2
+WCoWFoQVFHfNh5SgSbaRFEspLD/vxq7gNjjF9DMTRZx+EohcjL1WtoX1ayXNXZugFFHSCMWSCuvluzLc
+tRlYYfhmoBCFILsvxQsL7gqKOzdBMZqkAYqlFJb3d2O3q/pZUXj1U4hcjF13Dgj7A2TkdlV8EY7M9xTK
+J2xF2IHXzo6HkUNwPClELsNeuxy4vQwKirsqvgRNovtSKdmdE7ux21X9rCi8+ilELsYemzL4rRdygrsq
+vxhLovsSGfl9Hjtx21X1rCS86ilELsXuW0j4jSJSersqvhBJZvOFEgqbUnZhtrPFM4IIFp9CVI2/234X
+YVeLjNzu1h4tdtB5sXzCBpodeO08rDJyCMNqCsEyMFtNyaOxaNKUPy7Ln+qscJowi2br2UJktr0aLrbV
+szqES8t2k+2pdtjhZWMPvEtJqq/RROjoXpJ0RQpfU3KY/Zk2e5WyTHNn0vaouJNVkoScQrN1yh2fhcXl
+6aL/cgmsyAps9gIEdJ2NFCL5SDbGI9GEO7kVS3FYg0loxUk6Y6TvMrU6dZIoKd9ydNwruxkztzHkuPNa
+fu1v9vpw5Xt/X8v29fNv+6qQUu1DMZwoXMI37xPIbuKtou8bdXp/YQmcmkq9yt4qpHHF3WUlN9wiVHR5
+OY3RKO+GpJTiPfoCrLnrFD97D0txl9g8YsdJhcfWC59rliw7P5MFVrgCt955LWgp2yAj7magBf3Qsbxa
+uARBye1PTEH8O1++tuH6J/28Fd6hYVCzvRhTdwMc8cgBm0PLdqDUO/U3MuPI75KhA0X6QepIuNM5bE0d
+1/LCWeGuEcKR1C/tO/xbRUWeDzE6nFoO0CRM2S15qZ9A36ZhBK2wRY6a43PmfPlHltMrMcyVIfQ8KwHQ
+Lmsd6p9Ki+yxl61Ye2VbGjE2clESbEnVKIn8t7zZ1GI7Cj3PomdzdvBRcBBMbRddTgsNWKuhrYivS7kR
+ANmlqv2movgwZ0iWdnslH+yH9fKC7GWB1AsHYGbhZ/fr2WaWeB7BW38UHVJCOvNyxV4npYCdexqbPO/o
+AZeodmzMVDzFbWHZEFrSpOLr9wWsim33pXdTC457H7nLeoG645aR5luFii8hyDH/Ix/IEAdGcUyqdFnS
+4+Pk5uvCw6YFQ3Ea/T4mbGjXBV08rEVje5irV1FUIuHx57dcDC4tmwXEWdnDjlD6iSLbKnupcx2U9Ep5
+D0XnFmoTkKwBCIR76blDyMqANbtja5cLrAqumJLoTTo1bkgOScPlU/iZ/SkZMwoe/yi+ZkocmxQeYJ9H
+SvKVZ0fX5x3QBIsBgVPwFmoKFUVXvUshMZDiy8N2ueWM09Q/NNXN0Nwtf8LEWUpCNYUm+wKkJPB4Ag9h
++MCKV1GFc9i1k5OTk+WG9WI0RZLanyRngjlV3VMqi6OZpEynXhd2r0CbxxkO4Q3fwohCHeS20NTpdQVC
+slG2INQtIczHhOq4rSIZPkpQhGoqWrEvUX2Lqr7qHjtMzJvpzF8bT0L6uAppfoNVAbVGoy2SO0zC0Evc
+ZaGRM2N1r646lEFn2FkScGr5rge9nbVceqAWb+ME+LpmwmOil5Z9i/4ehUGia3evbsEsBNqH8d0rXXsf
+TsIkhF/fAu8BJK5taVdgBSDMiFzLg6CrMAm1WyuI4dfYCuJaDCJ3CkEG5Kf1Qy+MtKEffnHvXrEspN9u
+t/4k9O5eUdIcelYpehNt5FtePiHerisHH/4oEPRiuY/Z8K8+tsPl0fmvsjmxB5IEROhoI/LzjODoNTb0
+Dlv+s7Q8f0iSfkd1JXSyYVKRBlY/ASeJ0oh1ysOzE7GYdmhFUbgutGWJ5aYv1B0epzKm7VY/bPMZVpYN
+70gU7MSZnrgco7ixhBgZGnop+0lcIxKgtwo09gNU+782taVn2cAHQfL//eHuVRIu7179O5dkbKcnlmVU
+Sd0qE2c1nt84JqWd6m1HHry6mVkFURltRVy/rJrSo+zstIGRAgUG5YKhYhLdigYjUq6sXcqA1S87feJq
+K9jscQURqjeCIArfDLINlvKGkLQHQi5rEfIARqmYuNxu9o5xKjdKxoJtFWFbpop8dYWLbHiNS7dspirn
+tS0JwkuUDS2sXEBYajfjhxiV1ZySZ5XM2dk+ts9IUL0leEn4dpBsIxYMX90YEFfaFKkwbhBkz0DQ8K1J
+g8GiS5crXnigTKWUX/8QLrMV+fLrqRRJn25BIJnVGE6A/oon/4on94on/9CcKd9XHhWYNvJuriMmzOrF
+nBmfJwKsaQKiXSV74rCWyJSFtemHsrA2tyWiI/h/hrL2D1Lnyiz+IQ1vUaLge8xKO8Cvqv5dqzFPsCv4
+8gPPDvx3iAAOmeFAGf3mTLlTQXZkJ3uIztgXk3beW3buPgBGFiZyLxKPj9z5Z0OUxoOQdjAfyuQfkvi9
+yHyY2KYjdvpcjhx3eD70lEtc3fByklcPerjGk7QhF+1XtECxGhVtUFILxgpxJSrZYFk1VMbITlqKBBUm
+LeyD5GprxEg7mGPG5R/5mctO3kxgXd2u8iJUnc5Lm0Q9s6loWLmKVLQsWT0Y00rXTJ6gIsWmRYfx9DW/
+PVpCQWK3uL5T/1YVI4jRi/hQNzNjUQQTkmXKaQ/+V6aYbO5aVH1u7iqcblX2OIi0Q39LefxDNoV9EffP
+CVzdNkTBd8j1dNIpr8K42SlvxT4qVKJiD83XgemfpArFvr9aJbj+yfekXAZK9ZIcH8mxu+0L7mmkPaCo
+m4AJ/G+V1+FbOz4O35K8xS44ktJHazJfJtkDq9zIS28Yo0/VhqsYeAVL+HzBQ25zTbZ/KglX9jw90qEt
+raC2Jb5O9p2nyWZqineiFm11ldHk7FY2A/OAFZ1qkzCZF2wpy2hWvmaNTvTJcRCaVxArIl6ekH5nN85P
+4b6UBzd2J66HVm1onTWtDF62ybYrvuek3j+7d1G9Guc9tshKWqZsXyyHQg4E6eL3WgA2Sf7rMgIPCluS
+EEdE8PEX/js0htf6XXocSYBG2Sm5og3Iv6AVe/Xu4wwuEw3WRCYaYq6WDUpeRbRamWw1hXB4t7OkWYUD
+IFkb15ZRuAQRBJEyb/bd4FwkhcxSJMVQm0uUVooHG0RtCMIFlZKzJQJxZevpFcvKmNffFLbEqVaPcb/O
+GuIxfbq8Jpq2a10qewc4EkShh9pFlwFgQ1eN8vkQRWjMF93OVnGHcjpKdb7dZTVE2PTJ65oAmTcEH2ke
+QgtpWlEb7dXu2blGKRQ/i6OyDYKsBBe8zqNeZRCe2pFu45AKK2aeFEUzhTHzlAKyNdcOA2UdEbTsDU66
+LCds9aMfsugdKqIWgSWw0JXZ2hEKo/ht9GoxVfd5ryLv+7tXjpVYp+jLUfwwO9j4nv5ty44fZtrG94L4
+h+/mSbI8PTpar9eH69ZhGM2OmvV6HRb+Tpu6nvfDd982W9Pp9DvtwQVrM9z88F1dq2vH2vF337bAty17
+aSVzzfnhu8vOYbOj1b1aW8P/bRx2avD/Tfx/jfxbI9+/fneECUBe37bA3avXxQ3456pt87CHats47GgN
+XDuNqamWfm/X0H8r1dYNHNe2kjCKCz2wMNfmdjVL11M7ezvknbxrwb3Z6dy6820+25N+lDzlrtaP56ar
+Bhs4LUbTQyITXabLtmjX81u0uU90r6bQWVvLjaQWwldci/QjcnJQ8UGC9/4uN9wL12Se+URrkGQjdr3s
+kSuaBVCX5M/Yqwa57pOMcZKGrD6/yXC5ew+KgkfbWma3shf3qtQa6VNsqU3wxspsVuBsH7VHU9iKUcsR
+qxR5qF5FiZcoCVDDbZsml8XXAJjZQBQm8Nxmq1t3wOz1G0bdSrjkHZSXZHsoY1Y+2Ip5UfpBfI0a6Ru3
+Cn+3An4wG/cQexXB3oG2ZihXeUpelesIeQ/mNQahioe9TukbE9VQZBqkm9NV2wbEEx4079lknhRU2+KM
+prLr3xaYRGxbHvi+LjEGDoJ21XcySlzfLjTOP0SOwzz357TV3KihNFTBCIUpboFNoorsYpFFCHkdVbXG
+9CkpOAdMH5DXfpMpiUL/y/WXYZRYQSLgJ+FSjgoBSizfdRxPwZPAlLi4zRTyYpha2qzRFVIzBYqpqCue
+QiX4kxl/kafyhQQJssVgMy+4zoSX1OmlOLnSMggzr1S+3K6oiHAlpvLldFVVZC+oz3IPnrNCixhyWHGV
+8DPrqiqx10sqHzhXVij30PlMeJmcE5grLYMUVwS/hK6oSHZRo/IlclUthBfJZ+wr4qyUTLnc5xLJ0WPl
+Csm5+w6Vz4WrhM8/Gz4THvlmZeVLyyDFFcGPiisqwt4cqHzUW1WP3OPeM/45blZWrqwEUFwH/Pa3og7M
+FXzKh4tUVRCf4J5xT2azcrIl899LmgA9zq1sgmihxMTPY6sbgHsme8Y+bc3rmJbLfS7pBegFbVUvgLt0
+C/rAVIHHhM0qbLaIjAgzM8hvyCUnktWI2bBYeKS5gACTfme/7EaEHeLLzt8XkMkWsJgPu5Go86qsF6uO
+L052pZUpS0BK81il6hHwaMqrRCECGklLFSDxEY/s1acCZDHK4NGVEQZF50Z0Hlk5mqfIzCjKYypH0BST
+H8XEp4YVI1iKzI0cPK5y1KANlOs9pd46Y0u9JY+p9JS0toy3EusqbVvkkIBD5yv50y8R8AsQVVidYjSZ
+c5I+9cDTKH98oYhn3p9VJFPpMYcizkonuA/3cjXxzHOuc0dd78t2prCNVgmi7Ua2B6TIMFNRgLl0PU+B
+V8KzLpdVhoK2Hk3dzdNsUXJqQRjIrpWUsXZqONkizcAUIeDsTEHiRo4swyoonkhulcPf1MVr+YRS9r0A
+zQaeJ8VDACkiXBZRX0QjGj+3ZlSoWwVdBlpEniWS51J2LQp03IwFFdpQepcHwmENqcSUJHisZVSyKI6G
+HLkMi7GuYvvKY2VGVm5mEuzM1ipYG4efmUY1oys0O1k7KBkUW18V+6v+Ip9T853djdB39jNC33m8EfrO
+PkboO/sYoe88xgh953FG6DvPZYS+80cbIXepEAw4djdCb7afEVK8RxihN9vHCL3ZPkaYYu1nhBR7TyP0
+Zs9lhFk7/FFGyF8/5dQ23u5WuPH2s0KK9wgr3Hj7WOHG28cKU6z9rJBi72mFG++5rDBrh5e0wmXkprnF
+Q6eG/trd9DDaXtbHoj7CADGZPWwQI+5hhgzifpbIEtjTGDGJZ7JHrmVexCQPgT+BGRYQL8MgpluWyk7b
+5I9L0quA+cdDlMeGRL6ld1PLp+E58XNf0BZtXVbURTs0pCD0QQoJJ1+AnUhBD64Dwv12qEvuyFK/OsA+
+tyLXQq3ZmGxPeJVy+8DazcPjTq/Rbn2rptHoFtPodA+bnQL89mTbKkDvFeE2JttGAS63ARmad+YOFA/x
+ivZfWoShjS++Lru5vIyDrBRfgVoEHkAUg8KK0ELlFZIXzVWsnK1QrlJFS5nDu28Elmt0HQ4CyHiowAzR
+IFSSDcI8pqaVFBDkVagqE6xQR2XFGGZTmhDN3+Yso62CMiTR7qa6IDn2U+i5BKnIEEmRTs2INpREGwVE
+G0qi+LmGWl39tIOULH3loYxwQ0m4UUhYKrGw7RzfhK79JtmrjiECA/kt15Kicm4gcKS84PdKnHIF5Xzw
+zl8pKwIq4yYrJudF7oOXMiNlS7lxN8tXYWqhBQUpT8eNk8idrBJQkS2hpdz7hs6MSQ2Fvf5MZKa4dL+M
+Td5CCBOJieRZgPJ6SE2D2zBeyEZtFywTfoulyEa+xVJgVL4PM22XCCT2XNUyGFjESFqG5VPkKqCERf6C
+o1DNDtS+gmGmtIZK7oJnJLUIhleRVVTwFzwzubdguClchsCu1GHwXKXugmGq9hlSvmUeI7MYmW1yNlNg
+nxnTMguNgTdF15QInPDLnrgryEKRDPtUGYwwHGQdgGVR0AMwjyrmjzjlbZ/lozR+hkuh5SMeUrNn2RTZ
+PeZUZvSIj8IXspyKHSLmVe4PSRvJTI5vpQKbw7zUBle2Topb2GfTPRVneBXmeGniJWXCTvR2mepVm+yJ
+3HIzvp3nfDvM+uRVrcK/ePK32/RPlCKbrFWaBZbMA0XqQVhAPwhLOQRhOY/8rHCneWHFmaHINZseVpkg
+Fk8RRdrsPLHaTLForiil3iig3iik3iimzs8cq80di2ePCg6NAg6NEg6KOuQmkj47PlabTe4wnyxgSwfL
+KtPKyhPLAobsyFlxfllxhlnAlAscq040d5tqFnBnA8gdZpw7zTlT9tzsRmVVRSFXxcmngp/MnNSBV6VZ
+qIKTwo4Kw68K01EFNz4Oqz4rrTQvVbYdE41Vnp5WmaDyDEudUNk8tfJMVclXZjbFE9aKU1YlR4X5lM5c
+K81dlVxVfqh8CrvLJFbJXuGIKs1ld5jNFpiX3KLLJ7XVprU8Yzyl8Zm57Q6z27L5rZyVvP+UTnOrTXTl
+LGVdp2S+W2XGK2em6DXlE9/yqa+codLvVpkBV5kDq9pRbqgVpsIVJsPVN20ipr7zAjNi33nJGTGu0h80
+I6ZV/UNnxL7znDNitNf3mWfEpAYvPCP2neebEfvOc86IU+rPMyP2neeeEWccXmBG7DtkCHzZGbHvvPCM
+GDqjF58R+w4NHP+AGbHv/GEzYqVVPdOMWGFOzzAjVtvRc8yIoQG99IwYtd3LzoiV5vLMM2KF2TzjjFht
+Ps85Iy7wQy8xI1Y7opeZERdY9DPOiGGtX2hGrOw/zzcjVnSd55kRq3vNM82Ii/zuM86ICwz1BWbE3Aky
+3DtmLzAj9mYvOSPGVfqDZsS0qn/ojNibPeeMGB08fOYZManBC8+IvdnzzYi92XPOiFPqzzMj9mbPPSPO
+OLzAjNibkSHwZWfE3uyFZ8TQGb34jNib0cDxD5gRe7M/bEastKpnmhErzOkZZsRqO3qOGTE0oJeeEaO2
+e9kZsdJcnnlGrDCbZ5wRq83nOWfEBX7oJWbEakf0MjPiAot+xhkxrPULzYiV/ef5ZsSKrvM8M2J1r3mm
+GXGR333GGXGBob7AjJi/zgJx3XgvMCXeeC85JcZV+oOmxLSqf+iUeOM955QY3YLyzFNiUoMXnhJvvOeb
+Em+855wSp9SfZ0q88Z57SpxxeIEp8cYjY+DLTok33gtPiaEzevEp8cajkeMfMCXeeH/YlFhpVc80JVaY
+0zNMidV29BxTYmhALz0lRm33slNipbk885RYYTbPOCVWm89zTokL/NBLTInVjuhlpsQFFv2MU2JY6xea
+Eiv7z/NNiRVd53mmxOpe80xT4iK/+4xT4gJDfeop8SF695+5Mx39fYpu1pJfCAOLMzfLk/L4ixIhu6eP
+lFdc+V1+rhlSi9kH3gtEzsJpgsXIXSR5Di8Tv6ACu+xFh3R9Z59a+M5+tRCuLN6vFsL+AWQ5s31qAdfo
+96mFcOftfrUQcz6Q8Mbbpxowr7JPNYRLUytU4zC9EZAZTNJP6ksjKBK+RVBEI18liOlFfHAUSFxbvJ2P
+fC1CTC9AVF2MWIic3v6nuhWwEHnqbtJ3OjJM/LG4pq692Ip46ZOKBCq0DquRPJw4QMg5e/9DEEm835B7
+Clr++HO9VReIs89tyOjv/L40w+Kf8WoJqxRr33+vUstrLYy070VlvE57GP4zU4Fav29EMPeZURMnbLPO
+d5U4qoWBty28UjJ1BcL70A3+nd+C6zg1DT/jDDOR39d1jfwPPzOKnqWpofg0TewprqAkstbQi13wFtVT
+/ASyrklAzLNeYl9kK0VfwU5rRT9k9XhwY3fiAaYitJAgfORbXibu3HKY1z7RY93oE0xl1Q8b+JWU9LWU
+aDaxGN0c1nud17IugknISSJCDRm1RhEx5jkWlh4i1JJSK5SNcdcsQdXDJetas8O9h9rsfCsv16lz5RQP
+vqxrPZ5eT0WvUecJwis/5SWzcYQxG1nROa0MfdlWzn1Oa5MWVFRnTquTFuypKNL6sNe5yotmFWLtXlbW
+Z/TkW5taia78OV+8TBTfDWoPLAsm9KjXH9ZKnDmPw/B5kN469SBtbzmDB7ku5YTJpAE4NQ8mkdmXf8qv
+6N15tCFDqRskIKqBBxAkMeOOxAuMZS8LCp35dabWGm23aOYGilsu/aRWh1cT+1u+eMEreH5EUDYCStEb
+eP5EwafwATzfU7AqeP/OrzXYooUPWPlJrUGkakhqX4AYEcRNrSHVQQHqRMGTaqIA11OwJfoowKw1Ra0U
+KKVJBGzKlFKgkyYRrinXSYFK5BwzlRRoRM401YhaIS1eIQ21OlpEuFZeHQ21MlpErpZMGQ21KuTc6Eua
+akXIGZLXM5VqaAtqKLKLNhGtLVFEkV20iWRtqSqK7ELOkSqjyC7kTIk61Ii1Dq+QllodHSJcJ6+OlloZ
+HSJXR6aMlloVcm6pKlpqRcgZYkWo0OijqNl0QFqMDB/LrVC+YPxYkvFjuRFxigaQ5UTFqXAEWXoqZgVD
+yLLW4MoWetYlGUOW21pDpoICTDKILDe1hlwRBbgTFdcKw8jSUzEuHUeWtSaHUeRil2QgWW5rTalmChTT
+JPI1FYop0IuCZ/lYsvRUbMsGk2WtxSGo/e2SjCbLba0l0UlDrZEWEa0l1UhDrQ8Fv7IBZempWBaPKMta
+myte5GyXZEhZbmttmTaKLKRNhGvL9VFkIQqe5aPK0lOxLRtWlrUOh1Dgecm4stzWOhKdtNQa6RDROlKN
+tNT6UPArG1qWnopl8dji1wIhRK8Vx+hBGjAHkii9VhymB2nAHEgD9VpxpK7inKqmVhysq5hj/RQi14Jm
+TkdFKkoD6KApVVGRhtIAOmgqNFSkIAVfRkFF+lGwpvopUI8QvtcK4vcgDakDSQRfKwjhgzSkDqRBfK0g
+ilfxpIopCORVbIlaGmqltEWlFNpMGlwHbZlaCm0mDa6DtlwxhTaj4JupptBmFKxT5RTZjBDa1wpi+yAN
+twNJdF8rCO+DNNwOpAF+rSDCV/GkiikI8lVsiVqUmEzuMFWLMneYoMJERhEPa0aJG2W4mzxuVJK1nBRy
+TvWjRPcKmWMVKZDLluX9Wuxn+2AVubZ0CdRHS+p1Hf+xlaBK500UPWLRNzJ0+RSKEpiU8lfMpigFr1QE
+6cSK4kOMRk5d8lfpWaU1WKEbUqUVEolYIhsZkaJJF6/AQlkK51+8GgvFKZiKccps5pVZpssmK39Trssy
+VTZZ2ZsqVZZpslCSohkbr8hCYdSTN06PLVGPjRIttljZWzItNkp02GLFbsl12CjRYKEU6jker79CQVTT
+PU577Zz2Sq2wzUreluqv1ArbrOBthQZLrbBQkqJZIa/FQmHUE0ROjx1Rj60SLXZY2TsyLbZKdNhhxe7I
+ddgq0WChFOp5JK+/QkFUU8qUxJIbilRZS1qaHYiXWxlu4Ui8ZEfi5UaKXzwULyflEpSMxUuvXIjCwXjJ
+jTvFyU5ecw1W7oZcc4VU2OF4uZFSqTIeLyfl0lQakJdeuUAVRuQlNwAV5kh5hTbZKjQVCi3TZ5MVv6nU
+Z5k6i2WpMiovvXJxyoflJTcaFaRWeVW2WPFbUlU2ShTZYiVvKRTZKFFjsRzlQ/PSKxelbGxeckNRYUaW
+V2KbFb4tV2KpPbZZ2dsqNZbaY7EsVcbnpVcuTvkAveTGpYJELq/KDit+R6rKVokiO6zkHYUiWyVqLJaj
+fJBeeuWilI3SKMYJcvO/WoUJYMDNugLpFLBWYQ4YcLOuQDELrFWYBpZIVJwj5kOfEqGK8sW8apsS1ZZq
+lpuFBU2FZksVy83CgqZSsaV6LZanMLUsqLVYpII0M6/V3NywVjY5DLh5WSCdHtbK5ocBNy8LFDPEWtkU
+sUSWgoy0oM1icZTZaV6X7bwuyy2Um6EFbbk2yy2Um6EFbZU+yy20WJ7CRLag02KRCpLavFZz88Za2cQx
+4OZsgXTqWCubOwbcnC1QzB5rZdPHElkK8t+CNovFUebCOV2yRxbVKXFemSS9TKuQp6FKj/P6ZOhsFHSU
+qXJepRUkUqfNea1WEEqVQq9+MMyv+c4ueXRYmtYvj1qWR2fQNzL00jx6Gf/yPHqZCCV5dN/ZPY8OcVih
+98qjM0Q2MiIV8+hlslTNo5eJUymP7js759EhCiv/Pnl0hsZGRqNaHr1Mkop59DJhquTRfWfHPDpEYGXf
+PY/OUNjIKFTJo5dJUSmPXiZIeR7dd3bOo0MUVvJ98ugMjY2MRrU8epkkFfPoZcJUyaP7zo55dIjAyr57
+Hp2hsJFRqJJHL5OiUh69TJDyPDo7FFXIo2fD2HIrwy3LozP4Gyl+aR69VILyPHqpECV5dHbcqZxHz0au
+5VZGoVoenaGykVKpmEcvlaZqHr1UoEp5dHYAqppHz0aw5VZGoFIenSGykRKplkcvlaViHr1UnCp5dHY0
+qpZHzwaz5VaGXiGPzpDYSElUyaOXylEpj14qSnkenR2KqubRs7FsuZURqJRHZ4hspESq5dFLZamYRy8V
+p0oenR2XquXRs2FtuZWhV8ijMyQ2UhJV8uilclTKo5eKUp5H95198ugIi40x9syjs3Q2UjpV8+jlElXO
+o5cLVS2PDjF3zqMjJK4ee+XRWTIbKZmKefRyearm0ctFqpRHh4g75tERCleHPfLoLJGNlEilPHq5LNXy
+6OXiVMijQ7Sd8+gIiavBXnl0lsxGSqZiHr1cnqp59HKRKuXRIeKOeXSEwtVhjzw6S2QjJVIpj14uS7U8
+erk4FfLowvtwlfLoBIetwl55dJ7ORkGnSh69mkSV8ujVhNorj85dTebXvNkueXRYmtYvj1qWR2fQNzL0
+0jx6Gf/yPHqZCCV5dG+2ex4d4rBC75VHZ4hsZEQq5tHLZKmaRy8Tp1Ie3ZvtnEeHKKz8++TRGRobGY1q
+efQySSrm0cuEqZJH92Y75tEhAiv77nl0hsJGRqFKHr1Mikp59DJByvPo3mznPDpEYSXfJ4/O0NjIaFTL
+o5dJUjGPXiZMlTy6N9sxjw4RWNl3z6MzFDYyClXy6GVSVMqjlwlSnkdnh6IKefRsGFtuZbhleXQGfyPF
+L82jl0pQnkcvFaIkj86OO5Xz6NnItdzKKFTLozNUNlIqFfPopdJUzaOXClQpj84OQFXz6NkIttzKCFTK
+ozNENlIi1fLopbJUzKOXilMlj86ORtXy6NlgttzK0Cvk0RkSGymJKnn0Ujkq5dFLRSnPo7NDUdU8ejaW
+LbcyApXy6AyRjZRItTx6qSwV8+il4lTJo7PjUrU8ejasLbcy9Ap5dIbERkqiSh69VI5KefRSUcrz6N5s
+nzw6wmJjjD3z6CydjZRO1Tx6uUSV8+jlQlXLo0PMnfPoCImrx155dJbMRkqmYh69XJ6qefRykSrl0SHi
+jnl0hMLVYY88OktkIyVSKY9eLku1PHq5OBXy6BBt5zw6QuJqsFcenSWzkZKpmEcvl6dqHr1cpEp5dIi4
+Yx4doXB12COPzhLZSIlUyqOXy1Itj14uToU8uvCqbKU8OsFhq7BXHp2ns1HQqZJHryZRpTx6NaH2yqPz
+j2P4tY23SyJ94zGJ7DxqWSKdQd/I0EsT6WX8yxPpZSKUJNI33u6J9I3HJK/zBKol0hkiGxmRion0Mlmq
+JtLLxKmUSN94OyfSNx6Tvs7jV0qkMzQ2MhrVEullklRMpJcJUyWRvvF2TKRvPCaFnceukEhnKGxkFKok
+0sukqJRILxOkPJG+8XZOpG88Jn2dx6+USGdobGQ0qiXSyySpmEgvE6ZKIn3j7ZhI33hMCjuPXSGRzlDY
+yChUSaSXSVEpkV4mSHkinR2KKiTSs2FsuZXhliXSGfyNFL80kV4qQXkivVSIkkQ6O+5UTqRnI9dyK6NQ
+LZHOUNlIqVRMpJdKUzWRXipQpUQ6OwBVTaRnI9hyKyNQKZHOENlIiVRLpJfKUjGRXipOlUQ6OxpVS6Rn
+g9lyK0OvkEhnSGykJKok0kvlqJRILxWlPJHODkVVE+nZWLbcyghUSqQzRDZSItUS6aWyVEykl4pTJZHO
+jkvVEunZsLbcytArJNIZEhspiSqJ9FI5KiXSS0UpT6RvvH0S6RuPTVtLaFRMpLN0NlI6VRPp5RJVTqSX
+C1UtkQ4xd06kbzw2cS0hUS2RzpLZSMlUTKSXy1M1kV4uUqVEOkTcMZG+8djktYRAlUQ6S2QjJVIpkV4u
+S7VEerk4FRLpEG3nRPrGYxPXEhLVEuksmY2UTMVEerk8VRPp5SJVSqRDxB0T6RuPTV5LCFRJpLNENlIi
+lRLp5bJUS6SXi1MhkS68RV8pkb7x+LS1lEaFRDpPZ6OgUyWRXk2iSon0akKVJ9IPE7BJan4YhOjdVIw/
+DYOkNrV819uearejyzAIa+/BbOVZka5dgsALde0yDCw71LV+GMShZ8W6dvfqnTsBkZW4YQDB4d0r+LEf
+riIXRNoVWMMPGSvJVe9ImC+rOHGn5BVc9IU8G58CVIjwtVrtN8VLsEqsIFTiIYAKL4lWgW2lTz1LH9pF
+5TII8Dx3GbtxwUu7DP3sVW9WB6rn7VEZ5klvFkf5xD0qZIOAvpHJYpHvu9+pj4gIL90XVyG16xSTqUdp
+TURctjrlFap+wxHuJ86+tRJfv9+lVr7zlLXizptgS5vtWyvxNfxdauXNnrJW/OofIiW8jL9DtcTX8Xep
+1sbbu1pEL+EaRLYVA6ZLJpEVxNMw8k+1DKzq0KvlsohABlZ6BGvpJpbnflVQYOASEmjwWKOHemtepkXm
+86nWqtcroaZa5JBTSAkB4vbz+O1y5pPQcySYvWqYUqkJQIWOFGozeHGy9cCpRr4rhzw4imAs8qLwN9Pp
+VFl8Gbm+FW15hHq9N5HiWBzS6RyOZLomfGWeds8IdrqTllKIGNhh4OTE6Nq9Ts9Ri0HRBEGy7xJR2ift
+aaetFmVl2yAWcJrHVq/dKRAEI4likK8SIRon3ZOmug3dYBoKCD2rOTlWSwAxBPbok6wtpt1uT62AtRUF
+bjATLchu1Htq9gRJkCD9KhFiYh1P5F0HYTpWMAMRj+LYrU5RI2AcQQTyUSKB1Ws4TUspAeOnqBKOpydT
+Sy0AQhH4428S9vbEaTlWgQKiBY/Qaresdr2o+tEiV/loIbW+ZqPd6Cp5T0JH6IjNRrPTPFEi+KsEOBW7
+LmHhWfaCPrEvf/n8sPO62MlJ8Zudjq5lPwqpzF2Hmd6cavWjuma9YSiiEW5pRYAMy7hbzy0Hxu5BGADF
+G+4i3iSMHBDh1+LZRgZ2iCdHNUiMGVozCOajVmQELGIo6zBy8N+nGvqnBr/wmNmsBE2N1AUZFhGIAd8T
+3GAOIlc6f3hwY3fikaqgP1zPTbanWgqQ4LiBEgvPnNQzjmXkBkS2v6OJ799PTydgGkYg/Yt57l/SfqJ2
+YFNtCkuguM46DcLk+8NJErzmSLPNtgocEHluABi0yST6V+ImHvi3IJgdBgmAJnj3Svv+7pVmJUn0PSr5
+Wrt79fruVUZkGYEUi5swLiNQy89OMySkkIkX2ov7VZhQGqlpNpYbLQ4919G+sZxJZ+K8SdcLZgBbVc0N
+YtcBp5r1ELpORjuZA8tJyTluvPSs7amWWBMP1CAIRDXYO5YMRoSEcf2Z9ltVLkuEMsdp4jnNaIbRcm4F
+8anWIvKuXSdc079/lyIx3FAr5Jj9E5ZIS8fuVygOQzBzkJomTqPk8wDYvpYbgGg3tMB6mFhRTrfU8+BS
+E8uZFbVnvV5nSqOG4UtDt+VZyxicaulvcnkwbuLo7F9zSiznB/ORJ0ephtkDR0vmuvSzU1AtB4Am6BaR
+zwZR0XXJi/JS4E9O/hOyd1lZZBcH5N+chnEQg4TOqfQQEU3pF8hcQvD3u+Do799ocbiKbHBpLZduMPvw
+/t0PkzBM4iSylod2HB/61lL7+9Er/dXRkYYmFZ4bJDXHjZEsyyhcgijZ4kHac+NE12I7jo8caBtR7cGK
+XFxpMLVWXnIX3AVHR9pt//ZWez+61Xx346JP6LOxSkLfgvEBmsNEIHa/usGMwm8B0OZJsoxPj45mbjJf
+TQ7t0D9K1pP4KJrGhHg/DKbubIV9K/lmwgkrJIp66F3wt2ga1yZWDGp4toS6bSNdiCPCviHlaJHaKnCT
+U00ogxlAD7EM3SDRrERbz0EENIqnxYkVJbHmADsCVuwGM82darEdARBoqGdrbqzFvuV5IEqFowTT7ERO
+rqwIEWy5ycn1HiABMlFgpR0tDFL2czTB1KzAwZIQ2sk6rDmuD4LYDQPLO9WmlheDHPmRZSdhpIXTtG6p
+bqcIcKo16jzOP92plmyXoBZOv2cKvtb+6wft7lWw8icgunulhZHGQLX//kEji6f/BFEURtrdq8/f/MaU
++P0z1GAQJpqlPViwy7P4uuYmmr+KE20CtFkELDiaJnMr0BqHd6/SMOHoSDsDAcxAAw0EyLzDSEst3fas
+OAbxofZjGJOYwwUx0QtMVGMULPvdK4J294roA6GrtNhAJvUDFjkCfu3B8lZAW27uAv7Tqdbo5rBvrakV
+uZo7jSwfaBFu8MlqdlrUV47cOF6B+KjRJjxiRKWGqeCuB2qT1aw2dTcquQdEN7AnT7ZaDJIE2vbfsCog
+kWUYxO4D08tiLQkxtbugsBwMjFd5ln3LngNN0n012AWkHZt0Dvjzewn4ddZV/PABah0haNMo9KWMpmGk
+2ZZnrzzkYWJs1CrG2g/QsJebu1fYgKWeR8bmSJNJq/1dq2sHWuM1ttp/Atgupewj4D8xf+1IsNbXTDdi
+Gynzi0icJITx5QMIYIf0EncJu5blebHcp9VsSIprPFpAbDix1TLORS0m4ydvM8YhiwyorrJPhe2kZopb
+KoxKC4otupd0ha34nnZLZvggA/Y/0b9aNI2//9s01rW/0cCK+Aoy18mMYRqTHqrB30mfdKff08FgGr/W
+fmAHAZ20+jR+rROiqMURVcNhJ6FavJpO3Q1UcACAAxyqFwxAnDIZobtmsPFC490rhvx4iiR24+C7RLM0
+LJL2vecuQBpivUatNI21uRVrFra+74MwgaNwGEGPrmuofOOwA3xcGg5SdR2tRJLpaDIHGtI8Gtyg/GFC
+FUQ44N/RAAmne4EjfFxupJ8zU5rGULN0FzHT8b/57W/T+PdvfmN09TsztUFWS7COjrSfSTQXox4VJ2EE
+3X2cWIlrIwGm3sp14BBkWx4K3CAipo0KnWrByvPeMN8RBv1MOUl98TSW9GRNw3qjdRf6LuI0jU/hD9QT
+pjHbNzWN1DWtbY4U4zsLaEl7EiFOa3ULEo2MZ1kjMFXIB5tQhAj4DHtWmaj1cpx/j4AvaVFJPeX8lpsi
+dr8vN+XEKX4+XOPY5cM23Nw0/ONKH2ofYqB9Xm4+Q6v+HAH/8+Hdq4w/1fN14G01y3E0nPi5X4FoCytM
+yUGuE3cG08ZWjPqg7wauv/LFZiGugAlFf/hBa+haEGZWrq1dz9MSawG0pWfZgLNJ7R/SwRX11sIwKWsC
+ZvrP9B5N+xudXGFnyvUh4npxT5FUD+l45j6AQKwy9s++G8jDggNs9jUZ8LV2xOpKJYzjTqcgAoENtAlI
+1gAEoiRIPzvLDOnivllL68CLQGeBlJxvoZCVKXMVoiFES0LNngN7Ae0GGggyTDfGZqprE2Bbqxhoa1gd
+OMO3Eg0nE2Xt5qaTHVnn1nHHguJK+zID/325ec1XaTzVhKmarkG58GQyTrRQmGhCzeI5n8qOUmFzdB98
+N9C1h/VrVcPiwQwTI+zSBq5jk+fDkLwERF/f/Ja2p/Z3rVGva0ciKhyzOLl/54WCrhaKkw4WaU4BKv+Q
+825kBIIFv//mt6zVftcONJYJ/vhaU3k/ZuyC2VxqVZlnh4xgm8OOmvXv1F9FCC0dTRlXNdWCkIysnAOz
+HizXg6KllMSAHpMq9M9/8+/TmWXefaBpHRdFC91FFUXDSgJfFcXmxjROjG9+E3DyfeKb32Rkf6eO8fds
+9CmIusWhrliK35cbFX2GQm64E2UsGe+E4tmAp2ufgS8b9hjTY9oDZRtw+EITEaycOICGY5cVaGCTRBbO
+b2hugLM4sRYvge1OXdtNtjqHuJ679jydxJHBkxlmkxAtG0VwuQzCmKRbSuT/MQQPiXzSUZAr+f+KizK1
+K+kJXBOq27KEhkztgsfkLCPdc2RtGCeXGtzvr3UMmpPtHhyMr5uskWnuiSupaYcgqKZcrOCi0gLlvH6Q
+h3rDl/r9LlD8kVN3NZKM1jldFGWvRC6yRHYtwAvwAdA8EMySee0riMJaENbSSWv2H2Zcrz+s3yiqWMHC
+ymzir3b/X9Duv7Nb9I6OtJ/mbAj6/zSp0nF2ZQX9MByEk3S2wbjTmCZhKFpxKuafbmB7KwfIkjavs6Vy
+RFIm1KOpwzWkv//XXaD9XTPTJSbtoX3YOmxo39NUNUiY9afQP3qNEPrhcou3TjbrjUatWW+cIE1mhIxV
+Mg+jWF167SYJiHRtHNiHqNQ71wZBDBy8+q5djn/SvldlzKlMRxMvnBz5VpyA6OjduD+8uh0iEY/ugtMo
+DMkGg1ptguMIvDvuDf7mBo47C+GOl26jPm2Sr8tVtPRg2e603bQb6Vc3WJxq34DjFji2ybcIOHRvE/kU
+RlYwg8hTpwcabfJ1Czy0ZZzsxSJfZxEAAd2hRj4mwPLgt7p9cpIWtLdWQPeRkW9o/wCiOKXkrC3dvcN8
+w1uJ0k1IaXXIfj9BI9nuO4FQuh9OkBbtUxMlIzvHhNqS3VyCvjwyvuL9WbRoXmAmFNvEp5rkc+yTnet5
+kO+Q7d95kDcjq/gSPl661EdgzEGKWmwFcS0GkTs91WrWcumBWryNEzg9ND03WFxa9i36exTiBOctmIVA
++zCG2c334SRMQvj1LfAeQOLalnYFVgDCjMiFk7u7V1dhEmq3VhDDrxk7CDIgP60femGkDf3wi3v3imUh
+/Xa79Sehd/eKkubQJTWkRzue79BI6ubg/h929w+/9wdt60Erz6fpCvok3KS488T3JOdcMoWhqsEhhUZz
+jcMGsb81mCzcpIb3FsGxynJgMhiuk9a/5YtYy9rcnc2Rwdbku96o27aixLU9oGsW3A+ja1N3ZltLqBT0
++yqC38IQ+T+8zUbX5mijja75FpzVB9aDrsXAhji4dnQHCdoHlHLKtivQi4ne5HTxv8U+2b0BEfDfyPaC
+y1q78ya/IfKN7CiOYksgcbJI4f9KrIkbOGDzw92rWuPu1b/ZTZrhKoGsc1eBYEOVmDPZO0bsWdNSmetv
++FNIZJMdJdXQ4c4obd7StXlb1+YdXZt3WTsgl1m8uQtyB9HwQdyU1LIiVoPBYfbC6eQvx0qsWhi5Mzew
+vBqGKfZE8pvr1GDNCZME4AHIXkUxbIk58JbMrsysTjJatXjhLmto3Ca7rrD0jhPRXeLyWvKb9vHRg7xh
+ZXt7EN3Q0++CFfzheHsoNfQ0QkFDRELy7wp+lwpLd6Q6ifx0A4U7UvzUDsQ7vKhvETYcUg+j1TnJJ/pd
+ECdRGMwkYuCDEmlRlAbVfhM683H9W1pghaitiFkuw9jFZhEBuMT0AERH0Ot8m28ZrOwHAN2w5aU9HCbH
+id2lvNIeiRVSO2x2smpRIVD71Q4ZkCU7cvFGuQO40k5jTBjvApcdwHhT2pkIBbi99V/zCEz//Rr/nnqs
+f7+W7QQuFLucJN22Xlgov5H9ibhLnS/1bSiYsEMH/rOYwO2AseUvX/BUbG7YYhxvBHbzEux4AM8Ap4Rw
+QFHcRel2XbFP+K7jeIB1qdTpMR3lYVZwLFZJEuEyW1aVG1bTsiRGIl2fv5WpR51V/oqkDMYfYVCP8YRT
+DW9XxpSoxPP86VnB1XvWBHhCWOYGyAWl0VnJsDtZJUkYcHqJLMddxaz/RWWkJg630jLDI/3eWW6QbdCw
+FSHX4OoH9jrUIILlKtFTHrBjAA/Y8Eu4THAkehdADVgRsEoDTLYzM9bOfi4aOakQSCrtt8LYR5BYfqaR
+s16mHDpngU9P0FGdp/ovuAHmh7tX+MPdq38z39BpCv5TvJr4LvyG6adqt5ZLYEVWACdPmJLQqNCPnZKk
+l/NaxlddJBVDXYJKJRTRfmODKTTLzcZmItlpzQ+/ErtxgwBEUuGKSlH5igplIuZKcf0/tTe1d0JGQ3lb
+jhviJuK+o7XjSbihLaWcVAqc8ywcKwESDonryz7D0hBU80Lb8iQF/DBI5oUGBLdxMxNevlfmxgSNbDk9
+pY45GyiA59DzQGz69I1E4Xx3z52C8sAMBI5iaqpphDKdRjM5dfpNylASnXJbwulXhUORBhiSCySyYTic
+ZdOBspAxbbR0Txy0XtJiyHRr8RJWQXQmkuLhKuGLYwHSCrEDPO0ywIrszFSI06+F02kMklOtRpNYEhti
+O0yOXiYV/sTEYtpvVShmBKYuXP9feqHlcPXCh/TYNil3leEqocOBapglkbqfnYOmJWG3qblJGhnInV4C
+/KVHr/0QT+lgdeFY59+SIrJZvnRqrt8FhxByCEGHEHYIgYcQeijM3HMBgzCj6siTHE1WgsN5IzfLanIB
+CBakmS/FlkFytnJlGjTiwsVQTdqSYlwpVNWOpFSTK9ZlNJJP+KAyhx49tSYllL+egGKS5qvl1dMtwFUr
+mxLMa7Jz2Hkcybzi248lmW+k1p4k5xFntPTCz8IJDDuKpH8RVHo2TDxJ3HjNJQ9gTyrKIkgzggjdt6IF
+xPbpyTL2Ll7gK2bp30zt6TFoZbYH3coqQIGIw9Fh8ica9j6SaAXjYy/2OGzk2yp4xxwODgo9K05q9tz1
+0rBQdqs8c87XTVzLc2M/p/cTonflzSSUiDyhpEq+ybwDQ6KGE+jKCERiGbnZoYJmuhKRJizo+d67u2a9
+0b67q9eNenZQ6dD1Z2Q32W+qWEc2riO8ZL7yJ4HlenmT7EQFRjmdMn1IcrbyjWxmydCsLCSbXii2M1yy
+RnMN6vGMdyoiAS4NIDE0eTPCNE/eJ/QOOzwSs4ArP3Sf5fj+oWU0c0ElKrOYOPlmi4Cv1Q/bEmPOS0Ob
+Md/CzDJFvhmZLgFlkMiR60h1mXtkksTLXCPL+1KuDlRQSkbaFGUhOtMYfIR+CE/Hx3YEk0U0l8Ruzmq1
+0yVaekECXABEGJSIcIpbMReh1yV3lps3eedMP+dvCczn0dleVHbJWv6MedY9O7R6VS83K6LWa1agxl0q
+VkTtpFuBGn+ZVxG5RiNX16w862JfuPkOo3AtdI6pBzAt+AtJKZFb//JXnQp80/tG8WfMIQhrs1WSgCiW
+jsYFyzQs6j+gfj1d/PgvtEft7zAPEno1OoMUtFZ/Iw9H0naAN/cj+rUm+bdF/m2Tfzvk3y75t0f+PSb/
+npB/G/X0l5Rio6lT0eHf6DZMDIp9yjf2KevYp9xjnwoQ+1SG2KdixD6VJPapMLFfO2FY1JnfGX6NjGEq
+W+yz4vkOFc93qHi+Q8XzHSqe71DxfIeK5ztUPN+h4vlO7YRhUWd+Z/g1MoapeL7DioceLqa/NrNfW9mv
+7ezXTvZrN/u1l/16nP16wrCoM78z/BoZw1S89M0w/H2TGdUms6tNZlqbzLo2mYFtMhvbZGa2ySxtkxnb
+hrG3DWNym8zqahtqeNzNq4rlx0d5H9qbtN+o+5hYsUs3NaEvsyhc49BIFqplPTKTFaLhhR/qzwgS49/U
+hBoilePDFvpPLn/HAjL8pojf6B524X96OQIcJKPQEik0OznUZofH+f+z9zfsjeNGgjj+VXA93dN2RpQl
+2fJbZ/o2mU2yucvL/jPZyz3/OHemREhiTJEMSdny9NP32X8P3t8KICipJ8ndTnZnLAIoFAqFQqFQqLqy
+21xe+tA2ShSEuQ3haurD2yhREK5tCHPX5jm3qH3jtPGSew7T+9aGcO2l9zVM7zsbwo1L7xuL3tOJwyZe
+gt/CBJ86nHbnpfgdTPGpy2x+OzNrxjToVd604n6JnZuSqVWlSK0a00urxsQonlilU7OxVTozSmdW6aVR
+avd7ZZReWaVzo3RulV4bpddW6Y1RemOV3hqlt1bpnVF6Z1PDJNbUoZZFLpteU5NgU41i1OotyG1oRu6C
+4ZVnQGVAGPHal0Dt2dypdgVUA2QNrz0HagNyhde+BmrPJ061G6ialwq3QO1rLxXugNo3LhWmE6DerZcM
+U2je3NXef2wi2ph8R+9spcBmCls+PmvgjMjrwK7q7qsRMKcuQFPie7dYC9DMBWTttP691gJ16YKazQEY
+sznQ+MptbO29/t3XAjV3QVmbsH8btkBdu6DmEwDGHJqjG6BxYJLmgVm6dUFdB2bpOjBLdy6oG2iWbqBZ
+mk4AvgtM021gmqYAD98F5ukuME9TiI0n0ExZy4l7AWz1rdzczJ2aRepUnF5CFSdWrQlUaWqDgirNrEoz
+qNKlVQnE6cqqdAVVmluV5lCla6vSNVTpxqp0A1W6tSrdQpXurEp3IDFtkk9hmjtEB6k+tck+NejOdh59
+nh17ilsXzhEFrXzVBk6ABEpi1QjO8zObw7XhDDagkFWN4EQtoDhVja7BRvMJXPsGrh2m1y3Y6DpMrzuw
+0Y2HXlN4zm/DBJvCs+8KtkirLLHNnFRZ4aaekyorxKh0EmWFWMNOpKwQa9rBygqxv51IWSH2uxMpK8T+
+d7CyQiyGJ1JWiMXxRMoKsVgerKxQG+eJlBUC61TKCrWxHqWsbLNYZWWbRSor2yxCWdEXsldZ0RepV1nR
+l59XWdGXmVdZ0ReQV1nRl4ZXWdGXgFdZ0Znbq6zobOtVVgz29CsrBuf5lRWDqQLKij7PfcqKPt2xyoo+
++9HKis4N/cqKzhbRyorOJtHKis42/cqKzj/RyorOT9HKis5f/cqKwWjRyorBdwcqK9alL7mpOamywi9+
+TqqskCumkygr5G7sRMoKuVs7WFkht3EnUlbIbd6JlBVyG3iwskLuD0+krJD7xxMpK+T+8mBlhd54nkhZ
+IbBOpazQG9ejlJViHausFOtIZaVYRygr+kL2Kiv6IvUqK/ry8yor+jLzKiv6AvIqK/rS8Cor+hLwKis6
+c3uVFZ1tvcqKwZ5+ZcXgPL+yYjBVQFnR57lPWdGnO1ZZ0Wc/WlnRuaFfWdHZIlpZ0dkkWlnR2aZfWdH5
+J1pZ0fkpWlnR+atfWTEYLVpZMfjuQGXF9ikjjhsn1VasDLwn0Vb2xYm0lX1xMm1lXxyhreyLk2kr++Jk
+2sq+OEJb2Rcn01b2xcm0lX1xhLayL06nreyL02kr++JYbWVfxGor+yJSW9kXEdqKvpC92oq+SL3air78
+vNqKvsy82oq+gLzair40vNqKvgS82orO3F5tRWdbr7ZisKdfWzE4z6+tGEwV0Fb0ee7TVvTpjtVW9NmP
+1lZ0bujXVnS2iNZWdDaJ1lZ0tunXVnT+idZWdH6K1lZ0/urXVgxGi9ZWDL6L0Vb0LG3uM2j4xRP8lkJm
+aRupv90HJyr6hf16uavqwEM79VDI7IxlSPO8hxaRMpwITLM+uG6CNR2tnubEy0cjA/3pUuJSfylmpaP7
+1PdaCmyl9+lktjsAmsw+5ykyIHPaSvVLcx/WWxe4bQGg7HMGf/Ygwgp9M2XkQGXT0DV5jTNetWvuy26T
+VKuEPDA/qzLxstB93WQ/8pzMzy3QLA6SBAyERdIfSPV3cOP0wENgjuwP6KNBGe1r5hvOV4vbLFUx4qyW
+ELQuA745syJLvItHIHCTLlwEGNFMWDolgYHcrZbZEDgfjZGEK26G9SpjkY7cT9YcGd/9s5RdZ7fZwteN
+B2KXgV+dudLKemdrcbm4WSxCVLbSo3uHtLxdLparIZACMwZU3QzrmYeJHdkf7PlSX/2ztbzE18sF3AEI
+rcuAb+48iZLeWbpdZdM7HKStnjveLxym2WqxiIcTmiG74mZYr3m5qkbGL2tmxKeAqMN4jiG4Lpwusz84
+s8E+90/F9XKVpSESqiT6XszTRZbheSSQwCSYtTbD+uPRkUf2B2setK/+qVitMF6kcAcgtC4DvjlzIkt6
+p2W1ylY3wRXCYfXMzGqFb9NpPJzA5DgVN8N6ZWGqR9Zva3bUx8DkzJeu+OINAVhd5n5yZkYU9E4Mzu6u
+w6KLgeqbl+lisriJBhOYFrveZlifNOryyPxpzYn8FpiSbJWtMAQagNRlzhdnPvj3/nWyWC1XyxAdKaSe
+2cBLvFxdx0IJTIZVbTOsx4zHUVG/nNXRPIUnYnm9vF2mAFwXTpfZH4BV0TxFTMLd/O7ubhlm5uapby+/
+WywWOBJIcD00TzEzAPaXLslT1JH125oF9TFDnw4+JBlIM5BhCh0FM0Awu97mcATAjO0RETm0fAQWa13N
+r7L5HO5FyA6zm6u7+WR+4+sJ3+ElXkE9gQYGlSt/yCiOWHvoUyQRaLOgKWbib3CcdWE2n4+Q+hdkY9D6
+6jc3+Enq6U7jOyCz0PxmPr7TvMM7O+WPetcGBGRxLYta8JO9cSUpAw6KYhYYhZrMumq33GgmTwiJjwic
+P3MGgbta7Ubq+iY81G32DzDUbXaCod7dTcNDLdb/AEMt1icY6nR6dxce6774Bxjrvhg6VgdGdJBTEP8Y
+7D39ehB3RScJuJYsq7JrqiIaWxE+iWbapEmx0TfiOgF9Q8zN5x9sAzsr1O8cTpOTI7wdQqK3yOt7LRT4
+3hcNbYmzqyzti4ZGw9bxUB/6toYm4+m8RThtybGfBG0dscjBmzSrXtxSW+DXDV7hpk0anO2WOEu2lQgn
+Qn6LleNOn4mRzCHwGZpwEkC5TfC+TkuvpmemGuhjISBcf+wMWWabySLjJUZ0fqQRkV36T0TkNKG+TWeX
+ciudzc9hPO9pgucNTTGBPoGx4RCq6pRkzTQCzelARHTskTkRf25wmlVl8foX9KlfTXM7YWHHAbqy+aI5
+BYeT2MWfhtwd2R9pGrJIUaACa1AQWnB7IwY/lRNSBnxDFtk5GJDfWxHIXxIXL56JCwhPuaFCqEYialfz
+xJSNxUgobhBGs0iUZgGcJuPbm36kDG6oizSnccOjNwcr+8JlKP2CVgjOcGw2pnixxWQ7XCr1k3qPJj3k
+MD+32xGKqmjzXFT8NKsrM9y3tQcHtuAZ34GhDbiPM3oiScKDBFGc+hGk+IVDucYip9/nA+L0zwTuX0YI
+Ktruii6vZRIqKLKpiKMPbL9wIFRSjabGCEWv1eoGF5yR9cUKcktbHxdyEI44qEcgk52omIHap7iIgXCg
+M6cXlrg/FFENIJAJcwqRiMJNtKQhCnq6aKti12GA1pdg1qukrwepJ6D/g/RCbbv0xBeGK/uSeZkdqzDR
+duxdyQ3UNYcGdm7v0RLz6PZwXGk7xvONf8i05146a1m4vXmMZG+X01lPyrExzcKerDDOyG4AR+X3ulVZ
+iykYA1qmMdX77aqKiI0gM9FOZM8/JDQTlfC1dFENJ75wxbk+lPH0ICEfabG6Ilr29c0IXd8RJfvuPHxI
+YnR6SanqmmdphzNzt7yn360tdJzz+qDJUE6CI1mgQ+m5PZp8m67xPdo1xRlNtJLe0y8X7fP6m/22GL27
+XJLUVfttUbbfvie5hO8vLl5eXsYvl+OqWV/MJpMJqfwePef45efV/tv31DEa3b5/d4nfXS7rtNugVV4U
+375/N7tk2L5H2bfvfzsbX6Lr8c3lb8bXJCr/5TIZXyXT8eRqfHWdTMdXaDqeJuPbYjqeIvLzcnyVXI5v
+l+PrZHx9iabkv7MbItbGN8SN82p8TUBcjufJ+JaCmo6nP7y/YHgQJN9d4oc3DgkaXOO0I/zG/7QrKC5m
+ogFRAms6Oqfw9BamMWM6Xl3WZirfue97PLuwI5GPafSDqJ91wmdJi81n8wHYEVFvSqTRsGZcoHgXBQi/
+p6ohpOCssBaKoKYjlitYaC3bQxanYryuqv38FsGP4GQtdy3xjWS6nxQ9xtejZM/Z1Bgd+gm6RBfoiui+
+8IBPL4eu0ByUQ+wGh8shNPnNBM02Vz9sJ2j+mwm63FwBYkPJB05vPi4uFC5u6z2aTur96P8pYUq3SI00
+hoTk03xxItEH8KuUfTDX/rjCD8AvSvoF2ynx51mYYA99dYcLQNdgdqB8B9vCQp5WHSDpffUPHq12dJEg
+7aPIyK1soBA+51g6dDQWkST3N7Up7sHeR/Bg9QPozRlWTKSFslUq6A41MtCB2g2h/3CsZAb+A7Ez8+b4
+ZNYByMZKoWBzRxj5B+eVSX1NTso895RR8dHTFQsHnLbLqyX2X0rI0qFDY3vbsQOLgoI+fYF90jsmllyL
+U/z8NCMcBvPw1UeTN8JyghZZoktVhySDanFahGJlgb+tIwg8A/FKgWD9w0WAjbHD3pGTEGj3xbTJvPzR
+LHfZ8nJ+NXd6/n/KdjebTUZofnk62x2nImCo4CUg58ip+Hvb7+SxkmGknUGTGUpm6Abd6KfQtmuqJ2w0
+IOfQCZoUl+hyO0kuyUlaHBiXebMsMGq+fT+eW9+W+2/fX76Hi179RawVVIMdTn/xf5elLy81ueRnscCB
+V2O0sIiyFkastU8w+f9BjiQbDW/qt/r19BNR/VTWP7ngvfY/Y+H/w1sAlQBzzAdHSLD/tAL+p0hWIvnv
+Zy+0JaiPx39cEQriGClDe9sGbIc9PcXUP4kN8fBdw9u+x5Y4YP8ItTmFTVEDG2lVDLQw7VqWhj8Ak+hp
+CDcP2xejJqG3yalMRRrogZbGnpZD5uQQzCJsIJEQwvLuIITjJVgviH67Y5w8i2l2YqY6gQVyICRwKvHV
+9eQ68z6WE6XDh3esFXIQHPTpC+y9gXGdzBJ5BNTDV6ZmDHOlQL8hLNTm1EjFy4pw+x67ZJyU6G1zEvuk
+j/GjJ2aojfIU2ivdmEGXStOzlrzSukdN9aK8a2EfSxes4+8KvLToS2qow9MIAqHrQ4yU/HXXdvnqla5L
+XHZWKeio/9np33F6hrEAo+P6aBlCehBa1osp20EWeGuoYWdHttvmWVbgmP7s1xShngFQdCUwko7cUuPs
+gj7B2HsRVHx3Wm5xyQc5FwfR0t2HvW7gnGnaTZOXTwIs5Fns+harq4NAPE+I1BYXHUaiXvCJsZJ9bM4E
+yqIrYZdvxVfgC0vgSQ3hUsHhGroB5t+1uBGncnUTEv80Rz29tGsMf0ca+YQFfMXpf75pDQWs86M/AZVz
+3vfyc9GVPcEQ6ZxneFk1qQFEtRdWpEVXjtk+/OmUTzQJWO1RJelR/ESfjMeSk/G1VLlSs91DucpxkbVY
+e3iRKjLVFRHBTYKfcdm17hhl6MTYsBiTyc0CfsQqSxzgw8JEfDWZXN9ld3AX17PlEuxCmyvxbWwY+UKT
+dHk7QtMrNUvmJEl45mTJrs1J+1I0pOcJ0dU5/TlWP3moGxTfZKyi47Qb9rzJGGtT1Vn1QnaT9brAQyaP
+TREwtvlycfjYoBmOGiFvGDfO+1PxjApzGUs57Sm2RTnrnZQBfuDamqfXs+tbqJP51XxxPfN0otNefo1f
+X9NLIgYZxYLEspeYwmDwIjuQnAOWWWQjz0LTxnzgUpMz5oYYwvP5fHHECOEJP2K5eUd7fzom4gFLY8ln
+uPOEfK404ANX22x6e3sJrrYpvsGXV2AXBunZt/iVdjMboentZITubvwkclYZ73nwGjuIhENWWEwT3/oS
+Yz1wdckZcudueXN1OTl4bNAEH7Oy4HHen4hlaODZaJrdpLMFzO+ixIQ8cD1NL2+v7q5B+NOb9Hbhwtdp
+TT7Er6Q5ETQ31yM0vZt76GKvI9rj4EU0nGgDVlB/fc/yYeM7dO2I2XCHNLmZ3KwOG5IzmUesGmh496fi
+DREYOD4M/1er1XI6ufkAxg7mJQ78wcH+v8KT9HYCRmbMLu/wZAL2olOdf4tfRbMZkS439BTsp5S9kETX
+8Fr6ApQcsKKimngWlRxuaF2Fhycnyhne8vpu7pnCwatryCDhBeYZ6v2pOIeHeI6VSMa9SOhCS8EeuDct
+b2eXl5dQD4tsNr2cQD3oJGefhqys+QjdXnq2bQ7NWle828Fb1CHUG7CkYlp4VpQY54EblZwad9Jm09Us
+O3RgwMwesZbgQd6fiFVY4N0hEvZ2dbdKQQkrSizoB+xUM3yNwT6yFE/wHOhDJzn9MmAtTa9HaDa9If+6
+85HIXkys14O2qOEEHLCaIhp4FhMf5hGbk5gbQL/I7rLVgQNzp/aIxQQO8v5krHJYjGngOaERfVpAHmp/
+uJzdzGA9PJtNZ1cufFNuNU/xa+h2NkK3tyN0d+mhirsZNU/Dt6LhJBu0ETVPUazlSujmyeap+NOSmAvg
+ADhNp9lhQ3Km8qgNyB3e/Wk4g1+mwfdQgy5KLEiDL52O6EontVUWv4Csm8IwnezFZGMEryt9kD031f7B
+DlhPg5p6lpYz8IMvpo6Y4MEL7pCRw2uvZ/z3J+Yvz73VoNsUB9bAtXhkZ9A8HHJpNSFWHrLTT2dRFPOt
+yb5LLH24g1flQTdXAxv3rMzjb7KOnPCDV+cpbrZ6qXB/eo6DbroG3cVYkIaqlcd0Bc7D0Gsu64V9mE7e
+dRm89tIHOXxVDr/rGtS0b0Ueefd11AQfvhqPvgvrGf/9ifnLvRsbdJejgxl6EXZwPxDhh12MER1iek0v
+Da96iONbeYGLMn1kg5fd0Nux+HY9C+6427KDJ/PgpXbk7Vlo2PenZiTwNm3QLY8Fabg18qjeIPoPv0cj
+SvuUnKBv+knlW3bhazV9kINX3gEXaYOa9qy/E1ysHTXHBy/E4y/aekhwf2IWg+7dBl0PmYAG7nvH9ASR
+f/ilm/FqKkgj3yoMXsLpAxy8CIffvA1p2bMEj7yJO2ZmD159R9/MhQd/f2K+Am7qBl0lGXAO2AUP7wsi
+/tA7uqvbEZpd3RED1qSPSL61F7qz04c3eOkNvqUb0LBn4R19a3fEvB688o69xQsO/v7kTOXe6g26h9LB
+DNzxDu8HFnhDrvTmsxEirm7XVz2k8W913is+fVwHbHTD7vXi2/Vuckfc8x0+lUdscEfd+4WGfX8aNiry
+kq+s4FtN7fam990ehQkts8lkfr24hIHsygw3RQ5BMr0PSmMBBeGYhIHRdJxKSrWavNcEva/6ijWHR99P
+80S26lHgF89CRvumeeIUEu3Wh8SXzNVG+qfvgQcleVStvkE2CDMUqx45lD7d/jNJu/3tw5t2t9jm3cOb
+v6j2I6tKg1scrrHYdV1VGlW88RLGqzTjwkh/GssfkfIHuISCaXNg+lUJvzftapphJmeINDm3X7PqGSGL
+tG7dunaMWaO6NAZ5XscLBpkYyYbv0SbPMlw6L6BZdcLA/IHyYdSxkeujERGnNLQB/Yu+zxc/iJQVfxd4
+1QVGq2A5O9LLJu9w0tbpEpP+eVwJsMH9fbrqcGPR3nlNb8YLGM/mc7wF38nrZTIMwMObhzf6ghVp5vCW
+PYTXy1QqNFHqy2JpxgbgHwWGvtYwEfC27l4NUniSr8mGW1zuBgRSVnC0oMrTCd/q3LjKq6JKu3vajNFf
+RUKZTqw8o1KY6ynlyMcpl65uGlkp9nsCIsj+i7ztkrZ7LXAw5MExeaiFpwL/v/F0HhWy2ZiRRK2ZxshP
+qc8A1K5R52sjVSlrpSe57ItNY8JttxpKLlImY3yGAWi4WdhZ+AHJ6BWSN9e3PiS32ZFIbrMTIXl3N/Mh
+WayPRLJYnwjJ6Wwy8WG5L47Ecl8cgiXfUhAkn6ggki1kLlYwvDyYoXg8dVec0dfffSc5cv+AN6L4nQQi
+xqAdhc24d/LY+GCB1j+PYhzALNr9/gOpBL5JM9aDfyJ9zZ1JjaHHUXMJ0NQhkAGAAI3kBUu9kKzRn2cW
+YAar3x+fFw5CxTglBNrqwRZ7x6GFzPpRmRoWRP3s3Tf6QQwcQcAwA0u2/fOeBIJb4i0uu//17cObrqof
+3vxlhMK1KDEi6jGyRFQkA5LpuW0pKohr5DGX4LL8Oc8EzczDpVKzNd0bPHHqzKDU3a/wHV7ildtn3uFt
+pMUCMKVMlSllWeC0uScj3BwcES0vN7jJuw/+U+WQyGcTeLTMYDdC1lfN1ia9Yq6nt9NF0BYYvucAeh9L
+e7HZPfsMm3gHdW45mZuda0ZAs/tDLYGxRnVjyTDTbyjeqay+wWmGG09dwMDo5P4xj+0+ex8w4CirBqFd
+oiJPhjF0l4y9GpRNUISjVD8SIQbj8vbbSem9EQatPrnp1NO1YVeFMVjR0KNTNDWFnN0BX4WhbnTLurJi
++CHq5nOjwLii0Uu0Sxg/FvLCwl8lAsxYX+Ce0XRVVSzSJhgLl/DhvYrd6sTApJXaLm06ELQe7dQw8IYn
+i97srPKm7ZLlJi+y8xFQi/1w6wI6QDKt9z29FakEwG+STB2hFwUNgMG3aoNkmpCy9bhqEFDji1DIgx8h
+VhA9pwJocEzausg7MG/QZDy/VvcvVhBZrSwAl2t6I//xFKwHn1yMqocpjwKErkJamrZ1e4S+8QDxXCv5
+qnsofHnjJbAs0m/U4tBRV20D0fFj4yID7TxUwmR5g5cysOtuW7pRuQ1JFIrW6+0uYisCJJlzvwY27Fmw
+cD89so3q3LBog7sfJuNCOPUIO680ixQoX5yMgwUgLL5tbBkZQUYyigwpbcyprj8GoSPzxjXN8oqcFmMb
+0Jjci2ofbmPiObTHQGut++A9E7teIWtfuzw57/cVcNSOPv01qPUYcqbtGtwtN94rdr3rj2bk+FG4WAWW
+ByoageH95au8wMERO/qyGsW7D0FGDGCOvjF/j/qr943HU5+ML5qMA9EyGw5BEGzpQdWAG4GhUz+SE5wG
+YXxIaTw2ovYQrvwGYFK/lh6gtjoihUbeV4siAaQ26UtMIg9Tlz5cozowYV1FjdveentJYDc44YmkF097
+k+5H1FV0TnA26ROT7g4QyvXih+bSGsiJczQE46TyJebRwceYlJ5kPSeap6RucI3LzCJXktbkKzxtXiBS
+FQMg9RqX5Pqc9XYBihu9I3DdX/bC5Y56o54qehmgQaj6dsWjG/eQN6pGHwKi+iHIh9ra2nd4H5J0AM74
+3jZp7TTp6QWw7/bIpr7cKn22adNP1r5ECeapv7qbT+Y3oVwz8Rcr6tII8qD6aomzqyyN8ZpyqOk9uYRr
+OqcU++rZ7a5Yg7uhSLJ9PgLrA1mfBMGNTNtkotA3iLgRRfYe0R9cBeL7j/2rzW3MF8BBbbWOASES6uvH
+9AnXMWi39gyE57/dOvMRmH8+hgAHuP1H9AhXOZAD2q1/Voa3DXNAux3GAV/SIX/gKvNOvGVAnVpmUktf
+i6bV4TMKk9hnBzy88WFoKCDDTZ1DodsY/ojnKy+Pj2LrDiQv5w+v7fSY5geiooEJmoOHg4In9oucR6Gs
+g33GSc2FKy91sQTfrEyNxztuWl8gAap9fW8qt9MgOJnW0WPDlYedZPrB914GgDksY7Tjou8JhRjjwwJi
+c7IUz4HEef6OT5B1+XayyPoGab2o5D4Eh02AS+bFZXYDT48sCeAm0IpLV2/F1zsIokXOwHkF7ETDyrPA
+wXOZ7TnTVXWwBxPL0HsdPVWmOIMqGRLwxxNVpOQRH7xeWoDTaOA5DT/rfZVmi/ki4+c9/XgMj1szhf24
+ww6NjhAhIbtM2qH55B26oP+eT945g+GHywFL2Xfe7YMYKU9106KarXybrvE92jXF2cObLO3Se/rlon1e
+f7PfFqN3l8v2eY3226Jsv32/6br6/uLi5eVl/HI5rpr1xWwymZDK79Fzjl9+Xu2/fU9E4i26ff/uEr+7
+XNZpt0GrvCi+ff9udrlard6j7Nv3v70ez6+vxjfzIrkcz+/Q5fh6OiMTd3lL/j3/zQRdjWfXaDa+u7lC
+N+PZHN2i2Xh6d/nD+wsGmPT67hI/vDkfSCmyX3W42eZl2uGDhO1B+81JkPpRJ/EKXemT2HZN9YTNaZyg
+2ebq6BkRonqYUuCSvidg8EBkDuGTA1GiRqsDRAUgeGBQ/2gyIrlCyZUmJZZ5sywwar59f/nelBa9vBUa
+8I/LWO1L3i035jmfbUkzWKTzBhEoMTDJTNvsxD6mu2BZG3ZaFLBpYzgyGmfQbZcaroTJRVqsBKK0NJm5
+xRxpWk7NnQm6EmWmTcwqBOQs0yeCAzRfs9O/ifHsnydx+NC56Xu+H4Z3lJRw3hkIanPCF2mH/+cZv7g4
+/zAEox95GWvmOv9zK/s1i8eie2OvAPcuh6/g+MT5R93jeF33TTX39LrEHFQIWQwhpkzM0OQ3VKH4YTtB
+RAW83FwB6p6mgjc8BgajG7uWurit92g6qfexB5OBl1AIEatb2qTlEtuRPlwPlfBh/XRZ+YGu7++TbZs8
+p8XOOsRr3OAlDwD1z9td0eV1gcmrNbOEMOhfqH2B/vntw5vpw5u/nJt3HdIjLeBE7GodAQIfkJfAc6jn
+ACnB8L5OHT8EPxbE1B9/paMG7xxeRYF6MhlwpQ/fcEBoFuvom0cHyQCOPiNl8B7OwK/Xp/H0MtjrBOm4
+c8X7jxyLjZAAfvvpAC82v7w5VsBoSAAGNgeP2AWogS3Scn2Gy3MQrKF8aIaanzfVS4u1p9juTELg/kw2
+uGRBG/8Fhp12XXOmVYMpAloDAWPVB0+UFju6zpEqhaNBHKotnH779NAu3t7nktC0sBreV6AFMETXGIrG
+Eg/mzzjfGC5H9TfMNkm1G++ZfMdtHY3LNYaes0CHLUKCK4ACke+k+5QiiouuE0nNp6f2/X3yghdPeZe0
+RU7v5za77cJ3F0O4kbDo6CDpZna7rX5gX37ELtsv3xcbGO2RHExNf9N7DxeFZqHXom68aNL1mpiEZ3aM
+HM7+U+iE/yMc4v2cftDJvpe4kSf6ABwjGkDo4i4CYLMrS7LpJ12TwkEd1fRPnLfptsxY7pqWfOd2Kx9L
+ZJjk1QVuFn0hSUwW8S4BZ233MvJ/8muQfgN51YJxHJ9qwP7ZWbM9WLjCAZNmVlUZ6ug/2TpM+6H83J6E
+kdsfk4M9fBou5UjB9wjaOIiVLymql4C12F1AkUuEwt7VtfWwX/g0zf32v0O6lBbokDIauKIYAhLaY8Vk
+ZniV7oquFyKsuh6MIChcB+PUHoQMaOEfQWfIEWzD/5El0+FCyDdSBD82Q6CDcZ/MKtPnISFhLMuj37EJ
+iLIrO9TC5ceEXdJXoWgt42zJD6Fw9iAC48PDUvlYnYDu0kULhEcwDCNS5FjN6F8qaJpFWOMNkdlGEdQ1
+w3iEtuvWqh/EvE7NluHGRcOYGavIfwXCDB7qv0EKxc3ecZubt18VjsmdNhZ7zJqRQ8xo1uak/qvfxqj+
+gQibQNSQEwS/IF3WeVF42C7IJlY7i5C8VOTtgEm4ivayIu2JSmAvKSAgAviSTUFhoWVynEGgkkXa5pKA
+9Mu6qV6ExTYAuksXIloNGS/5WaeOp7YhuKwmulbpiXRXps+L1DGiHhsTQ44EiLtDX/4lC9y9YFxGiXOC
+IHkbU5VdmpcshBPwOVkVuzxDn34EnHXUkkXj3v45F0/W/djlNHSNp5eCnvdxr9X6w1m649Bls/o4ZOck
+rfpUBjh800k0B9G9JR/sG2RPhy6YYA6Etku7fKnnMIDQAR7w9vFH//2p0YPIL+KKHXkMdCRPKKyBQJw+
+jWq8z+ZgpxPv48mB8VN79ZPAVqJhb3O0+DyUp3m7JF9WZayrz3hu22DGvhDLmldNrDs5mzRdcKl6YjIm
+k3dGOCIZ4T/dq4QO8/Gdli6Bj5d5NYh3iZrs7auiy2HPwoPX+ufIpBNO77wryuIsIHFTvehvy/sCVYrs
+BA5kR6C5AqypXmIhQMLEe3cZA8+UcVB0RbESAx4hn7/ArGu7rTYNPUMypZi1eaD/km/rqulSLgNMMafn
+iAh1YUg0KBXNZ/86ubm+CayTbdZLsW0WoNhp1omR98Tp/Qutk2127DrZZqddJ9vsR1onx856/DrZZl98
+nWyzk6yTu7tpYJ0Ua4Mco/4qX2KdGKl3nN6/0Dop1seuk2J92nVSrH+kdXLsrMevk2L9xddJsT7JOplO
+7+4CC2Vf9JJsXwRIdpqFYqZ/crr/QitlXxy7UvbFaVfKvviRVsqx0x6/UvbFF18p+2L4SjFBaCdakL9i
+AtCbAGFLElSqE/YQ+wEH6XAyzMd9TaOzMfYCCltGxn0+5KcjKsSrIexNNg0yKcCiIcgGd8K2VV6zYHHj
+Xduf9WKI/9/47jwCgm2jgKq4CWKGdgVMvgfSfAikMPJm1f5B3AzpGr7isUFexoC0rxVGwdrcsh5dXyJM
+uomsCqTkGTrjBluH5tq+VbIrTeM60oxjw18DG6+/Lsn/vY94NAY8N3dH+J6XMa3m2/cz+aHIS7xM62/f
+U1Tl523e4abIt3n37fspf2x2hW42s9lvr9B0zv47u9zMZoHnxjClpDH4wLVHAaTHsQUFEV63vM4hUodk
+0Q9ISPuGEm5iYwfUAFJm9YHuEYHUEVr9C5oMP7ggxlFi0On+Zkj/AWHoAJ7FAA6IRLdyUCIGkLYEYqCm
+P0WZfxxeAQjPNCgGnarT85g+/4FkITDYv6tENMkFCsShKxGQiv3MAYpBqEqPpFmmTXbIFTp8Daqdvtkx
+46VqMq4mLxqcPiXkw+Cc5NLXKDol+SwuJzkd/Ue0aXy5jrw5lHjLMb3PZalZ9EB82neeI1Er/HRyRyUA
+Gy3co4NMkYK4wGEcXXzAqIAARsmiyl69ninyUnYKNe3yzkqsoK6Rb9zq7W7hthBPcC6D4aP1Tkm8RJs4
+4SZq94y5j5VNaAYJ+cONqA3SRM/oqF1pi8AKs9AoQxEi+P+NJ5fnH4KufZ5l5qDo5XbBLUZAlYT0cA5+
+m6AJBB99o/P0oMXmTOCqqrooog6mn5VHtp94DBXf6tSeQsbSD6Kd5sJpirtkMr72slACxGBnnGo2szMh
+gxgwb7hYFNxuNIj5dp2QxVekryd4TasblsPiKd+u4ScL/YzuDqCr6iAwZ4+AoQb2iyg0GC2CmIA7RA82
+0G7Ri0+Gl0+D3bDs9ppyY7EzfzER5bJh4eNYdLX7AjuPAutFKxExG0XBZ6sHDWNo5GofnaDJO+2TRx3z
+PhTxbBH2JqShqdFWS0V22OSoDC0nmx8NJ/8EfbbrfzQJDhAXJJMXzjcmPEd1REiuCusOC4TnicndG5U7
+JndhfJ+GnBoNbKarLL14H4ITG+JQtPRN/3hqucG1+5zRewNsD+pywAwB7bxTdCqkYqYIaBqaIxg1TcQw
+sdMGRIx1jIiSMgIqx4n9TJbVruxEBA75dZ3Wuu5ASqqm3qRlK9xJ6S5bvcjfn92O4P3A8dl092tJjXS5
+rJosr0pT2BGdiUnITZ5lmmO4U1+fn2qVkHQyJuf0KvuTYcC1NSv7++TRLQf1ZXTTD/LYxK8uAkXq6f/w
+Jyvu9LqLOvTQihhlsmUjHyoOeiennZM8x4ap8qZ23N4jQ8P4DAwKc/Zq5RvkfPrUexPcD8SMstjvBN/4
+Xizrj7c0L+kLLYxTBDbU0mDi5FgcdmWGG4LeqQEbpgwLAGjitiLX1+k6LyksH7NBHj4w64SZo07XWPcT
+iE5KYb/n8R97xaszKz7SzAyQFA6eHYguZT0RlAPSbU1WSDbV5/x6cfkhNIWRa895RG4jA+UBnJ001CPt
+yzHq2BMMaNoD7Ksx9kyFh25YtdCIsNfGGlutXsXysnu0gqgNeUroD/Ru9y0v5+zeD3lVLO2/Q5+JAmKE
+ujPaa9214c2H50nz99TLi37Gu4ziu0uLAWAMDuPCyzgm9KHQbp3OTpSRzN/VEfSexa3zvsEes+ojF72h
+k6TZOlbdYGSnVL9yiX4zf+cGRbwBgyIekvXSfga2SFvM1Y5gRGE9ZIM/7sQ/ZOwKbW56Qk+krK64oRU/
+o1/usep4W3evIc++RVcaWHk0Hf2hvAafWuI9rpTXHk/Ka7yFJng6cXg4qZt8mzavB75yT00oFiXlZ+/9
+tqeL69ly6euCwnK6GENxHA9QazSthsFv8bIqsyEUMhXq1IZj00gVDKPS/Gq+uJ75u7HoJAsOptR0cjtC
+0+kNIZiHVrvlErfxQ5jdpjdXc2cIDIpDJ/55GJWm+AZfXvm6sGnEPh9MoSvCTNc3I3R9B9InL1dVPOY3
+6Wxxa2NOQNiUod8GkmV6k94uQOAWTci3gwlCFtb0ejZC09srkCIvaVPm5drEezadzWd3fv1zOZ3c2Khz
+QDZpxGeAOuFesss7PJn4erFoxD8fTiYie6Z3lyN0A1IpS8s1bqInN1tezt1lxYDYBOJfh3HPIptNLyee
+DizasK+Hk2Y2GaH5pXdJMafSIexzu7pbpTbuFIxNG/ZxOOukeILncA8WcejHw2lzdTtCs6s7snlNPIzT
+xMevYYkv3FltnlymaZ6GCpxsNp1dgcAdhmmeDibJfDZC89sRurbFzV9320XVNZWdo3kWsoyGwlGGjKCX
+A+4sLMQ01Ejoa4XCZ2ckBzwfCpniC9yEkwsM8WaammeqI4JtULyoqdzeJ1T4Gb2mOvIBJyq9Ypa327xt
+84VM9mDS8MrFQm8yXhZViw/yZwkSMjg4UFmfTK4mt3Mfny6XeO5JgnqbpauVp4dN45ybRbu71TLztnNm
+QWE5u5nPrFYe5fry9jK7mnpX3gxf4jk0ouw6u80W3j4CY1reLhfLlbeld1Szyexydm23g9Tg6Xx+M7vy
+jSm7wpmZp0BidomvlwtPD4ERLabZauFr55+lxQxPL61Wrto6Wc6vrifewUzxcjUFWQ7jOV5A4AMjSRdZ
+hudQI/8wrmfLS3sYoK55O7++mlwFbJ2XywwayWqF8SL19BAYzGqFb9Opp513PPPLy9XEHg+kFd7Mpks/
+l61usxuYy1bzpctlvIPQaKaLyeIGbuYdzNXddDa9ccS2o8fdTm+ntzPvWDD5HziWbJWtMAg/MBS8xMvV
+NdjKO5LrW/I/Z/i2zjVdTPHMK86o0LoDl/718naZQuBD6/5usVhgqJF/tVxN5hO5wP7lCb+umnSLW1Q3
+1brBbZuQpwpt1+Q15lJt1VQyLJI2JrURTkX6D+4y0VXB6hPbQUR07buEdIKOA84SlnH7Zn6gOmdd92hE
+OSAInfOgXDPiOgkLh9l6wxdJuiWUKqLETsjMrYfZWV1C9EX6Bfgp871gIjp/2iRrMg247M6u5hlej3wv
+pubnaDZ/N9KVS/fDfPIuBKCn+MYGZ38494RIc0JOGmRIy3ybdoIO7BclH7j4pi0nDMrLVV7m3QnmzkLB
+QMKdQ9aNh++NmH9ApATa2H1xIsu19wJfLLyj3RNFN0mXyuXB9tMCwufqK9NW1WHQ8hmYpxi6pZ8Gu491
+GTAtHr7+IdeQsJ3DyptmwT3CqaP3dKmiCQ9x1wi+7fhxn4L1vfWC3wad/gGYhYd0InDZ9P7wUOU9GT1t
+HHRG9DjPnMJzQut2UzX5D1XZpUV//BS4Gcz89ksDzrQxMikA+yjWDDNFlDdjJJYelrYe9pzMFSfO37PP
+GAeOzY4A6o0Q5W0Ns4eXQYIvGqI7cfkkjlOieSXyqUA8wjbLwEwTyTbxjDPAb70vIiY81m12DP9ssx+B
+f7bZPxn/bLP/S/jHiBQJj7VYH8M/xfpH4J9i/U/GP8X6/xL+MQMowoPdF8cw0L74ERhoX/yTMdC++Gdk
+IB3lVbFrN56p5QAhbS3qNrG3m74jj+EHZ0M7OIpHCMVBwTj6D2ghY8MB13jWZZ0H4lDbQ08z4Io/jKV1
+NTisu0AAJs9RT6FiH/V4CYzGQbeO1t2iF+bQKehtCExCGFPrLnNoh0OnQUfGnAZZ4kFk+DWpdRnqgTh4
+CsLNgAkIY2ldvQ7rbij5dVRM8ssSGI2ht7rW3S0EbijhQ20g2RPEz7omHtDXYMGj4WEJHlEC43DQDbRx
+z+yBOJTwPc0A2vdiqd9qD+tuKPl1VEzyyxIYjQMuzM1rcRjgUOKHWwG078HRvIMf1NtQ0uuYmKSXJTAW
+w6/3zUt8EN5QwgcbQTwfxNByGBjS2WCO1xCxOF6U+CZ/mC+C5XEAgRvO7c3TAJqH8bOcGwb0NXhb1fCw
+tlVRwnDQ3AB5ijZ6aHKfMpov7iJeeiltdqIu/ZTrK7nYmqgxVHW6zLvXezSem7jpj5FBkP5AeLQ5jfQg
+bn9Y4Iex+immPaauNt0K2xuJ7mLXdZVBUHUnODC12ySYjz1lfVjxbD33V4wUXZW2nTj6yQwbl/MJt3j0
+e5/c3vQFqXO8Dm7n53CoTZW0LzrW5jnkPG0m2vM2SpdPWVPVJJtpR7pZFLvmbDoRIcTkXE5i3GcoJT1B
+k3qCSNKmNKCvVKJk31Oglje/s7fVJs/C+U9JLSNuR48PhOa1E8xvGJU8+CRcEhExcjI/t0asPDbsa3pZ
+cVtlaZFUNS57gtdoFdnfZv1kb6wh+fnVyrOgNVVOBqt8jzPb91o3WCnXisl88gGaaNf9Q0pl8QFc7MYz
+BX2kWZ4W1TroDsF75OMT68DMlRGSTrSj8SrNMAI61X2y6N+rqiHcd8nf2rKHtkiV8WpF2mHCFsl8ooX+
+G+ZnFMasz1+MtaZL2Tcuhq9LDV4zaZdNVRSpdPJ31yuR52KKachDMs8ooS5b571AeYFIivzJB/F5o4Hs
+XR3efpjoGfVX1ANzMUesTUOCgXjYE4Cg1nx4DQoITNThbKhcJHcZA+kvuvLE/VGCvmcW7ESoga7CXHWg
+v6clWQ7pvo///CujD7RJXIk+ANDo/BQRym0JbAu/qPAkxytNs/Pg866AzBcq0zH709XEosXzi800zxu/
+bdyHEhPGnxzFDayrtChVdzy3ag9Qh6zUbENyt0+Nh3peXUbFoRkS5CUQhgUYKXhEmQIPCck1Fvms/xsQ
+oIEw6uFQLLag9qy9YFx3d5T61hEvxuFEabjMwP588beB6QuHvxkSp8cY3kfkiwtqxKmyTi8gCN85ht+e
+giCYnCXe11uctrsm4iVhcnd3d1fvdakgz5+CO4ADKesp1gMOUtL0XN6TiXXHTziSHR+tTH3BDdOvdl1S
+nfc8AhC0+wSUrx7ApvriV0siwZi7p0cT8cFqtwDpLyXpY72JGLBiPdJ+7QsA9G0MaNPRJABtOr2ywY27
+qiq6vA4yubb33Ux8buLyaKQsLKt0mxev9yhJ67rASfvadng7Qj8nD51+my6/p79/WZXdCD28+R6vK4z+
+49cPb0boD9Wi6iry9d9w8Yy7fJmi3+EdJmU/a/K0IEW/q7oKfZ+WLfnapmWbtLjJV6ToZ6Q/9B3ZctEv
+ttVf84c3ehfgt+9ft4uqeHgjQRvN1aBkLMVmmxau2fBq4t0azMcJRIo5H9UO7H9IYFgbza/uwQuhAncd
+buijJCrnNcRpehmaWMb9DNY3nzfJ73SsHI6UNT4bmz+nDaD1cO6E1Z07uxoap01TvQR5GeBckXJnfCtw
+FPM2GV+ZZi69G1OQeLqzDzK20drz5p4zGd16ZfeLNuEY0EjVSP9AyP7nfVIX6RJvcdn9r28f3nRV/fDm
+L4516Eo8AISg8rFFA9cp7rrXgLAF3Qb2YZJb09I5yeQssvGpYYKPMXU1XMOCKgb9iNFqAG1thrEhR1NX
+dqDTVz+OGKO1ePY2AoX4SbBQMacBckODJwKYD9q4b0Z4+PNeNFm9YfzO2kRPiupCnxXLec0HPp7gdjcm
+xUHHNkFyk9qAEt5DbMJh/QiSWsOYn7SIJrMArxPZ4LNDeF/DIH4mTEzMeQCcLS3G908GaQtOhUAmL0sV
+flyqbzOpDIbChkY+VfaaJvqfWVe1urfsD7DiMaJcBxRJNWJyAPpPffI/9cmD9Mm/qw3SXCtHKaZTV8zN
+bXPWJNyzJvPsgnTV4WYoZidWazlOSq0VH/rUWudeem7Jfw0y+sjHHN3FR1C9pYaCM9YV+oZlj/sJSrTc
+sJ5+zY1nQP8DNICxth14tV+HlecRuFM+OQB1jb80o/DBuBsvlDVcNM09hJ6puZuB773MQxsNYB/ZyUdA
+fw+xj6bbzO1F71iw2YI3VU8Y43jGczCPV3qMyQPm0ND2IznQHkYkDwKj0LiQDSKKB/uG4WNG/dASQtQ6
+tOiJV/3cyBoNYEfVy0f35DJImlldx/OVi0LscR6cEv/JJpKxnIFEchY0Do21xJ3JCQYSZi25jYssUgfM
+hAfEML1+Pnnn0xFs7cVOSKqdWDzKBHCJuLoh/4MIo86uoeEbZ1frDaB3xZFGA9ab6OMjdIT9UcS/gXA8
+b9iID7D1zMWR18Pc+pE3co1ag4hcoe4YtPXJhxCW/XGDMNanuZIcC5QvF5Kpyek+yYF4aHIFhJYJXpD/
+xWTBvRyYBPcSyDlrCZLetAtKlgHOi14PTBnzSCYqrHYtLgIX7GbFseGsIgT2brkRTu+oTsvk1WmnW2PC
+boIhP0QIpsGb0CmrwGlzjxZVtwl4RSmY0cGd5GGeO8ZL24E9EPsZufhO+HKVLnHynLf5Ii/ojYwcq9+z
+8foEWUSA8fa5KxpN+IODkf09KfG+c7/WDX72zBAAnAJh7vXmd0Li89GDeO5glTbqFQ7k7Pk/6R33uadL
+giHUJQXq75NgFOoy8fTJfEaBWbDczdWUJHVT1bghRbzOh5CbaKgnaPKAanQagPH2tiO09M+NFf8N8Fa3
+gHsJP4qsC3U++RCk9j2atGypKWIfs8z6R4LQ0LFEL1gi8pqqoPMyggrIRMeqrOZ+a03mEQ5VWpkQoPN3
+Q4zxlg+fSR1eqCVhOnJCLZoiFKLqQTOlHh+BpSyvgG82eWNvcSC9gd/IbeUqAL0IQGRtw4enqiKYpiYH
+wCb5siq9Y6SlfUnM5K2Q5WkmPyjlkRAiaXCNUxprFV3QHd70bvaj6QsEu2uKs4c3Wdql9/TLRfu8/ma/
+LUbvLpft8xrtt0XZfvt+03X1/cXFy8vL+OVyXDXri9lkMiGV36NVXhTfvn83u1ytVu/Rc45ffl7tv30/
+QRN0i27fv7vE7y6XddptUPbt+9/Ox7M5mhTJFWL/m47nCfn/Gft/xP+b8O8/vL9gAEhf7y7xw5vz8AT+
+Y412Nr6ho52O52jKRoe0kSLx/Sqh/4sabV5m+TLtqqYNykzrqGe4vILXeXOPCA2JykBUWHFOm79zLQfi
+I5DY1j/YIkef5DO7/Ad61OA4ySsf5ow70Z1xpYOftcQu6z2ArvWVoSs+UtFEyFV2zGG03huJPfnh5EQX
+V9x7d9KX20MeHf01zeervq3p+iQ7EzBj8aq9ams8KQ4pacu0VsGFw2tBsJ3MQCN4wuRK7YbbYHI6HzPr
+/j5xgEXpC3Yw+Lamp8mEzak42BrkY1p2U3Xk5djl9STD63OTjhCQ/r3HtlLJD3Z2SzoQNlzzPTBLwMlY
+b7lrCNvRi3Kvzb0nS40wSGqhsq2hjW/m3ljdFhmkv6/vJtZ2mpempBneBmZqLa2Ck3fAPLXLtMBnk3Pl
+fjxX9Qx+BiZQwf6S0+dIKO/cWfNiHVuAaaIDiJokUjN2ikTSBaLSi1Sr6BM0Vln6X/JtXTVdWnZW+66q
+4aakwNtqm2dZ4emTl3nbMtJ78GVlfmzV3Hmw1iqEofgHLkqB9ou1GVbNGxsaaJxqrbVcZ2sr56gMUeDU
+hkq0A4Q3x6lnIFaAMm+OUd9QoFyjayc1qI603QIuCw+JJST1DUkP9uVNBeodkJMSdG3l8DQQNmpDJeGB
+sJyhnoGosFnenJ2+UVi5O9d6vk0dS62e87kHc5rW04O5EX3Km1jTh7ybYHNtpcPUcTVrQyXhgbD0m56B
+6HGcvOkvfeNw0mCuzcSVOq5GXaAgPAaWJdMzBi0gkjdlg28IdrLKtZFcUsdTr+l+75kCmsbSOwXNk7cl
+SyTpnwAjoeRaTwJp0ljWcz73rAKaa9K3Cog3YGANrDztNIXQ11qvAgHRdF7X8Y+/fPQ3VNti8OlkAIBm
+GdW/DAOib/F9r3ADYNS1gPZhGIiJScpJmHRmde790kcsq5EwWPSSx2onbRs9BLGacZNFoJGp8UD5LgKN
+bS3DbO7VMGRzY0c3G3t3c9FY20XNlt4dVLQ0dzE7KZ9nBxONjZ3DbOvdNeQEOaunV1qrbqW0NFt6JaUc
+rSat7LGCc0sFEs7kecX1sm/wNtDQ12oebgYJJzDwtgmjPxR2qE9XnkWCiQqtHerZKwQP6b2fTGbnjugc
+SOtDu117eOOyp+Eyb5YFBhsTg0OgZZ0XhaddT58TGFeoCXV/WOX707hJZElZlVAMMajrLGE2E9CQEmrA
+jCwB+wvcGGoVqN4BYZPYN3/1xLULqe+BZktcFGA7WgA2JJZ0IEpFkG5AG73EbdoXL4FIWm3Kg5MuHvnT
+NvrM98w90E6fyigWMGDAjftaaewQZgi3leKKfr4AWivmiGAPo72a7yCXQBQGm3qZJT5HUJZss+Ecs80O
+45htdjzHbLNDOGabHcIx2+wYjtlmx3HMNhvOMdvsC3CMEceD7L3DOaZYH8Yxst0RHFOsD+GYYn0Ix4hW
+h3GMbH0gxxTr4RyjKHxCjjHDs2TJvhjOMvviMJaR7Y5gmX1xCMvsi0NYRrQ6jGVk6wNZZl8MZxlF4SNZ
+pm5yYcAaZwn9NZxPWLODWEVvegS3MDAHMAxreADPaA0PYxsdwIGcw0AMZh6D5ofyzxhvF+TMjdu6Klvp
+9xCf4NcJ6mgG9/Y6s9v99gb9hA9mDvrOF+qDOYKq5vRiHSyiH8CSavFXvOzAouc8w9Vh7qRAdBZ/oGI9
+HDpMhWQ2XbzemSQ1nEmuZuPb+c306vKdH8b0Ogxjfj2ezQPtrxavl4HmN6G208XrNNDW8D0k7K3WLpAK
+Dzo60mosNmko1qu3KXEeaPAzblrs7VdWCPcfhmPV8YEiMQw0AC80pAH96GtRVmCbsgq2IoUAwqq/XkxX
+0vpjBrD0NqCOGhOtM/LBY/VRDaZOg6m3AQuibPQh4yr3NZoCjcCeLG9GFi+VNYaDbLIKEZBwmYXgkOII
+KMxDzAOIF0aA4VFePXCMSLAx4FJq+gtC41W8zifUB18ntyd2bR8ESWa3Pe7vXycv8Dagr7npdWQA6Hc5
+EhRocLfcABBEiRcAwLNGQRwZHX6FYARJCfGqBaSPnCCfWjB6udQEpfMoCKmPQxV5nRlSkPrmqMXFij5q
+1ZuTj/de8ao1dGaWtYyZVtremlOtdXBCaVt3NlnzvqmkjaGFwZr3rws+coforL2f4n3WY0a1rX6g6FVL
+hF4v2uq6SZx2YkNwVJQBSgqMTR+4kK5iQ1TKR4/KYjcsK0/Tsupv7KovkQqMDUhpMWE9xm6nKzMhdQZs
+NwXaTcPtTNUmrNx42k7Btp5+HS1nq4uWAapOAKCUNdEaTwCYLnwiFZ8ANGNXGab/BKDqG8wgNUjANNQA
+a0aitCEPIG0mIpQiDxBzBnp1Iw8Ucx+IUpG81NE2hBhNyYTj4/xIhckLzKF1JM87gCB6R/G7A8ni9ngt
+ygvR5PRoZSowA8BchnUqExZTEbaaYtWnWsHtAVYIa1gwGIcJQooWDAKafr++BcOAF1tI7fJRBZiegPYV
+fx1LKbLNDlfBttmxKhjr/TQqmMTmZCrYNjtQBaPX3IeqYLzX41WwbXaYCrbNDlPBRLuhKtg2O1wFU22P
+UsG2GZcvp1LBttkJVTDC2adTwbaZ3HBOqIJts9OqYPaMHKyCmTNxoArmzMBBKhih/GlUMEqdk6hgMJ0P
+VMEgWh+kgnnofYAK5nL7sSqYw+lHqmC+uRysghHEjlHBYFYYrIJBTDBQBfNM/yAVzLvYBqpgvuk5VAUz
+/JsoRYr14SpYsT5WBWO9n0YFk9icTAUr1geqYNRv7FAVjPd6vApWrA9TwYr1YSqYaDdUBSvWh6tgqu1R
+Klix5vLlVCpYsT6hClasT6mCFWu54ZxQBSvWp1XB7Bk5WAUzZ+JAFcyZgYNUMEL506hglDonUcFgOh+o
+gkG0PkgF89D7ABXM5fZjVTCH049UwXxzOVgFI4gdo4LBrDBYBYOYYKAK5pn+QSqYd7ENVMF803OoCmY6
+DFOS7IvDdbB9cawOxno/jQ4msTmZDrYvDtTBqCP2oToY7/V4HWxfHKaD7YvDdDDRbqgOti8O18FU26N0
+sH3BBcypdLB9cUIdjHD26XSwfSF3nBPqYPvitDqYPSMH62DmTByogzkzcJAORih/Gh2MUuckOhhM5wN1
+MIjWB+lgHnofoIO53H6sDuZw+pE6mG8uB+tgBLFjdDCYFQbrYBATDNTBPNM/SAfzLraBOphveqJ0sDEN
+g68939fC4sPOw6S6FuSA12dfvA3Uax5e3/P6vN83jUBr9QjuAZTVRsxbaXiHMHfaKfQDAxhyvUvgbrND
+RrHNDhuF9b73sFFYFlLKOetDRkFMm4eMwnpzetgo7EMGAbwvDhkGOR0cMgzrHWTEMMbiKZImQcUnv4us
+bMSeL9nN+FegoXgBRCRqly/tZ0H8a6iheHnle5EVbCyeHfmeIwUbr/K9CBmjWrKP4ZHmy6dXd6T0KyTY
+CEQVYsbqyn4wZYSV9uSFvZxYwPWILhD8wbGqtS7+pd3VZEgtOrNHK1YG+6WG6NLlA/usjdPobTax4rQ2
+SVUWr8FHZmKNWmGnp076X98DPYRYdGhymtZSLZ1DaVfJOdXzKI3jmtCobuQR5D1Pv4GAIi30m71I9EHJ
+4NpiVPKDGgfNKlNgbSCyEpwzlqNLk9hqEZb2Mq8tyeo0FbmS2X/tNFSTm/k5xOMMBAySAppC0KYhYFrI
+Hh0eBXQJQgvipslRHaAvuM1LMpsbMXNn83dwvfnEqOcJCvSS3JjwbnzwphMTIM1CANZUAl5jG6jqRg5G
+BjGGe9/I0YiKnuFs5HBExRsfRDke/YEnXFUNSOd7qO5Wo5OWC9sHersxq/ehss3L5FnvQtMJJpPnF2+b
+jdlG6+cZfNPyDM433MEzTEsYMNfmcZaQxN8xGYx9e1DMdvGBwdVSh7WaOAJyFzrRJ63FrNIyyGiEKosz
+RPwumYzIf1/N6oFIiduGN9lbTUJxErcLTz/BIInbwtNVIEbiNpnqVYNBzrZdMuVYTYHRBxo2vOE+mYI0
+CDRdePqUlAi0LTzdcnoEWiYzmyoBosw4gjNf6lgPTWYcuZk/BaaHJHCPZuptD0XgTvW0yzBBLk2CTP3k
+uOTIXbrkmPqJccnxuoSIMfWTAu5NRlv1EwLukEdY9ZLhyiJDiC+uOGpXACFCfHHFMbsCSRHiC7hHSYwQ
+X8CdcnL4GyZzkyCXfnLMOXJzlxyXfmLMOV5ziBiXflLAvQlSXPoJAXfIM8h4msnAueo4AFbj20f9atUP
+7B813z/qvd0mtIHUC19PwR2kLnydBbaQOpkadYOSteZ7SP2aTCESBFryTaTeJ1OYEIG2C1+vEdtIXfg6
+7t1H6mRmtAiJ2JpvJPVrMgMpEyDMjOM38xAmQBdPn/17SV34uu3bTOrk0mjgl7c1303q1+QSoMnUT5FL
+jtolSJGpnx6e/vo2lLrwdRneUerkyqgeErY131Lq1+QKokaIQ644clcwPUIc4umzf1epC1+3fdtKncyN
+BgHJy/eV+jWZAzS59FNkzlGbgxS59NPD01/f1lIXvi7De8s2KS0VPQnr6KVQmEtAS0/CanopFOYSVNST
+sKbu61mQJgkr677OZZp6f+OknDk0CpFIKNDlDCRRiEJCgS5nHgqFCOTpVyNQiD6ero00/h7yWOp7EtDf
+S6FSl4AGnwRU+FKo1CWoxCcBLd7XpyRMQJH3dcvJMvUT5comSpBnhHJdXkFkCfKMUK7LK5gwQZ7x9KtI
+E+QZT9eCOCGesVT7JKDbl0LdLgHtPgmo96VQt0tQwU8CGr6vT0mYgJLv65aTxdtSsx0Ksnhthx2tzHG0
+2zHKeNs2qu3ebdv0WC0XwZ4FfbzNi2DnjESexn335duk3SqnNY+tTdxNbuld94TlRd6+Ak3Bc5Ns3ujN
+91Bz+AglASx6+/ecpiSEohcF8GAl25MWU4dccOYCnWhTHekpSLQgkEYHsoeAhA5dJgGDuATPXyYZg+gE
+jmIGMWcuMftoOdPxn8G07CPlTMd95iNlHyWDmIRObCYhg8j4D28GHS9tOk57qHip434JUXHaQ8NLHe1L
+mIbTHgoGsfCf8Uz6BRHxHfcM6l051Ovlwisd8yuQfr1ceKUjfuWhYC8XBjEJnQpNKgaR8R8QDTrObTpe
+9lBxruM+h6h42UPDuY72HKbhZQ8Fg1j4z5Em/YKI+I6UAkRtbEU+q6WsrW/E9SvUNrgT1/pOXO/B9uGt
+uF70Y9CzF9dFPxLBzbg29p2wsdOk3FTHewpTLghF347rPQglZj+uF/3YRG3IddGPUMSOXBsbUNBGahJ0
+pg9h5iFoHz1nOvozLz37yBnGJWZXrot+dPq35drYjQKmVZOUlzr6lyAppz2EvNQxv/QQctpDxjAe/Vtz
+XfSj0rc318ZWFLTImkS80pG/gonYy49XOu5XPjL28mMYl5j9uS760enfoGtjXwoYck1SznX05yApL3sI
+Odcxn3sIedlDxjAe/Zt0XfSj0rdLUx2ndM5/ScQBsDROXSV4BEwizoClceoqPafAJOIY2INR2EZsqj49
+SIXsxSZpZwBpeylrnMLKmYeyvYQ1TmHlzEvYXrqG8Qmali2yhlEKmJlNqjpnw6TvcFga57ISPB4mfefD
+0jiXlZ4TYtJ3ROzBJWCRtqgZRsdrnTZpeeXSsp9DjRNaeQVTs59DjRNaeeWjZz+HhvEJGrItmoZRChi1
+Tao658ak7+BYGme2Ejw6Jn1nx9I4s5We02PSd3zswSVg/7aoGUbHaws3aKk/oPObxE1icvOyHIILw2ce
+N+mpwdl74HhN5SZJIzDym81NqkYg5TOhx7/Y2ibbbIgdndSW43Ob9tnRteZ7qHmvHb2v/347eh8KPXb0
+bTbcjk7a6EgfZEfXgOwhIJF29D5cYu3ofehE2dG32WA7Ommi43+IHV2DsYdgxNnR+zCJtKP3IRNjR99m
+A+3opIGO+3A7ugZhD0GIsaP3YRFlR+9DpN+Ovs0G29FJEx3zQ+zoGow9BCPOjt6HSaQdvQ+ZGDv6Nhto
+RycNdNyH29E1CHsIQowdvQ+LKDt6HyL9dnR9K4qwo6ttrH6F2vbZ0bX2e7B9rx29F4N+O3ovEj12dH3f
+ibajq52rfoUgxNnRNSh7EEqkHb0Xm1g7ei9CUXZ0fQOKtaOrHax+hQBE2dE1IHsQSJwdvReXSDt6Lzox
+dnR9N4qzo6vNrH6FmkfY0TUQexBEjB29F48oO3ovKv12dH0rirWjq72sfoUARNnRNSB7EEicHb0Xl0g7
+ei86MXZ0fV+Ks6Orba1+hZpH2NE1EHsQRIwdvRePKDt6Lyr9dvRtdogdnbbSdYwD7eg6nD0IJ9aO3o9R
+tB29H6k4OzppOdiOThsZ4zjIjq6D2YNgIu3o/fjE2tH7UYqyo5OGA+3otIkxhgPs6DqQPQgkyo7ej0uc
+Hb0fnQg7Omk22I5OGxkjOMiOroPZg2Ai7ej9+MTa0ftRirKjk4YD7ei0iTGGA+zoOpA9CCTKjt6PS5wd
+vR+dCDu6lY8hyo7O2+hDOMiObsLZe+DE2NHjMIqyo8chdZAd3YgZtk2K9RA7Oqktx+c27bOja833UPNe
+O3pf//129D4UeuzoxXq4HZ200ZE+yI6uAdlDQCLt6H24xNrR+9CJsqMX68F2dNJEx/8QO7oGYw/BiLOj
+92ESaUfvQybGjl6sB9rRSQMd9+F2dA3CHoIQY0fvwyLKjt6HSL8dvVgPtqOTJjrmh9jRNRh7CEacHb0P
+k0g7eh8yMXb0Yj3Qjk4a6LgPt6NrEPYQhBg7eh8WUXb0PkT67ej6VhRhR1fbWP0Kte2zo2vt92D7Xjt6
+Lwb9dvReJHrs6Pq+E21HVztX/QpBiLOja1D2IJRIO3ovNrF29F6Eouzo+gYUa0dXO1j9CgGIsqNrQPYg
+kDg7ei8ukXb0XnRi7Oj6bhRnR1ebWf0KNY+wo2sg9iCIGDt6Lx5RdvReVPrt6PpWFGtHV3tZ/QoBiLKj
+a0D2IJA4O3ovLpF29F50Yuzo+r4UZ0dX21r9CjWPsKNrIPYgiBg7ei8eUXb0XlT67ejF+hA7Om2l6xgH
+2tF1OHsQTqwdvR+jaDt6P1JxdnTScrAdnTYyxnGQHV0HswfBRNrR+/GJtaP3oxRlRycNB9rRaRNjDAfY
+0XUgexBIlB29H5c4O3o/OhF2dNJssB2dNjJGcJAdXQezB8FE2tH78Ym1o/ejFGVHJw0H2tFpE2MMB9jR
+dSB7EEiUHb0flzg7ej86EXZ0K6lelB2dt9GHcJAd3YSz98CJsaPHYRRlR49D6iA7upm1YpvsiyGG9H2h
+GbLdpn2GdK35Hmrea0jv67/fkN6HQo8hfV8MN6TvC8147QKIM6RrQPYQkEhDeh8usYb0PnSiDOn7YrAh
+fV9o5mu3fZQhXYOxh2DEGdL7MIk0pPchE2NI3xcDDen7QjNhu60jDOkahD0EIcaQ3odFlCG9D5F+Q/q+
+GGxI3xea+dptH2VI12DsIRhxhvQ+TCIN6X3IxBjS98VAQ/q+0EzYbusIQ7oGYQ9BiDGk92ERZUjvQ6Tf
+kK5vRRGGdLWN1a9Q2z5DutZ+D7bvNaT3YtBvSO9FoseQru870YZ0tXPVrxCEOEO6BmUPQok0pPdiE2tI
+70UoypCub0CxhnS1g9WvEIAoQ7oGZA8CiTOk9+ISaUjvRSfGkK7vRnGGdLWZ1a9Q8whDugZiD4KIMaT3
+4hFlSO9Fpd+Qrm9FsYZ0tZfVrxCAKEO6BmQPAokzpPfiEmlI70UnxpCu70txhnS1rdWvUPMIQ7oGYg+C
+iDGk9+IRZUjvRaXfkL4vDjGk7wvdbA3AiDSk63D2IJxYQ3o/RtGG9H6k4gzppOVgQ/q+0A3XAIg4Q7oO
+Zg+CiTSk9+MTa0jvRynKkE4aDjSk7wvdeA0AiDGk60D2IJAoQ3o/LnGG9H50IgzppNlgQ/q+0A3XAIg4
+Q7oOZg+CiTSk9+MTa0jvRynKkE4aDjSk7wvdeA0AiDGk60D2IJAoQ3o/LnGG9H50IgzpVmb0KEP6vjDN
+1iCMCEO6CWfvgRNjSI/DKMqQHodUvyF93OF9l2yrsqJ5U1n7VVV2ySrd5sXrPfr+l7+tyir5A17virQZ
+od/isqhG6LdVmS6rEfquKtuqSNsRenjzm3yBm7TLq5IUVw9vyMfvql2T4wb9Dr+QD6orINQ7Reavu7bL
+VzwLLv1C87XfI1Hga0iy1aJPnkyw3lZl5W1HC3ztumZXLlORgxlMtEvrqRJcFHnd5m0g064GX6Xb1mng
+yztP62i5tvU23tzztJKep19vBWXpj4qpT4FYKejDQxB8LVpq4+gdid1WH07/gOIjHLF1kh06Kjst/ZBR
+bbNTjsp4b8I4bX3oqOw09UNGVaxPOSrz9o+CslLWDxiWnbZ+yLD2xcHD4nSpXnCzTFusLcmuSct2VTXb
+e6SKfQt6V9chAKrYKxHSOu/SIv/BA0ErB0DQzeOFJupNCkVF7fM9upxMopoKKhqNRUkPAC723fZX/Z0v
+qiIDWt7EtQSx5gW+5pSgS61d270W+B7x794tj+wirBXPKPzVarXyVq+bfJs2r2aDyeRmAbZJjUb3G7KT
+jZD1VUvtrgDOrxeXXiRavKzKzEHjenkzv8n8aMhmFiLqO4DK1d3Van7lR2W3XOLWajO7TW+u5gFEWCMb
+Df4VQGJ6d303889hXq4qq8FNOlvc+jEgLazu6SdoLlbX1zd+ArykTZmXa5uDltPJjb973sjCQHwFkFik
+twt46dCWWVqucWM2yZaX89AksDYWCvwjgEF6M81mqRcDTU5JItyu7lapHwHaxOqffQO6Xy6yyywNEKB5
+MhtcXl2mV5PQ8JsnZ/DNE8h9s+nV9Nrb96LKrIU4m87msztvg+2uw1nk0uVdFOnySabYhzOfj+fnYSEH
+tp/N5yOk/hWEsskz7XhzjyYXE5R+0CDSHa5OG8y3ZbasN2lGdPeyKrEnh7vdblE1GW5Ytnh9kvGyYoej
+hADTtlZVwvrxE7LBKWeUl6rJ2O97RP+TkC9mS3UqoUcjf0Wtiwa32FwJebnBTQ6eH57zNl8UfCj0R17k
+3es9EgVAm7z0tmInJ/+Jo27ykuP2E3rw/cn9/QKvqgaLX1q6f2D+bOqQqdoHa1C9Lr0vq+5svOjKcwO0
+Pm27MsNNkZdYa7ZYNH/u8q7Af7EQW1ZlhwkLPrxBZw9vUNp1zRmteY4e3pw/vFFA6gaLVsaBsW5w4p5O
+VSNKkEVRLZ/+tqs6CUOw5rTeo7Yq8gx9lWaL+SL7IO4L1phxVZKXbZ7he5Q+V3mmYHcbnGYCXJa3dZG+
+3qMuXRQ4IUW4ScjqqLUWDUUm367Rp9heatpkw8zEG2nRrJp6k5btPbrk+L7kWfUif38GG2m90VlwOvsX
+UkPUbvMfCDoaQCUgEbKPUfA5gMxvmpe4GdasTJ8XaePQVkoeVmuRZuvQfE4mE602nRizNhFbRVq3+B6J
+v2B8WNsuG+m/NhKYIwddzdOAlLDucYa6zQj8nAWGlWE8w9ch8GoTtUUXXNXEgn3K3E+U36G6lC++4f91
+KMyUGIq0Q9IxBSrgB3DuAUhl48VPvkJttWuW+LdpXefl+j/+8JtvF1XVtV2T1uNl2463aY1+cvFm9Obi
+Av0b2RLQNt/nJUrLDD2+xSVbvKQgoYI2+dsON6+PKG0wynDdYGLmysYP5cUF+X/0+yZf52VaFK8ozTKc
+oWzX5OUaVbsGpUW9SVsKepvmbB3ICgvcEUNht8lbjsJL2lKQGW7zNanZVUR8PeOyQ49MwXlEbZcvn/KS
+KOpVifLff5+kJcrbdofRywY3GNF6iJ6YGLiXaldkqMZNm7cdYsI3L/MuTwvUVbvlRg3ml1VDefklbTK0
+rLZ12vENaYRe8PtnjJ5w3RE+aDFDmo1uV2eEKqRgi7qKwkqLl/S1RQ3udk1JSvIGNcxuiuoW77IqWRZp
+2+IW5WXbEc6qVihF7SbfbnGG2DZHqa8Q/DUZ6D3adF3d3l9crPNus1uMl9X2ontZtBdyqi8oRdqL2Xx6
+N2e7JiUxIw5lsq/v+Q/0L3wP+qDZVFTtRFMjeZsR+1srQAqIaZuhcOoizcvEhTY6AqYGLUmXXf6MvSjy
+v/VaIGy2KCjnFHnZJVne0iWa4WWRSm0tkVJnhFpc4GVXNUmRt12yrLbbNCnxC9n72f7yUMqp+zecZnm5
+btmHh3IzHZH9CW0uR2hzNUKb+QhtrkcP5ZiUjEnRmJSNSeGYlI4312Cu1LcbDjoxCj441ntVUfv8wbFO
+WNXYZ1qNDmzjVNM+6zq0qkA/CAWODW9KGC8vl8Uuw6x7stuevd1ME/nrnHIko8TMV3/m1KdEu/TVv3Tq
+U/pe+epfOfXpVMx99edO/Ws+dXD9a7v+QzkupF4FNSGleiN3AlUNOXlsf7hAf3ytsdAnEBdAD+WYf0mm
+/m55lam3V1nByzGiF5NhPmv9z3r7n/X1Pzum/8ve/i/7+r88pv+r3v6v+vq/OqB/XU5VTf5DVXZpgZod
+30Ppsm3cFNFvN42QOUyOOJLJKtePw+KXAsV/U+2Y63raZ0OISHR/sSU6Ri7RbLdpURApSv/wU5MWh1eR
+a0AWvW/T5on0sZVqpvQ0e0u+Jfynx0jA6izWzmB+k7edHMmYbiq7km5ItjgwyrTl/euSzC0iOkdLK6G8
+w9sW5WVXoZwWJvQoKOCzb/3Q9doJgcmayJOJDvvDQ8m2XHJWvi9Ssjdu8iI7h6/J3+qgdcp9tunz27xd
+CvJcXKCf7/Iio3rgIzlgP1KLAlXs8nbrnfq7ybvzD8HLEI2eP5fHZiIl7UO0ze70QN588PGcam4yHhP6
+RmklTQSSwoK0vbBB1has59QTy4rNGLehANaJh4fZZHr18DCZ/Gzy8OYDurhAeIuytN2MULloa1uP4rxM
+qfgfgomfMK4NvqTooAI/42JE7/RRg7fVM25RhlfprujQoqleWtyIFUb1bcowFKTUB4HFYnkxc3nYyusU
+fpiWGP8Bt3VVtkRJzLfpGrfoDJftrpE/s6p836F2mRYYLfBrVWZcr2cGv/ZcMuofyakmb1G9a+qqxasd
+OR9VNeFy9JynKC0R3tdFvsw7tgmjJu02uEHdJi3RApOBdhssibCqyGmqQI8/zbfrj48tPRGgP2F6Psqr
+XVu8oq7J2REEPbzhCJNDW6NGtXgVAImhqa6bKl1uUF6in4tzA3qejdjppcxQukjLrCpxhvLOqnWJFniZ
+7lpMiqg9pUVF1bXkCNNt8iZL6rTpXolNZo27Fp0xjiXD+lVVrQuMfpvWjF7ElrXcoBfcYEJdvK/xshPj
+5+N4ybtNTg9R2xYXhDu6Ci0wysvnqtiVXdrkxSsZaf4DP5ui7zFGadFW8Wel6e305paIkO06WRW73Ba5
+8rsuttGvCYao2+y2izLNi5YDkB/s7UEW9O0RquJibeydegmwYTqlYoFrQ+ElTZrlu/bMbcIKzu1Gwj5q
+thBfz7kQubhA/x3jms4WSju0rdoOTSeTd4QbcJik4hyer3eNUj3GK/qbkZLs+XRVtqQHcv/c5VX5vqXi
+HNG7dcYwioHGoZ3qs9ZDIg2TsGRHF2jmqlVTGwrHya98mPV8otqqpek/TF4x1vstNUNQsiWOCJOfu7xM
+xSfOuVDdh1LMHl95q6baUonHTDZC5iHGctXKFIBj7YBuLaOLC/TvadOh6T36HncoRdt0n293W9TgIqUH
+866ikBkoNgd76dExmbz7oIOZ3aPfP+OmyTNMW7G5IDCoJxyqiDR9yVtNhBQFERlt1+BuucEZh/aywSVq
+cUdFTsrHlZaZgJh2XZMvdh1GVck5ao1wgbe47ChfCS4g/RqSwSQ6p+33m6ohexwtYoQisl0goMkB2o5i
+QlnDJi0DQVipwMl0P0Lsrxn5iw6CfWTYJVPhDWN3cI92TSGhaEv4Z7uuqhu8yvdkV0qfcIuWZDshNiq2
+EScvePGUd8k2L5MMP+dLnNT5HhcJNZUwzCtP6Yj3sth1KKtwSyT/siqfcdOhrK73337M6nzMK/2R2PXe
+t6isULtbknVN56pFu5JjmCEPEm1eLskeRVuXbZeWWdpkAvB3uoUP0VtzuV0s0zLftZjuF1+tcNp9u2xb
+bhJtcFsVO7Io6QKnH1FVFq+oXTYYM2MqdUhSNe/R9G6W1fn5iPT861/cJdOpUCh2dV3xgfO7jT5YM1L3
+HH0isL7noyJ7n2OOdyZ5tj//4NRiFx2SbzS20W5GhByTVuCzhzePGiuePzIfz+er8eV4wv+eP7w5/xC0
+rtVNVeOme2U3w0Q/YwslL4ma0Ob2dqWtA2sbY/8lOq7+nayMtCjIaM3t7R6t0qLF50JMr5Awf1OiYOsG
+Qrbi/WiUwQWRMytfR+i/fMu68gGEm0FWT3VitkYdPQrSlCjE4HCMWvR05hs1gJRe/yC0/B3Kamw/Hoya
+aHYYbr2d2jW99IWx06p/oek8DC8L9mlxCzD2l+OjMHcc0+9xjHkMlePmlmuMzNizrDL8UJJ/+1VUUupT
+TGmZOlbA7idKlfi+a3C6pT0TBYpuAUzvYjf+KC2Xm6qhhzp6HU/O/E+4VI4U7AqxaujpIUUf0dfhy11h
+vfmPlt711bsOda91vqTXlNTtFmf0HP6EXxdV2mQP5dMis49qT4tMHNKSV/Pn3muHIbU8ZCNFimrAkY9U
+WKxN0NYeZ/xM2m3gjEahOaczOU796TuXFsB4iOItFAbTOlritsNZIserGZ1hhMqqxOf2DFH7GjUbMKaU
+Pi6RZq8Qm9YNNqxbRKtdLqtdyewpbbVlSwFVu67edeQ8mXaoLtIl/96l6xblJXW8IX8z4Jm6Q3QR4owo
+SAbe4+vrRdqVDar8gi54ogESpwzy58Ki05gMTiv/JA5M0tZvVkhUmekM9nrPu9G1tO+k0wpVCVt1fMEd
+O3WbFUZ0gVbiQJYzAjPNnHmyUDqqK+0c87OiJt7WTZ7JK/FPsPOMpPg2fcKJLD47/xAsT+RRsj1zWPCX
+9JAq68qx/keXE7d2ZmZgUnuL01IfGwWpmrYjeqChxgdh8Og2pD4FSE/DI0SMgbykSF+rXTeMFPqhuo8g
+2ij/UL3Ikf2hemkF1nTmlgVOGzrOVVGlzIz3Sjw4llWx25ZRCDbVC4xUU70odPg6/AM18tIeS7xm539m
+cmEWB2H3JFCJjwhmZ++NupviwovDo43SokDUdyJPO4zojUODSzEGdKa5kzS7Mn1JX/lexJdnWi7xOT0b
+jssqWe+6DjetJ2rWByh8FZczCH0k81WMxI8/U0r95NuHN8uqSB7e/EUAdQN/fLALFGiE+HlDm9Xv2Njk
+zH5XbbdVyYbVMilHr8Go0Txt1hiRmRMk6Z9VcyZZOWt7ph/rLtCvCNj2te3wViLzK1zihkxFi7dp2eVL
+o3Npo5OuNLqJw2Lnt2w27tFbigP7xVY0V5RsQ5FFWA5AGvBM8tql7jNO/bN6SGnYe4jzECYGdSra6yov
+uxFhZKH3CIOXbjrTBClZirrTj4cUuih7q/6mWplbZYTeKmxaQT7tk1AzKeJvdcwheARJrVN3vRP0NfDJ
+rj4zgbpds2UkLX1QtxDz68QhEqafQ6RGsSow09/IH9wbmj+5tKc+8XAGYwGr9LPLv0XS4DR7jcCurtqc
+2XaEQVTaO7nMEuuGyroFXlZbektSVahMGyJ9qUqddmzJ44atti4nQmzxyoFxrzRhb3zUl83jmN1bvVTN
+UysveF4wIv95JMR6RM9pscMtB1akZPBVibpKbf7UoU/411Hw4xOvT4jOZ2+J+kW5llJJUJr/5EQmY7hH
+EzRB5KYXl126xqwlupBNzwXhf5ZlKEWPkg0fyTj5jSC/GBU3U3T5sPbUnonKqiM62wvRLTk422zO64/R
+z+q6yNmd1q9/MZ18Q6X1L/MGr6r9GH23aYiiSr59n67SJufgsop2ktY13bsr1OC/7fKGTcDYsp/3DBfk
+3Gq1anEXR9i35Y7ekpig3RWTr85IVfTtt/yth45YudueG8bCn0thgZ5z/EKNpC1Vyeh50NIm+a6jGrXc
+aXVF3U7Tll461IT6Z2W6xfdom5dKIp+PED1b8SsPum12Fds17yV0hNDZvr0nqLdb/tZ5hLYZfyA8QsWa
++3WP0L6QT1G1i2FMkRBYsVtN9OgI5ke0LqpFWqDntMmpip+3ZCHSgbAmRu20We+2uOy0S94x35h+l26x
+4LkS7ztjg6oaVBKFlOgKpALx1tAqjPWRf/yolSQE1Fm7PRel2yxYb3QQ4eKhv9U/plvc3pMOUbtF2wwV
+a7QvTGD/stqVS3pNZ0N7S5r37pxgj9u0Tp7wa3tmbHRykdyjvMzw/sxpOUK0U36a5z7DdLEQ4zGdIMLy
+b0v0U1Tgct1tXBjnI1R2Gxg2+gZNSfmuKM4175bf8gWgmnCJjX6n8wTbUNoOna3ypu3O4/hjm5fHTztt
+4Jks0kHcXIkJ2OYlm6M1EWzmZML03+Z0BiYj2higIFfqeihIpQghYJEC9ONSgUGiOyzKW7RMi+WuSDu1
+5IW80tdyVWJU4LZFk/FkRkhFdpqK7uEopeYlhkC+zTvqwkwPd4/kQumROf2TbeIR8Zs0JlqFtOVK+qpJ
+KfHFht4qFwtxXfby8jJ+uRxXzfrij3+4oKKZS+bk6uKr7d/oPeA23QuznMDX8HuZjCfklYeFfoqWu6ah
+pzbym16T7tYoL/l+6CKz2K3bMbudpBi1m+rlfy926/Fynf/XPPt2enM7u54GODfdH8+5N9c347tbP/Om
++4HMS6b7PkZYAVIE77uRu3ToV6MlStB4MnPZ/A+YeRSmaFGk5RNqO/qGI18p2WBsKvL2veENKb+SfYgy
+VEr9xhA77YtF8B/UT4oumW36RMBrrku7Li/yztjonWnLy1W+P9u3R07cw5uHNwidwUM+D3V9NM88vEna
+7cMbD8uwTgYxjcYE/YLznKhlZOZHlAbk38lXn2jFz/Ial0k9duO9QmmHCpy2nSGeAFlYsTOtu6PYkvC3
+1NOAVBEPNIiGW7wK75B1/ox1olBxRfyKmrHSYKFT6PB9IoJgH+QVDdknxGnYDUhCirXjrvH2RJxr+TVy
+6OmLRXnqWKVbFiIILzaik9CdnTuDpM+ql8GbdLq/j5CUOvHTvUN8zaKwTfenJD69JWjrtGzRdld0eV1g
+h/JtD1UXuHvB3Ki55UZYMgptfH6a8sZnb2n4lxF6Sx2Xj2NuAcohMDwdQI/igkkuB0NxJYMTH/rWCfN4
+OWgCBTd8a3UVNFBBYx/QXV7Gd8eWA0S9ng5tJtQZSIF530ohzDlKnW85S/6uklUCwphd6JSVBGHLj6rE
+PSxelX7pMUJl1WlaZlq+MiFOj6P9UoXA/pIifYgM+ufkeWAsX5Tlh/en2YF+2aRbTI8EZHbRmt0rUPc7
+cVfX4oxx3OJV81PvKlEZc4tb0+Blh8rddoEbVK0YQHHlQdmUu7+/8oMYOSwZFq9H55pCLz3z2MlGKGAC
+jmVidcHDneZyfsmTFoW5dSD0TutdXvjDNmbXONtrnu27QOE3fc61Ar0FBm0kAse3VMu9B/Re7z2CvHYj
+NtuCGF050VFXCX9f/dZ1gUkd5rhsUQ2hfyH0fJszK+AUdZum2q030qSpmJbc8H31iaH7mejJ+WdVSIa+
+73CZGfMgL/c+a4xuQRoB34xonkHQnx/KvvUYeSVDLx6qZ+p+mbb5Ej0SlJJPi/rzo1wwhFb4b7u0SPhN
+d4H3i2qv7hcBUulEIvUTAr7Vrz7593VTvTDnevEZcgjX6Bimmm79lzd67B8O0/pq98burVvcIZw2RW5c
+sGiYyFmKZaReVnJv+Yuzt/nIurCwSKFhMqZ2bdUBNeKhT8zcTSIXfwjVLlK9skT/GzT94B3uJDxcqwc+
+YNlF/gEcxsUFepTgEjR9lFdU7KaCOdC/mvcsaYlw2eUNRk31gnLqaF5XLQ061IP6md7ZubG28xVVYM7Y
+CMheSI0GdNfPyc8J983+GXVnw9ucYbdrMbXVjRnGyUSfZP4xyAgAK8h7Gg9HaDxhsge01bIbFLretV00
+pcTib3G0KDL2Wgw8h5SvmkjrsBccq7JYawuOTFCJW0pDfuPGX5zQn+jRBsM2aSRitXSuz5vABBfmSyyE
+yCOEfJkWIk5nV9WOc7RCE3qBpZeooX4WKLEgMugT3J0WvMHy8bxHZzP0E6jn88iuA2Fq6KCOgG8+1P2u
+KjNctjhjzINeLtAmLVbKa0dnpkQ81h00W0m7dfpGP6eYEZq2eaX5xZCr3Krhb0v5kFuqNmk2coYqWcTi
+dEUqqJvadqzhLCMVfTLf5g3jCnDUhwKzWIxD4+D1DmzfYb6OPZMPnUMNIhQyaqYcykjn9FEP74m9Xzvk
+/v/xokmTtmvyWrLLxQX6V+4W9oMqxsLZ6SwtOtyUKRUS6yZ9pTOpxePTZE17rg2CgeHEYeh1zX3ZbZJq
+lXSvNT776tNbo2pC0f587g9/xeunyyUmBhv3OTuPvYRXK7zs5Pj+vUiXOEM0jpF4uoQ2KVVkl9QLgEYv
+os/kqg6X1MeCEgMpWsmBacF+xLCc45pWR/NXfasB0NgrOFZWebGG2IVdcjFHVjUJKobDPl12MphOyxV0
+7s+QGd4lj+aksSss7tVHYWm+fKQh81JmS5s7mLEzCUV+hN7y67YSvSXvVfnWZHu/ddz//iWht/Fldyba
+a40S+opdlsh9jH0/j6kr3oOQ+sovw48FC2k0UuxGfiaLNTsSsSWTNk9aNCx2V5ZuOTSyZT/taua3mtP3
+dzhjXIDaJcH3HtHQZGra+AN8+toS76WTgfOwi15P0UgjqKySbEdeu6cdTuQ0m7qEHgdNSi4fY5JasXyZ
+yVgbhuiDKtniVHGxhmFhBAP3okjrxi4dUrcPxQB2ukh2wsfCJIsgl1+gwxHugqTkNPwa3D2BjUCvbAho
+j4iuskxT0cOjM6WyOclfu7LTlZ5++QlSHRKjfTha0hRS2N1n3EzKQf63TY7ZvT8fnrpZTX5iHueXVUnf
+nYsIDOwVKlvixLurWvE4e9Q/nGt3eVHIFwW6kqS68biZmvYgj9nLf/dt2DGc1h/6LEr8AhxqyJp+DVgt
++iyd6oNxZgTesxjGB8Ogob3P2Dt2CfHuWlZh7zeonkzjGX5QZ2bDgTSrdnQHZjpyVeru9AwGynZ012SH
+avRoIf2owH5EnpWMkLue486eTEHQ3/cC227bpR01JMvVMxLvfe+Z14KKEnEqrQKhfr1CxgP96hPDUXLM
+1/IZgNTEP5q6uCsI1CfXrrcS47XvFjRVX0pshJTUVh8gVRyQ5+ynTwJxuvwbD7eZdlyAPOoS9JHX+l3V
+4XvuGsycY9PnNGdPl0gjcrBr6QmtepHhXh4p5o/k82O3qqruUSe0IXnfcnkp6cbUFnJJqnPK/J1a2775
+Cgl3YKrsjo3F91GfB/pzY62TKIDRK8h9XS/YPymrhBhq89UrzWj5WmPtuP5HvO92KfW92VLX5qYqtOgr
+VbNN+Ffv4zxbjMl3aPT5pf70TB3qWZH2xNL8sAciWLIadvhK6GWgVtMX4E2r4g2Zx+r4Ilyy0qBaxaos
+3Dg/RV7fa6k992aQH94MOPsbJdb7RrbOqBc/OTaWFT9hoqpEP2W88LFFeckeP/LQWu1IyH3pKYgzyksp
+D41B9gLR/JG2/+7778ehd6oGkuzjCE3UfSnwXlU00V+salXpSZ5eX4mq6osWt4SHGuOxgRpMh24hT73s
+xzzgWbJtE7yvicT3HuftqP4ehZUYvnZtV23zHxgCjywA7SOTj6irEHPDxKhkb97+hBf/Pe/4+WxssbJa
+dSzO7Zk2TGop2NA0MnwctfpiPY4Wy0pVMJRREsRZbIT81cH7Fu3KXUtEgniJV9XpMu9eP6AW44iYWvWu
+KC6m0/nsesx64e3FhY6i2L8yScXOlQ05CdF7XIozf2gitpo//vY3c9SS9yvUB0cIKvZOHKVoleMia3GH
+PqICr3GZ3dOrDhZ8EL3QYCsLzKFlot+cedPKxnkry8boX3d82moW8ocltMvy1Spf7goWF1oApPA3VUld
+JdIO4WxNeLDFH9CLePbOgmKpHshN80v6yplRfGURi/9MqEGI8ZeAoYnNrWioTgxEZfn99+SdKt1gdyUB
+ltJwxqJrGfY5bkpFmLTp9XzunVQ6r2yxAfsGD8jMFh2zvHyS6H6/q+sGt9xXlOlXgvtYaqOOOTyjBWmo
+aIE2+XrDjuXM0aSrJFACi6GDM1SxsFwUzIt4U3rWdnlRoGVRtTg7l1ISNXiJ82eq1Cx3rSIqER/MXYOx
+3RI39B3tsiozKozac/SLbI1HZJrzDhVVRV5TpYzBl2lJnwZhtE0zrGO6Tbsli1nGnvRQTVN4ejNpwaLp
+jRXFBs3b3eXd7RgSDLow6NvAdEek9ImsmgLztYoWuOtwIwZCiCwKqP/hkh8pt0x/fqEbLRF6Y1PU5QUe
+2R+btFzjSN3DCqmaLrDSZfgzTfquLe82+gmIzA4Phkq6bkeMRV6rHSoxD6xYEGDorGq4fGGOu3SoXcVi
+z6nQc4YyRYa5pIK82SYMjBGjkl6/EI//s68+cYqLotfP6Bskv+oawedz462cuCU6EIx1ezf5YOwMdDv7
+KcX848VP2fA/PooF6lHCZDQECBQAol/1YqYuk5JJsY4nZlKsT0TPCEgRemlSrM9j1M6kWPuGL67Poobf
+bk81/HZ7iuGLECZ9w2+3Yvjc6sW2RqUEpC3LM0CXo7r4o76ILMQqWaJI7KkiLExFL9JRLl9SFvkTVoka
+CES1yM/I4q52Hdm1uQo40jcibs6nOwbKyyxfpl3VnDunqITCpSBjz1PG5NpzAc4bWAlc5Exic2FN1aG8
+5TQjt04s0wYrpdJN7MoMWHvYoUmSIHh88uXTYmchuFT6ULqMKWM0fG3OR7sdAV+LNfoEusNNQCe4iXvL
+90ttDyD2U/0yk0avpqcTo9dHRuNtleWrHDfSONtVKMPLBqctRlWD8pL/3W1YlE8Rr7LM1HJD1crZhkQM
+EFxj+raMGFr+N6XU/yakr8ftsm0fVSym7wkbcI8zpkqMXX4WIgg89Yubeu/BnzS3vu1Fo5PLkEFnVg76
+sztiwRvwiIt1z4iLtTviYv1lNo1hIy7WcsQHXuv51f8/E+T/wk834vGEPN1YkVTVUiJSIm1wCoCEoq+K
+dUfZudWcB1RaoQ0uaqWpVc06LfMf2NmOLCDiuESTGK2kew5TCscEMltuSnPk6uKOLUZUN1i87ta9i7VV
+QxGD4wyrcieZi8aEwZ3DSNAgqyfq8wdHQhE81e1wjZd5WqAGU4sBfVS+qhpU7bjHIwvz8r5FjyQ8zyMt
+7HgeXtKfEdaIISyDAx0WhINTxXKb9gXlCNZ+KI3wPIHgPLYLdA8Sjkt0T31jn/hug5dPi0q8vyVLsVKc
+++syI5MgTyB0MxDO3LzyxVIDQax/5ZpwsCb4pQQjFYNBRwCmgsZGASVMhrAhWqJSldvdpQsayxZ7GFaH
+DOUXMWYaRMO0p6D/g3SUtPOXdmEr85maebjhduHDklCQxIHpkXv18WYglVQaDjtSuVwq9IBJk2+0In34
+ByjRgYHLIm2xPnp3fb118BBE3+t21axC7rzykTK2TbOMGZYf9Q74rQ3MEjpTtF3a5UszshZlCivalh9t
+g2P2vhhdkI72nBZ5Zr4pEe7vK4wzopjyi9GmZYYB0jszGvKgOChtMIPD9DP65xj9qWqe6FJGLEt2XrDX
+mMsix3Q7z7DWO41K2S6rGmfo8Z4D4Q/179kPBsvMILfYdSzbAd/oiWzO28RsT77w39qNP9vmcUOv8Uxk
+dH8pcfGapV1K3aUo9VXdhF8BfnKN2HYleYsrIzEQoCO2Es+dz/myKs/P3Rw3dNtVqWHSJ/5JHOQYT5yx
+QP/nxAjFlgmzPzB+ZHU4PcYcMoXHQC3wOi8JYy6f6AMjogg3KY8Oc7atFnmBzxELPM7WAI1Vtxa5diir
+UHjqsVtDCfpT+iJc18fpvXRLN0HiyCdOXCx0G5X6zNdZ0yHY3sseAwmxgaoVz6LIZBCxmpuHAAqSRvej
+lxVW4Rker8cj1jtXoc613QMUVOZmTpwS7qm/vdzQIcmluya0m6rpcCMC6/MgV+QFCJ2wCrXpVgb5T1sW
+YEDiyqZjseu6qmz1VjztP00Lwss5kHMp2H5uxrkiI6CuejKGFTu20dCbaovNW5kwlRp25R0Ai2VfLZe7
+mj1FIA+P+NZ/xlKd5ESCpB3p8UXEk9y36jXJuYiO31akDjGdYnajsMENtqWpTAlsGQzUDct/z5dPItBQ
+zjm854VQu5XOK8ZmCc05QvD8ijKS1SdfvSYyl5BV7myllsuDXPFsJUunbJoGgIowkvCGjesNa+Ko2X7M
+PY+CfIzcN9iIwbAnaquqeKJ63E/KqvsJC7Gmr2x9IMaJxxiKlcvEfc6kLzIuRejSoiKNsHW6qJ6lBT8v
+ISTcNwrbPMsKDIyNSmG2jyuJssCb9BmbhrWqxC0wRNsw1jdY2feY638EbfmEjoo3fjJFnyDyuCPgkUlN
+ExcC1eyOLeIGs7hchBEFWUdCJohLKBrMulIhVrlEYUDZzZAGuGImubFBIX2xf4l16NIlGIU0oNIFXpry
+ldVumrx8UhBhfS+s8QGqHqjs6VzCWcJZUIfT7TMIObGkpl8s9DnwQOkxhHuc5hGh/h6Px0DseFWuRe1c
+yVhiqpgGgZkozFUJOSPJH+TVphQAZvQMbxvA3VZHsW7wCjdt0uBst8RZsq1oR1oWavsVPdziHrHf5zBC
+MoU69NBcN9cyKz5VVV2X2pQrT1Sz0Gpy+2tDbwjYopBmppYYVla5cLAfadnP5DGKO+E8SosfyW+s+/o8
+yoCASnf8E2ZX4K8Il8tq16Rr6p7LNKBOYWqklKOHFuH4z/JP71o6Umb/J5i84PQJ8RcR1DSG0jYpMc6I
+OpW2ecsjhqa47TaYCv0NvanNyY03e5PPMSR+Lu9VVj/m8cK6J/Ei8iXldnpFwyJBsCvfaiW9lMbo1yw5
+Jtq1wjGJnjqXS0wedLIv7Yam+15gmrSoZIkvUx72nU4FmRmG5lh/gUI8PAjkNb7nxQa5mM8BnQjaK/NR
+Iggz3wQy5+wCnAKkTJS2HUrXKSEvDXlFAxikr9o1h4pxAbn7GE4TsFMPLYq7wee8tAazyXv5jdeudh3Z
+fJXoko9bdy3Zy9ggmMK6TEtU08sjcb6gy4MRjMmz4lVzZ+Xrn1Vo9UdqwhVMu8sRn0Y2yqLAJ5QAaL6m
+polAnyDvUVa+5KGnVdbrWPqWJtKGwNGRu7cmjVxHcNcwJuCY1lxPLgKrieUKqafzsF5paIh3VUWs9K6x
+xrDgIURx1FD/IaGRLu/R/IN/xNALe556gFo42bM9am0x32yrexVziBxb3aU0XGEPUHo8bfA2mqoCokNd
+82oGbmTfkMo5of9JXvO/8Sdi597F3awXqXpHBnfD3bTO3eQcxm0Q3BhICSnYBDqcjF/SViwTnKGv7yUv
+cbX8a2KGAryuLXmkO7bakkJbhWS5GcEFbKu9cS2XlyX2vMHhidLo+oVrNPTGlDAw/xOuppYI0xMRxQRC
+hDhkN50PIZ6TDWhGX1L7Cj4AkROsHSRAbVWshOWE/s8QmXTRmqw3ns3Pob7/DyQGR2CpJWsCr2bgpwih
+u8qgHzxCgRvGI1j6S/OsYrWuqkMcNoAHAdLCB+l/tIVuIKnEGGmXGHVBWjoAjAdGBIhTYa0kQU/vfJrQ
+RbgeWfH/by5fZzehXqBHc9qPJXu8hpBjMPfdV8Ka299J6tpmj5ONPmxOcSmgSpwc9lEr5vOPSES1run0
+mm8nPWOPGRR1gSdP3vianqrUbdbDtjXR5tgb6DOoVV9EKUAiDcT7KPlk4EKwKavuTBDz/BgSBvkCUDnE
+CTdnJxPRL/l98rVAgToLIYj/j8rMAZaAUe8l/mkYJfxk8ld8KejvjvXlwc9frkVVVGrdxzliKPQMmDay
+6tn0dpLh9YhYS0gqx+w1WayV7WA6f3cufp0jplnwE6plzQBsPG4wJvo4VzqpCRxG7P6HGMhRVzHFUIVt
+ajC9xO9eKhEEpKvqdoTaLm2YbykmCtHilRszX1nWdFaXFEtdZyWyYSk4Y5fAe2pAaTo5DGIfS24mJN8C
+LjPz8y39zOrzvDH3aPKOV5VfaMpKX7Zze0YECSRghq7Zi46M0dm57bUszoja5Im5+B/CkdCaia6qUVdx
+B6G/30y8/gPMBKPBF54Ke+BZ3mCe3WI4CTK8vkdX8wyvo8f5lkoAvScdfOQwnHWUdJsGi+hF9jgW1NL/
+dpurMdS7pi6k3TJpqeVrLqdQVGtwduRS0vvVe4satG5qAUb9+g8w6h9rrOS/hEPZqR3g0GrX2QW3k4l3
+HAyeGscyb5aUNFoHJtRDMedBNc7eGhZLemsyQuPpnOx5abku8OCVRFuJ2USz+buREe/N+cBmmtXW/76x
+K9ofjKxkhwZZ+HnaYiMS10M5XnQl7POp9Cwz/sGiK53oB2ZQA1njxX15Q1UOpSlQl1fu0qFdage8PXYt
+brjxQrPkxz/bodgBQQ3s+spETZ22uOV90SlTzusIGb/3I23oPGUd+a2Z2EdG97pBGwwyQOq6IQbg4CAe
+AnMSZ3hZsYDlGtFEwCmmN8vnR5oO7dy5GVdYbLDABRbwtJ694mJxf9sKsThx7J6OX8ehBlPWZGiY79CV
+G/Un69k3RUKUimuG2BTgDDY9ugkQ5/TnWP1kmI4iqo75oD75e6fYihh5TmJ04AjjBWLTfYT8sKEcDr/c
+dbsGJ3VTVSv+Hl+4GBY5zfNNYlakHx+lQ+RDmRJhoc+MCFigpidV8oR68uEmoZ5frWQ78230z3jETLHM
+pFwaECORdEnOrfTbZ5f8bP3K+E3c64D910xSPbRTvjz6OxcVTSTO4ci1v8nLJ5scwrEt5QX0TT8PFKtc
+21JU5OUTE+pE7PDLXlM6a78SLfe7kB+kmS2gdenBKqgvUUKJtnGD0HmAs4pGFzHCagg0U5K5EtEUP2Nb
+/Ehhy+msJJA+PM8K0OXj7yr2tJ/56wixyPx4NrjBNm/8nM3+9yQFqb6Fy8eAEdtWUqztnUt90h/4ufuX
++uh9sUexabfx2LDMmQY27dbBpt0C2LRbEBvtxaQiHHXcZWgYhGMevXFvoPmkiXN08Uqf6WGyTWqJmLSe
+qIPpN8juyPKrkKUJf/WXvBqSQbyIW+VLGgqJv6tpH0pqifoz0fK+fXjT7hbbvHt485eRVdDgFkPfOTXE
+e7OvXTQBj26VGpe2RlykaaF00zYvXlG929aUMLa/VdqiF1wU5L/3dG2OEF/TSG22NAFKpgLFsLgpWqoR
+QV6p7NtiHgjPN4oN0nYzZnYwXptrjrImB0bM1OTgwLfcHphWbR/MGen63AifqnliKICW1mjYC+1a/qB6
+XvXCVU6CAl5D0aaw4/JhoOqrbaGsT0TkbvBje4eZVBsJW7HS1TRLMTG+wrOq8SqzxY6lLTnoTnb63n4c
+Pb6fxeOiU4biRiqX+u++/x4pozmLuvX6vsGIzXDmzrljYoesAoA/7xc5WdCqY5JKFn0kpG2qOqteyC6y
+Xqu7apekjoQKUNap++EYomi0p0nYeEOZy41G28LZha73GE7t1rwa0tN/ajrN2odXP82wAh61aDTUqsTm
+3ZglIpxWX0BSALLiC0sLODi0uTM7ZyBxzGK9UREPuB3CO6xsbO2nxl2ZezEVcPwJH2A0HIevnR4mjtnN
+YmdP3kHOTSNHpBx+G3bjtm1k/zcKuv/XJY7NQUeKExAcbJLiB4qWnW1N4cEOjrrlVbe66hZXy9pqWFqN
+IGX3CsarBs4bAMdyrzadq203amqvKXf0jJjhZZE2mPqT8wf9aSlPceK9DX9jAoazs7ykoZi27Fg2XqUZ
+tjOHgG+0ElLz/IO+gOmCO3csrGa8grF4c4w++Vq6fvZ2Y7JEQtE/BFlZ1yLK/D3a5FmGyw8xwxNoGvcm
+f9xgJIQJe+WOG3T2+NMsf/74eP5QUklDX2/Sv9hdIv9B2oi/qeuCfwBsrKDUondO9JhPVST+qJdzzK+M
+RKFpgzv6FHKbcmuDMW5arl3uG2Pb4nKno0B+BwOgmI8n9LeL8hnF2x/oH4kAasZpkeoe+YP4BHA+Zu+r
+mFGhKtHDm6rG5cMbEVaUIYrYi9R72jGFq2enVaMQH63IVrKCWs8TLVaLXoNSviHyiiJb2eFSdoVv+UsQ
+lhwQG4Ushy+36MhoToCy3TW45ZKfPfukAY7ylXhusmFhW9kTsEyEGNhWGY30VHW4OWcSqO0SetQKXoQp
+zAZHAFctgSDgdqEat09u2S3A+y/dEqE10M0Ruq1+YCKP2KSkQ5PB+hN4mItQS2opZQj5p7HijpF/fC+I
+PQAbPSuQhDixwJlvzo39V0QFaKQcaVFXobXwikG7Gp2lT+kIMTFJ+2fxZ//bru1o7B0uQbUsYW2Xllna
+ZApvHtcxLTPyRJQcv1saUli0ZfcrgOziYkobgnjN7HmxZkXtEbVtcWC8KPIom6bcPdvV5uWN2i960RdI
+uVPOZihuLKzuCUZC0RC62df392zmPvniLkzAxE/mphg/eG2sDntGhF86fvSkV23wtntu1OjJ4+INLoUR
+Cf17RfSK8V/bEaKWd569WLK/2IIPT1oG0PfrP+8TGSzvf3378KarambyB8oo/byl3A/QV0xIpsWoc9lY
+LDMniCK1IxL9t2nRGSUK1Y9TctO8aT4+nou0K52my+jDzVhrS5SX6bMo0bYM/oW5tzpfRUg3PagTuXdt
+R+JqgcbPQNuqwRBWXHHhVx6PP2WNPj4m/IX9UuSETBuMMlxWHc548CQeAFw0aR/1EeYd3kZdRiEORnXd
+epQiAlJ/jgoXsJ1/WeC0oQlgNx8G3xxLwNAVMl9APBy3D31IPUY0bhmPH0OmiDmtNmmZVVuSbp/sutR3
+odSjuvd7BXlwUHk1wAoi+wXHSDKEOKVws3KD37eorFS62QHh8mnmitnNzeRSenwDKq4eJONrI80E5D0i
+Muy6yhjztgNUMk3n+Pq+SPvBs4U/tActEodugUvAkAMmj0V4FGi2efgGCoK4WFvWM8OCZbr5wJixOidF
+zUitOdyyZwIb5q8Ql5Dm73HPYh21iVRmdkNYhsptiDdALXO8RhucZtjcW9knrzAOHj+DQhYM3LkyckLg
+lNTXXK96TFIy/rQz22wU2jT7RGzKg/t9REWOPqIUIBYLa29uVuFAwMduR8GdhZl1uJ2QqnM0/6GeDps7
+WLBC7fSqXwbI17t2JV9sdzOEEv/I9fE+IPq9CKsrDjw6wpOT4Bce9sEIa6ecMJF9+Bkn016cfVB8+IM4
+q7PJUSiHKRo9Bge/s7fyCcg9IjwMPPaiFdEn3zktHI/PPDQy/Liv0QffWYdXMz/L2jIU2cObhzcfjOsL
+ORaioqgVaekMasE61wwOkF0dALGr+wFYthEHhvkq/zOU8dKAZxpv3NmAg81YrxWBx6DhWXTOv/BEDp1K
+32Q6VJLWWYhIX9/jbd29OqTwhcMzncm0Z4L8iKZFzYfPjJtGnheVvSotM3IepLZR3TvMOCOKbUX7qF7P
+8IOhVSzPi2bMf8vMLGqFLi50ATSt98Kaam5qh73xYC7C9JiaP1uOwcLzkfr4iRiV6ofkjbio7HZYcM97
+DZXZhfSk2bpZkjSpvqANCUxF44DK8PjSj9yLjYicOkVTYWuQiujPZfZo7mJITxM4Y6f6hzdMmX54I077
+4hZu1VRlJxLzFumrkcWNTV3ruIjBuVrlxcnUjNOo3/Kr44T4aT0i8IHRTCq/r9nTwXv0KzKTmiMqHRoP
+oIy6ao3JuZS5UKKuqopF2nAe4L+GJEhwYlLSSvQpGp8IPSCq5VDqmIYUJ6JP9glbzxnNI5+LWUsbjEqe
++4zeB9CozIqDmLeBdjY+H6lC1p9bxfLRFZH+7XdDlif3H6ipj55XqJtcU1IZZmKiTtHC88G0UvrQ09pZ
+a8O5a6HWGX4EnxhHxWNIEu6UkMjtU/oum0mH/oC3+d4MbCgv/smiFYHhc2HOqUqe1xmnbU6z+5V5vStU
+fHiFedJuJaroX3jKIO6R/oHgpFUt1p6qxfoDMp3Hv6+LXKIpNxvpRm5NYtLS6kZCtEZ7GifPOOgnaHwz
+h5JbwNV4mlW6wY6kkXtXAx9pf45iAuchkI3Id1cvMXWOibts2y36hgE4hAikeRwd9Jqf9YcPx/RerGN7
+L9Zm7/LWnT6Yojs05xDCqxQPsQcJYzH6HrMcKjSWfF62uBOOQClLOnpvekIqdmVWDfhWY/hLM4IKgVdW
+on+WN128Z3lEbV4uMco7kcSZCla5UlWy4K+tt0YRr+7MtSWDEhjblfFEA9JQ6G6jnZqWVbHblm6kf2NX
+CkVSVkLQkYc98e1Pt9VQpfCfZacxrb0/1lajmbAn5/4sRiqHkXg6oqUyQhQU6irU7uq6ajrdAet9y7JR
+aAlRZKwaVFcvuMEZ8W8RSe8eG5Y2g6Q46bomX+w6TFIyI6p9dxV6eLPJM6JqdhsZ5f45T9Ejcb54HJPQ
+yTwlrwh+/GgeHh8RWZvPuQgwLI8Tj0xqpDzxCumGSZG6qokuOubb75YkoHzOW+aOtsllChIdG+7v9Yhe
+aPTiAqfPPFe0km88EaXMN5ySfVpmlOCXNIw8zA+SjZHlfjGty49jLj/zFr2kNIk1wtQ1BqXoX3//W2H7
+JIDp0FloZnEU0IdJ74NUIOUBdyzT2c3dFYuTf3ER3ehqPr+j8pJeDuYlYRdQGdFltE+0mNw+NKcTfUYq
+jj3mmy/C++IO1yoTcf61W9xAXF2EmJcQEbVnkxHi/6fcOQPXBhHR5Q+PXOAcL/pOrd7zjLjo8y18Z1dp
+uwZ3y43nieBHM5LgCPimskyIUiMso/VRD0UIDFKhL4PAv2/FwdFzUhZOy1nGBoCm71jgeEIaGlid1qQH
+K7YsqaDhm/RLXhSorDpKPlTtOuOczPbiMc18m7NkEr/+Bc1I/g3tg6f4H6PvNk21xTRlDYXGRSmN/j42
+99x3HzwrRHD/NwDR6VeXsPpnnbTOkc/N3gq/jlLWBs2yoOc0EuaFrqpRtULtrqHbt33HYzGJZiiwGQQs
+omNxA8CxusFYaNLIcPnBNzItzhyTJjxvi0wJOAQXu9srS3mAlg8YAvZrV2v51HsmRp+NxqZC1ne4tef+
+O5suLdv56eawrEhM/z3hq6ddTZI67VrO5YSuWb5a4QaXHYdlXJGrd75jvzSAcrDAmUS89HK5YjSgrjxh
+HkV1iDOjJ0Jpf//e4BqXGcvJUJM/VYKKDZ0gKWNaVJWY51Ir0lfckHUpEpVRW9IIZZimz8YZqhVgCo0B
+l2sXpdR1ckdF3fQcLTAqcNuiZYGp8XF2jlqiheWrV1TtGrnntSMJ8fJcKqMEi7m9F43tLS/hOI2szxw1
+0JLH2Yy5IBtGtLR4SV9bMxWTVHGk6lgXOKV6HTfGjTlAqsXlNO0YT83BjpM6XmSv046V7SZtcKsOw7oT
+MYeatpRYDJuxCLrRaxSWQmXmf65jyTsroizp5Rulr4nf9mhGtpGTfh1W09X9evYeaXkBWAF9ctLWAlCo
+GQzimAgMkGb5wPtulxYozTL9dPU9bp5Z/tcULYnpP0mLQirzIi0NHX7VsBOaTGjF95XXaode8nZD4dFH
+M2x0pC1DtatQyvPBu+sCcEiITV/qZsm2PwQ8OH7GZQDNGMM1dCUhFjJuA1k/hK/N/F6JiL4YlX37/AN7
+YaSS5kd76w1Pk8+5hMxzf+Qu+IFLICeMDl08UBDvD1wOFNdlQNvYVwhAxnHNLPa73ZOdeo07HC6hBMmo
+q+TlV/HKCcGvJanI8h/K+o5krv+zsdf1WtVXbuL/Xts6BeXa13VqM7O5oaaS/VykLzgfgfUB5S2Qtv5z
+RK8R/cBVIMH5EZTZPY25MDqordYx22ai+1Lxtf65M/sbw2u39vSGmardOpMdYqp2G9lrRD9wlQOZqt36
+J3p42zBTtdujmKrdukzVbk0OCDCVcFDsYyobZARTaeGehkoELx+FU3tICqBv7KK8pGeGqhGVPoQPKK4d
+nwv0P25wi1GzK3CLuxZtd21Hg6/oT6uYUKcyvKukW4J61dhuaU8F3SZMUoqM4fQ6nd1+5uWaJNNjnrpU
+8ZePGbpNWqIX/D6j7iQUmswpaKj56OMjqtOGP/skdapdh/JuxF/Cl1Wn0FNjGDMKmZAGMPfhSxBeE767
+kMMbH4aGAjL4cmcwdBtDwLICrkzH4eDzQxnT/RCSHkBBzgLeC6Zjmh+IigYmdEN2ACh47tybxl7HDWmo
+/8V2gTMil1jWI6oB/77GJfr1sirz5ZgrncQggDNERFiDfvvrP7I8wlX9StkCzSbTK/Sn9HWxa17HxiXL
+rsU5g0TuWeijcPsOz1C0zZsA9oKUpNdsqW8iqlYyKfcTfmW+b3nVkPMpEWLUfkDiDwDJd/tuDZQP5jYv
+9XR6YmtbEIx+YsSAULlpLZcCE4Fkves63KBvnAK1jfCUTJ8B/OFM+LZvnu0zaoJgLyeTvb8LmULEc0Mk
+bSnJlOX63nXqcpHMRF5mykqM2grlncpSz9zs+NMCK+oE83cRGWoic6rISDY+gnJ4ZsZSKy5QX1sgPSn8
+hKcf0vo8EK04BhMz0q8ZSiiWaPzihvnK5uwyhmYU32AWuGRLs6qzayDyKW+Ny7G/XxpVL4F6MqtqRDos
+d08sy3jy2fpCJ3H/n1MxO/AODcrc0tPcl7G3t11gmURxuSectROmV9CjN22XB3Nay30UF/TVH0JHCXmx
+7ouCxO+TOAwkYWg74I7s+tqdHK/bomrXkZ2wbvEuqxJhe4S2Pp0ung0QTBhvO3qz7L/istCiCN2+z160
+t/HnUjo+lABZw7l9zyL2XJT0bKXn6ALNOFRu6D47cFc+t/MK6681RPCavn2d1JU6RUzlgLcF9IxjCJda
+yzxYVVvZ8glDT2X9An2YGPCsf+b2gNnw0BnNe637yP4nW8WzVZh3tAwrJG0JukBfffKDXawp5M9+9zys
+2Tlohv+/0gAyaIVfULfJyzUzSiuTuynD+GcebSRmo/TZkyyILtNCSachFblPTfW/3AMyQPuxIjwudL8P
+kckw7/Mywx1utnmZdjgCQXuv8+z6AHZ6R9Du36cm94Jcn38IpsaIxw1MkHGa+TG6ip4lW5cZfPIZJuw5
+cEA1kZSI4psvioWmF/3BNAOExAazGBgig34aIC96IvMwU2v3miyKdPlU5G1n6MdMaKhx0959suXvIlps
+jA6SK/9YHGtsNO1L3i03zjbjsIqqp3iFfTPvAUzzDavBb6S/8Vh1PsCk6xO2tvYQ1ZeSipaCoLf2Ouym
+RfFBC2R7CON7WZ8jEOJ94xGxzcJURVumxfLsq09np1PVzj+jb8I6jO508BM0+3xuBdLjOA2dqCP6hacW
+1OZc5S9ce9ApAdrW/y5sA0eBpX9S54fxdN4inLY4yWn48ZEET4pbK7VanMw7hSyOP4ghJEfDI+4UaYf/
+J8xzSeTB4p9asH9Pr1k1txcam66FQihzN0t2U5kKY0lVYhJLte1ojpqCjJJcbhiXE3WTb3Ez5g9B8upi
+LB8dAHfF/qAN9oMxZ1FSKLrnlbp/h2+cX9HZEXfR536wPqhAAkizpp0KEnIGAFoohjS91oCqWhZJ03vA
+rOtzYjNrKbEVyDFpZhow2lvx4v1sLupbLm1WKeDaBtbod2sDmxkhwsNHJtnQPBiR+9e0Sculir+l3y8E
+jeFyEkELOJBdMv4SwYf0yNf5IZcKPSA0pSXZ0tT6O2xkBCBvcpjvNFpWZZu3HS6Xr0wesfe0dZF2dC+6
+4LKrHek76q6mCVIMtz7qYMkdaEu0IH0qJkSbfL0pyBLgITa6SoNHwLAxyYxaDBA10ZLSs7bLiwIti6ol
+xtqfstofUYOXOH+mroLLXauBzEvytIfaedkdsHwbWJUZ3Vrbc/ryZ6w1inuhl7ftDrcX07vLu9uxbb9n
+N0amDuJ1MXW2ExbXVATKEHFOiUj6C72PoX9++/Bm+vDmL/KNrhA72gOqSC8h1xRrB7TzXmjAKwqM3tcr
+iuztVVlR/02+HBVcxqJN0dmdTh9KyeR4X6dlFhPp3+ha5AMMboDC90vQjcfogjcsu7IKvhVX3zrQWTMW
+8mzzbGaGG5pZR+RmDI+eu1PGjb5YDxt9sR4y+oCzqG/0mmcnyy+bF1hqac4bKcM2o94zRYYdilewCGhd
+JwDutD7bqMAuDwZCxhOTQ7EQ2x7g8tD/YA/ecGk973YLbXFGCyiHsyaSgvh4hQ+t6xE9PIZ/kZZrI92u
+3lQ952BhaMv12VefaJPP5yBKzslLXm2wHoC9AALz5yzt0oRty3+xYEqIadc1Z1rFc1AI2uQK5eBgPGEE
+WwGzcUyjOM05TtAKrtZvfA7o/JxdQsnfnZp96jujTlh5p3UUL/dwm0fnZmV+jVsvj9a39Ua9+SzMNnZQ
+lmHXllYk/YkVfH3yAXzZ67lWDPARi95sKjyH8NOQ2Yfnn6eC0kWadmv5c7oA5d1l8J5JB6eulIzAoiJQ
+efD6cIL8bBAqcmNk/SEt12q//J6Y0lBDvonHmPKhZLpsqrYVto52jP4HLrOqUYHnqcs4NW4zPxAKUb5D
+4z7YC+rshbMx+lmL2h157cocu9IG86hADc5EEPuVDC5QsSdCepSiFlUlqhu8IvDU01br+oUM5VNos+T2
+1Ld6i6Tb7LYLzhmm5VQvt/cvx3oq2Za91PsdxhnqKp6dQYvQ3h/lOu44LA+2WhUW0870zGFe/QuMWLgq
+PrXiXKKc/7uKvSlPS4RXK7zsxhLi7ypdwpxzFz41YelyiVsROWasDqwvePGUd0lb0PCWlI7oE6gihCit
+Peq+T7bVD3pl8vlokK0GC6HjQEqNhqHKalU7TeKq0P9WfZBaoEeGjod+62LLPZe/gbQnZ1btJl0+8dqa
+tdcFxbxRCHv8iSIuH3JEyEYdmi0bYfSNZLs926XbzkoIENo3zcb2BT6YEa7H3u9Zzm6U/wFEs+L0I/Q5
+yEzNrmQGLzq7Ya4iNWK4SuMTcz+101/8afP6X3mFXdNWDQyJlfWaGfQmHo9RN2lAHL8wmAfyC2/scSF1
+5dYXWtj/uer+rqtOm+UfeaH9vRYYNUGzUEtyA/iv/zirrv3x9lGm9hEztLUTwm9j+nVLJjyr5imlE8Vi
+Y4lw3zjTyILylofrG0P52wb0eHiX/yl5/r6Sp/3nEzkusL6NXDeshJan5ih9GimyyosiIWug6TdGghL0
+OGEIILOra4WMKWKm83rP0u00i7xr0ub1v/49cXYdPYJnnQg06UyDjhy+HmAFWHEvv5KCAHk0txOh6dEY
+4jBrT4iSa1EGnW9GkMV5BPusDBWe8l2wen4rrVX/PV8+0QjLNLRTmT7naxY+k0S/q0pcShecFtP3SS0z
+b1HbxpjuaSz3FM+lWKbPHx9bGvT2p7vi4yM3JZXp85D8BOZV18T/pAlIo/xZdKgFtg5mtxJVdVOo801E
+YAvlufMniVO3tf8qFi01vyF63Y/LVr6hVSnh7FtkidLQ9G8QxyvrJXOwTRcqNCvpqEsXrZFzSV5SilLQ
+GG8X6m/xKFyVItOZ0AQGbXhYm7OqaTohrPrPrXpqRRuSGWQqzADO1Z4EpucndC/3lPRxZj92/uP0gH5N
+4DNEbj2FopxGHtTenhJ46HFPWcE2vvercGXPU104q7HIuiMKeRf8YXuNunTBP0GR3v3sqqdTFJFjrVAx
+7HE2i6goX2WnaJM2GcLkrKMCtIo0+0PjqbOgNXlRmEu7Jl/4awp78nyaiWwXelkFcgtlko9hNmGQB/KJ
+20hdT5sk+G80bUCOM/ScNnlKw/Vq9CBaH/pksLZAEgy87Imd91nfef4qu/QDZoGa1WUf/bZuqhdxP9zb
+lRDdi5QGWJcynDsFoU4U1SmPMkR3+hGik0LDw1PnsUc+Y48U/y5diAwLMvo5+UaABH2HaE3zJAbsu3pA
+8e9YNwrt36XPNK+R/BMtGhFklX8o02f9J9s31W+e84v7F7d1VbYEn9IBbCTyFsA2eMuek1jYsKXUVAQ2
+SunenS85UBZehQXSf8GIO1l1FVo2OO0wWu2KgsmGEaLXbCpuLPMj5GAocxKMlMokszodFRxdj2+bsVDW
+1K+f5l5mTzWrokhrGhyWz3wo3qaThoOGIUkWuHvBuKRd0i/0RTudP0aiolpXjua1SBtL79K/aHFvf46X
+6a7FdITiSULOcnSTWCT8ypdQ9wWjUl4SZnhZpCzyBWmcN62I10weE2lw2opZZQTrl7gVab/zUuRjE1G6
+ZFDnquzSvOTJe/QPyarY5Vko0jOk9/qJ3kt2RzDYC4h+/I+Wx/eln0dkQH8lodVQ1aA27zAq0y02GDCh
+Nfuc5U3HN72lml3Y6S1Q1zLwAbwBu7kZIC2HdcOPQfcTgGOfHqXyO3PBZZfuVlfKEnHwOhPhdURA/Ucy
+E48sKBspY/FceKzaR7mzPp4b09Z34nLT75BFoZKZoEdOnUfUVWjNcx89cvCPrUhfcdxhDdRCLM/cieWw
+68uA5ah3SmoygS0XXpV2PTMlthVdUyBUBeIT968F63TpXQduPYUXuJfpjKR5ezSqribZ2a7EE1tVKw5G
+yLuWRfj6dyNHjmiO0c+FZzf6b+lz+v2yyesO1cVunbMos/Q6EpeyARUt9CzKFWAS2Y7qIla3bGtgpl2C
+GM9SY8TCLfB+Ue1RU72gqslx2bHotugPIho8qS3WgyVWHym4qjS5F7Ezz1hNrBypSlDF9TPp7OIoaPyV
+AA+/zjZ+nKGq4aHpyc7Px9uORCYOPnAm6FuUdy0uVhya2F60qMRj9F1Kth+E0zZX4SEzXDK7DEEK7bq8
+yMkuNvbv28JZ6eeeNGd8XqhCmJcELzWZMqOZWAV0Ohs76qhZCmzrdkmvCBcNQkJ8+qHf+4cQt2HnMTtv
+IVk2hsNhjwUhAlTgFKUPCjQwHLfZEIMexjWL4N7iOm3SDssA7m2FVlXxRP3ILH7CKBeGvpJppfmS68ZV
+g+iLB+YBnrY8S8DYYQf6Dj32Vd14jreGC5n6Enjb5QY6gcOcMK7X9SdVj/IRW9ZibQva/QqXmFKsxU2O
+W7m/kkGy5Z385NEQrzwkN1Oiy1W+3jU8sDdZSQ0m8fAbJfPYemo12ulvMrhHNdXLqT0P5SXapnXyhF/b
+s7frJs8SVdjKxy1vyVvde6SK6OPdMw3QCLmt+W74Ni9X+d5oTb+cUaiehtxa9dUn1vozcPO2xVmeau1o
+tnAdp3PVCNCl3c+mRs3+8WgKCPn0BSN6l5HS24/4rma0MBCmmwHLD93QXIyaDg9q606GXfaPo64ZHWhK
+WkNd+/UasNIj/gmkBLNGLtAwdDAPhd8qdF37OEI+4kc2+2xMkfrBN1pyJBNnVMoS7Ugm6lMnvVXVaMmq
+611TVy1uj2Y1Ta9wJvszNKOmRuEkiSfw0H/Jt3XVdCnfV9xX+PSgzkZGZX5SVolsY04hjdCUlmvcMlwX
+NMFfV7FUYAt+fK5WKKUPwyZosVs7A+Q6j2bu8o7PUALCmfPNFQe/DbdMMLoztR7tgyawYUdeoVqxfYs9
+nKwalKXNkybuhXr6r+Qz4b0Wpes0L9sOpbyRqqzkMiuR1jvrIGzYL2V1VsMKQBi+LwiAYfWtawLD2mrJ
+DWANg+BN/Pow9ABxsTPYBLrI8EDyXGd8tq5GHEsyX7vC4giUiN+0qfPR9hkBkQPs0dAsWGshSHb4KkPV
+s9RD9yrDq3WBMYNA2KSN9ZzLPeFGjCMdRr6jOA6YCm8czj/Rd9b2arckg1rstCB6rZPaxy91DcoXWOkU
++pFkpzBOsc4poH/QZU5xO8kqN0juX+S02pdZ4wbow5a4M4h0EOWO4bTI9U1vjrxpZZdpkx1yZQInI9/m
+pfCJm3yID35AMw3PZjdXk69oHIRltSW6aHI5md9e304n1/QgXDUZ1yfpWSMhH7wPJNNGehXpxTS3r/TQ
+2FsvJ2kj6MmkVhDxVlKrbdoqPqKNx1Nu8gHwl51YeUKpOZglEtczIggZp4qpLQuqE/bkgBG3F7eJSJHa
+fTh4FGk/GmZ69QhMPkvuTRZV9sog02yP1Gj6qNseH6k6nOHlE8uxQHHTLrDIEqAGF5ZkAe1qlZNxu1tu
++O1c2pI1QpNzc+NkXq7Rqqo63Ii8kvm6ZBdpNEITHdT4oQQvxrXXpWQUWmoCtdxpieA4bchd3okoAtbF
+gQHs1WzV7hZuQ+6bYTSTXqv+CAIUC2JFsWc33ETtwabEi/YI+wbZgBwnc4ealu/W72ksmLSgndFwNWlt
+CsRkg9PMNdeaNAI6AjMlMrcWM+CIUZFmUNz87qM78Wnd8wxc1DLegFu8ECvQ+MtOV2gE5Rx9L+28BfaV
+TLTHwHw2lbQYJsiU/FLSExAObHkOnsl4YvPIJcMpXaSRhJ6gCTqA2B7/pn9jrF2mzyDPa46MdnZVg0o+
+EZE4AsjJ9QrDsXjXkhscOc0VaxB2oa5lKoK0yZjhXnSab9eJSNlySCgL6zJX29MtTtQ6shOpSUzc9+RM
+uNAbvUyaq17YxZ64AqR3mDRfcbmhubeLV5TyRPXs2ovcpskU/71qjYfPXBpyoaoI2VX1320IjpITGoZC
+mYvnvxfWkE4UQlzNAFF11FDIr4GeDSJcpjwcePQMAjphJUakm9DVQLs9N7z61J2AZiQGV7fdG7y8zVp8
+S9FHAnsXMaM5mb3dukVfXd1D55Z6kxd5/ZIWXVVerHjlr/gfyZWAJLS9CeI34QgFSM2K7cd58IA9Osbv
+9SSDXMmwGujakQv3M7hXUGZi2rK5V2j79UCeYrkeEdv3RPgM5nHXCheyR9rJo3Ale9T6fOQwukp07LqY
+9TEtBfRFuPYUgwsOzxngF+daQJ0XRd/YqIDHV0Nbsws0wxhRTdIyK7CbIxQhO1ymqKJ1/bUng6P4pz+T
+o17b2LdGbol+QDBulA6LC22rtAZ29jXtZxhRNkUArroKfGpcxR4Vh65xLfa1L22jb8ac9I3/SBOmIfdP
+MF9x2PbeQbJNgop3a4NgMl+8mugVybz6EUKZQUiW1a7sbKj04wej3jqt7VprKcarpt6kZaueFbzkJDgv
++x1WKAz3Hfp8rSKeqQXzBt3q2fPbOi1V9KOloKIR/V6qmwaYvGHlaLlhgbT8k/Oz5bJqMu61T6cnFV/k
+WwV9KOKJ/T1/U689GddWa7VKSCZ/bb3qiww+r/uPt+fgPYkmzt3uwOPioH6sN6zGuBwjg9tLWFefnLuv
+ZIsU6qD/gZJ+1QNKMvgADjyxMu8ExmQpZctGPtsd9ND0Hr1V7XXHReAraBvTKxpFPsdn0CajAVmE8/Pr
+NeFjn1ZDvTziGh33DKwa6UqharfobPEqlO4RStGqal6onaFI2w3xvLt4eHPOrV1gJ47zj1VLswl4E6n4
+JdD3Iq42DVld5CV3Z+6MceUl2lYZbkoZa09Ath2a/MiZV1VaPf7GyLqqUpFK3arAvcPFBfr1L+6S6RRt
+0uWTkQJ+wxTHzWtNB/gkh8o9YfTJWuzyQrxoERnbxctrOtePgriPVmpJtObeji1KS+EjyiH9hKnzP6FU
+dWaZGQNwgxev4gkFswc/WvbuxzGDJ1ivQl2TL59I1PG87CoZI134IMtx0kc8JAg6/WzhnQr6yeoCoxLl
+W7qxdrh4FV66eTeGufWemupt3nNM9rIX9c6tR3kpqyTb1UW+TDucyJiKRyHhBBu37rUBLnXuU5WsrNN1
+XlLIPlkp5Q4VXbuSDjc7Ow8JJSV76nSNtSuN+CTdShwrFHVxDHzdQ4ZRrZ6zc5iRTLWavjC2WpXg9YXe
+qRXE1oOPsKq7xaZt3bhTsuJXg3i6Xht+juobC4Ple4XtVvQmSza8AJxRyLCbOkgZ5lGuPysStlPXDYSt
+saTao8CbIZtvAyaA0GHubfCmF74rgboOHvHf9l5sSxHhwrYCUYMsFPcS220QwSg9b/U1nyIXdQDc0HgY
+9M40L/OqTDuc3Yt9guw0D2/I3vvwhsfMQDTEbIOf82rXFq9081UomdE1NJfZMJ3cKDF+Sqm6AK3Mo9H3
++Q/85YHgdynX1tYVtVbGXttAwjYpSFh3SOCyEj17wMjM5EY/GPypZxjQUWu3B6HWbn2otVsDtXZrodZu
+HdRk6gf2Jv3fJViC7L+wkLwAWhyXEZJ/7/WuzX7tTvnZz2VvfQPUdj3TWR56MGW9kLK3OXtvEx5hllj0
+CEZYPh0iBU3jCywLY3uLkIdeBzIoFJJ8TliVWJwoqFJP3SpGTPSQY0W+ynFjvP15pGWP8mH/oy0CHtnb
+3EWaiVDave9HiTZHqhvHUeOD//kcqxfM1aVV8QX5Z7uDJwgF/F7afb21SFssd2/vcZYiE4rEr4e6YrXN
+GFe0ctolTVV1KP3q09efHTcE0BcxpBdZx7VfbOvuFdHO1dtIKve3KbeCse0d04q9aXYuLtD/b0eOQ6t8
+z851DHRe8heF5MJ50ZVIZxuPLs3cnqb1Xru9JqFfOEzJ4zTcDTXdsSeq4uaBx/iSzE1f1NDsfOj58n3L
+wWhMTH0XzDSrjcFZpNziVNss4KsX5hLawH9lT1Zbq1lzxfrV4mugMyJacCYIvsYd9Q3HDapKxLRt9pie
+v8ijS9hIckJIyJUjYRVm2JEg9+Sry36snIegOWOwzs2DGd9trJqLNd8uuIJA/5O85n+jRV5b0vqIVeHv
+CdZvKPXIylyP0HTyDrAX0g747cDXY6s7J5ecqeVP6P+kxCJqPjs+NetFyjodz89hs+Bfd9tF1TXipKtk
+qywQzIfOgG8k2yxo73OqGkdGVRo8MapqfeY+QJkacp0ghw2N8Sdodu4bkDw/qWLtbRz4/BJ6dhmyYff6
+dKcFbsIpndSs0rr6jml+gG23rI5rtpXHd1YhIrScb/4MAK7QIk5sMqt1kTZr3CDapBXDp2ZyMlnCjPs9
+C4XMvHNFqAXyBLnIlx19uUxvU0iTt7xty/hO41MV/0TuGTzGEVVuGDr0EQ31LN6m3XJjI6a0NFO/UKWW
+kqGce/41b7c580CWUDkf/IJHUdpg5oMmppha+tIlvQKj6JEaNOMh3zPft5JJ8nI9VgyUZFpv4L5FwSil
+iaRktbiHLBXl+PGzjGawZ71TMoie2S0h/X5MOqCBbA1Mq/XG8mdFh5sy7cwlpj98V/ourOjSHuhJk1Ke
+ojF4p2TI+3dKVi72Pw1AUuBnXJzJXviyWrPv5yPUX1ccVCLra0Xn8GZtIvtWSXl55htxspi7+Fvg5YUR
+rVpBOtc9SuX+LgJ5k7KNlQWFXrzZezNHZv7ODNtnL2OErIacInJf149RPJwpSst8ywzK+UrT1dk7AmHF
+eCh1pxe9FjMCPOHXVZNucYvqplo3uG2TRdokbdfkNW6lv1JDnBj17VQtrreyITvDEH+yz2Kh+RpNZC1l
+LeRgfOZxZT42+6OFzqUzjTZWNUvHFwgtXtGyqeqarKe8853mZCfWgQ6yM0nChRUKVS8y+5nWQI/nbZIr
+kQHrBj2zcmIlaMdMd2CpbgYbeDgNUixtHKrpp06johNg2aICZ9oMfYIXOC8+O4eDkzh8DDAa7TS4oEyM
+2BLF0swil+w9vNzeAq3zqky6fKtf3v4LVT3RGUkihps2aXC2W+Is2VZCUSO/NWcHrV/grM3VdgbUw0pG
+gCMjrgabCK4My3dU3BOwJxL2L1hAnB0TaFWJyC3qCP20Iv+uGvTTLH/+yBQK+5HHcKfR31UyRmGLO9dB
+gEeGtd+OoLxlt2WIbkmQuo1ofCItN5p06d4VVHuqitC7JkqKX5OFxG8PCAaI0loPIojScrmpGlQ1XO9S
+Serysu1wmtHgOUXOY4Fn+fNjqwXFzFUPFKTqZYx+zRYLu5amVgoZoNRSSlhcCJEUmzW3Johd6qdLddlp
++yL9ksYqZ6MgocvP2NBaQrdqi2lFpFwiELljX2/OdeGkdcd68kgooZgFu+WVzlWCZ3r7x0NwhU/u2sUO
+zVhHDTi03gWrR2mkYgvnDSIKsTx7MBcEvllQJZEn1wuN9ejLRg2kcdkobw7hu24Xk7g7K287IIAw+nVJ
+XTiIQuxZCVXp8Dl3hmYh51R3Wtg5l0cPvyq3mV07JviK9iJ+HeMQ9rqTzjmPlKfGSmVGidcUGS420K5m
+BkvcdSafON5bGgbOTTwwIUFziQ7LumD3dCMu2N3iwc8E9Uc3LjgwQYd7tQGGxex9KhPXnbw1HYWz0WvQ
+ht6chufEn6L+13J43YYFPqeXJj9NPz4y/tIEIeTdojkLKKblVU4u0QYLkMClN1AzdJGL/o0crquySwtl
+waZOsWxRpq/VjibhIHDZSxY+fnooElcv6IzvVec0+aiEqR/UBwaZC0dHA4K6+cPK9Ualc5/NqiEAEefC
+gdJgMQu9UQrLqt7nI96r096L0vDy9oGwn1XoDsHO0wToktUftSHmuvUwpF1/fQftnjBhvyx27Qbainm6
+BdYRkyoGYqir0BPGtbt2ML1NrBLy3zH6bdWygPK7Fq92hdjLWVBMmRSnRWd4vB6PaLSF9tzZ0VcUTXYK
+83CgTlY9BTjwsihkPg/dow/aje3cZwfH5Ag9Zff6P8VE2fDsnl6/dpd77OtAPffAz7LMtXl2FX+VQHVa
+xlVyQ2A7CKpKlCstUZxCiJdRs07L/IeUxWcoXkfsCS3NG02PFixjPFUT2a3jI8/KPdSkarpragqftEty
+KH6DZ3IXtIdenxteMr8hi+hX/CmldJPx9k5HRQyiunVUN4o6J7avPrFGn231pWdvVp/U2ggeB4eEK9L7
+7rkI1QY6fwc/CXFCU6mOqP0q2JGLi6l5RIRR0m8oeEz0RhoxITukdUECeZVoVcJeJRJP2sA6Lqv84LRU
++0brVHW6zLvXezSeK4uKfDP80/Tj+5bNJBCS2XCl9SER44DNHvQInfec/pQq+HncNbsayM38AxzJ7mdZ
+ljP5oSerWCm7yzNuWpHGJP/99yJrAHPIN20zumkmLYUJp0vXTFz9ekWiFaOXlAdt5+W8gxHKOwX8cdPg
+1bcPb756ePPIWuuhsTIiOgiy4231Q14U6bhq1he4TP7j+4usWrYXf8KLi1/Q88XFssiXT199n67SJv/f
+v60WOZkwCjHkYi+86pOySv62S4t89UpD7r/W+KFko9bZWx6WJxHBwvWDJasPZB4V8/PLXbdrcFI3VbXi
+Fx38QQodWEvNA+SgIw1kx44uZQOzAu95Tm18pXdV2nYi0Mhe5vmknxP5xX97sdzg1E1hVZXiFThXxWCx
+wXqxxIZYeqwweOxnVewCOBaaSqtgBUPjQADbgFGigdbdzEUdw7ecdJ41VU0ySHWkk0Wxa86mk3p/boqp
+oA+E2b8ZYM3/CNx2YWBATPOONAyQuIjSd0DDa+pWCyRu8jfc5Bnud3djTGi89+sx7WsXOsraZY70FR66
+yTqsyxgm4zWP4TV7TvwsJzozOU+nlbrC8I2f56Ot6+IVdRUzsEgzQFpm2ulf097G2ypLi6SqMd+IEkS7
+Ukb1p7yQmSHaZVMV1CDBG6rzHUpUMG7SP6vKz0xaiyTL06JasxaafwZqN7gopCNHuhSeB6nen0xHRlrr
+ddDLBVownxAhk8jfbbdbrZjOr49UuM7897wotIEJkyehACgA+T0569F6WJzstWpGwav2JOCz7p8oybVJ
+2T7LADNkWkk7vT9lFaYJxOwoTfphUZrK3v5A/0goGNOILJemfhVi5GIQHwBaMLMxdzj6btNUW4yqEv0p
+L8mjcp7OhfvqIKbvcPe+Mb3zyHCX5kU7Qi3GHFpkRM3p5G4+pW/5LH9BEvAXowwX+YJ6sRSvKKMPzGka
+I5GxWM4MozRdTV21W24+PKJsh1FXoZRDW5cpeYdJ1CmmmJBY6SoWCYk3MmZQqWJDBOf/XuzW4+U6/695
+9u10fnt5NROOWhijtGiriFHSYKHtxfTm+m6uOOZ7ukSy/JlgKDhBYxzCMDw2lxATD6W57EJXC5wHJK+y
+neUevdUh6JEMZEY5ruAQrIjY6DYNuRhjbkIsJxGtwZ6zUmFScWcpvsC9WouY1A0u0Yr5veXakEf8Dhmj
+vKNSp8gzjEgmBLVQx6s0w+hrRw/Xb/TZCN1E8/QLSaQsqUCAJfKzegjK+6I7p+zLbU7K3eafNQHFycxY
+M5UuauYGyd4MTycX06nQ5MSKXabF8owsW5Sgrz5Bc0d81z6fG8LMTPMIA3ze9EG0sPIIjc9Gx2zrG2lf
+zCgh7FJ/0+Tlk53pi1VXO2OPyDXpyzQKnA1VP7Z5eSipaRBXmvnpEUTlkdxx4zJjBzaaMor1JJ6gPOf4
+BZ1xCp/zdPXmc2FLWxMzwgqHz6g4HNu5dj47I9Jm45FmFW35PrZIGwvnMKP3xBMLeQg52xb7CLO4yeSm
+/4k3GIHsFfZX4ZFJdNUF6vwUAalt3wVrHnh/AEvRhoKj2DkXPRpTIkKRUdda6pvB6kISWkhjA4Cudxvo
+aDq0DU0uV0AjN2H4gl97D3xWc0ALB2v0x8MGm/X6z9mtREmy563UFYahNDGFiuivOFP7pqEACRb8LSk1
+8xvw5ckPqidSJiU8iyWfX2wl8nkTnlwBKJGPVYhFJc0wfw7FSpn4oPv5J/1QzV9T83OrKrGB84IP6LNN
+K7YNsTTKVY1atuLEMpFHDBZlOi0zxH3IJWUHHGY1PzUyyrbSXde7UmQRbDvyb67g7eoaN9wBnh1wIvPv
+/vuu4+hzDKV31pkzmHPSW1VTtsCIiA3zwKmP1HgbYp93jYr+BQcfe8MOFz1rDvC370efabK7rhLUJpzP
+/XRpvgUe9pyRn0gslhxRZmsvKyEAKU11w8w9OkvAnpPXc2/R/jzUCjpM/pHOJb2Z4teUgqN1xLyBzO1H
+wlobKxiGJWLoSZkp6DRMvDiOi/2uwW2e4RadcVcMsqLMFUP4z9D8zh9KR7Xz7JjesPgiAr44zYvw9/7A
+9xwcndd2U+0KHtgGLTBKmXyU2lhpb5j9kfBZdRbk1gqETD0AycDRGU1YRiVPq6ig68NxeioZiBaPjX0F
+5QWlGi4zFvmXjo4CFM9OtcyiIg6fdCJV7oxI5Cl+qZonVJWsgbQE91HBijiujzogPMwKfuFheVBFyA/l
+AcyevXKNmEtVnhdBM+KTWHNQyEczYsh4Nm/w9gP6rDcoUqA+9wPQGshFh1Oq5SnNmhGGujcJH1/C7hlL
+4cDtSZvq5YJYZiVLyfbJlkPsjQKe3N3d3dV7faOfT2rzDYT8oM59rCeN1b9fpgVdiXJffSjllAXfLhLV
+Wn9hjVrMt7b3rUYH/miOnJXqqmHzA9hAzOsPVr7NPpiiGzoZ0aATya5Wq9w8j3qPNcMO6bIb/QQZd5aJ
+ON450J1ER4HD8tBDsGcowPHqC4zAotQwjZz2cA5McrtFnwAGarXlGmTqQjzk5vCKtW4B2Rd+Hi1Mz+Rg
+L/vC7IXABUDuiw/egBjjrqqKLq+DAsI5HPBGPodlubx4Pcum+PtdIw6m4rp6yTJfp80i75q0eeWPInh7
+9rZMc8zPyxY3Hc549mOlejC30I6ICAl8LMyzFX8tUe0aemnJYskRlUq7b+8qlD5XeSac8wngF5w3GcuC
+z/Juy1mhAKnXgh0wzbgVZVSw7kXpK01iXaWTSvp5xs0rKirSY9VkPM8PFiZuIXR5mnUKk8RrIKetNpxn
+yrif9BylBJb6GYq9GmwadVvofd0KXiOq22cGm4IyHNGkRmpWkeqoV5R4EYHMWa7XjO0FoNXgj3OoMgKm
+Z1m0YgGo3BD6rZ07EEl3k5iucykwUMO5TiIJU5b3dgYRnAY4cAFpz0etVlCOPIcAjUqeqjwvgrNpksD0
+fgyNKswwgqe8ZHQcX01CBkh2PI0blU3zMCrr+TwO4jTJRF76eF0tRVa8wSSCfTaPIQM9th/OaxYLfEFm
+s2MIRvPa8VQmXR9DY2omYYET/7xP6AGJbKL/69uHN11VP7z5i9S0mOUZWeJQ3Zg57Sn9eyA0etwyAAZj
+oh4gWmAPGAohUg8MUsU2wvypSamZTjgx8Lpiw1HaFDsCQ15YQh/S/bDcFW34vJifbK8XVtr7TBr0g+HU
+Wvc4LQmiuiFNVGwEpQhpEUJW6TYviG6h/aLJyv13+eh3v/8jA6Y0KFQ1SrcZS+jyEW2z5W4PpoOq9ivR
+Knmj5SnMdBpSPmDGanKiJjG8CDrUdPRIjbyPzELY0RjHVdPhzIYh3iyHnE0Nb1jzq3bLLAsK+h6Pvnun
+rKMNkJKKKoDuZ7C++YLeJBSHw8/CIqhwVSvf2v7UYsF7Bg7Lf5RQq4dXtVbP/4MHCkGIUx4oOMzIAwUU
+XYEjNfj6TjaEwiWbZf1XdlaD/mAXsr4e6+JU5x4BPXDuMasYe7o4Rk/cEKuu8jGSv9lTk5iDEoj7j3J+
+EqOW5yfby9VDFp74AzxFUaPS2VefwLaf0TdIK9J57fM5+glKpufHaMdgn+gMYoCIc5jZqtp1bs5432w7
+BASfN/54yAd1TlFVO0SaOd8GcAFrcQIe8K9f+GjgX+PSDGavXh67Y0vurKh1nnrE0tFkFWbKBPVXtCSz
+k1/s4AOId2qP5wXzsHsEK1s8EMHIf7dxRXG5fojXU1gP4XLa4EsKuqC1aXJKCveYB45gHJOqEXzz9xsX
+xDgye09Orr2KQo9aL1qzu/X3Le+EXvyiBRbKHAXO4kIZDez5jotVyBbiXOZWPFDlsRN3wPTzuiS6Xigx
+iqNwQVl/8C1LZVSyEpkOWJaNdq31n7tPv63VL3UHr8Sg/esIIWJzwXHbzxcdV9Tuc5BdT1PTD7TrGSre
+oXY9cwc9zK6nL3c3GsvvV6sWdzrD03O7FX1WFHHxaglX2zxvlhq5jeASMGTxhCftFugJiwV1Fv0DXlSV
+N5ik/JNatszHmBYK4WxHoCwdII3FU3kHkHox/7aiM5CYK4oK1K8+OSLsM3MbgGVpr4sh0JV6hhkZ0X+s
++nYf7ull0Lwb3/fgvNAq2kNBdnP/u6rD0mWUB664l2EtpmOaaLHatbgYG/7XwuMtzzD1rFlgVKdl8orO
+CJdTmxHh8V2Lm1Z73Kd8zM5pBwiZzpF5I2ryMAvU0IkzgmGKBCojtNh1PKTwtk67fJEXefeKzqjv2S/z
+Bq+qvezgBb9vsAhzTZ+WSfAy4CCtO2NJ7+SIWbwHuptTl0PzOzti5i0ZY0aGmJcZzdrGTLmid/40MX/G
+/KlR3iIeU5u9fb8cIx7MweyAVKSTsmuoHZK2Zk2uPE00XMFiibIGV+DJkStR3hGqaC+bWFiRMfp9Wbxq
+6U1ajKolSWCE0g6lqMu3HLv52CZVifddJFXJNEFYCzQ58rt6WW3JXEq0Fb5jkXqXwgh4g342Klr8/YmZ
+nHfLDY/4cc8Y3Gmn38yEn8mFX0ZK+bIscNqQaFfnQF/Rwf6kfOHROeQuZSNjBbKS34nYXhHf8Oe85QsM
+xld/FScRhWLcGqPgHDp6KAFmcb8SvrAcWsVZAQBOgbCoGi7bnY8eSt47uD7YzKsbEvpnkXb4f1KnNc94
+KIZQlxSov091WIC7TIw+VcAhGY1dUdrIO826YA8fROJpm4ecMANIg5YI9917Uct94WgHNvFNMYqUCFBV
+n0Tw5qODIw34qT/yF8MdTT54iActiUmLoFWRZDt2YSegnMOZ2X6DV92FeMRRdk1VsDf2ZfrszjavQUk2
+ggpKeZvaf8dmGqgtKvPwpdRrm6BDryXpNdnZNLk8h5+fTsdCMyDNRBYBv0P6bOz6pItjfBuK/Y3YtqrC
+F8DNxanYIZMbZdSp0ns9rtzgnLb6cgpLUdHCTVclQvvy0LxRAX4jBhMOv+uk2gkMkvWvD5XxNsytDEUz
+64sKTi4in8uRqCPFPb1UThtZ5+xukuH1SKT3KdLl04g4xp/bnyaT6fl5EDG1Wgzzw8GYzW4ORI1FWFpW
+JVv7MsoDSMokX1ald/XT0r4kct6FQRprq0PajPoq6lQpq6TBNU47Yv9DF1QREdrI5+Cg0CcTVpJv0zWG
+updN2NHycwQ1YsDKJhKsOOzXPMwWPwNUDarz2gwPmJaIHg9xxqJIkllkb2wrIhTzcs3OXyxLDWmgIlXS
+aScR/Sg0pvO+VrtGnojQpiqydmwrphyZNijzDea2nnhBfhbTueftceihNRRpvpJR16riozBDaLtLZxzG
++CTIlxtpK57ZtBV6wZoHQpG+om5TtRgwwQZkvXlRGKgIZORnn9X98Z5YSejRXXsj8EE9Vic3BFM97auz
+5OTkwZf8QD3gpj9p/NWpe04DWdJ7a9M9gnBD2bFnPrUYm0hoy89S/lCLQBdOOOQ+fw/FKMp1QMZwpQfy
+ZYNTzkibvENpg1Pio0PCbKGqRF1V81ivhOnHdhIcD5nzLiGAOL09ybxca9YRoMyYhd6QJEAPdnwSUxn2
+B/UCRNsyrbVDhqN6stIYQSOe/ry1G3PDmbyzEaSbiUdijD2j2yuJZSaXo1MrgSqusnpz1SXeTY/uZ3gx
+qsO8yB1uXguAN1+00T0Z/yYUT8NIfveHqks79pqv4e/S6WsflReprdkzSlZBGDjQJ/1A1xAo+Ozymmgq
+5+pp0hhq3K9AiFaAyiCKNKllZ3+l1IVS6pnIGIZhbtX6TgvF59612yusJ6KiOAgnVD8j0l8HTE3A6jJT
+y1Bj0Wx8M2+5TojycpWXLEwrRF+Z2xokJHk0FqClKLZcciCisaqftfPmrxquiuTNssABLlrLy8rJOyB4
+UUsebYr43PRqZT551yNvxi7sH53FnJ3KYahTMotlQQB4h5IiinNIzRPwjbwZcAaJMrwsUnYsTMoqybd1
+1XRp2fE8ieQQLxI2I4TQJ286Z/RfZNsP6DOh6M+b6qXFjVICObyuqmW4QBceKTVAyXbbPMsK7GvHS+Gm
+jBt8TXkp3FTjJghbrTTQng/Z0x4Y8iHTZYYzV1yvB+RerFUMbiYsrbjbX33i3z+7p18zlrSOshQIqWpP
+TXAsgIALNBwG2R9GW0s9aPdv3WqrAcsca4ePXI7ZPv5Pb9nxf0sTWZDbOHJdKdGcvztXvbATcrIHCHfg
+jB8SEF7jgIc348Vapd18eCNgnEPZ5CyzyIC+zd6d6WBoyM8efHR/hXVCXyM4h3wjWrpJZtlSP1d86o39
+7ADxTZO7RYx6FitLb2wodmOuVYh/Pin1CPJe0n2tHemjjjwGKH4GOhQcs1pr4GSO9wMBCsn8yTlbHQqR
+Xr7oKIrs8gPhKYgTdz4mAXonE70uT3sRJGgy0eoL002YYsnEJdkkRBKKlUWTiTvmQQnuGSwnb6+VeYDB
+AIWeQlFf0GZz32I2VhDXwuRC4l5oUneydDXLVaTdQsKCw4gAEGit3i5oDKKluwmDQr7kPkMw0C68DoJm
++6geiofu2XwoRORPHDQEFXVBe9SsHInEOoY3i3UIBjvSgXDI2SzQss6LAu7fqBGAMAGbT6K3zIjt8T+6
+vMhlgotltd2SqFv8APnIn8bJA+0/SSIzjqcSrXw8mgI8zmReMyJeadXP6JM6OrtC1ZshCyBkV63X9Omi
+ImVeorrJmesQU58JOdg3Q+Hzoz3OElp9GMa69vtdg7O8u0e/y5dVkbboV2lRpOsNDw/3/X/8+o/ou++/
+ZzcieLsgsg23dVW20u4Zny7TdpmxMoJ4gr73RdrtiZTrYE1vyOlJKae2GPonrUT/qhZ/xUvmzPCcZ7ga
+5pFvP0cDo2hIAgDR3u20J/JsxVjBGUza1nhJRGGXV5RFgjU444QrkXj6Zbc5C9caIe4W3wPsNQ7YTL7u
+dCp+9akP38+L195Kr1JXgmLW6CbtGjdLXHbpGp/1wUQXvbQ8B5NrH2Jp+GWB9yxlWseTDTEDBIvzTsEx
+WZOqfEXURUR5lVTs7uGfKwklGYOSzU31ouW+QJ+g3JOQkLbAsIDOATC8Qj8kYi9s8DNuWuxFSFaIRUw2
+8CHmgwjCJC/F7aG+0NfjtKQfKZa+HwJQVpEgSDV7VAqHAQRa5UVhDUYF/4xoTw28E3Msaxq+dBLbegq1
+nka0ZuH0k4neWobYj28/hdpPPaxguRNowLq0YYYFODopK4eQ8oLEZcZR8wY8HQaQu5tBAHnRIHAikuin
+ngDOw6CmVD1Hfqi8Ajw/mtueOzfoky+CNYgiCEtMCgjLNyMgJDEbNqTAVIBw5AWGBQe+2AjTqcHdcuNg
+JL6HSB5YEEaFSKoHFgMELkz4wEKwgPXSPrAILFD9S8ADUS0AECLM/uGZYNPnAoyY1xYXKw03sivo00CK
+7/2bBQTDWY0MRhRbmJCctahBCnOECcdZiQxOLzOYUJx1yKBELEObPtYqZHA8k3WC65d/BqW1SjWGZhby
+T/orEs92bzTjdkQjNWxMu7IqsdYd/Xn6WVB2CXF0F2Zq8duwS6jzPWyYOOYOllqphG2KoydO7BRD8UNg
+KH4n9LUi+5sgqY75qr2LJ08KVjXdJi0z8ntMA9VrYUfDGT4MZ9FgGC7R/LPWixF1EuhoqCsq3Ffb5cun
+VzWkf+Fh1Fp0pjpllc5dKwkrgGwkTrdaVdsd6/tlg3HZ0Gep6qqhbZKKvKIzFzf/qr334l/YXbeKCG63
+UOVnRoJt+lKPW5tkXgX2CBPjEhlYsSxzKj9dOp2+1k1FbEk0zVxdtV17QXOQVckmz7DY04C2m926WudN
+ustwQRvPJtPrC5LH6fJi2baseVpmSYvx04V2827QxWOwEjYoK4L81HTjC9jkEGJupORAejYZIf5/51DI
+OnK0c3L5ivVDXE7zkhD2r7uSPVOlztxyersKVdAU0Feuefe+ZWl6cDZWRtcWr3YsmeXDm++f8poA2aZ5
+KRo/vEFFXj61H1CrJUt+eXkZv1zSRIJ//AMh9+XF737/x18kf/ruZ7+aTZI//uK7f/s+IZ8nd5P5xa+m
+yvjCzaf/9sff/maOfl7lBW7qgj4isWfF5sKv77V3XV/fG/4Y+jJKu3xp2g81f2cxfdonNWX0xWGBpTdk
+XhsVfeEFDxfFYxm9nV57asl7jdDuzp0pK/G38TSg1znefoq1rxndDs2G0A554Gb0J+ZWX4qsJdqGWTdV
+PUJv08Wiwc8oL9EZn9GXkZzJzblh+ydRAkbobYHLdbehOxn5oi4AvvrEwdFbC1JGdjGyqTVV/fletvTs
+/iQxxQt5G2nGp6euwTb1thutom64drXxiwv0P3gaCN0aucFFzQOUjLd5mTzLrvMy0ZNYuX2T2huttpHh
+ykVgLEEHwUqQQXDadsQ0Wvr2pHxS+5H4nJDPsIdy3P2BFaAlfJ3gPNm8uED/bddSnXiZkryrUOY4GhW1
+xR2LBcB8bgSKnsxwcNQfnuGQ5GVd5fuRIVDbLl0+CUlE97C/7XBLFbCL6fXd1c3d9c1FjqcT/sCtbvEu
+qxL6ciehSW26hOS0SciOUO3c1OM+Rzn6AszelY5SLn/LXseQBf3vIrXrP93Npyt5RBSi7Ujt+bXsNyR/
+6BMW7ebUkELGJeoAceQA6lxII7DiK9Slqolk79wPy8VAVYZRaWJR2UejIny4hiOzOD1dpP/XcGyK05OG
++45F4fLZihZFIrHgNb2E5m+xWnSGx+vxSASGHm8XSTmlMrAUNcnNA885SMunj+fG4olYA9Rrk9RD/+Vb
+NDHWxlaNvFRDl1HAkr4lse0AADqRt69gDzpx9aiGSfQ0b5u+nvexPXN+H9D34mSjFuw9oPPiZAMXQfUO
+Zefvqy1G5EI5TwsOE+26vGhFVirL0qkYy2vh1BmKVBo9lA5FVVAym31ssA+lhrnBMgbsfQ/sRp1jAtAX
+B2IuWKAHfHEg8mySA8A/H+uV9Ue8l35CY/qCYVuVFRVD6FMgpr+qBenoPxOOAQosv8FC6JPxKk98thVo
+WkfeDX+CzpRwG3mj/AmyGcBtumZX0shQn7SHm3qBGtkflJNSqg/yn8Z+TMZlm4+tOfEakc223H/cbOu3
+JJuN+YXCJ+CJpv9ISfhVPGHjvipiCovqBTf0bCJgao/dVCE4/TQTr6+tKgTbLtM679KCbNBuW60QWiV/
+Yq+K0zJDtNqSHWD1LBaF9NH3Z7ooIJK7UCi1e6DgJgiHr7sgHM/a1KssqiJDPYOidfqAsHvLMBDfkBjF
+dX8PLcMIL4QmTXuWxRzoFQ9yv3fk9XYf7JZvSiO8rTdpm7f6axtaEHr2M+bP2rJXDTMVaxDm6u2uw5lW
+X/voWkRYB+SpTDKfqEZmyJT5uUfEUxnttqPfwXbs7Jy3S9U5sVtDBCPfz97m67JqcPKSNiU9jHaNQx4V
+T0fY8MAwO97BE0mLPskEGvS3nkHDaEjNG+hr9FP0i2yN0fRWt4c7yTfA1xIsOqhCgCUlkSTkeUdguyPZ
+8ZHY2Diwv+3yBrfGI15UNcwPlqWipU+iqIoh7BTMBm1sk+IRPWjcpzVVCS6KvG7zNmDdP9y08sfXulo3
+ab15tVF11lDsA8L+95LsgR2D/wM32XErFHvymCgHUOM0l7q99b2qlPhYjylj+z+HDgyfPYrld99/j2jM
+HdRgGXzXJCu80lZp0WKV6zf0LFvXLcsqIbnrid/sGpe4yZei5Am/kjUhMj/do8nFBKV6NAg7dACcZCn4
+TtC5VzIES4brBlNmf3jzqMZ+/khk78Ob56vxdDzhf8+pPDapcn70Q4r/IeM9op14CiBVeH41w2iuR4YU
+BcAjkLz0tmLr9+hnIMSGW+BlVzXk8992aZGvXsn8dq81Frfe1a5ZapeV67zb7BbUxLuZL+qLTbct5slC
+3YRdLIpqcbFN2w43F22zJFeYF+RKbrxsWw7025P9Q8H9O31CQcfdjlnULyoyM5XzicaTVTcT//bHP/47
+ajA1UtO4vcbVYL2pc5zRMWa4SF+TokpZ6OBq1/AXGGRUJx+L+RyYdcSGxfdR58kIQj/hx9afWAmIfuJE
+UqdP+aqu7ciJi9oYVvnyA33zg8sOVbsOPdJ190iV30e1HB8VCKmrEA3C3kB/Tj9S9Fq0oiwgWjorHhJ1
+Fxeqz8AjYRGPfd8DUBpWUkWEr1mw0UVXasZvIH4feYDVECb6ELDWhMhpxigTC41QWTRP/7xp8Oov1jxd
+XBjXL+js4Q1Ku645I5XP0cObc+1GRo1wsWj+3OVdgf/izDsIjdY1wZ1uZOK/6F9pdDGa4phew6Nuk3Yo
+bTBaNemaQsszXHb5KsdNO5LtWAxs2tPjX9PntF02ed3dPyJ2cUQUn65aVoXq0CQqiUH/1cMbQYuRW6pB
+fXhj0+ziwnMDJklU689JDD2pJpuKZdMw7E61XKJUkfvbruqMBFeB5+jrJn1N5pPJB3OKluxJyLLK8Ae0
+a+mzM6P9owjYjaoVcf1Q72DWPLNgkpdtnuF7Ji5dhhD/ZbKW9PBHIqPae1lABOj9BXVYyfJ2uWvbcV4u
+d11eUUH6kj/lF6Lx/2aN7dnrNgTFT05WtI6KQx6snkiH2kawE1Ocb9fo09Dh1bzxZib+uFRAqqbepGV7
+jy4/yPnOs+pF+yIBgQA0LCiTeWkMLDq03KTlGrcsoLtuJ6YVXlGK6C1EWmbqUp35wDxhVIu5WhZVixuU
+LpuqbdGCRY1pxxLan0QcwBZ3wupM708WeJmShZh37OzTokfSH+En9N2mqbZ4TBqnRVtJYAwSafWolsAj
+qkrRNm01eAoQv4Mh5FLEIy2Ibx7dCiklyRdzQanI/+QfzbeANyPlifzstweTJZ/mpS46DwIGzCcJuF+V
+IoIuQuMyfV6kDcDrpYobK/BapNk6VkLQbdlGZdwpNyQzekCR1i2+R+IvcziiepfJu5Buo6DExgWBd0+G
+Ew+rhbVV321Ub5nRGx/3tN4b8vByMhnQITlz6VujfjLXx6xjoQ0fp5lRRnnvG/5fB1lJFn2wRtxgH55o
+TPti6OpUdzAe1l+kuMFlph03//L5/xsAdNN02CGNCQA=
 `,
 	},
 
@@ -2344,10 +2380,10 @@ w5rSETf/9vD/BwAA//9003TYIY0JAA==
 		name:    "custom.css",
 		local:   "pkg/uploader/assets/resources/assets/custom.css",
 		size:    82,
-		modtime: 1553451733,
+		modtime: 1622811395,
 		compressed: `
-H4sIAAAAAAAC/8ooyc3RUUjKT6lUqOZSUFBQyEjNTM8osVIwNDBQteaq5dJLy8zJgcrlZubpospj0QEI
-AAD//0xhMXJSAAAA
+H4sIAAAAAAAC/8ooyc3RUUjKT6lUqOZSUFBQyEjNTM8osVIwNDBQteaq5dJLy8zJgcrlZubpospj0QEY
+AExhMXJSAAAA
 `,
 	},
 
@@ -2355,7 +2391,7 @@ AAD//0xhMXJSAAAA
 		name:    "logo.png",
 		local:   "pkg/uploader/assets/resources/assets/logo.png",
 		size:    4562,
-		modtime: 1553451733,
+		modtime: 1622811395,
 		compressed: `
 H4sIAAAAAAAC/3TX1zvbj+P38TdKo0ZVldh71WiM2qW2WLVSW+1RK/YeFbvUKrFpjBKitVN7q5mipSiK
 L1FKqtSIdV+fw/vgd72u5+HjD3ilGBlq09xhuQMAAA1UR8MEAID4/wKRAgCwKsDwBwCAW26qBqoA0JhB
@@ -2433,157 +2469,449 @@ H8HLn6xlXodqPff7t5V3zzxkV+hr0jnXsmQ+/dUCdx22CQI2sXLUavrWdJH2+WPYbkybr7VY839/veNE
 m5bz8DxSYVOzQERyKLusTOVvmXZ1ZKJPqjWFsKB+kULUzfuyLTz+heUDXKAfG+HofGQ1bHTe29rEThEq
 Qf23iU3JYm113lW6QLoV10qdNmRRX/4RfrxjXN+eVh9e4eWLFhw/qSb2NEE51YkxAwsfuKNZC6IAAOAP
 1LQIdHYIdFFw8ndxCHQBJCESMmISEDGIlBlESkFCRkFSXgwiqwCB1Bp+wf5/wNvX2cM17P8G3++73wIA
-AIBqGmo0qNnH/b8AAAD///H03wfSEQAA
+AIBqGmo0qNnH/b8BAPH03wfSEQAA
+`,
+	},
+
+	"/audit.html": {
+		name:    "audit.html",
+		local:   "pkg/uploader/assets/resources/audit.html",
+		size:    1501,
+		modtime: 1792369712,
+		compressed: `
+H4sIAAAAAAAC/5RUzW7bMAy+9ykIn+vYLdYeOtdAB6SHHdphPw+gREwkQBYNikmaeXn3QbKTOGvWdT7Y
+lsiPH/+7DjQurEfIxIrDDHa7h5W2Ao6WXQfoNex2FyO1Gelt1LoAAKjaOn3jM10jb2FulF8iCIEYhDn5
+hV2uWIklDzYA45xYowaDjJewsWKS4ioggxgl0CiNYGUCX1QIG2IdQDGCxzUyBEMb/zEiDrSOlkDebUHQ
+uQAbg2KQQUE74GGjAgSUy8E3DcTA2NAa9aSPomjrV+FUCgzj4j4rVMxHgS8tsWQwdyqE+2wmHmbi89Ck
+D63EWY95wDl5rXib1dMEABXg87fnp6pQ9ZEs/XUd2AVMpszE+4QmDW3XexrlMNqI71xH7zk7ujgYGSz8
+ArNqlLc/8cRYoe263vMN5ewdETVzuCfqD+mdh2ZEUolBpcdnPvWgElN/tw1WhZjXkh8B+bzkQWvGEM4L
+Hy06fV70CRfEf2F7WMifdFUxdrgqXoUTG/p47jrg1MGTqRe2GMbJPBt/f6kPicQXyT1tWLVZHasTczN5
+JG6UQHZdlrd5eZWX13B1c1d+uCtv4jBVheizVpOFmMN/Kn3FhgRjUt9UreakMQFSipNuunoD0vKhTZpZ
+XvZh9WVI+Jb/H54q9Qb6tGyxdV3A99eCXGiVv89us/qJhsE/bp/30PWTMpIf+6Qq0pzU4yl+bqwInmLa
+k55oVoK6D/6oDOQ08sHBtOlI9nuu3zlpQcY1JwQBEZRze8DksLpOdvXw93sADGoB0t0FAAA=
 `,
 	},
 
 	"/database.html": {
 		name:    "database.html",
 		local:   "pkg/uploader/assets/resources/database.html",
-		size:    3759,
-		modtime: 1622811287,
+		size:    7990,
+		modtime: 1792370435,
+		compressed: `
+H4sIAAAAAAAC/7xZ3W/jNhJ/z18x4OGABojlHu6espGANtmii0uatLt52xdaHMXEUqSWpJy4qv/3AylR
+kiU7/rz6IdDHfOk3v5khmaoChhmXCMRyK5DAanVHLZ1Rg1UFKBmsVhc9qZliSyd0AQBwkymdQ452rlhM
+CmUsAZparmRMpqwxQxIv6+W5LEoLdllgTOacMZQEJM0xJqnRGYEFFSXGpKoguv38xy+wWvW1M46CGbRQ
+VcAzkMpCdEvlrZIZfyk1wmrFuKEzgayNfbt6FPSoCzi6V+k3ZD8vgTAjyfumqgos5oWgFoEIr0jgh3cN
+XgbM3O+G8QWkghoTEwfh5EWrsuh9qhcSdIYCMqVjkiop0QM7MVZz+UKS2/YR1I+ub6ZeY2ClD7nFN0uA
+s00G1+JJlbRaiR5SjfRnL/xRa6VhteJmwuWCCt6BRKAQNMW5Egx1TD6jXqCO58rYr1waS2WKHz5JbjkV
+cEstFeolDkz58GxQwycWlwa1o8WHJ2rMq9IsLpqLli7j+PvcGcS7ziP3uzE5FWLtmx044P5M8tIiGyi4
+32PhTFIRwRM1FoFK+OnuMfrt4xdIh9m4Ago3qWKYmO/CeBSup9ObqX901bxyFfOi0XRvQOmR21o2X5rv
+oif4/Mf9FSgNFJ4aK42gwzqOogjYzGMVRVHQqUMDqyDjQgCXYOc48lfXCcxQqNdoHbWph20AZY/MDRsm
+GSKb0fTbBhB3UKqqtr37C+ZlTiX/E2uxpjetx8f4IrkY3B5fdkzzBWqShI7oy2hLnRkUmFpfXI3apooK
+/A2Wx8lWnmQNTPg9tKnozmsAaenkelTttNejQhV0Usnn3++hLsObaW38CK+BqWS1guAUWq+N01YoCZQ8
+waPn+7vfWEskD0v3hVN4oJrTu59PcGm+C27x37uA9TIOVm5xs7ebaa1/RioW1M57RMy4wIMavtd/r8dv
+7unCNNXWDczoidq5l1vv/NtGwO31V9fgvxqcR2wWCqCOZ71nB+PHtetHKZZQGmQOMKjTE8GvytgrKJS2
+VxAG0BWEiQNUMkg1MpRuIhmgGoG/SKXXzYwa38n5dF2aJC66g9Lo1c6TRuf7gDSGxNURjBPnzA3Waydz
+Xmnreok+DCOvdixGIzwGMDDMaClsS2OlN6Lhgj4zGoG9JPnUXB2ESqv+NyHT+RujEz7gzAh1O47QJw9C
+qFU/T32FGI6osS6SMXbB7JmxC0tukjw3Vwdh16qfB7sQwxHYdZGMsQtmjxsw90gXCJgXdglWuVEDXFp8
+0dQiA4Npqbld7h4V07ARPW090GyIkrBH2iNfrVKzJAh3uzfET63sho3xiTkPtn/l0sL7GQ9rhxBMk2IC
+tLQqVXkh0GJMJL5OOqE1mlTVyOEA5cM39y04l6MNyeG0+oZYuG0ZpKXWKC2ED4mglnTvAsl3sjGCZ4PN
+xhDl4vq3nx4+Djahfik5deuxbg/avFq4hn6dq1JaL/APz9wgZhVopMwHFIKETKvc5/d1jnqfhdP5TmWO
+qCFNc0OSj29WU/B3aFGbAxfW3si5ltbO2BFdL0SxaUHt3pzpBOQXpWGw37ry1Kv5YoWJrS5xwDD32Hzj
+xWSBmmfLEc3c+0JjhlpjYNfItVWAMtXLwtbV0Z4RRHBHLdZLd8/H+lgDLM8R/lQSQWVg59xAvSP+0AtY
+qDR+/nK7zSfPvKV2t2Cs0mjg+cvtgeci756K9E7yrOqccQOP/43eO9848PRlwMaqCtdHn6ycd6S5hKnS
+kuT3EvUSmlv4gUswmCrJzOUelSnLfIa6rs1gcVdvaeUOHG5far096ruRPKKy29h6pd1YO3l2BdvjubUv
+d90vRHN2wvZIO0C6qgaP/g+Hg1q9ku2hd4yGVIlJzib/2XSa16N3Tt8mqkBJkgf6xvMyB3fXa2Rb5s5W
+hudcxuRfNdNb47uontO3xwJl13PM3qwPjOx89Sj5pJSIHmrjY14ewc0NgV7uSOl5csSZwC5H7u7UHP3Y
+5cgb3yNHn5jAU3NU+9qQI2f8XDkaBvr35EjwDF3z6vLUpQjCSz85ci5Li9smx9611TrclbsujvtW5Yjc
+df425C9Yjh7qj4O/oNBc2gzIP6MfM3KW3G74kMsTWqpfVmr+MrebVyWD/+OOszQrrVWySZMpZznvDvlm
+VsLMykmheU71kiTPBaPucL5WGjkcTId3VjPNvdJ5cnHRav5vAKDN3xM2HwAA
+`,
+	},
+
+	"/history-event.html": {
+		name:    "history-event.html",
+		local:   "pkg/uploader/assets/resources/history-event.html",
+		size:    4619,
+		modtime: 1792376238,
+		compressed: `
+H4sIAAAAAAAC/7RYbW/bNhD+nl9xELpiw2rJTdt9yBQNaNJiA4a1a7IfQJvniKhEcuTZiav6vw98kS3J
+cpxkbT7YtEjePby75x4xTQMcF0IiJCSowgQ2m390pRiHpoH0E86V4em1qDF9r0zNCJLT6fSXyfTlZHoK
+L9+cTV+fTd+4XU0DKDlsNicdmzPF127yBAAg14X/9mMGpcHFeZKVwpIy66R4XjFjfoW3bP4ZSAGVCHEu
+z1jYmWe6OPGjpgGxgPSdMcq09v0KLlYwr5i15wmr0BD4zwln8gZNsgMQjUQLX6Fc1kyKL9gzlnGxKlp/
+8XQBCLFZha2j8MN/TmzdcZKTC0D3t+kjyKksLhkxi5RnVA4neRHPGdeEMPd/YWUxPm+ztdbYTUieEe9g
+yMgcQfROroRRskZ5GFXrrLP2Ka6uiNHSjnvpPegkPXq+Ws7niBx5N2Xdv9xqJtsczRi/QfCfE+t2WpsU
+tjWRZ27tqMcY38d5aMttwUR12Pr+mXw1Xjh7Lphd64R3NKmXhDwpfmya8R0/RU+7ah070P7MU9JmCPl4
+3h7RONLpdJo8pW7eCyls+QAE7cLvguLvJZr1UQh+1eXSMBJKplc4V5Jb+AraCEkLSH6Ypq8W9kkAQqs+
+iiAs+z4Qggt7FMNVaK6Ptv+RrR14sOILHnXydk3oiAAzN7jP1wjv9lgxhLPtj271PpYWT67NVhrq2WSa
+FEO++jhog0Uf4SjKAV3zrKMpeeZFJ2piXr5q3ZZvQNPkdVJ8QquVtJhn5auio5xSEaQXTH7QaBj1VU+P
+9ZzrEsFEY1CzNcyVJCYkaEYCJQFnxF4AkxyEBSWrNdhS3UogBco7Ucb6acZrx0kKj1Kv6Qd6bdPAraDS
+BS96HqZIF/lccfQRDlriY+ufbS3vknNYtSEcVkllNZsj2JpVVTKqCMY1d3gmWY0v4NmKVUu0cHYO6e/I
+OJpDYrHbGbccWHew9AZl2J5Dr2OJeUSBYuWx3Xxvd7pHzr1d2SFUT9CbULf9XO5oM1PGRdKopeTIQU9O
+E7C0rvA8qdndpERxU9IZnE6xDuDfKr7esurkiHyPV/hfalfgt8yCwTmKFfIXoAwIAmFBKqiUU3b4jJrS
+XoH1Tzp8XxyhZmxs34SZOthqSWm/PStds4yI+5AGSRy+12cR2m+Cn3fa4B+XsNkk23SThBlJR0P3pZZU
+CYkT63WKucvBpbqVIVqs29E73p9QPq+35bM7WaygndmRzF2Ujsd2l7ler/pocCXU0o70qj16XKhaM4M8
+Xnj25vfDiSuU1AYzRLFYhgubWvgbzUMuay6M6YCSujgZb1dpPO8Yv8dKMvROCNL3p5DoYb03qobNBp4b
+f83zSFUI+JiM3tep/SDkuEJrH9q6e6dKHbBjPbgFEJiJ/0L6QadXZIS8geRnF8gIKlwoOlwZrJ3s1oar
+wbY/JI/r1G0Z3wpO5Rm8bAv4gz7avkcb//i7yjXe0T3vKP9XFEbb/z1XreN9T1hHH7BOAJkbM3J0cM90
+S8fAknSs0Ac4HyUb1yUajOKw9dXi8hiEBR5v7KRgHigPpI7pxwDCQdVqnTnRsijpAYLV+2dNHP03AIDe
+EWkLEgAA
+`,
+	},
+
+	"/history.html": {
+		name:    "history.html",
+		local:   "pkg/uploader/assets/resources/history.html",
+		size:    4036,
+		modtime: 1792373462,
+		compressed: `
+H4sIAAAAAAAC/7RXYY/bNg/+fr+C0PsOaIE5Ttu1HwrHw4D2sAFFh91df4Bi0Yk2WfIk+tLMzX8fJMuJ
+kjjX23rLB1uyqId8SJFU+h4E1lIjMJKkkMFu97N0ZOy27wG1gN3uKhFaGrH1MlcAAEVbhrf/vb9Hu4Wu
+VYYLkA4sVsYKFLBGi99DbSxwB8rolX9XRtdy1VkUsJG0hqIyAsv1oPgGCTVJo4s8fAapgda4VzVu5l4G
+aqlwNpiTt+XVMKqNbaBBWhuxYCskBrzy0guWRyUMKsWdWzAvmlmzAa7kSmeSsHGZJ94ss1fsQLAQ8n7c
+UxmV8Y5MshxEFF+i8lwXTHDiDomV74ZBkYfFkw0OFVZ0ZEplNFmjIJ1krmEgxQEVNG8wUXKE6n+FaYN3
+7rnqcMFY+ZNSRT58PJfue7BcrxBm0Vo3hvgruH0PM9jtmEeQNeCf8Ky1UlMN7DvHYPYc/j+7lorQjsiw
+2w2kUewPWBlhHjQwnsUjY/IBKglSLuT9v46ZI06dY+VteD9NxCJmDNio4RvjdSLtuqpCFCiSOIxuH7hA
+KjMVgdtx+dFKay7VgxpHgSl112FtWtdTR7W2pmHltTXNdESlbjsC2rZDQiHJBjNlKq7Y48MclMQgh/FU
+9iRJE33lrfL581Rc/eKd+Q95khlZknkkxzvzzQyXHZHR0XbXLRtJe5uXpGFJOnNNeLVWNtxuWTloL/Jh
+7wkgh7XFeqIbnKCZjpTUmDmsjBYB9wZDNeeThIrcey12oSEzZu+tNTYtXSljrtAShGcmfAm2J9z7fkT4
+Auuu4Vr+hUdgB+WHIjnYQnypcFQ0TMLTBzOxntbIRTq3J86idXknGyxyWp+v7Nvb1OJ7fS+t0Q3qCwK/
+dWi300ufwlViem0s0OlakaeGF/kZLX91Ka/OW96N2Zy1u4Ls6LgYxX2BhN0uetF/cc57XTncfx6iuI/F
+VK0nsY8KfqZMm43l7YTg5FHN8R41/SjFwh+NX94FHX7oYzS7NrbhBOzlfP4mm7/I5i/hxeu38x/ezl+z
+0GH5hD05iUkry8j90Lr7/mQWqQf92zYOB+YPws6So3FRdsL10z6KmOE0vYsXw9ltyFkHX2B/JZnPXtWO
+XdSXYg3H74nAboekBX+5fOaex2syiumdB7deBH1Y5VdWW7uvCs0ym7PyUGM8mdZiedmwqVtYmngXjD+r
+KvtEMMq1XC/YG1Z+NNEzDmrT6Qn3nOs6tqfIkzQv8pCRx9X4zhBXR1s031djkdUKP8PvnSNZb0PzQ03Z
+EmmDqI/+IVSo6axYF57KUW43HaFg5e3abKRewdAWrfNnHsiE+Qc+TE0dpqOBoyuK3IOe6OnUqKXlK6nD
+GYXD0LevIbTnPlcy2YqBTfSNNgSzj7hB++nmA+x2QjrvP/FQLYslKkVUUv/BYtGKTk9A+/50Go/L/xI1
+QeBCrVLyn5P6VYmnJ5WA9v3pdIJUEHgkqSLvVNrMND/r8YfR3wMAMP+6L8QPAAA=
+`,
+	},
+
+	"/login.html": {
+		name:    "login.html",
+		local:   "pkg/uploader/assets/resources/login.html",
+		size:    2113,
+		modtime: 1792369194,
 		compressed: `
-H4sIAAAAAAAC/9SWz27bMAzG73kKQqftkOQ+JL60BTaswFp0fQDZYhJhsuRKdNbM87sPkmXH+dOicb0B
-yyGQYZIf9fto2VUFAldSIzCSpJBBXV9z4il3WFWAWkBdT3pRqRE7HzQBAFisjM0hR9oYsWSFccSAZySN
-XrK5iGVYEmJDvJBbyBR3bsl86nRtTVn0AkKQ4ikqWBm7ZMLKLVqWtC0B7Qr8tJiHkKM0hwozAim6tAOp
-zGiyRjHQPMd95YMaoY4p/AagqkCuAJ9gdmX0Sq5n1yEDmHtSDm2oX9eNKIqOFWy5KnHZi0oe7m/hIawX
-86b4AFUPd23RsbqGVhQ61SjaBSV3cXVecTFvSvScmQu5TSbDndp485PPxtEL9khdlBT8WzLCZ2LBqZB2
-zqdIYnZjrbFQ19JNpd5yJQNq5RDqOoZEUl47xHVRAU23YFAonuHGKIF2ydpBaDqIAKvqoJzPGpFRYSx5
-a+xljELaUEYnPI4wCFzxUlFLo9E6peGbHpmG1I64zpAlX+LqIipd+j8is9c7pdNuYGRC+xO0Pf8uItSl
-j/N8tT0MeMb2nZyy6472cdmVDq0XZ8ljXF3Erksfh13bwwB2+05O2bVlD9k1b8OcK3XQvN8c+L9pXhIK
-ltwi3yJgXtAOyEDpEKQmXFvu3y0Os9JK2i3modSYxyB37qexgiV3cfUGa7qk5kjsrkaxp+1jgD37Ts4c
-mvHe/2aP5bljyc0zWQ7hCgmtu+yd1RQZyx5fbJA5TRfnrPF3zhjToxW0pitEkfLsx1HgldEaw6euN6c9
-4EA6+PZ1dvSxFQx5SSXu/SWdI1h+C836N2zKnGv5C/sgXlN+92SQzNGUxJL7Eu0O4iV8kNqPo9HCfXzD
-kOgyT/3Hsx+TtuJrc/K9iXnDuMTIAYPS9dGblFjtHTPif22V0QejNxxHhHrN/41RCWeUlesNHXNJSyL/
-PASbXZnmcu9sShpS0tPCypzbHUseC8EJF/Mm6US6Wfp5SCaTruc/AQAA//+1xYK4rw4AAA==
+H4sIAAAAAAAC/6xWTY/kNBC996+o9XndYWcRQijOgY8bQojVcnfiSlKMYwe7ktlm6P+OnI9OpplpdsT2
+xV/vVT0/l93J3xhf8alHaLmzxSFPDVjtGiXQiTSB2hQHAID8jZTwG/45UEADHbIG1k0EKZf1aapqdYjI
+Sgxcy2/FfsnpDpUYCR96H1hA5R2jYyUeyHCrDI5UoZwGb4EcMWkrY6UtqndvIbaB3L1kL2ti5bwoDpus
+773nyEH38MOHD5siS+4eAlolIp8sxhaRBbQBayUyHSNyzMqVeuzIHasYRfYKdjVE9t1Km3lMbLH40ftw
+Z3wFH3vrtcEAEn72DZDLsxlxyLPZ3bz05lQcckMjVFbHqERN1q7mOX2ZdnosdYC5kZaalqFs5s4Cnyj6
+KUGWQTtz0b5DTmjqGpgkKbGqFqAtKyEghmrbrfWNP/auEdBiSqnE+6/2aTO9G+x2s6hg/MRXuX/HEMk7
+eHyE49o/n3cRDY2LDZnT42rxLnaqIk0OA/Qn+bV4XkDwD/DHEJnqk1zKTlboGMO1F50mt4W2MnbymyvM
+hGvvVlT7HnqW76Av5Z0o1iNu754h1T506ea03ijR+8gCdMXknRLJW3LPZJpPyPUDQ7qoSrRkDDqxXCeX
+PIVR2wGVSC7+gp8YzueXIu1rzIdONsEP/Qvg+RboEi3UPigxRAwpqyg+Lr3v8mxav8HfS58KAMjsQj0R
+k44meJuqgWo4/hSCD3A+U5TkRm3JPD4COpO2t2x/i7OzYFU34fTAvvJdb5Gf4MP6kiVA7ashvuDYVoJf
+1Mxex/jggxHFr0vvlWZeAkyGbqP/Z+gWZzH02sFqCCFdnw24OnlD986oJbGsEU2pq/sbdqXflfL/wi7A
+v6EdOu3oL/wMzmzAy+JvFMDn1UYqexmu3uh/EcqB2bvlcONQdsSXwyzZQclO9oE6HU7bMzOTXqUuz1Jp
+PJ3Ps/TuFc+/u1P30iz/Vdn8wfDPACTbJW1BCAAA
+`,
+	},
+
+	"/openapi.json": {
+		name:    "openapi.json",
+		local:   "pkg/uploader/assets/resources/openapi.json",
+		size:    17279,
+		modtime: 1792375574,
+		compressed: `
+H4sIAAAAAAAC/+xcW3PbuBV+1684w/aRlb3ZvjRvWScz8Uy36ybe7Ux38gARRxbWIMAAoBQ14//ewYUU
+KYIXyUxsz2xeZJG4nMt3Ljg4ytcFQCILFKRgyWtIflxeLn9MUvuUibVMXoMdAZAYZjjaEVRK9YrKDMqC
+S0JBo9qyDN0cgISizhQrDJPCjv6ZCHKHGswGoWfmEt5tUe1B4ecStXGfTKEGIuDNzTUYeY8ihd2GZRtg
+GjKFxCAFKdyqGrNSMbOHgtwhyLV7uMMVMGFQrYnd4GpDhKNCureZFGt2VypiqQSiEKTge9BkixSYX0Gh
+Lrlh4u5oNNOwJZzRZcXwFpUOzP6QLAAe7PPE8oZKJ6/h9wUABCkCJKXidugFKdjF1k0AeFgAfArTPDPd
+eU4K9vGnoykFMRt90NPFgZ567h2axleARJd5TpTdJfnND68E19ZmTKMfKvUoyRG2DHeols3xskAvq2tq
+x9+hCXs0BynUhRQadYswgOTV5eXRoy4JtxuEbZTuFIig1bO1VDkx1be2GteMY5NqAIAkk8KgMJ39ARJS
+FJxlbu7FH7ol3sO/RGcbzEn0HUDyV4VrS/5fLjKZF1KgMPrCT9EXlYw6Ex8WQ9+b3x6a7CQU16TkXWbi
+ZNT6uHinlFTJIrbFw6L5GbZLLlqSnYq7q9akE+EGN0TrnVRUO+sV0oBCUyqBNAX8kmFhrPatb1Bo361R
+ochQjwC1l6hHw7Ulo2cFvDbPLwJ+1UbW92Wbfog5r981/sloIzRnYgkf0dhAYIMYMQ5wOdPahoa1knmI
+Fj523SMW9gFTNkqU1h9poBIwL1yECqgNEckuQUD75f3qTIPcolKMUhSw2gMRgGLLlBQ5CgNbohhZcYQ1
+YVzDjpkNaENMqeHvl/9YQgdrdcCyMdO7xzrOMdMJZzHDcFIeMA3H+k+S7o+NI0Ryu4hRJaaLCZCfAvgh
+uD8C7APQXkRAfqJTGMG9B+sHl3Ukz8Cpfy5RsRZrg+783344yDUQzoESQzQaPWMewZk2YZdZPXNgNAXm
+80mpKCr7196Zus9VkX5vn232hUu3iVJkf7Q3AABAwgzmumf+mCFYSe6TyMSHF5iABBVefA2we2iitiCK
+5GiayXgzsQYASATJ/dnGz2+JO2EiOMFNkk71bj26HdbJ27D7IOuf0qnmuHfGCF2eZsjoPXzmNsP9s0qM
+ekzkeSdEZT8mPmDBSebzoc/n4yMkRY0EJiymqj/cxPnSGb8q06BKAUbW2Qww4/MZE8t5Tk5yyhioX1Bq
+E4XrnylNI0wE4JxwSPW0VxUETrSBxiLz1kii6z7araohDqKlkedVFTlI5QXmJRumjVT76WjLUJiQcep5
+4fU+kDIntnLpqrVNolMQuENtYM2UNs8KSpUEXiCOjGJ3d6haWa3U/UD61SkDhNxNBpFHjFRL+GiIMhqI
+CDoFokFLKexnIbVmK46pi9SyNLAjzBUv1tKdmXzRfUv4EBwrdqZh8dUkLAZad0RXtZjvf2QbhN9tYPoF
+wq8gpcapTuw/GzQbVJVDcGdotwCd16HdOKK+VeriV3++ubyjD6Ry8T3Hk2PGwdy9r7BWkxMmgqqArA2q
+5sWK3cg5hpEcOqKWF5RDd9T+5Dn0EwKx7QTCHfHySOaDvuB2wzRQmZX2rDeAzTdbwrg7BlZhxd12jniA
+XwoUb26um4Oa16ef5kx1wl41M1Xm/Obm+rFRZhK82jqpb5kPuq13q2Xw0WK6xXF9h/x10SkzbowpWpJ0
+s+2bFRLVDtbH2mtc059zOe+OKZLXr/1C1JbsciZQw85VEgzkZA9ULpMoQGMqTjzMWwxHz0jORbkaRDtr
+6NHluBPqd0HDDqhjl6OIqMNF67Q9xnLmBrt0yfGCdAnXxn0/qwviSYTVV1+YLrObo8ymK6z+dOZJWY8E
+qocTvHlYZsRUKt8gV39gZo6DeojcvyfoZjbdbaGstzas62+xs0trJ20UE3dTE6TfOp0ug0T3U7WNLBSj
+Kx2ME/FOmmV/8O7Rfb2r9ZHtc9KEbcebXiZKt7qNiEq3I5AERZk7OGyZZkaqJIVEEcokl3cM7TdOVvYj
+k8IZ7KfOjh8kxzO2s4cFu3CV09q/XZE6ssW7Q+35LKy7i6LUd3FNQ7ybcQrgWxApFR+dfAQPDwD7vlRs
+qrKvelp4BiVDKGV2POE3TfbXhGucJJtSo5okn2EL+DUsY7FfNxkO2F3VhvHIbasepPa28IttJay6kQ4X
+EKTTjDRAIfbA9HQi/2XlEpxBY1UwMoQ0MHIaIbqfktgdde/t9EgC1OB8YiGkUPLLvp+4Dm5PwO4wfgEA
+klxSjDwf01PbiSUpJDkRJeFJ2hJ67cKirPe6iBEf07NQnzWetVqvkU2UzRGOz7OqOGlC3kQhM4XPqcU5
+YsiKaHwyWGZSCMzsUh89I/Oo4apeFvycQyW4Uvjyu2OjdsTBz1XCP9cXx4k2LEdZmmGa4ykbAECSM8Fy
+Z+8/jLPkLnMhbJkCE5ZiKaieQGhOvthyxUFVeg6ap2x7TTnOu+3l6LYHoP+TrdFK7Pto6GfyxQ4GHnb1
+zRQHaoaVNtGNVLcpJ58RelnpFAMs6Ss0O0RxuMTrIb1NHMkM2w54uJWUHIkYSVBiB2wUthhIB/fOUOuf
+iGbZm9Js5iLCP9Su0lRqhPe3tzewsrsAKc0GhQmn+fq+q13L6ieYy+we6anhQCGh1odFKuRdJt5F+mpC
+z3LowcGq11insNqDaOSG4cVyYkDqxJuhsDm1GuM7WL7X4YNGzrdwVo9eS9GfO0zAKQe+vigz3eAvxw3e
+huugd0duy+Dhv6ikRb9uBdMqGA1gnGnJuwfI088st4oITbJQaAxrAsct8hQ4u0d46689UviAhMKVzHNm
+XNVZwUdBCr2RQ3Q2rGrEa0wtRMUai86tRdm15vJnpvfXAClo6X1D5XgzIkCVYugwaIt3+lQfdpysKbni
+mIdevw6BzjFlG8zuX9fQu2oEVYfX2yovoq9o86X9qpBaN0249s2BPlJUPx+kEjUIae8S7jEmHtEpa38b
+N9jxGbEFT5OsL4RrkGurR+F/UtLob1/tq17PkBjbt6Fa12iyDOWuR4sgSvPYuaW3RDxBvh25VmKKlbUi
+a4oyX0UTw66o38sdcNkQ8B6MlPeDOd9JnR5n3fOc427cbc+3czf1hVLrN7ikNrTIT3HDfVQKq9I4U/Xz
++93SNu594czmwiHZv+809J0pdtzOWVF7rMmF+bNZ3FD58hHL9h7uphQNWpVx2z7+N7fcpI2dhb+d341c
+D3qLCCE+Vj8HSjT736gu+o/akTq1o9XFLszcD3sPP7yahrlHhY1ZPPNtp03zTO8QGiSnOObzL7q/4dEq
+9PudRPwcpYWTjsvHhQdV1v/JBOFaNv4bCoiGKkHrqUbCqqdqMdTCs3hY/H8AfuOj5H9DAAA=
 `,
 	},
 
 	"/orders-consult.html": {
 		name:    "orders-consult.html",
 		local:   "pkg/uploader/assets/resources/orders-consult.html",
-		size:    1990,
-		modtime: 1619966579,
+		size:    4346,
+		modtime: 1792374712,
 		compressed: `
-H4sIAAAAAAAC/5RVwW7jNhC95ysGxPZWS1v0FkgGFuuitwXaTT+AEkcW1xRHHY6cOIr+vaCkOnIsJ60P
-BknNzHvzyEf2PRisrEdQYsWhgmH4Sj50ToDYIMPfHfKp7wG9gWG4WyQUZE4x/i6riBtoUGoyuWopiAJd
-iiWfq3SsEtJyKqq2dwAAmbFHKJ0OIVcxebNn6tr54xjgdIEOKuJcmWIzklDbnRZd6IATqfssHaMWWYJP
-ohk1WLPIu0AqyQuTg74HW0HyGzMxDIMNG+uP2lkTe3UBp7Xzyti9AqbHkKtfPqsz5uvP6wZzNVPte0j+
-iEMYhiz9l9eC6kKBGXhTIZpCl4eFDvH3hmmsPI1foO4a7e0zTsvzFp0hUmOP64jv4mXt9qq7qRUboCRm
-LAW0NyBEB8iCMPn9a8O7jnXc++Q7luRNgBdo2XqpQP30Ofm1UsMQsnTOSi6R03a19bHunxgPUFh2eIvt
-Q40BQTOC1AiV5SBw5jfXeXHoYRiAp+n9+0RG8YKcHOaKjsiVo8fN0z2Eksk5dU3hLVzyJTzowuEV/ctN
-mlM/2MnQaOcuTnU8YG9Y7PB5NgoYi17AIDitJQjCN2g60WIxQGfHL9YLcknO4d5qh1AuL4EAghDQYSnI
-6BPYIRzJ7dEbhAM5ahr0F+BUgXZWh4B+REcPWvtHfLb7WOvZ/vBvFJdRnbmnaTL+b0IzD+oo/IrUmdSo
-zdo6b1dsOiZsv0R2WSr17ZCHU4vvR/yOBXf2gM7+OCAUTP6DioTOlrVYv18PzNI1zjH2RofxDl49fKz9
-HuGT/Rk+MT3CfQ7JV3Jd468M9IFUZpuVZHDb98k33WC8zcZ5loq5ndP3SZRvvPvMfyj9nTou/0fxHYaS
-bRvvmVsY61pem2sRf61mlo5nb+G9dDTf/IxNvrx60qIbN2z39dKTWdGJkAc5tZir0BWNlfPTVIiHQvym
-Zdvo+H781RotmKVT0gVclkbLb+9eW/knAAD//7ubBUrGBwAA
+H4sIAAAAAAAC/6xY7W6cOBf+P1dxZPWVGqkD6bv7K8sgVcnuqlLVqpnsBRh8YNwxNrXNpAnl3lcYM8DA
+ZBpp+6MdzPl4zuPzResaGGZcIhDLrUACTXOrpKmEBaUZavheoX6qa0DJoGlWI4VEsadWfhVlShdQoN0p
+tiGlMpYATS1XckNCZ8WEaWeUxCsAgIjLsrJgn0rckB1nDCUBSQvckNTojMCBigo3pK4huN3e/wVN02tm
+HAUzaKGugWcglYXglspbJTOeVxqhaRg3NBHIjqi9KuMHSAU1ZkNaxOtcq6r0dp2AoAkKyJTeEJasXeQk
+vqOWJtRgx8RNFDqpkZbFH5ZqpMDZSM/jCz6pdI8scIdL2CaIUiWtVqLX/VNrpaFpuFlzeaCCOzVhsDs7
+njhDBLR6NBvy/pocsQ1/OnJ9SC2tXz2gKOzxDyHVNVgsSkEtAhEuADKLZCBgxKuHuc4QWULT/Yhdb3gc
+V133v3/Criqo5M/YHftsO7oIGT/Eix5f9BeV8YyLLnBuIFVaY2qBSgZWqT1Exmol84Geu0rTNo2DLaZK
+MgM/odRc2gzI/66D3zLSNCYKvVYw8/TAC1SVvWnDDvzDkqngOmvryLwDbpRwHkHgAUWn+aVsT0zw8fiy
+aTyR/at7pOyLFO21vAONlK2VFEPVTqFFYbl4Ky7ke2zL1IzJP0fkww4NAtUIdoeQcW1cUU7s/BTYwgXd
+Pd68DMTdq7FPAjdEHVBnQj2uf9yASbUSgswhnLoLPpiHtrxm8Kf541UvJJkpqBCT8mwr5QTFHT77zgCM
+o7TAEASl1liEz1BUllqOBiru3nBpUadKCMw5FQjpuNUasAgGBaYWNcoA7hAOSuQoGcJeCVUUKCfOVQZU
+cGoMSucdJVAqH/GZ562tZ/5NnjBuHTs+pu7B/b02hf+xa4lfoDqyO6Rs6VzPD71C/KFFF4V2d17k4anE
+lyX+xkRXfI+Cf9sjJFrJCxYVCp7uLJf5smAULmGOwrMRtpNuMfk0lTnCG/4O3mj1CDcbCG6VqAo5K6AL
+VLE4ShXDuK6Dz7TAti275yi07LxOXQctfU3zstjR9FZVOn2F8Ts0qeaux5zzsczlvLhG8nM2o9DlXjwq
+RVd88WpUl8szXKtHsjwYhhEPqRLrgq1/P50Oo3nvSnhtuyZN4m5I+Ed4yyWYrm9fzReA2UIjqyJBTaDg
+ckOuidsLpvZPlgN//KvrARlP86PNpYsc1qjxtHg4uqvr2ekLA2pYNZZ8lYKmuFOCoe4Wt8sG59vB+bVj
+4GhK/Lku/YlSCwIxB1UAQ6AixwJl36x7cxYh9/1FBpO0u7B3vC69joOdxB9PZvxyPnVzwKXOoHuSNny0
+ELwqcUZozne1Yd9YbmbK5c14U99azWXe3qsHit8hgDeLC4yfcwPeeGIhCjvzv9paorAz+IqEGrP3H134
+qXS6w3QPhXXCULp//780W8fdwykl6kfXN47LXM+p0scIdL/1vaWSwVv3KdRvgtuqLJW2yK78i9NV8erq
+cta0SNYOW585Izj+4t8vNoPRWrmwozrDs++zc7Uz8jlH5+RIfN/LgNVUmu7rc7GyLuaFHnCulkNaJnpJ
+/sUOtf36CbaoD6hByXb/s1hJCzmiBD2PB80ffauCVoT571J/hhoKtECFaF8KRKMx3dmlrnaplKYlMDye
+G8RtOGvN8914Ofa3f/JpPvWUVNYq6fPeVEnB7fGOEyshsXJdal7Q9qP1n5JRi1HYKcWr5SgmcPv/K4hX
+UdgSH68G4X8HAPjKe8L6EAAA
 `,
 	},
 
 	"/orders-lab.html": {
 		name:    "orders-lab.html",
 		local:   "pkg/uploader/assets/resources/orders-lab.html",
-		size:    1962,
-		modtime: 1619966579,
+		size:    4318,
+		modtime: 1792374712,
 		compressed: `
-H4sIAAAAAAAC/5RVwW7jNhC95ysGxPZWSVv0FlACFnXRS7FAu+kHUOLI4poi1eHIiaPo3wtKqiPHctL6
-YJDUzLw3j3zkMIDG2jgEwYYtChjH31UJnjQS/N0jnYYB0GkYx7tVcOn1KcbeydpTCy1y43UuOh9YgKrY
-eJeLbKoSMqtKUdwBAEhtjlBZFUIuYmKyJ993y8cpwKoSLdSecqHLZCIgip1iVaqAM6F7mU1RqyzGJ1aE
-Coxe5V0gVd4xeQvDAKaG9FciTzCOJiTGHZU1OvZpA85r55WpcwHkH0MufvoszpivP6dazMVCdRgg/SMO
-YRxl9i+vFdWVAgtwUiPqUlWHlQ7x94ZprDyPX6DpW+XMM87Ly/acITJtjtuI7+LJrrjqbm7FBKg8EVYM
-ymlg7w8gA5N3+9eGdz2puO/pN6y80wFeoCPjuAbxw+f051qMY5DZkpVeImfdZutT3T8x9JbDusNbbB8a
-DAiKELhBqA0FhjO/pc6LRQfjCDRP798nMokX+GQxF/6IVFv/mDzdQ6jIWyuuKbyFS7+EB1VavKJ/uUlL
-6gc7GVpl7cWpjgfsDYsdPi9GAW3QMWgEqxQHRvgKbc+KDQbozfJlMXsARghosWIkdCnsEI7e7tFphIO3
-vm3RXQD5GpQ1KgR0ExI6UMo94rPZx1rP5rt7oy5PSiz858n0n4R2GTRR5A1ZJTeo9NY6FRuWnBKKL5Gd
-zLi5HfJw6vD9iN+wpN4c0JrvB4SSvPugokdrqoaN228HymyLc4y90WG8azcPGim3R/hkfoRP5B/hPof0
-F2/71l2Z5QOpdCErr7EYhvSrajHeXNNcZqxv5wxDGuWb7jn9H0p/8z1V/6P4DkNFpot3yi2MbS2vjbSK
-v1ZTZtPZW/ksm4y2PFmzB6+er+i8hMy+WftPlj2zd8CnDnMR+rI1fH6GSnZQsks6Mq2Kb8VfnVaMMpuT
-LuBkFu1d3L228k8AAAD//wP1BASqBwAA
+H4sIAAAAAAAC/6xY3W7bOBa+91McEF2gAWop3d2rrCygSHYGBYIWjTMPQIlHNmuKVEnKaaLq3QeiKEu2
+6LgBphetRZ2f73w8f2rTAMOCSwRiuRVIoG3vaQZKM9Two0b93DSAkkHbLibCmWLPnewiKZQuoUS7VWxF
+KmUsAZpbruSKxM6KiQXNSLoAAEi4rGoL9rnCFdlyxlASkLTEFcmNLgjsqahxRZoGotv1wx/QtoNmwVEw
+gxaaBngBUlmIbqm8VbLgm1ojtC3jhmYC2QGxV2V8D7mgxqxIh3a50aquvF0nIGiGAgqlV4RlSxc1Se+o
+pRk12LNwk8ROaqJl8aelGilwNtHz+KJ7le+QRe4whO0IUa6k1UoMuv/XWmloW26WXO6p4E5NGOzPDifO
+EAGtnsyKfLwmB2zjn55cH1JH6zcPKIkH/GNITQMWy0pQi0CEC4DMIhkJmPDqYS4LRJbRfDdh1xuextU0
+w+9fsK1LKvkL9sc+0w4uYsb3adDjq/6SKp1x0QfODeRKa8wtUMnAKrWDxFit5Gak567WtEvhaI25kszA
+L6g0l7YA8q/r6D8FaVuTxF4rmnl65CWq2t50YUf+IWQqui66GjIfgBslnEcQuEfRa36tuhMTfT68bFtP
+5PDqASn7KkV3LR9AI2VLJcVYscfQkrgK3ooL+QFNLayZkn+OyMctGgSqEewWoeDauKI8svNLYAcXdP94
+8zoQd6/GPgtcEbVHXQj1tPx5AybXSggyh3DqLvpkHrvymsE/zh+veiHJTEmFOCrPrlJOUNzhi+8MwDhK
+CwxBUGqNRfgCZW2p5Wig5v6Nb6kGLIJBgblFjTKCO4S9EhuUDGGnhCpLlEeOVAFUcGoMSucJJVAqn/CF
+bzpbL/y7PGHXOiY8/v7B/b00pf+x7UgO0JrYLVIWOtfzQ6+QfurQJbHdnhd5fK7wdYk/MdM136Hg33cI
+mVbygkWFgudby+UmLJjEIcxJfDbCbqIFE01TuUF4xz/AO62e4GYF0a0SdSlnxXKBKpYmuWKYNk30hZbY
+tWD3nMSWnddpmqijr21fFzuYXqta528wfocm19z1k3M+wlzOC2kiP2cziV3upZOyc4WWLiY1GJ7XWj2R
+8BAYxznkSixLtvzv6SSYzHZXrkvbN2SS9gPBP8J7LsH0PfpqPuxny4usyww1gZLLFbkmbgc4tn+yCPjj
+310FyHRyH2yGLnJcmaaT4fHgrmlmp68Mo3GtCPmqBM1xqwRD3S9plw3ON4HzK8bI0THx5zryPaUWBOIG
+VAkMgYoNliiHxjyYswgb319kdJR2F3aMt6XXYYiT9PPJPA/nUz8HXOqMuidpwyfD/02JM0FzvquNu0W4
+mSmXN9OtfG01l5vuXj1Q/AERvAsuK37OTfbxIwtJ3Jv/3daSxL3BNyTUlL1/6MJPpfMt5jsorROGyv37
+79BsnXYPp5Spn33fOCxuA6dKHyLQw4b3nkoG791nz7D1reuqUtoiu/IvTtfCq6vLWdMhWTpsQ+ZM4PiL
+/xhsBpMVMrCPOsOzb7FztTPxOUfn5Ej6MMiA1VSa/iszWFkX80KPOBfhkMJEh+Rf7VDrb/ewRr1HDUp2
++5/FWlrYIErQ83jQ/G9oVdCJMP8N6s9QQ4kWqBDdS4FoNOZbG+pql0rpuATGx3ODuAtnqflmO12E/e2f
+fIYfe8pqa5X0eW/qrOT2cMeZlZBZuaw0L2n3gfpXxajFJO6V0kU4iiO4w/8LpIsk7ohPF6Pw3wMAElj5
+494QAAA=
 `,
 	},
 
 	"/orders-radiology.html": {
 		name:    "orders-radiology.html",
 		local:   "pkg/uploader/assets/resources/orders-radiology.html",
-		size:    1981,
-		modtime: 1619966579,
+		size:    4337,
+		modtime: 1792374712,
 		compressed: `
-H4sIAAAAAAAC/5RVQW/jNhO951cMiP1un6UtegskA4u66G2B7qY/gBJHFtcURx2OnHUU/feCkuLIsZy0
-PhgkNTPvzSMf2fdgsLIeQYkVhwqG4Zs2lhztT0BskOHvDvnU94DewDDcLVIKMqeYcZdVxA00KDWZXLUU
-RIEuxZLPVTpWCSm/lFXbOwCAzNgjlE6HkKuYvtkzde38cQxwukAHFXGuTLEZaajtTosudMCJ1n2WjlGL
-LMGfohk1WLPIu0AqyQuTg74HW0HyOzMxDIMNG+uP2lkTu3UBp7Xzyti/AqbHkKtfPqsz5uvP6wZzNVPt
-e0j+jEMYhix94bWgulBgBt5UiKbQ5WGhQ/y9YRorT+NnqLtGe/uE0/K8SWeI1NjjOuK7eFm7vepuasUG
-KIkZSwHtDQjRAbIgTH7/2vCuYx13P/mOJXkT4Blatl4qUP/7nPxaqWEIWTpnJZfIabva+lj3G4bOSVh2
-eIvtQ40BQTOC1AiV5SBw5jfXeXboYRiAp+n9+0RG8YKcHOaKjsiVo8fNz3sIJZNz6prCW7jkS3jQhcMr
-+pebNKd+sJOh0c5dnOp4wN6w2OHTbBQwFr2AQXBaSxCEr9B0osVigM6OX14canFyfgBBCOiwFGT0CewQ
-juT26A3CgRw1DfoLPKpAO6tDQD8Coget/SM+2X2s9WR/+DciyyjI3MY0Gf83oZkHddR6Rd1MatRmbZ23
-K84cE7ZfIrsslfp2yMOpxfcj/sCCO3tAZ38cEAom/0FFQmfLWqzfrwdm6RrnGHujw3jxrp431n6P8Mn+
-Hz4xPcJ9Dslv5LrGX3nmA6nMNivJ4Lbvk6+6wXiBjfMsFXM7p++TKN943Zl/Ufo7dVz+h+I7DCXbNl4t
-tzDWtbz20yL+Ws0sHc/ewm7p6Lf55ZqsePWKRQNu2O7rpQ2zohMhD3JqMVehKxor59eoEA+F+E3LttHx
-yfirNVowS6ekC7gsjS7f3r228k8AAAD//9bc0/K9BwAA
+H4sIAAAAAAAC/6xY7W6cOBf+P1dxZPWVGqkD6bv7K8sgVcnuqlLVqpnsBRh8YNwxNrXNpAnl3lcYM8DA
+ZBpp+6MdzPl4zuPzResaGGZcIhDLrUACTXNPGVdC5U+gNEMN3yvUT3UNKBk0zWqkkij21GqsokzpAgq0
+O8U2pFTGEqCp5UpuSOismFD3Zkm8AgCIuCwrC/apxA3ZccZQEpC0wA1Jjc4IHKiocEPqGoLb7f1f0DS9
+ZsZRMIMW6hp4BlJZCG6pvFUy43mlEZqGcUMTgeyI26syfoBUUGM2pMW8zrWqSm/XCQiaoIBM6Q1hydrF
+TuI7amlCDXZc3EShkxppWfxhqUYKnI30PL7gk0r3yAJ3uIRtgihV0molet0/tVYamoabNZcHKrhTEwa7
+s+OJM0RAq0ezIe+vyRHb8Kcj14fU0vrVA4rCHv8QUl2DxaIU1CIQ4QIgs0gGAka8epjrDJElNN2P2PWG
+x3HVdf/7J+yqgkr+jN2xz7eji5DxQ7zo8UV/URnPuOgC5wZSpTWmFqhkYJXaQ2SsVjIf6LmrNG0TOdhi
+qiQz8BNKzaXNgPzvOvgtI01jotBrBTNPD7xAVdmbNuzAPyyZCq6ztpLMO+BGCecRBB5QdJpfyvbEBB+P
+L5vGE9m/ukfKvkjRXss70EjZWkkx1O0UWhSWi7fiQr5HUwlrxuSfI/JhhwaBagS7Q8i4Nq4oJ3Z+Cmzh
+gu4eb14G4u7V2CeBG6IOqDOhHtc/bsCkWglB5hBO3QUfzENbXjP40/zxqheSzBRUiEl5tpVyguIOn31n
+AMZRWmAIglJrLMJnKCpLLUcDFXdv+j7IseuvBiyCQYGpRY0ygDuEgxI5SoawV0IVBcqJP5UBFZwag9I5
+RAmUykd85nlr65l/kyckW0eID6N7cH+vTeF/7FquF9iN7A4pWzrX80OvEH9o0UWh3Z0XeXgq8WWJvzHR
+Fd+j4N/2CIlW8oJFhYKnO8tlviwYhUuYo/BshO14W8w3TWWO8Ia/gzdaPcLNBoJbJapCzmrmAlUsjlLF
+MK7r4DMtsO3E7jkKLTuvU9dBS1/TvCx2NL1VlU5fYfwOTaq5ayvnfCxzOa+nkfyczSh0uRePqs/VW7wa
+leLy2NbqkSzPgmGqQ6rEumDr308HwmjEu6pd264vk7ibC/4R3nIJpmvVV/OZP9thZFUkqAkUXG7INXGr
+wNT+yT7gj391IyDjAX60uXSRw+Y0HhAPR3d1PTt9YSYN28WSr1LQFHdKMNTdrnbZ4HwhOL9pDBxNiT/X
+mD9RakEg5qAKYAhU5Fig7Ptzb84i5L6/yGCSdhdWjdel13GWk/jjyVhfzqduDrjUGXRP0oaPdoBXJc4I
+zfmuNqwYy81MubwZL+dbq7nM23v1QPE7BPBmcWfxc27AG08sRGFn/ldbSxR2Bl+RUGP2/qMLP5VOd5ju
+obBOGEr37/+XZuu4ezilRP3o+sZxf+s5VfoYge4XvbdUMnjrvn765W9blaXSFtmVf3G6HV5dXc6aFsna
+YeszZwTHX/z7xWYw2iQX1lJnePZJdq52Rj7n6Jwcie97GbCaStN9ci5W1sW80APO1XJIy0Qvyb/YobZf
+P8EW9QE1KNnufxYraSFHlKDn8aD5o29V0Iow/ynqz1BDgRaoEO1LgWg0pju71NUuldK0BIbHc4O4DWet
+eb4b78P+9k++xqeekspaJX3emyopuD3ecWIlJFauS80L2n6n/lMyajEKO6V4tRzFBG7/3wPxKgpb4uPV
+IPzvAGQO85TxEAAA
 `,
 	},
 
 	"/query.html": {
 		name:    "query.html",
 		local:   "pkg/uploader/assets/resources/query.html",
-		size:    2214,
-		modtime: 1564212738,
+		size:    4718,
+		modtime: 1792374712,
 		compressed: `
-H4sIAAAAAAAC/5xVwY7jNgy9z1cQwvbW2Fv0NrADLJqitwXanfYuW3TMjSy5FJ3ZjMdf1M/ojxWy3ZmM
-E2+29cGQrUe+R1Kk+h4MVuQQlJBYVDAMf1Ag8Qx/dsinvgd0Bobh7gxaeHOKyDsAgKzy3ECDUnuTq9YH
-UaBLIe9ylY4+1HYEjmBDRyitDiFX0W6zZ9+1Z4ARZHWBFirPuTLFZvax06ILHXDSdZ+lI2phKfhFNKMG
-Mme2bxhL74S9hb4HqiD5mdkzDAOFDbmjtmRiyDbg9O/lz5gEBewfQ65+eK/e8L4+TjeYq1ly30Pya1zC
-MGTpv9oWks8yMgvYVIim0OVhkZf4LFRHhmn9DHXXaEdPOP2eq/aGKjV0XGe/yZ2126tRTyFSgNIzYymg
-nQHx/gBZEPZu/5qIXcc6Ho3kE5bemQDP0DI5qUB99z75sVLDELJ0tkouFaTtakpG/79h6KyEZeS3IojP
-Q40BQTOC1AgVcRB40T37fbboYBiAp8/76yTpCsuY7CAni7nyR+TK+sfNl3sIJXtr1bq0pYzkQ3jQhcXV
-MC8LPbv5xlMRGm3tm66Jh/eKwh0+zQ0JhtAJGASrtQRB+AhNJ1oIA3Q07rRa6O+/nKBj3FOQeBYQBCGg
-xVKQ0SWwQzh6u0dnEA7e+qZBd8HrK9CWdAjoRmJ0oLV7xCfaR39P9NldFieTMWlzWNPH+N6EZl7UsS4r
-lcikRm3W9ni9fJnU2w9RbZZK/XXYw6nF26hfsOCODmjp8wGhYO++wbNHS2Ut5Pbr4CxdiyPafCX6eCNc
-3+t7YO32CO/oe3jH/hHuc0h+8rZr3Gqf3kzpBDDbrPQGt32ffNQNxiE7fmepmNu2fZ/EdI+j2fwHqk++
-4/J/kO0wlExtHH+3ONfrcL2Hz+yuVyJLx/O9aPN07POz23kaBVdv6zgANkz7ejkGsqIT8Q7k1GKuQlc0
-JC83biEOCnGblqnR8Ur8vTVaMEsnowvqaRknzvbuNdR/AgAA//9DJ3aYpggAAA==
+H4sIAAAAAAAC/7RY3W7bOBa+z1McEF2gAWop3d2rrGygSHYGBYoWTTJzT4lHEmuKVMkjp4mqJ5rHmBcb
+iJJt2ZLsZIDpRWOR5//3k+oaBKZSIzCSpJBB0/wunSRj4XuF9qmuAbWAprkYkMZGPLWUFwAAUWpsAQVS
+bsSSlcYRA56QNHrJQi+DrTyhJ5a6rAjoqcQly6UQqBloXuCSJc6mDDZcVbhkdQ3Bzf3dL9A0Q+5UohIO
+CeoaZAraEAQ3XN8YncqssghNI6TjsUKxM3zALuQGEsWdW7LW6EVmTVUO5HsixWNUkBq7ZCJe9A7ccuIx
+d9gF5ToKPdURJ+EP4hY5SDHg7W0NPplkjSLwh1N2HliWGE3WqC3v/601FppGuoXUG66kZ1MOu7PdiRfE
+wJpHt2Tvr9iBfft/XcB719pQf+2NisKtD4eu1TUQFqXihMCUd4SNPDoMxiDWvcmLFFHEPFkfRbxXMPSz
+rre/f0JeFVzLZ+yO+2I8UBUKuVnNaj+rOypXk3HqgiIdJMZaTAi4FkDGrCFyZI3O9qG7rSxvKz64x8Ro
+4eAnlFZqSoH96yr4T8qaxkVhzxVManuQBZqKrqEV2j9MiQuu0rb13DuQziivFRRuUHWcX8r2xAUfd5dN
+0wd3e3WHXHzRqk3ZO7DIxcJotW/0sXlRWM5mzLt/h65S5I4Tcy7A3u0cHQK3CJQjpNI639wHcn8qbN0A
+2z1eTysJZ7T4WnD0pHDJzAZtqszj4sc1uMQapdi8acdmBB/cQ9uys26O67AX88KidQVX6mAMtN04YeEt
+PveTCIRETSAQFOfkCOEzFBVxkuigkv6m5CT//EMTaouZdNSWKgIhOFSYEFrUAdwibIzKUAuEtVGmKFCP
+9JoUuJLcOdReMWrgXD/is8xaec/ymx4nJyIftN6t7sH/v3BF/yNv8zKTiYhy5GLuzs6nL6J89aG1Ngop
+P0328FTieapfMbaVXKOS39YIsTX6BZINKpnkJHU2TxyFc35E4Unv2z08fVfXYLnOEN7Id/DGmke4XkJw
+Y1RV6Nk+PRvSjkCsosQIXNV18JkX2G4N/xyFJM7z1nXQhrtpXka+U3VvKpv8DWW36BIr/eg7p3M+D9M9
+POCbzkQU+vo+avPQ9/nq4mgUzMMUax7Z/H7bIxlIjFoUYvHfqSU3gDV+ciyo2zFs1e25/hHeSg2uWzuX
+0zhnhON0VcRoGRRSL9kV8/DnUMcRBuqPX4qC2BCw7GTOpXGPIIdL72Gnsq5Hpyf27B5VzekrFU8wN0qg
+7XDreaHTAGgeYe3jNU7E3NL4xDmBQszAFCAQuMqwQL3dG1uRhJD1c00Ho9J8Abx6ffntcAtbfTyCMPP1
+1u0qX1p7/qOykgPM86rCGlh0epruYdU82DG+toYvMvdkpc7avPcG43cI4M0kVut38t7u1YGEKOzEv3ZM
+RWEn+JWFN4zoP1AUxxxJjskaCvIMUPq//57DBcMJ5Blj86ObPTtMu423sTuP7Bb8vuVawFv/BrkFxPdV
+WRpLKC77i2PEfHl5vrJaSxbetm11Dczpi+I9O7W55uG6Fz75ajvXawPdYys9HVvdbWmALNeue3ef7cSz
+NWP39l7Muzcd+JPIZG7S3X/9BPdoN2jBaIHWEVaaIEPUYMe+ofvfduRBSyL6V/v+DC0USMCVai8VorOY
+5DQ3HV/SeuNWOTw6BQBaFxdWZvnxe0BfJUdfP8ba44rI6L5PXBUXkna1EJOGmPSitLLg7beA30rBCaOw
+Y1pdnPZw5Mb280x3FoVtklYXe8a/BgD+mWpsbhIAAA==
 `,
 	},
 
 	"/status.html": {
 		name:    "status.html",
 		local:   "pkg/uploader/assets/resources/status.html",
-		size:    5150,
-		modtime: 1620392739,
+		size:    10408,
+		modtime: 1792373462,
+		compressed: `
+H4sIAAAAAAAC/+RaX2/bNhB/z6c4CB3QArGVdm0fAttDl6RYhnbdmrQF9jJQ4iniIpEaSTnxVH/3gZRs
+S47+2YmdAHuLlePp7vjj/flRWQYUA8YRHM10hA7M5xea6FRlGSCnMJ8flGQ8QWdG5AAAIMvghukQhieC
+B+wqlUQzwYcfiNKfMRKELgQLYRbA8ExKIcvPAQBGlE3Bj4hSY8cnkkI8G7x2JhWZOrlBiISiBI23enAT
+Mo3gXQ0o4Vcoa5YDAFRMhYBFCBL/Rl8jvfs6l7JpDytsUOpfN0rqnwMAXIYI/l1zbogCPzQ+UCAasgyG
+lyzG4XshY6LBeXV09HZw9HJw9Apevjk+en189MbsyCF4qQYdYrFYNb73BiUCFxpIkkQM6dBaolBOmY9w
+jZgokCnnjF/l+2uURkRpmJKI0arNw3q33Qa/s2wBge8QpjHh7F9cR0ND6NcereBZ/XVQAttXY29u5rmy
+P5YSJakqet/5mk1xtwhVqe+jUg2YuSh2gi23oS8yFw4R/ilBSXRtaBshDJ6QFOXAE1qL2GkG7igQMoYY
+dSjo2EmE0g4Q30Rv7Lhasitz/Bbq6YDxiHFs0QcAMGI8MfidJTh2QkYpcgc4iXHs+EoGjsFeimPHAOjk
+4vN7mM+7NHqp1oIXKlXqxUwvrfI0B0/zQSJZTOTMmXxJbMLi4mbk5gtb/HdNALaMT0JShU8gOs0arYV0
+qVPLFB8i1CLVxtPBDZEG087kd/MeSG3g1f2i3nweSjliFzn8o1AaJPrINeAUuVbHmyXEkSZehAtT7I+2
+k6dNPmn7v+zYKR1OTDUZuTrslvwjRTnrJ5qfn36yZ3zKpOAxct1vwSkqzbjNz/0W5B1Mu+zIbQvWyO0M
+tYFM8/+zDKSpw/CMHcIznGo4HsPwF6a0kLPhmcVKU3qu5nOzuL5vaoBABU3t7dDd1XSSZaAxTiKiERwL
+aoMYJ3diPh+5mvZXtkvpLCtis8LTxvYtdFzOEtzcuUTiyopih0auedpPUTsGFyksUrjVzre3GTvf+kVg
+bBY5XfRXF+gLTtX3RDKuA3B+OBr+GChna+154tmZ+kfD1mLxRd4hA9MYP1cvinqJ9CEBVl8jN4RgZ+0p
+PKtidNENgC8ilRA+dt46k99EUUy7nWx3sNu5kduSx0euNbLhnwRCicHYccM8qTuTi1DcAImiVVNDJluM
+NqvUPzz92ZQy1aeFaRpJoHkscZojd0o08YiyEypH27xu0nnBhj0W1LdCUCSyuKsB7SjGG+AznHxKkJfc
+Vt0tR+nMLnZsaLScrJTAfA7PY3LL4jSGstxHcntX9MV9gb+Rw+ccUoXbuXnOv6h+ye0hDabRtubSaO/W
+fiNMI4VASCAlXG3ngFF2IlJbjUCzGJ+rF4ewLrFeDuE73C2IwDhooUm012icREIhvf/5+khuzWYW6ow3
+NMLD9aP1gQVoorQSw9uESaQP4rPbkXba60cDu1hbC9aq2KIks8ASeWsU1hk3b6W75bCWY3ytc3lXpoBI
+BMqUtWcX/Grde1akpUKtDYs58gXFCbHM3si1Pyz4awnY4ZZkZE2LtM+Il1jDnMDZD2no3IsGe7qsV0Ai
+hQ/KMH5GlcaPwXd1EucFcu8Q4yX6fNEOrtqUfdzhQMMG1DSnEBC2oxyTZV2h2PguA7o4eC0Sp2vsoIUh
+TkVLxPi1obMp0WvZbctpZO1qpR4fX5liWkg76j8mMgo74B9ryN4wUXH/cdBgPX4KUDh9dfo0skTeG+wx
+MVQcL6HgvhecHcGWSJFrRiL1f4t2yfPHOXR5MX8Kp+6dpXp3AgAvIv51dwP6DT1gXKMMSN6GmslEoZ/K
+veChCMDj4IDYl+8NB1D7xUPttzjCv65Ogn0g0Z8uvMgnLAWBFDFgiSyfEsnMOKYOurzsuedN17CXIarl
+qJdPgmKKUtruHrxZvVWHQDgFn3CDUg+XX9zYsZCpFZLrxsEaS7YgL9suc1vpFHvDmfvbccVZvWxdet+8
+qpn0aL0QbeNfVxehAcOImstQPrWXoa28fDfhRCf5XJ9lhWpL7NlHPdidynJjUc/FzRFqv2xoJItqSaK1
+c1L6Wfksr/ir/IVe6dZulSlMsXjPOFMh0uG5+hOlWKOTqt+Y/Uo4/FX9vmx9Wmy8BnGtBT8xOjZqz0/t
+VN7nDcu0WOfifwMA+jP8hKgoAAA=
+`,
+	},
+
+	"/transfer.html": {
+		name:    "transfer.html",
+		local:   "pkg/uploader/assets/resources/transfer.html",
+		size:    4725,
+		modtime: 1792375182,
 		compressed: `
-H4sIAAAAAAAC/+RX30/bMBB+5684RUza0NowBi9VWglRpm3SJm0F9ji58aW1SO3Mdsq60P99spNACvm1
-Am2l5S3xd+e77z6fL0kCFAPGERzNdIgOLJcjTXSskgSQU1gu9wqYsaALA9kDAEgSYAF0r0jIKNFM8O4n
-ZV8MwCIKqDPBAzaJZQo89TWbY+4ofzzK5uCHRKm+4xNJYbboHDuDFUwZrjNFQlGCxt+6czNlGmE86ajY
-91GpEnvzjFDOmY/AFMiYc8Ynj7dxKZu32N2SUr6LF5V/N88XoTRI9JFrwDlyrXrlPtwKJ54m4xDzUOyL
-U72dpw1LdeuyejFzMLhgM/RcPW1GfotRLtpBL6NQENoOO0SlGbciameQirke67l1uRvbBuaMAqrXkwQk
-4ROEffYW9nGuodeH7kemtJCL7rkt/cOjUOKDBda4ey6lkE34rKIr4uhQE4V0Bo2WqTUdJEm6pSl794OQ
-M6LB+Uw4/DyCdye9w+Pe4YlpB56raXuvO4SOJN4lmfPqueZrO0f1yskKh6HCtepV38E2V7Dcqz3Tw7yH
-j9AXnKrbSDKuA3BeHXbfB2p972kbeDH3F4sI1zYesT/GGJjG2Wv1BmIbK9LnVEl62T5RR41dPMtsVWg3
-RJob0AFfhCoivO8cO4OvIruWmpOsT7A5Oc+taaGea4MctLmfH3yqIO1554ycvMY5IyKxQtp2zEgS96Bi
-1DhwS7i0eAJTiUHfcSWqeIbOinHI+LUz+G5XMgV7LqnxZgN7tFzC8X11C4w/GgELg+KQaDImCs8E5+ib
-Ty9bo/Tmg4oa5dGAfx9OQFjYvlj/MhMmSRMVtzCNZ4SnPedJIcBYSIqyo0VUNaHeSYZmgZSJ5jKiRFt+
-7od4o501zyQv/UUokHLFFNNC2gtnm8rI4oBfNpCNaWIl/e2owWa8C1IYHg13o0uk88kGG8NK4gUVtJTB
-umRLpMg1I6H639guZL6dQ5deyrtw6k7tn8eLCGAcEv+6eXD6gWNgXKMMSDo+caFBoR/LjeghI2A7OiDp
-f9+mdLD6VtDH3wAAAP//hNzi/B4UAAA=
+H4sIAAAAAAAC/9RYT4/bthO976cYELn9LDm/JO1hKwsoggQIkCJBs+2dEkcrthTJkCN7XXe/e0FSkv/J
+m80iRdA9eGl5+OZx+GaG1G4HAhupERhJUsjg/v7NnTWOgGsBsgvD3Q5QC7i/vzowr4zYBusrAIDClvF/
++LtpEWqjG3nbO07SaKi5hgoBIy6KBZAZkEESSE0GuDbUogOpPXGl0jzTALUIwhj3QpgaeqsMF+DRrWWN
++eTxI/d+Y5zwwB2Cw86sUUDjTBfnJ78L6LVC74GD5d7b1nGPID2gJnQogAygrt3WUpjVwUZSm8NvHp0f
+mWywmpzKMK3hNUanRqvttEAgc4txOQFjREUBdiSauBdLW17F0W4HsoH8jXPGjTGNFkKuoVbc+xXjCsOu
+hM9McH2Lju2DPoAMCH9D23dcy7/wCGwp5Loc/Q07euj+o8O1xM3RnPbl6L/9ASxlL1j5ug3efbFsX+4J
+7HbgwmPIh58PURLSqwnpxwGpqI3AMvB+K1EFPsUyPiqW7auzxQWKn7B2SKfYSYIjPOEdZV1PKFh500oP
+Pk3aSKWgQqgjQfETSPKw5qqPMtCGwLdmo/O4LSe+UXmc9Uq8Ujh5jl/iZ+a7YVAZJ9BF6SVmRhtvg3B8
+x5Vi5RnocTzfS30ezWMObiSQooSfIf9g80/kpL4F9r+QpgOpvq7R+3FB57bZ3jZpbFIKKy8SSCTESMJu
+s+cMPG0VrthGCmqv4f/YsbjRH2zcZRJfB1cW1k1h7qrseUK7wTuKeNZh+TBqsSR3MdRDMszMCaEorx42
+v6CPS4rEofihOKmT0scq43mHwNO47p1DTWA0HuvyJIMPUuQX6X3Yy1M2F0rJhjst9e3M9t5MtTPwJC51
+yJIhYxrjri5q9plcwLMmpvT16pBRovhMwv39Yr+EqQwMc6YyMFksznxxLU4DBNIHWlMvEZx4xT0uwJvY
+aDwobAiws7TNYWhzsUQf9QQyIHWteoFnXiUtwLjUMgIkb+IgbqfUt/lxxPf19uEd+50rKaIE8nc+fnns
+5g35/CVVrQNmPsNnTrVf03JGmVx2LXV0vogbVnOtDUGFwK1VEsX1nIYO4/HGXepmj4tw0RjXQYfUGrFi
+1nhiwOsAvWJLclz7Bh0b1ysyqZXUeLLKQmrbE9DW4oq1UgjUDDTvcMVq7xqWUiJW3/z1p1/fnlfLBxAS
+mwkjBGZ7OrvqiYwepvu+6iRNnCvSUJHOrJMdd1s2iCrEeV5YQvpQ1MQUrfLn4LNYJi/lwXkhBK/878ay
+5rpG9ZRgmp4C98xjbbQIYS1fR7CHozSTUnMHqFR5js9Pjwvu94tlagSsvFgrAv/s1pneztSIQvEKVajO
+I1K2L7is/DiNr4tlNJ2BOKQ6HqQZSDGHeEQqNC9n1LiwQzPek6lNZxUSrpjGTTYhzzCI57Uj6NDY4bC7
+v0e+xtRhgMxwF9mf+08vJXmxjKDlQ2Xtq0vAJLBLWr2szlesfNc9VZ2Auk4Eu16RtNxRdJiFTvwdtWvT
+veZbiLfqtVA4Rvi04T1SvY1UmJQ7wM3JNUtWaUGjHa9rtLRi+R/e6EVsonX0vAwPGDj83EuH4st6esri
+U5f/lpl7jvi0zDVN88SEnb0RfAi3+TGWINPlf5/EG3S4v9Xnh+8ftIA+vjGglhNwh7ERS32Q87Meg+Wf
+aGkBeBe2ONqPB9jJ9UglAV067+Yz95h/o8oMrwu+3BKnd0fD6J8BALwDBLd1EgAA
 `,
 	},
 
 	"/upload.html": {
 		name:    "upload.html",
 		local:   "pkg/uploader/assets/resources/upload.html",
-		size:    1370,
-		modtime: 1562944213,
+		size:    6317,
+		modtime: 1792370435,
+		compressed: `
+H4sIAAAAAAAC/7RYT4/bthO976cYEDkkwFr6/XIqAluHblMEaLK7SGKgPRW0OF4RoUiFpLxxVX33gpRk
+/bFke21nAzgSPZwZ8j3OPLMogOGaSwRiuRVIoCyXmVCUFQWgZFCWNx2blWJbZ3IDADBfK51CijZRbEEy
+ZSwBGluu5IKEuXdCIm/prbnMcgt2m+GCJJwxlAQkTXFBYqPXBDZU5LggRQHB3ZfPv0NZdmevOQpm0EJR
+AF+DVBaCOyrvlFzzp1wjlCXjhq4Etpl3pjO+gVhQYxbEZT170irPOv69kaArFLBWekHYWzZDueFayRSl
+JdH79uXdPPSWg9kGBcYWONufXScdNNlSt0nBRxV/Q/brFkjPdmQhvdRjJa1Wotm8Xpa9jNxfUYCm8gnh
+Fb+FVyg38G4BQWcxpkFz+DdXmcuyTh2/+9nBPU0RXnUdQFlWK+/m22LZznJ4DgbgdTOw/PwRyvLNPKyi
+ji6kpmMvybCK3bcvCrCYZoJaBCL8LhN4fdLuv+lGmIeMby4kUW5QO6BItKyfJujTPR4Wf1iyY9LOxTEa
+tYZjHNL4Peca2RiZGs/vtVYaypKbGZcbKrifLwxWY7sR77FhYBu2c4Sb1faP8Rno7LxfHZqMGvOsNCPR
+Y/10AjS7STt42pEj8LSGY/B06lqTzgfuj1cD3MFycBGCbWY1ggRoblWs0kygxQWR+NxZZyZojIkSDHUF
+9iDhywDfxXmzd9o7KNeLm60R2YrG38ZrX3dHRqtcUTQG/0KSp1Tyf3DMdqr89Fl4DVJq9WM7SxVDEj26
+59O6TWfaURo600/e8oWtppvbzdF+Efj0AxcJCDnUJkh0r8A7n67/h92nVOZUHAxSm0Sf/P9VOIi7e3Ru
+8EH3nsyg16mXBsFsjcW0TsWgtVw+GXj94evXx78fPz/8+dctuOcv1QtQyeD+oXqZ6JRX6YctQa5fcp3r
+mthgUG9Qn1Bzcy1In+mnkfw8gvfaWIVzpU4GhS+xNnsXhvUqMqUtuXzbR4qeSakQvaydPAD3MUtzi4xE
+D1JsITfI4JnbBNIDFPfefkbJaoVOhW5+rtwZuDsJ6eVB5XO0pI0qmBr6a+mYfp5nwfwR6QYB08xu3ZbY
+BHe1w3EQmELjFUStGFwTT1BaHv9s8DtSyr1DdpGg6rs8iQCPB7XVUQJcLIC6OVxHBfVWdfVCLFVTi5vW
+67578VnduTkGklSPL6rIF8nZNqu943xf5bFfzQP8QR3YQazSW/j//wL/L/zlMhSbZZ913O9UmlIwmFFN
+LTIQ3FhQa0iUseYWmEopl8bLAon2WelvBmxCLVCNoJHGSd0QVG4beTWoAEP+HNXUd0pK9Hc8YBX8ppR+
+y1QM3MDDH8EJ2vgc1nbvXswERQfXQ6f81jfk8J2R92vdt02e1Yv/nJl0TAPbBCkbG9fR+CWLTSJ3FzIP
+bTJt8cWX93GbeTjmex5OZuKu8K56TzS1tupLFo2Uj9Ous2bDlty/S5qHlr0gsheRJwb2toO4tQY8EHYc
+ivFfjkdYcfmu9YobjWPMLJUxHsz/4i3b08dmJ5DDqciTBN4n6jz0Z2842Jz9a10CmjPLtUCqvShzuwNK
+N7JMrYFK6AQAq0BjqjYI3AbwtdJxLK/qateQG6DimW4N0A3lwq0+mJJxo6XVJ6j5UzLy44Sv967Q93FY
+5dYqWRPC5KuUt0xcWQkrK2eZ5inVWxItM0YtzsNqUnRz+BzsdYg+kPPQ7XN00078bwB995c8rRgAAA==
+`,
+	},
+
+	"/versions.html": {
+		name:    "versions.html",
+		local:   "pkg/uploader/assets/resources/versions.html",
+		size:    3508,
+		modtime: 1792374363,
 		compressed: `
-H4sIAAAAAAAC/7SUsY7bMAyG9zwFoakdHAM3BrGXtlOH3pIHkC36IlQWVYpOL3X97oWVXOz4gjZA77JE
-kH+SP76fUN+DwcZ6BCVWHCoYhl1wpE3fA3oDw7CaaSoyx1GyAgDYNsQttCh7MoUKFEWBrsWSL1TepSaq
-TMqkNvYAtdMxFmoszJ6YujATJJHTFTpoiAtlHkzWRWSvW1Tl7nzabPOkWdRZHzoBOQYslOCzKLBm0QIY
-f3SW0Vy5qMkLk4O+B9vA+gszMQyDjZn1B+1s4uAinu4uN4mMgrFxoaYRB+06LFTfw/rF8KibUciNPfwn
-lKBj/ElsVPl4Pt0B5VJ0ATPdvBuYacQMzIvpazBLGuchWYNoKl1/X0jH38LZq+9nzVnwG/Zdq739hbe0
-07ZfGVqk9RbhMT0fVfk4/sEH21zgf7wjxI7dLL/U6e0zO7WdB5a8jorgdI17cga5UHuRsMnziHxA3gRi
-+Uuc/wzzE3mP6fEAIfhMxA+GarARvn1d3xHKzVTGlyBj+7R/Za3qRMZRCWvsqtbKBWUlHirxWWDbaj6q
-cheMFtzmp6Kb+7DNxwDK1bRHfwIAAP//tDDYvVoFAAA=
+H4sIAAAAAAAC/8RXT2/jthO951MMCB9+PzSSnGyyh1QWUKQboMB2F9gYvVPi2CIikSo5duK6+u4F9c+S
+JTvxoehFVvhn5vHN4xtlvweBK6kQGEnKkEFZPmq1kuuN4SS1gi0aK7Wy+z2gElCWV709sRY7t+UKACAs
+ouoXAODLFs0OSOYIlCIkg5DSguVbFNfAIdHFzg28YEE+LFOEjFuC/R783/nbH01yKMsOCCRcQexi5gU3
+KK67pFyJiWzNcqOzDAXEPHkB0sDVDvTKLc99+KUNDtLClmdScHJrcaUNgiQ3PNw/TCotaJXtgCckt9VW
+uWq2VdH8mp+giK6qt/3eLfC/GKNNyx4AQCjkFpKMW7tgPENDUD09wdUaDTvQ2wRpIvwN6SbnSv6Fg2CB
+kNuozdfUrgay0iaHHCnVYsHWSKxCrtWCBS3LvWQh8TjDFlf9R/X0bH6EKaQUuTgeM8OBZmH07DQQBpRO
+Tnf58I28BBU5Ap6Mzi/bsdQn10fjmTA4BhsGk0dywh+Vw7gywUxew2wLDwvwe/KdADBBSz0hpieaLLOt
+v5Q5+l91wjP/SZucE7Db+fyzN7/x5rdwc/8wv3uY37OptL1AcgX4J8wkzKEsQ1tw1TIYc7FGqJ6e3SQJ
+WsuiZGMMKgoDtzI6SGryDMGpQ4QkJut0EmgoVbEhoF2BC2a4kJqB4jku2MronLkLtsEFq4n57VcoS9Y7
+XDU0851uoCyTFJMXFB326L8DT/qD0Jf6XwBu5DolFr2jDmdtM/+Rq7YjIPxPYaWY/5/TFgBAGG+ItGqO
+bjdxLol1+iIFMSnP5tWP3lAmFXqv3Cip1gycPy2Yc1xnt16foOhsVgCAHzrLKps+jy+oAZ7l4GKFj/3D
+RcksXuoAkOjM3bMFu2PRN31ofinfIsSIqm6hsEO6BMr4QGFwZGZhUJl7dDUUw0kz+1Cl2xJbTLQS3OxY
+9Fj373EhhjjDwKkh6vfNgSJ7aDoLPo110PkKbSdbH0gxVN9J7Q3udyqFQNVe8MSa1eCK+4/PP54uDtOA
+6keaRNOnaUzi0QfAmYqG6ae2duk9FOTdsegxdazaMEg/RRNkN9MjrtO7LtJnF+mWRWGiBbre4T9JzByg
+MKiGwiC9G6nVYXzGxCBNXp9iYGn5hlCwaJlKC7be9MotJBU48TNIsjWHIC0oTWBT/ar86qPswxf29IdQ
+8xJrI9BkaC3UqLTStuAJgs15lp2wrwOXX6UaM3lkGi2ArlX43wv/mYxUa2A/ua7fgKo7d3ug8VrvsLb+
+wuxk8o7N9tpJsfPmDCztMlywVykofYAbzFlV5O9FVWESl4WLwsJ0NOexN6+jLfGNqniFweh81LH5ve/q
+I9+bXn5CH9Nq/KZByNUKDaoELcRIr4jK/ddhsfP0oQZPXdzD2z8DAADEgi20DQAA
 `,
 	},
 
@@ -2606,13 +2934,20 @@ var _escDirs = map[string][]os.FileInfo{
 		_escData["/_layout.html"],
 		_escData["/access.html"],
 		_escData["/assets"],
+		_escData["/audit.html"],
 		_escData["/database.html"],
+		_escData["/history-event.html"],
+		_escData["/history.html"],
+		_escData["/login.html"],
+		_escData["/openapi.json"],
 		_escData["/orders-consult.html"],
 		_escData["/orders-lab.html"],
 		_escData["/orders-radiology.html"],
 		_escData["/query.html"],
 		_escData["/status.html"],
+		_escData["/transfer.html"],
 		_escData["/upload.html"],
+		_escData["/versions.html"],
 	},
 
 	"pkg/uploader/assets/resources/assets": {
@@ -0,0 +1,138 @@
+// Package audit keeps an append-only log of changes to the configuration.
+package audit
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// FileName is the name of the audit log in the configuration folder.
+	FileName = "door2doc-audit.jsonl"
+
+	// Redacted replaces the value of a secret in an entry.
+	Redacted = "[redacted]"
+)
+
+// Entry records the change of a single configuration field.
+type Entry struct {
+	Time       time.Time `json:"time"`
+	User       string    `json:"user"`
+	RemoteAddr string    `json:"remoteAddr"`
+	Field      string    `json:"field"`
+	Before     string    `json:"before"`
+	After      string    `json:"after"`
+}
+
+// Log is an audit log stored as a file with one JSON object per line. Entries are only ever appended to the file.
+type Log struct {
+	mu   sync.Mutex
+	path string
+}
+
+// New returns an audit log that is stored in the file at path.
+func New(path string) *Log {
+	return &Log{path: path}
+}
+
+// Append adds entries to the end of the log.
+func (l *Log) Append(entries ...Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return errors.Wrap(err, "while creating folder for audit log")
+	}
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, "while opening audit log")
+	}
+	enc := json.NewEncoder(f)
+	for _, e := range entries {
+		if err := enc.Encode(e); err != nil {
+			_ = f.Close()
+			return errors.Wrap(err, "while writing audit log")
+		}
+	}
+	if err := f.Sync(); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "while writing audit log")
+	}
+	return f.Close()
+}
+
+// Entries returns all entries in the log, oldest first.
+func (l *Log) Entries() ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, err := os.Open(l.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening audit log")
+	}
+	defer f.Close()
+
+	var res []Entry
+	dec := json.NewDecoder(f)
+	for {
+		var e Entry
+		err := dec.Decode(&e)
+		if err == io.EOF {
+			return res, nil
+		}
+		if err != nil {
+			return res, errors.Wrap(err, "while reading audit log")
+		}
+		res = append(res, e)
+	}
+}
+
+// Changes compares two snapshots of the configuration fields, and returns an entry for every field that changed. The
+// values of fields for which secret returns true are redacted.
+func Changes(before, after map[string]string, secret func(field string) bool) []Entry {
+	fields := make(map[string]bool)
+	for k := range before {
+		fields[k] = true
+	}
+	for k := range after {
+		fields[k] = true
+	}
+
+	var res []Entry
+	for field := range fields {
+		b, a := before[field], after[field]
+		if a == b {
+			continue
+		}
+		if secret(field) {
+			b, a = redact(b), redact(a)
+		}
+		res = append(res, Entry{Field: field, Before: b, After: a})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Field < res[j].Field
+	})
+	return res
+}
+
+// redact hides a secret, but shows whether it is set at all.
+func redact(s string) string {
+	if s == "" {
+		return ""
+	}
+	return Redacted
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	log := New(filepath.Join(dir, "audit", FileName))
+	if entries, err := log.Entries(); err != nil || len(entries) != 0 {
+		t.Fatalf("Entries() of new log == [], <nil>, got %v, %v", entries, err)
+	}
+
+	now := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	first := Entry{Time: now, User: "admin", RemoteAddr: "10.0.0.1", Field: "query", Before: "SELECT 1", After: "SELECT 2"}
+	second := Entry{Time: now.Add(time.Minute), User: "other", RemoteAddr: "10.0.0.2", Field: "dsn.host", After: "db"}
+	if err := log.Append(first); err != nil {
+		t.Fatal(err)
+	}
+	// a new log for the same file appends to the existing entries
+	log = New(log.path)
+	if err := log.Append(second); err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := log.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Entry{first, second}; !reflect.DeepEqual(entries, want) {
+		t.Errorf("Entries() == %v, got %v", want, entries)
+	}
+}
+
+func TestChanges(t *testing.T) {
+	secret := func(field string) bool {
+		return field == "password"
+	}
+
+	for name, test := range map[string]struct {
+		Before map[string]string
+		After  map[string]string
+		Want   []Entry
+	}{
+		"unchanged": {
+			Before: map[string]string{"username": "user", "password": "secret"},
+			After:  map[string]string{"username": "user", "password": "secret"},
+		},
+		"changed": {
+			Before: map[string]string{"username": "user", "query": "SELECT 1"},
+			After:  map[string]string{"username": "other", "query": "SELECT 2"},
+			Want: []Entry{
+				{Field: "query", Before: "SELECT 1", After: "SELECT 2"},
+				{Field: "username", Before: "user", After: "other"},
+			},
+		},
+		"added and removed": {
+			Before: map[string]string{"users.old.role": "admin"},
+			After:  map[string]string{"users.new.role": "viewer"},
+			Want: []Entry{
+				{Field: "users.new.role", After: "viewer"},
+				{Field: "users.old.role", Before: "admin"},
+			},
+		},
+		"secret changed": {
+			Before: map[string]string{"password": "old"},
+			After:  map[string]string{"password": "new"},
+			Want:   []Entry{{Field: "password", Before: Redacted, After: Redacted}},
+		},
+		"secret set": {
+			Before: map[string]string{"password": ""},
+			After:  map[string]string{"password": "new"},
+			Want:   []Entry{{Field: "password", After: Redacted}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := Changes(test.Before, test.After, secret)
+			if !reflect.DeepEqual(got, test.Want) {
+				t.Errorf("Changes() == %v, got %v", test.Want, got)
+			}
+		})
+	}
+}
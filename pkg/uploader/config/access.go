@@ -0,0 +1,254 @@
+package config
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/secret"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Role determines what a user of the web interface is allowed to do.
+type Role string
+
+const (
+	// RoleViewer can see the status, history and configuration, but cannot change anything.
+	RoleViewer Role = "viewer"
+	// RoleOperator can trigger runs and pause the service as well.
+	RoleOperator Role = "operator"
+	// RoleAdmin can change the configuration and manage users as well.
+	RoleAdmin Role = "admin"
+)
+
+// Roles lists all roles, from least to most privileged.
+var Roles = []Role{RoleViewer, RoleOperator, RoleAdmin}
+
+func (r Role) rank() int {
+	for i, role := range Roles {
+		if r == role {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// IsValid returns whether r is one of the known roles.
+func (r Role) IsValid() bool {
+	return r.rank() > 0
+}
+
+// Allows returns whether a user with role r may do what requires role required.
+func (r Role) Allows(required Role) bool {
+	return r.IsValid() && r.rank() >= required.rank()
+}
+
+// User is a user of the web interface. The password is stored as a bcrypt hash or a secret reference.
+type User struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Role     Role   `json:"role"`
+}
+
+// dummyHash is compared against when the username is unknown, so that checking an unknown username takes as long as
+// checking a wrong password.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("door2doc"), bcrypt.DefaultCost)
+
+// isPasswordHash returns whether s is a bcrypt password hash.
+func isPasswordHash(s string) bool {
+	return strings.HasPrefix(s, "$2a$") || strings.HasPrefix(s, "$2b$") || strings.HasPrefix(s, "$2y$")
+}
+
+// hashPassword returns the bcrypt hash of a password. Empty passwords, hashes and secret references are returned as
+// is: the latter are resolved when the password is checked.
+func hashPassword(password string) (string, error) {
+	if password == "" || isPasswordHash(password) || secret.IsReference(password) {
+		return password, nil
+	}
+	bs, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// checkPassword returns whether password matches the stored hash or secret reference.
+func checkPassword(ctx context.Context, stored, password string) (bool, error) {
+	if isPasswordHash(stored) {
+		return bcrypt.CompareHashAndPassword([]byte(stored), []byte(password)) == nil, nil
+	}
+	if secret.IsReference(stored) {
+		want, err := secret.Resolve(ctx, stored)
+		if err != nil {
+			return false, err
+		}
+		return want != "" && subtle.ConstantTimeCompare([]byte(password), []byte(want)) == 1, nil
+	}
+	_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+	return false, nil
+}
+
+// AccessRequired returns whether users have to log in to access the web interface.
+func (c *Configuration) AccessRequired() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.users) > 0
+}
+
+// Users returns the users of the web interface.
+func (c *Configuration) Users() []User {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]User(nil), c.users...)
+}
+
+// UserRole returns the role of a user, or false if the user does not exist.
+func (c *Configuration) UserRole(username string) (Role, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for _, u := range c.users {
+		if u.Username == username {
+			return u.Role, true
+		}
+	}
+	return "", false
+}
+
+// SetUser adds a user, or updates the password and role of an existing user. The password is stored as a bcrypt hash,
+// unless it already is a hash or a secret reference. An empty password keeps the password of an existing user.
+func (c *Configuration) SetUser(username, password string, role Role) error {
+	username = strings.TrimSpace(username)
+	if username == "" {
+		return ErrUsernameRequired
+	}
+	if !role.IsValid() {
+		return ErrInvalidRole
+	}
+	hash, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	users := append([]User(nil), c.users...)
+	i := findUser(users, username)
+	if i < 0 {
+		if hash == "" {
+			return ErrPasswordRequired
+		}
+		users = append(users, User{Username: username})
+		i = len(users) - 1
+	}
+	if hash != "" {
+		users[i].Password = hash
+	}
+	users[i].Role = role
+
+	if !hasAdmin(users) {
+		return ErrNoAdministrator
+	}
+	c.users = users
+	return nil
+}
+
+// DeleteUser removes a user. The last administrator can only be removed together with all other users, which leaves
+// the web interface unsecured.
+func (c *Configuration) DeleteUser(username string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	i := findUser(c.users, username)
+	if i < 0 {
+		return nil
+	}
+	users := append(append([]User(nil), c.users[:i]...), c.users[i+1:]...)
+	if len(users) > 0 && !hasAdmin(users) {
+		return ErrNoAdministrator
+	}
+	c.users = users
+	return nil
+}
+
+// AuthenticateUser checks the credentials of a user of the web interface, and returns the role of the user if they are
+// valid. The check takes the same time regardless of whether the username or the password is wrong.
+func (c *Configuration) AuthenticateUser(ctx context.Context, username, password string) (Role, bool, error) {
+	var found *User
+	for _, u := range c.Users() {
+		if subtle.ConstantTimeCompare([]byte(u.Username), []byte(username)) == 1 {
+			u := u
+			found = &u
+		}
+	}
+	if found == nil {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return "", false, nil
+	}
+
+	ok, err := checkPassword(ctx, found.Password, password)
+	if err != nil || !ok {
+		return "", false, err
+	}
+	return found.Role, true, nil
+}
+
+func findUser(users []User, username string) int {
+	for i, u := range users {
+		if u.Username == username {
+			return i
+		}
+	}
+	return -1
+}
+
+func hasAdmin(users []User) bool {
+	for _, u := range users {
+		if u.Role == RoleAdmin {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveAccess stores the users, API tokens and access settings in the configuration file, like Save, but leaves the
+// other settings in the file as they are. Unlike other changes, access changes are saved even if the configuration is
+// invalid, for example because the database is unreachable, so that a deleted user or a revoked token does not come
+// back when the service restarts.
+func (c *Configuration) SaveAccess() error {
+	path := c.Path()
+	if path == "" {
+		return errors.New("failed to find configuration folder")
+	}
+	if err := c.loadSecrets(filepath.Dir(path)); err != nil {
+		return err
+	}
+	bs, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return c.Save()
+	}
+	if err != nil {
+		return err
+	}
+	stored, err := c.decode(bs)
+	if err != nil {
+		return errors.Wrap(err, "while reading configuration file")
+	}
+
+	c.mu.RLock()
+	users, tokens, basicAuth := c.users, c.tokens, c.accessBasicAuth
+	c.mu.RUnlock()
+	stored.mu.Lock()
+	stored.users, stored.tokens, stored.accessBasicAuth = users, tokens, basicAuth
+	stored.mu.Unlock()
+
+	if bs, err = json.MarshalIndent(stored, "", "  "); err != nil {
+		return err
+	}
+	return c.write(path, bs)
+}
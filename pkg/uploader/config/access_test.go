@@ -0,0 +1,219 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRoleAllows(t *testing.T) {
+	for name, test := range map[string]struct {
+		Role     Role
+		Required Role
+		Want     bool
+	}{
+		"viewer views":        {Role: RoleViewer, Required: RoleViewer, Want: true},
+		"viewer operates":     {Role: RoleViewer, Required: RoleOperator},
+		"operator operates":   {Role: RoleOperator, Required: RoleOperator, Want: true},
+		"operator configures": {Role: RoleOperator, Required: RoleAdmin},
+		"admin configures":    {Role: RoleAdmin, Required: RoleAdmin, Want: true},
+		"admin views":         {Role: RoleAdmin, Required: RoleViewer, Want: true},
+		"unknown role":        {Role: "root", Required: RoleViewer},
+		"empty role":          {Role: "", Required: RoleViewer},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := test.Role.Allows(test.Required); got != test.Want {
+				t.Errorf("Allows() == %v, got %v", test.Want, got)
+			}
+		})
+	}
+}
+
+func TestAuthenticateUser(t *testing.T) {
+	if err := os.Setenv("D2D_TEST_ACCESS_PASSWORD", "from-env"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("D2D_TEST_ACCESS_PASSWORD")
+
+	cfg := NewConfiguration()
+	for _, u := range []User{
+		{Username: "admin", Password: "secret", Role: RoleAdmin},
+		{Username: "desk", Password: "env:D2D_TEST_ACCESS_PASSWORD", Role: RoleViewer},
+	} {
+		if err := cfg.SetUser(u.Username, u.Password, u.Role); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, u := range cfg.Users() {
+		if u.Password == "secret" {
+			t.Errorf("Users() == hashed password, got %s", u.Password)
+		}
+	}
+
+	for name, test := range map[string]struct {
+		Username string
+		Password string
+		Want     Role
+		WantOK   bool
+	}{
+		"correct":                  {Username: "admin", Password: "secret", Want: RoleAdmin, WantOK: true},
+		"wrong password":           {Username: "admin", Password: "guess"},
+		"unknown user":             {Username: "root", Password: "secret"},
+		"empty password":           {Username: "admin"},
+		"reference":                {Username: "desk", Password: "from-env", Want: RoleViewer, WantOK: true},
+		"reference wrong password": {Username: "desk", Password: "secret"},
+		"password of other user":   {Username: "desk", Password: "secret"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			role, ok, err := cfg.AuthenticateUser(context.Background(), test.Username, test.Password)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ok != test.WantOK || role != test.Want {
+				t.Errorf("AuthenticateUser() == %s, %v; got %s, %v", test.Want, test.WantOK, role, ok)
+			}
+		})
+	}
+}
+
+func TestSetUser(t *testing.T) {
+	cfg := NewConfiguration()
+
+	if err := cfg.SetUser("desk", "secret", RoleViewer); err != ErrNoAdministrator {
+		t.Errorf("SetUser() of first user as viewer == ErrNoAdministrator, got %v", err)
+	}
+	if err := cfg.SetUser("admin", "", RoleAdmin); err != ErrPasswordRequired {
+		t.Errorf("SetUser() without password == ErrPasswordRequired, got %v", err)
+	}
+	if err := cfg.SetUser(" ", "secret", RoleAdmin); err != ErrUsernameRequired {
+		t.Errorf("SetUser() without username == ErrUsernameRequired, got %v", err)
+	}
+	if err := cfg.SetUser("admin", "secret", "root"); err != ErrInvalidRole {
+		t.Errorf("SetUser() with unknown role == ErrInvalidRole, got %v", err)
+	}
+
+	if err := cfg.SetUser("admin", "secret", RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.SetUser("desk", "secret", RoleViewer); err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.AccessRequired() {
+		t.Error("AccessRequired() == true, got false")
+	}
+
+	// an empty password keeps the current password
+	if err := cfg.SetUser("desk", "", RoleOperator); err != nil {
+		t.Fatal(err)
+	}
+	if role, ok, _ := cfg.AuthenticateUser(context.Background(), "desk", "secret"); !ok || role != RoleOperator {
+		t.Errorf("AuthenticateUser() == operator, true; got %s, %v", role, ok)
+	}
+
+	if err := cfg.SetUser("admin", "", RoleViewer); err != ErrNoAdministrator {
+		t.Errorf("SetUser() of last administrator as viewer == ErrNoAdministrator, got %v", err)
+	}
+	if err := cfg.DeleteUser("admin"); err != ErrNoAdministrator {
+		t.Errorf("DeleteUser() of last administrator == ErrNoAdministrator, got %v", err)
+	}
+	if err := cfg.DeleteUser("desk"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.DeleteUser("admin"); err != nil {
+		t.Fatal(err)
+	}
+	if cfg.AccessRequired() {
+		t.Error("AccessRequired() without users == false, got true")
+	}
+}
+
+func TestConfigurationJSONMigratesAccessCredentials(t *testing.T) {
+	legacy := []byte(`{"accessUsername": "admin", "accessPassword": "secret"}`)
+
+	var vars persistentConfig
+	if err := json.Unmarshal(legacy, &vars); err != nil {
+		t.Fatal(err)
+	}
+	if !vars.hasUnhashedAccessPassword() {
+		t.Error("hasUnhashedAccessPassword() == true, got false")
+	}
+
+	cfg := NewConfiguration()
+	if err := json.Unmarshal(legacy, cfg); err != nil {
+		t.Fatal(err)
+	}
+	users := cfg.Users()
+	if len(users) != 1 || users[0].Username != "admin" || users[0].Role != RoleAdmin || !isPasswordHash(users[0].Password) {
+		t.Errorf("Users() == [admin with hashed password], got %v", users)
+	}
+	if _, ok, err := cfg.AuthenticateUser(context.Background(), "admin", "secret"); !ok || err != nil {
+		t.Errorf("AuthenticateUser() == _, true, nil; got %v, %v", ok, err)
+	}
+
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vars = persistentConfig{}
+	if err := json.Unmarshal(bs, &vars); err != nil {
+		t.Fatal(err)
+	}
+	if vars.hasUnhashedAccessPassword() || vars.hasPlainTextSecrets() {
+		t.Errorf("MarshalJSON() == hashed passwords only, got %s", bs)
+	}
+}
+
+func TestSaveAccess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := NewConfiguration()
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	cfg.SetCredentials("user", "secret")
+	if err := cfg.SetUser("admin", "secret", RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.SetUser("viewer", "secret", RoleViewer); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cfg.CreateAPIToken("script", RoleViewer); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// the change of the username was not saved, for example because it made the configuration invalid
+	cfg.SetCredentials("unsaved", "secret")
+	if err := cfg.DeleteUser("viewer"); err != nil {
+		t.Fatal(err)
+	}
+	cfg.DeleteAPIToken("script")
+	if err := cfg.SaveAccess(); err != nil {
+		t.Fatal(err)
+	}
+
+	got := NewConfiguration()
+	got.SetPath(cfg.Path())
+	if err := got.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if username, _ := got.Credentials(); username != "user" {
+		t.Errorf("Credentials() after SaveAccess() == user, got %s", username)
+	}
+	if _, ok := got.UserRole("viewer"); ok {
+		t.Errorf("UserRole(viewer) after SaveAccess() == _, false, got true")
+	}
+	if _, ok := got.UserRole("admin"); !ok {
+		t.Errorf("UserRole(admin) after SaveAccess() == _, true, got false")
+	}
+	if tokens := got.APITokens(); len(tokens) != 0 {
+		t.Errorf("APITokens() after SaveAccess() == [], got %v", tokens)
+	}
+}
@@ -0,0 +1,217 @@
+package config
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/secret"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// BundleFormat identifies a configuration bundle.
+	BundleFormat = "door2doc-configuration"
+	// BundleVersion is the version of the bundle format that is exported.
+	BundleVersion = 1
+
+	// SecretsRemoved marks a bundle without secrets.
+	SecretsRemoved = "removed"
+	// SecretsEncrypted marks a bundle with secrets encrypted with a passphrase.
+	SecretsEncrypted = "encrypted"
+
+	saltSize = 16
+)
+
+// Bundle is a configuration exported for importing into another installation. Secrets are either removed, or
+// encrypted with a passphrase. Secret references are not secret themselves, and are always included as is.
+type Bundle struct {
+	Format   string    `json:"format"`
+	Version  int       `json:"version"`
+	Exported time.Time `json:"exported"`
+	Secrets  string    `json:"secrets"`
+	// Salt is the salt to derive the key to decrypt the secrets with from the passphrase.
+	Salt          string          `json:"salt,omitempty"`
+	Configuration json.RawMessage `json:"configuration"`
+}
+
+// passphraseBox returns the secret box to encrypt the secrets in a bundle with.
+func passphraseBox(passphrase string, salt []byte) (*secretBox, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, keySize)
+	if err != nil {
+		return nil, err
+	}
+	return newSecretBox(key)
+}
+
+// bundleSecrets returns pointers to all secrets in a bundled configuration, which include the password hashes of
+// users, as these would allow guessing the passwords.
+func bundleSecrets(vars *persistentConfig) []*string {
+	res := vars.secrets()
+	for i := range vars.Users {
+		res = append(res, &vars.Users[i].Password)
+	}
+	return res
+}
+
+// Export returns the configuration as a bundle. If passphrase is empty, secrets are removed from the bundle, and
+// users are left out; otherwise secrets are encrypted with the passphrase. Settings that are overridden by environment
+// variables are exported with their values from the configuration file.
+func (c *Configuration) Export(passphrase string) ([]byte, error) {
+	c.mu.RLock()
+	vars := c.stored()
+	c.mu.RUnlock()
+
+	vars.DsnPassword = vars.Dsn.Password
+	vars.Dsn.Password = ""
+	bundle := Bundle{
+		Format:   BundleFormat,
+		Version:  BundleVersion,
+		Exported: time.Now(),
+		Secrets:  SecretsRemoved,
+	}
+
+	if passphrase == "" {
+		vars.Users = nil
+		for _, s := range vars.secrets() {
+			if !secret.IsReference(*s) {
+				*s = ""
+			}
+		}
+	} else {
+		salt := make([]byte, saltSize)
+		if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+			return nil, errors.Wrap(err, "while generating salt")
+		}
+		box, err := passphraseBox(passphrase, salt)
+		if err != nil {
+			return nil, errors.Wrap(err, "while deriving key from passphrase")
+		}
+		for _, s := range bundleSecrets(&vars) {
+			if secret.IsReference(*s) {
+				continue
+			}
+			if *s, err = box.Encrypt(*s); err != nil {
+				return nil, errors.Wrap(err, "while encrypting secrets")
+			}
+		}
+		bundle.Secrets = SecretsEncrypted
+		bundle.Salt = base64.StdEncoding.EncodeToString(salt)
+	}
+
+	var err error
+	if bundle.Configuration, err = json.Marshal(vars); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(bundle, "", "  ")
+}
+
+// importBundle returns the configuration in a bundle, in the format of the configuration file and with secrets
+// decrypted. Secrets and users that are not in the bundle are taken from the current configuration. The database
+// password is only taken if the bundle is for the same database; otherwise it is returned in missing.
+func (c *Configuration) importBundle(bs []byte, passphrase string) (_ []byte, missing []string, _ error) {
+	var bundle Bundle
+	if err := json.Unmarshal(bs, &bundle); err != nil {
+		return nil, nil, ErrInvalidBundle
+	}
+	if bundle.Format != BundleFormat || len(bundle.Configuration) == 0 {
+		return nil, nil, ErrInvalidBundle
+	}
+	if bundle.Version > BundleVersion {
+		return nil, nil, ErrUnsupportedBundleVersion
+	}
+	var vars persistentConfig
+	if err := json.Unmarshal(bundle.Configuration, &vars); err != nil {
+		return nil, nil, ErrInvalidBundle
+	}
+
+	if bundle.Secrets == SecretsEncrypted {
+		if passphrase == "" {
+			return nil, nil, ErrPassphraseRequired
+		}
+		salt, err := base64.StdEncoding.DecodeString(bundle.Salt)
+		if err != nil {
+			return nil, nil, ErrInvalidBundle
+		}
+		box, err := passphraseBox(passphrase, salt)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "while deriving key from passphrase")
+		}
+		for _, s := range bundleSecrets(&vars) {
+			plain, err := box.Decrypt(*s)
+			if err == ErrSecretKeyMismatch {
+				return nil, nil, ErrWrongPassphrase
+			}
+			if err != nil {
+				return nil, nil, err
+			}
+			*s = plain
+		}
+	}
+
+	c.mu.RLock()
+	current := c.persistent()
+	c.mu.RUnlock()
+	if vars.Password == "" {
+		vars.Password = current.Password
+	}
+	if vars.ProxyPassword == "" {
+		vars.ProxyPassword = current.ProxyPassword
+	}
+	if vars.DsnPassword == "" && vars.Dsn.Password == "" {
+		// the password of another database must not be sent to the one in the bundle
+		if sameDatabase(vars.Dsn, current.Dsn) {
+			vars.DsnPassword = current.Dsn.Password
+		} else if vars.Dsn.Username != "" {
+			missing = append(missing, "dsnPassword")
+		}
+	}
+	if len(vars.Users) == 0 {
+		vars.Users = current.Users
+		vars.AccessBasicAuth = current.AccessBasicAuth
+	}
+	res, err := json.Marshal(vars)
+	return res, missing, err
+}
+
+// sameDatabase returns whether a and b connect to the same database.
+func sameDatabase(a, b db.ConnectionData) bool {
+	return a.Driver == b.Driver && strings.EqualFold(a.Host, b.Host) && a.Database == b.Database
+}
+
+// PreviewImport returns the fields of the configuration after importing a bundle, like Fields does for the current
+// configuration, the secrets that are in neither the bundle nor the current configuration, and the results of
+// validating it, so that the import can be reviewed before it is applied. The current configuration is not changed.
+func (c *Configuration) PreviewImport(ctx context.Context, bs []byte, passphrase string) (map[string]string, []string, *ValidationResult, error) {
+	imported, missing, err := c.importBundle(bs, passphrase)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	candidate, err := c.decode(imported)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	candidate.UpdateBaseValidation(ctx)
+	return candidate.Fields(), missing, candidate.Validate(), nil
+}
+
+// Import replaces the configuration by the one in a bundle, which is validated first. The configuration is only
+// replaced if it is valid, and is saved as a new version.
+func (c *Configuration) Import(ctx context.Context, bs []byte, passphrase string) error {
+	imported, _, err := c.importBundle(bs, passphrase)
+	if err != nil {
+		return err
+	}
+	if err := c.apply(ctx, imported); err != nil {
+		return err
+	}
+	dlog.Info("Imported configuration bundle")
+	return c.Save()
+}
@@ -0,0 +1,185 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+)
+
+func TestBundle(t *testing.T) {
+	source := NewConfiguration()
+	source.SetCredentials(TestUser, TestPassword)
+	source.SetConnection(db.ConnectionData{Driver: "postgres", Host: "localhost", Username: "pguser", Password: "env:D2D_TEST_DB_PASSWORD"})
+	source.SetVisitorQuery(`select * from correct`)
+	if err := source.SetUser("admin", "secret", RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err := source.Export("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(removed), TestPassword) || strings.Contains(string(removed), "admin") {
+		t.Errorf("Export() without passphrase removes secrets and users, got %s", removed)
+	}
+	encrypted, err := source.Export("passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(encrypted), TestPassword) {
+		t.Errorf("Export() with passphrase encrypts secrets, got %s", encrypted)
+	}
+	source.SetConnection(db.ConnectionData{Driver: "postgres", Host: "localhost", Database: "seh", Username: "pguser", Password: "db-secret"})
+	plainPassword, err := source.Export("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	newer, err := json.Marshal(Bundle{Format: BundleFormat, Version: BundleVersion + 1, Configuration: json.RawMessage(`{}`)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, test := range map[string]struct {
+		Bundle      []byte
+		Passphrase  string
+		Connection  db.ConnectionData
+		Want        map[string]string
+		WantMissing []string
+		WantErr     error
+	}{
+		"secrets removed": {
+			Bundle: removed,
+			Want: map[string]string{
+				"query":       `select * from correct`,
+				"username":    TestUser,
+				"password":    "current-password",
+				"dsnPassword": "env:D2D_TEST_DB_PASSWORD",
+				// users are kept when the bundle has none
+				"users.current.role": string(RoleAdmin),
+			},
+		},
+		"secrets encrypted": {
+			Bundle:     encrypted,
+			Passphrase: "passphrase",
+			Want: map[string]string{
+				"password":         TestPassword,
+				"dsnPassword":      "env:D2D_TEST_DB_PASSWORD",
+				"users.admin.role": string(RoleAdmin),
+			},
+		},
+		"same database": {
+			Bundle:     plainPassword,
+			Connection: db.ConnectionData{Driver: "postgres", Host: "LOCALHOST", Database: "seh", Username: "other", Password: "current-db-secret"},
+			Want:       map[string]string{"dsnPassword": "current-db-secret"},
+		},
+		"other database": {
+			Bundle:      plainPassword,
+			Connection:  db.ConnectionData{Driver: "postgres", Host: "elsewhere", Database: "seh", Username: "pguser", Password: "current-db-secret"},
+			Want:        map[string]string{"dsnPassword": ""},
+			WantMissing: []string{"dsnPassword"},
+		},
+		"passphrase missing":  {Bundle: encrypted, WantErr: ErrPassphraseRequired},
+		"passphrase wrong":    {Bundle: encrypted, Passphrase: "guess", WantErr: ErrWrongPassphrase},
+		"not a bundle":        {Bundle: []byte(`{"username": "user"}`), WantErr: ErrInvalidBundle},
+		"not JSON":            {Bundle: []byte(`username=user`), WantErr: ErrInvalidBundle},
+		"newer bundle format": {Bundle: newer, WantErr: ErrUnsupportedBundleVersion},
+	} {
+		t.Run(name, func(t *testing.T) {
+			target := NewConfiguration()
+			target.SetCredentials("current-user", "current-password")
+			if err := target.SetUser("current", "secret", RoleAdmin); err != nil {
+				t.Fatal(err)
+			}
+			if test.Connection != (db.ConnectionData{}) {
+				target.SetConnection(test.Connection)
+			}
+
+			imported, missing, err := target.importBundle(test.Bundle, test.Passphrase)
+			if err != test.WantErr {
+				t.Fatalf("importBundle() == _, _, %v, got %v", test.WantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if !reflect.DeepEqual(missing, test.WantMissing) {
+				t.Errorf("importBundle() == _, %v, _, got %v", test.WantMissing, missing)
+			}
+			candidate, err := target.decode(imported)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := candidate.Fields()
+			for field, want := range test.Want {
+				if got[field] != want {
+					t.Errorf("Fields()[%q] after import == %q, got %q", field, want, got[field])
+				}
+			}
+			if got := target.VisitorQuery(); got != "" {
+				t.Errorf("VisitorQuery() before applying the import == empty, got %q", got)
+			}
+		})
+	}
+}
+
+func TestImport(t *testing.T) {
+	srv := httptest.NewServer(DummyHandler())
+	defer srv.Close()
+
+	sqlitePath, cleanup := createSQLite(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	source := NewConfiguration()
+	source.SetEnvironments([]Environment{{Name: "test", URL: srv.URL}}, "test")
+	source.SetConnection(db.ConnectionData{Driver: "sqlite3", Path: sqlitePath})
+	source.SetCredentials(TestUser, TestPassword)
+	source.SetVisitorQuery(`select * from correct`)
+	valid, err := source.Export("passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+	source.SetVisitorQuery(`select * from missing`)
+	invalid, err := source.Export("passphrase")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	target := NewConfiguration()
+	target.SetPath(filepath.Join(dir, "door2doc.json"))
+	target.SetVisitorQuery(`select 1`)
+	if err := target.Import(ctx, invalid, "passphrase"); err == nil {
+		t.Errorf("Import() of invalid configuration == *InvalidConfigurationError, got %v", err)
+	} else if _, ok := err.(*InvalidConfigurationError); !ok {
+		t.Errorf("Import() of invalid configuration == *InvalidConfigurationError, got %v", err)
+	}
+	if got := target.VisitorQuery(); got != `select 1` {
+		t.Errorf("VisitorQuery() after rejected import == %q, got %q", `select 1`, got)
+	}
+
+	if err := target.Import(ctx, valid, "passphrase"); err != nil {
+		t.Fatal(err)
+	}
+	if got := target.VisitorQuery(); got != `select * from correct` {
+		t.Errorf("VisitorQuery() after import == %q, got %q", `select * from correct`, got)
+	}
+	if versions, err := listVersions(dir); err != nil || len(versions) != 1 {
+		t.Errorf("listVersions() after import == 1 version, got %d, %v", len(versions), err)
+	}
+}
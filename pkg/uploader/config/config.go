@@ -2,6 +2,7 @@ package config
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/json"
 	"html/template"
@@ -9,18 +10,20 @@ import (
 	"io/ioutil"
 	"net/http"
 	"os"
+	"path/filepath"
 	"sync"
 	"time"
 
 	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/secret"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/trace"
 	"github.com/pkg/errors"
 	"github.com/shibukawa/configdir"
 )
 
 var (
-	Server     = "https://integration.door2doc.net/"
 	configDirs = configdir.New("door2doc", "Upload Service")
 )
 
@@ -35,6 +38,15 @@ const (
 	config = "door2doc.json"
 )
 
+// Folder returns the folder the configuration is stored in, or an empty string if there is none.
+func Folder() string {
+	folders := configDirs.QueryFolders(configdir.System)
+	if len(folders) == 0 {
+		return ""
+	}
+	return folders[0].Path
+}
+
 type QueryResult interface {
 	AsTable() template.HTML
 }
@@ -75,18 +87,36 @@ func (v *ValidationResult) IsValid() bool {
 		v.D2DCredentials == nil
 }
 
+// Err returns the first fatal validation error, or nil if the configuration is valid.
+func (v *ValidationResult) Err() error {
+	for _, err := range []error{v.DatabaseConnection, v.QueryTimeout, v.VisitorQuery, v.D2DConnection, v.D2DCredentials} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Configuration contains the configuration options for the service.
 type Configuration struct {
 	mu sync.RWMutex
+	// serializes validations, which run without holding mu, so that their results are stored in order
+	validateMu sync.Mutex
 
 	// username to connect to the d2d upload service
 	username string
 	// password to connect to the d2d upload service
 	password string
+	// door2doc environments that can be uploaded to
+	environments []Environment
+	// name of the environment to upload to
+	environment string
 	// database connection data
 	connection db.ConnectionData
 	// database timeout
 	timeout time.Duration
+	// database connection pool limits
+	pool db.PoolSettings
 	// Query to execute to retrieve visitor information
 	visitorQuery string
 	// Query to execute to retrieve radiologie aanvragen
@@ -95,30 +125,97 @@ type Configuration struct {
 	labQuery string
 	// Query to execute to retrieve intercollegiaal consulten
 	consultQuery string
-	// Set to true if the service should be active
+	// Per-dataset options for running the queries
+	queryOptions map[Dataset]db.QueryOptions
+	// Set to true if the configuration is valid
 	active bool
+	// Set to false if uploads have been disabled in the configuration file
+	enabled bool
+	// Set to true if uploads have been paused by an operator
+	paused bool
 	// Pause between runs
 	interval time.Duration
-	// proxy server to use for all HTTP requests
-	proxy string
-
-	// username to access the web interface
-	accessUsername string
-	// password to access the web interface
-	accessPassword string
+	// How long uploads are kept in the history
+	historyRetention time.Duration
+	// Number of versions of the configuration file that are kept
+	maxVersions int
+	// proxy settings to use for all HTTP requests
+	proxy rest.Proxy
+	// base URL of the OTLP/HTTP collector to send traces to; if empty, tracing is off
+	tracingEndpoint string
+
+	// users of the web interface; if empty, the web interface is accessible without logging in
+	users []User
+	// whether scripts may access the web interface with HTTP basic authentication instead of logging in
+	accessBasicAuth bool
+	// tokens that grant scripts access to the JSON API
+	tokens []APIToken
 
 	// results of the last call to UpdateValidation
 	validationResult *ValidationResult
+
+	// encrypts the secrets in the configuration file; nil until the key file has been loaded
+	secrets *secretBox
+
+	// path of the configuration file; if empty, the file in the configuration folder is used
+	path string
+	// settings that are overridden by environment variables, and their values in the configuration file
+	locked []lockedSetting
+	file   persistentConfig
+
+	// checksum of the contents of the configuration file when it was last read or written
+	sum [sha256.Size]byte
+	// result of the last reload after the configuration file changed on disk
+	lastReload *ReloadStatus
+	// set for copies of the configuration that are not in use, which leave the details for remote logging alone
+	detached bool
 }
 
 func NewConfiguration() *Configuration {
 	return &Configuration{
 		active:   true,
+		enabled:  true,
 		interval: time.Minute,
-		timeout:  5 * time.Second,
+		// the default of the configuration file, in days
+		historyRetention: 30 * 24 * time.Hour,
+		maxVersions:      DefaultMaxVersions,
+		timeout:          5 * time.Second,
+		pool:             db.DefaultPoolSettings(),
+		environments:     DefaultEnvironments(),
+		environment:      EnvironmentProduction,
 	}
 }
 
+// Path returns the path of the configuration file, or an empty string if there is no configuration folder.
+func (c *Configuration) Path() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.path != "" {
+		return c.path
+	}
+	if dir := Folder(); dir != "" {
+		return filepath.Join(dir, config)
+	}
+	return ""
+}
+
+// SetPath sets the path of the configuration file, instead of the file in the configuration folder. The key to encrypt
+// secrets with, the versions and the audit log are kept in the folder of the file.
+func (c *Configuration) SetPath(path string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.path = path
+}
+
+// Dir returns the folder of the configuration file, or an empty string if there is none.
+func (c *Configuration) Dir() string {
+	if path := c.Path(); path != "" {
+		return filepath.Dir(path)
+	}
+	return ""
+}
+
 // Credentials returns the door2doc credentials stored in the configuration.
 func (c *Configuration) Credentials() (string, string) {
 	c.mu.RLock()
@@ -133,19 +230,56 @@ func (c *Configuration) SetCredentials(username, password string) {
 
 	c.username = username
 	c.password = password
-	dlog.SetUsername(username)
+	c.enforceLocked()
+	c.setLogDetails()
+}
+
+// Environments returns the door2doc environments that can be uploaded to.
+func (c *Configuration) Environments() []Environment {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	res := make([]Environment, len(c.environments))
+	copy(res, c.environments)
+	return res
+}
+
+// Environment returns the door2doc environment that is uploaded to.
+func (c *Configuration) Environment() Environment {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return findEnvironment(c.environments, c.environment)
 }
 
-func (c *Configuration) Proxy() string {
+// Server returns the URL of the door2doc environment that is uploaded to.
+func (c *Configuration) Server() string {
+	return c.Environment().URL
+}
+
+// SetEnvironments updates the available door2doc environments, and selects the one to upload to. The production
+// environment is always available.
+func (c *Configuration) SetEnvironments(envs []Environment, selected string) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
+
+	c.environments = normalizeEnvironments(envs)
+	c.environment = findEnvironment(c.environments, selected).Name
+	c.enforceLocked()
+	c.setLogDetails()
+}
+
+// Proxy returns the proxy settings used for all HTTP requests.
+func (c *Configuration) Proxy() rest.Proxy {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
 	return c.proxy
 }
 
-func (c *Configuration) SetProxy(proxy string) {
+func (c *Configuration) SetProxy(proxy rest.Proxy) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 	c.proxy = proxy
+	c.enforceLocked()
 }
 
 // Connection returns the connection data stored in the configuration.
@@ -160,6 +294,7 @@ func (c *Configuration) SetConnection(cd db.ConnectionData) {
 	defer c.mu.Unlock()
 
 	c.connection = cd
+	c.enforceLocked()
 }
 
 func (c *Configuration) SetDSN(driver, dsn string) {
@@ -167,6 +302,7 @@ func (c *Configuration) SetDSN(driver, dsn string) {
 	defer c.mu.Unlock()
 
 	_ = c.connection.UnmarshalText([]byte(dsn))
+	c.enforceLocked()
 }
 
 // Timeout returns the timeout used for all queries
@@ -181,6 +317,22 @@ func (c *Configuration) SetTimeout(timeout time.Duration) {
 	defer c.mu.Unlock()
 
 	c.timeout = timeout
+	c.enforceLocked()
+}
+
+// Pool returns the limits of the database connection pool.
+func (c *Configuration) Pool() db.PoolSettings {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.pool
+}
+
+func (c *Configuration) SetPool(pool db.PoolSettings) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.pool = pool
+	c.enforceLocked()
 }
 
 // VisitorQuery returns the visitor query stored in the configuration.
@@ -195,6 +347,7 @@ func (c *Configuration) SetVisitorQuery(query string) {
 	defer c.mu.Unlock()
 
 	c.visitorQuery = query
+	c.enforceLocked()
 }
 
 // RadiologieQuery returns the radiologie query stored in the configuration.
@@ -209,6 +362,7 @@ func (c *Configuration) SetRadiologieQuery(query string) {
 	defer c.mu.Unlock()
 
 	c.radiologieQuery = query
+	c.enforceLocked()
 }
 
 // LabQuery returns the lab query stored in the configuration.
@@ -223,6 +377,7 @@ func (c *Configuration) SetLabQuery(query string) {
 	defer c.mu.Unlock()
 
 	c.labQuery = query
+	c.enforceLocked()
 }
 
 // ConsultQuery returns the consult query stored in the configuration.
@@ -237,31 +392,82 @@ func (c *Configuration) SetConsultQuery(query string) {
 	defer c.mu.Unlock()
 
 	c.consultQuery = query
+	c.enforceLocked()
 }
 
-func (c *Configuration) AccessCredentials() (username, password string) {
+// QueryOptions returns the options for running the query of the given dataset.
+func (c *Configuration) QueryOptions(ds Dataset) db.QueryOptions {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	return c.accessUsername, c.accessPassword
+	return c.queryOptions[ds]
 }
 
-func (c *Configuration) SetAccessCredentials(username, password string) {
+func (c *Configuration) SetQueryOptions(ds Dataset, opts db.QueryOptions) {
 	c.mu.Lock()
-	c.mu.Unlock()
+	defer c.mu.Unlock()
 
-	if username == "" || password == "" {
-		username = ""
-		password = ""
+	if c.queryOptions == nil {
+		c.queryOptions = make(map[Dataset]db.QueryOptions)
 	}
-	c.accessUsername = username
-	c.accessPassword = password
+	if opts == (db.QueryOptions{}) {
+		delete(c.queryOptions, ds)
+	} else {
+		c.queryOptions[ds] = opts
+	}
+	c.enforceLocked()
+}
+
+// QueryTimeout returns the effective timeout of the query of the given dataset.
+func (c *Configuration) QueryTimeout(ds Dataset) time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.queryOptions[ds].TimeoutOr(c.timeout)
+}
+
+// AccessBasicAuth returns whether the web interface accepts HTTP basic authentication, for use by scripts.
+func (c *Configuration) AccessBasicAuth() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.accessBasicAuth
 }
 
+func (c *Configuration) SetAccessBasicAuth(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.accessBasicAuth = enabled
+	c.enforceLocked()
+}
+
+// Active returns whether uploads should run: the configuration is valid, uploads are enabled in the configuration file,
+// and uploads have not been paused.
 func (c *Configuration) Active() bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	return c.active
+	return c.active && c.enabled && !c.paused
+}
+
+// Enabled returns whether uploads are enabled in the configuration file.
+func (c *Configuration) Enabled() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.enabled
+}
+
+// Paused returns whether uploads have been paused by an operator.
+func (c *Configuration) Paused() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.paused
+}
+
+func (c *Configuration) SetPaused(paused bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.paused = paused
 }
 
 func (c *Configuration) Interval() time.Duration {
@@ -271,83 +477,202 @@ func (c *Configuration) Interval() time.Duration {
 	return c.interval
 }
 
-// UpdateBaseValidation validates the base configuration and returns the results of those checks.
-func (c *Configuration) UpdateBaseValidation(ctx context.Context) {
+func (c *Configuration) SetInterval(interval time.Duration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	c.interval = interval
+	c.enforceLocked()
+}
+
+// HistoryRetention returns how long uploads are kept in the history.
+func (c *Configuration) HistoryRetention() time.Duration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.historyRetention
+}
+
+func (c *Configuration) SetHistoryRetention(retention time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.historyRetention = retention
+	c.enforceLocked()
+}
+
+// MaxVersions returns the number of versions of the configuration file that are kept.
+func (c *Configuration) MaxVersions() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.maxVersions
+}
+
+func (c *Configuration) SetMaxVersions(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.maxVersions = n
+	c.enforceLocked()
+}
+
+// TracingEndpoint returns the base URL of the OpenTelemetry collector that traces are sent to, or an empty string if
+// tracing is off.
+func (c *Configuration) TracingEndpoint() string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.tracingEndpoint
+}
+
+func (c *Configuration) SetTracingEndpoint(endpoint string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.tracingEndpoint = endpoint
+	c.enforceLocked()
+}
+
+// SetEnabled enables or disables uploads in the configuration file.
+func (c *Configuration) SetEnabled(enabled bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.enabled = enabled
+	c.enforceLocked()
+}
+
+// UpdateBaseValidation validates the base configuration and returns the results of those checks. The configuration
+// is not locked while the connections are checked.
+func (c *Configuration) UpdateBaseValidation(ctx context.Context) {
+	c.validateMu.Lock()
+	defer c.validateMu.Unlock()
+
+	ctx, span := trace.Start(ctx, "validate", trace.KindInternal)
+	defer span.End()
+
+	c.mu.RLock()
+	v, query, opts := c.validated(), c.visitorQuery, c.queryOptions[DatasetVisitor]
+	hasUsers := len(c.users) > 0
+	c.mu.RUnlock()
+
 	res := &ValidationResult{}
 
 	// check d2d connection
 	connCtx, timeout := context.WithTimeout(ctx, DBValidationTimeout)
 	defer timeout()
 
-	res.D2DConnection, res.D2DCredentials = c.checkConnection(connCtx)
+	res.D2DConnection, res.D2DCredentials = v.checkConnection(connCtx)
 
-	if c.accessUsername == "" && c.accessPassword == "" {
+	if !hasUsers {
 		res.Access = ErrAccessNotConfigured
 	}
 
 	// check timeout
-	if c.timeout <= 0 {
+	if v.timeout <= 0 {
 		res.QueryTimeout = ErrInvalidTimeout
 	}
 
 	// check db connection
-	res.VisitorQueryDuration, res.VisitorQueryResults, res.DatabaseConnection, res.VisitorQuery = c.checkDatabase(ctx, c.visitorQuery, func(ctx context.Context, tx *sql.Tx, query string) (QueryResult, error) {
-		return db.ExecuteVisitorQuery(ctx, tx, query, c.timeout)
+	res.VisitorQueryDuration, res.VisitorQueryResults, res.DatabaseConnection, res.VisitorQuery = v.checkDatabase(ctx, DatasetVisitor, query, opts, func(ctx context.Context, tx *sql.Tx, query string, timeout time.Duration) (QueryResult, error) {
+		return db.ExecuteVisitorQuery(ctx, tx, query, timeout)
 	})
 
+	c.mu.Lock()
 	c.validationResult = res
-	c.active = c.validationResult.IsValid()
+	c.active = res.IsValid()
+	c.mu.Unlock()
+	span.SetAttribute("d2d.valid", res.IsValid())
+	span.SetError(res.Err())
 }
 
 // UpdateRadiologieValidation validates the order configuration and returns the results of those checks.
 func (c *Configuration) UpdateRadiologieValidation(ctx context.Context) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.validateMu.Lock()
+	defer c.validateMu.Unlock()
 
-	if c.validationResult == nil {
-		c.validationResult = new(ValidationResult)
-	}
-	res := c.validationResult
+	c.mu.RLock()
+	v, query, opts := c.validated(), c.radiologieQuery, c.queryOptions[DatasetRadiologie]
+	c.mu.RUnlock()
 
 	// check db connection
-	res.RadiologieQueryDuration, res.RadiologieQueryResults, res.DatabaseConnection, res.RadiologieQuery = c.checkDatabase(ctx, c.radiologieQuery, func(ctx context.Context, tx *sql.Tx, query string) (QueryResult, error) {
-		return db.ExecuteRadiologieQuery(ctx, tx, query, c.timeout)
+	duration, results, connErr, queryErr := v.checkDatabase(ctx, DatasetRadiologie, query, opts, func(ctx context.Context, tx *sql.Tx, query string, timeout time.Duration) (QueryResult, error) {
+		return db.ExecuteRadiologieQuery(ctx, tx, query, timeout)
 	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res := c.lastValidation()
+	res.RadiologieQueryDuration, res.RadiologieQueryResults, res.DatabaseConnection, res.RadiologieQuery = duration, results, connErr, queryErr
+	c.validationResult = res
 }
 
 // UpdateLabValidation validates the order configuration and returns the results of those checks.
 func (c *Configuration) UpdateLabValidation(ctx context.Context) {
-	c.mu.Lock()
-	defer c.mu.Unlock()
+	c.validateMu.Lock()
+	defer c.validateMu.Unlock()
 
-	if c.validationResult == nil {
-		c.validationResult = new(ValidationResult)
-	}
-	res := c.validationResult
+	c.mu.RLock()
+	v, query, opts := c.validated(), c.labQuery, c.queryOptions[DatasetLab]
+	c.mu.RUnlock()
 
 	// check db connection
-	res.LabQueryDuration, res.LabQueryResults, res.DatabaseConnection, res.LabQuery = c.checkDatabase(ctx, c.labQuery, func(ctx context.Context, tx *sql.Tx, query string) (QueryResult, error) {
-		return db.ExecuteLabQuery(ctx, tx, query, c.timeout)
+	duration, results, connErr, queryErr := v.checkDatabase(ctx, DatasetLab, query, opts, func(ctx context.Context, tx *sql.Tx, query string, timeout time.Duration) (QueryResult, error) {
+		return db.ExecuteLabQuery(ctx, tx, query, timeout)
 	})
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res := c.lastValidation()
+	res.LabQueryDuration, res.LabQueryResults, res.DatabaseConnection, res.LabQuery = duration, results, connErr, queryErr
+	c.validationResult = res
 }
 
 // UpdateConsultValidation validates the order configuration and returns the results of those checks.
 func (c *Configuration) UpdateConsultValidation(ctx context.Context) {
+	c.validateMu.Lock()
+	defer c.validateMu.Unlock()
+
+	c.mu.RLock()
+	v, query, opts := c.validated(), c.consultQuery, c.queryOptions[DatasetConsult]
+	c.mu.RUnlock()
+
+	// check db connection
+	duration, results, connErr, queryErr := v.checkDatabase(ctx, DatasetConsult, query, opts, func(ctx context.Context, tx *sql.Tx, query string, timeout time.Duration) (QueryResult, error) {
+		return db.ExecuteConsultQuery(ctx, tx, query, timeout)
+	})
+
 	c.mu.Lock()
 	defer c.mu.Unlock()
+	res := c.lastValidation()
+	res.ConsultQueryDuration, res.ConsultQueryResults, res.DatabaseConnection, res.ConsultQuery = duration, results, connErr, queryErr
+	c.validationResult = res
+}
 
-	if c.validationResult == nil {
-		c.validationResult = new(ValidationResult)
+// validated returns a detached copy of the settings that are used to check the connections, so that the checks can
+// run without holding the lock. The caller must hold the lock.
+func (c *Configuration) validated() *Configuration {
+	return &Configuration{
+		username:     c.username,
+		password:     c.password,
+		environments: append([]Environment(nil), c.environments...),
+		environment:  c.environment,
+		connection:   c.connection,
+		timeout:      c.timeout,
+		proxy:        c.proxy,
+		detached:     true,
 	}
-	res := c.validationResult
+}
 
-	// check db connection
-	res.ConsultQueryDuration, res.ConsultQueryResults, res.DatabaseConnection, res.ConsultQuery = c.checkDatabase(ctx, c.consultQuery, func(ctx context.Context, tx *sql.Tx, query string) (QueryResult, error) {
-		return db.ExecuteConsultQuery(ctx, tx, query, c.timeout)
-	})
+// lastValidation returns a copy of the result of the last validation, to update with the result of validating a
+// single query. Results that were handed out by Validate are never changed. The caller must hold the lock.
+func (c *Configuration) lastValidation() *ValidationResult {
+	res := new(ValidationResult)
+	if c.validationResult != nil {
+		*res = *c.validationResult
+	}
+	return res
 }
 
 // Validate returns the result of the last validation.
@@ -357,76 +682,278 @@ func (c *Configuration) Validate() *ValidationResult {
 	return c.validationResult
 }
 
-// Reload loads the configuration form a well-known location and updates the values accordingly.
+// Reload loads the configuration from the configuration file and updates the values accordingly. Settings are
+// overridden by environment variables. Secrets that are still stored in plain text are encrypted, and access passwords
+// are hashed, by saving the configuration again.
 func (c *Configuration) Reload() error {
-	folders := configDirs.QueryFolders(configdir.System)
-	if len(folders) == 0 {
-		return nil
+	path := c.Path()
+	if path == "" {
+		return c.loadEnvironment()
+	}
+	dir := filepath.Dir(path)
+	if err := c.loadSecrets(dir); err != nil {
+		return err
 	}
-	bs, err := folders[0].ReadFile(config)
+	bs, err := ioutil.ReadFile(path)
 	if err != nil {
 		if os.IsNotExist(err) {
-			return nil
+			return c.loadEnvironment()
 		}
 		return err
 	}
-	if err := json.Unmarshal(bs, &c); err != nil {
+	// decoded directly rather than with json.Unmarshal, so that errors refer to the lines of the file as is
+	if err := c.UnmarshalJSON(bs); err != nil {
+		return err
+	}
+	c.setSum(bs)
+
+	var vars persistentConfig
+	if err := json.Unmarshal(bs, &vars); err != nil {
+		return err
+	}
+	if vars.hasPlainTextSecrets() || vars.hasUnhashedAccessPassword() {
+		dlog.Info("Encrypting secrets and hashing passwords in %s", path)
+		return c.Save()
+	}
+	// configuration files from before the introduction of versions can be rolled back to as well
+	if versions, err := listVersions(dir); err == nil && len(versions) == 0 {
+		if err := writeVersion(dir, bs, time.Now(), c.MaxVersions()); err != nil {
+			dlog.Warning("Failed to keep a copy of the configuration: %v", err)
+		}
+	}
+	return nil
+}
+
+// loadEnvironment overrides the current configuration with environment variables, for when there is no configuration
+// file.
+func (c *Configuration) loadEnvironment() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vars := c.persistent()
+	if err := c.overrideEnvironment(&vars); err != nil {
 		return err
 	}
+	c.setLogDetails()
 	return nil
 }
 
-// Save stores the latest configuration values to a well-known location.
+// setSum records the checksum of the contents of the configuration file, and returns the previous one.
+func (c *Configuration) setSum(bs []byte) [sha256.Size]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	previous := c.sum
+	c.sum = sha256.Sum256(bs)
+	return previous
+}
+
+// loadSecrets loads the key to encrypt secrets with from dir, creating it if required.
+func (c *Configuration) loadSecrets(dir string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.secrets != nil {
+		return nil
+	}
+	secrets, err := loadSecretBox(dir)
+	if err != nil {
+		return errors.Wrap(err, "while loading key to encrypt secrets")
+	}
+	c.secrets = secrets
+	return nil
+}
+
+// Save stores the latest configuration values in the configuration file. Values of settings that are overridden by
+// environment variables are not stored. A copy is kept as a new version, so that the configuration can be rolled back.
 func (c *Configuration) Save() error {
-	c.mu.RLock()
-	defer c.mu.RUnlock()
+	path := c.Path()
+	if path == "" {
+		return errors.New("failed to find configuration folder")
+	}
+	dir := filepath.Dir(path)
+	if err := c.loadSecrets(dir); err != nil {
+		return err
+	}
 
 	bs, err := json.MarshalIndent(c, "", "  ")
 	if err != nil {
 		return err
 	}
+	return c.write(path, bs)
+}
 
-	folders := configDirs.QueryFolders(configdir.System)
-	if len(folders) == 0 {
-		return errors.New("failed to find configuration folder")
+// write stores bs as the configuration file at path, and keeps a copy as a new version.
+func (c *Configuration) write(path string, bs []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return errors.Wrap(err, "while creating configuration folder")
 	}
-	if err := folders[0].WriteFile(config, bs); err != nil {
+	// the checksum is updated first, so that the file is not reloaded as if it was changed by someone else
+	previous := c.setSum(bs)
+	if err := ioutil.WriteFile(path, bs, 0644); err != nil {
+		c.mu.Lock()
+		c.sum = previous
+		c.mu.Unlock()
 		return errors.Wrap(err, "while writing configuration file")
 	}
-	dlog.Info("Updated %s/%s", folders[0].Path, config)
+	dlog.Info("Updated %s", path)
+
+	if err := writeVersion(dir, bs, time.Now(), c.MaxVersions()); err != nil {
+		return errors.Wrap(err, "while keeping a copy of the configuration")
+	}
 	return nil
 }
 
 type persistentConfig struct {
-	Username        string            `json:"username"`
-	Password        string            `json:"password"`
-	Proxy           string            `json:"proxy"`
-	Dsn             db.ConnectionData `json:"dsn"`
-	Timeout         int               `json:"timeout"`
-	VisitorQuery    string            `json:"query"`
-	RadiologieQuery string            `json:"radiologie"`
-	LabQuery        string            `json:"lab"`
-	ConsultQuery    string            `json:"consult"`
-	AccessUsername  string            `json:"accessUsername"`
-	AccessPassword  string            `json:"accessPassword"`
+	Schema          int                                `json:"schema"`
+	Username        string                             `json:"username"`
+	Password        string                             `json:"password"`
+	Environment     string                             `json:"environment"`
+	Environments    []Environment                      `json:"environments"`
+	Proxy           string                             `json:"proxy"`
+	ProxyMode       string                             `json:"proxyMode"`
+	ProxyUsername   string                             `json:"proxyUsername"`
+	ProxyPassword   string                             `json:"proxyPassword"`
+	NoProxy         string                             `json:"noProxy"`
+	Dsn             db.ConnectionData                  `json:"dsn"`
+	DsnPassword     string                             `json:"dsnPassword,omitempty"`
+	Timeout         int                                `json:"timeout"`
+	MaxOpen         int                                `json:"maxOpenConnections"`
+	MaxIdle         int                                `json:"maxIdleConnections"`
+	MaxLifetime     int                                `json:"connectionLifetime"`
+	VisitorQuery    string                             `json:"query"`
+	RadiologieQuery string                             `json:"radiologie"`
+	LabQuery        string                             `json:"lab"`
+	ConsultQuery    string                             `json:"consult"`
+	QueryOptions    map[Dataset]persistentQueryOptions `json:"queryOptions,omitempty"`
+	Users           []User                             `json:"users,omitempty"`
+	Tokens          []APIToken                         `json:"tokens,omitempty"`
+	AccessBasicAuth bool                               `json:"accessBasicAuth"`
+	Interval        int                                `json:"interval"`
+	Active          bool                               `json:"active"`
+	Paused          bool                               `json:"paused"`
+	TracingEndpoint string                             `json:"tracingEndpoint,omitempty"`
+	// HistoryRetention is in days.
+	HistoryRetention int `json:"historyRetention"`
+	MaxVersions      int `json:"maxVersions"`
+
+	// AccessUsername and AccessPassword are the single set of credentials of configuration files from before the
+	// introduction of users; they are migrated to an administrator.
+	AccessUsername string `json:"accessUsername,omitempty"`
+	AccessPassword string `json:"accessPassword,omitempty"`
+}
+
+type persistentQueryOptions struct {
+	Timeout   int    `json:"timeout,omitempty"`
+	Isolation string `json:"isolation,omitempty"`
+	ReadOnly  bool   `json:"readOnly,omitempty"`
+}
+
+func (p *persistentConfig) setQueryOptions(ds Dataset, opts persistentQueryOptions) {
+	if p.QueryOptions == nil {
+		p.QueryOptions = make(map[Dataset]persistentQueryOptions)
+	}
+	if opts == (persistentQueryOptions{}) {
+		delete(p.QueryOptions, ds)
+		return
+	}
+	p.QueryOptions[ds] = opts
+}
+
+// secrets returns pointers to all secrets in the persisted configuration.
+func (p *persistentConfig) secrets() []*string {
+	return []*string{&p.Password, &p.ProxyPassword, &p.AccessPassword, &p.DsnPassword, &p.Dsn.Password}
+}
+
+func (p *persistentConfig) hasPlainTextSecrets() bool {
+	for _, s := range p.secrets() {
+		if *s != "" && !isEncrypted(*s) && !secret.IsReference(*s) && !isPasswordHash(*s) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasUnhashedAccessPassword returns whether the configuration still contains the credentials from before the
+// introduction of users, or a password of a user that is stored in a reversible form rather than as a hash.
+func (p *persistentConfig) hasUnhashedAccessPassword() bool {
+	if p.AccessUsername != "" || p.AccessPassword != "" {
+		return true
+	}
+	for _, u := range p.Users {
+		if !isPasswordHash(u.Password) && !secret.IsReference(u.Password) {
+			return true
+		}
+	}
+	return false
+}
+
+// persistent returns the configuration values as they are persisted, but without encrypting secrets. The caller must
+// hold the lock.
+func (c *Configuration) persistent() persistentConfig {
+	vars := persistentConfig{
+		Schema:           SchemaVersion,
+		Username:         c.username,
+		Password:         c.password,
+		Environment:      c.environment,
+		Environments:     c.environments,
+		Proxy:            c.proxy.URL,
+		ProxyMode:        c.proxy.Mode,
+		ProxyUsername:    c.proxy.Username,
+		ProxyPassword:    c.proxy.Password,
+		NoProxy:          c.proxy.NoProxy,
+		Dsn:              c.connection,
+		VisitorQuery:     c.visitorQuery,
+		RadiologieQuery:  c.radiologieQuery,
+		LabQuery:         c.labQuery,
+		ConsultQuery:     c.consultQuery,
+		QueryOptions:     make(map[Dataset]persistentQueryOptions),
+		Users:            c.users,
+		Tokens:           c.tokens,
+		AccessBasicAuth:  c.accessBasicAuth,
+		Timeout:          int(c.timeout / time.Second),
+		MaxOpen:          c.pool.MaxOpen,
+		MaxIdle:          c.pool.MaxIdle,
+		MaxLifetime:      int(c.pool.MaxLifetime / time.Second),
+		Interval:         int(c.interval / time.Second),
+		Active:           c.enabled,
+		Paused:           c.paused,
+		TracingEndpoint:  c.tracingEndpoint,
+		HistoryRetention: int(c.historyRetention / (24 * time.Hour)),
+		MaxVersions:      c.maxVersions,
+	}
+	for ds, opts := range c.queryOptions {
+		vars.QueryOptions[ds] = persistentQueryOptions{
+			Timeout:   int(opts.Timeout / time.Second),
+			Isolation: opts.Isolation.String(),
+			ReadOnly:  opts.ReadOnly,
+		}
+	}
+	return vars
 }
 
 func (c *Configuration) MarshalJSON() ([]byte, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	vars := persistentConfig{
-		Username:        c.username,
-		Password:        c.password,
-		Proxy:           c.proxy,
-		Dsn:             c.connection,
-		VisitorQuery:    c.visitorQuery,
-		RadiologieQuery: c.radiologieQuery,
-		LabQuery:        c.labQuery,
-		ConsultQuery:    c.consultQuery,
-		AccessUsername:  c.accessUsername,
-		AccessPassword:  c.accessPassword,
-		Timeout:         int(c.timeout / time.Second),
+	vars := c.stored()
+	if c.secrets != nil {
+		// the connection string is stored without password, so that the password can be encrypted separately
+		vars.DsnPassword = vars.Dsn.Password
+		vars.Dsn.Password = ""
+		for _, s := range vars.secrets() {
+			if secret.IsReference(*s) || isPasswordHash(*s) {
+				// references are not secret themselves, and remain readable for the administrator; hashes cannot be
+				// reversed anyway
+				continue
+			}
+			enc, err := c.secrets.Encrypt(*s)
+			if err != nil {
+				return nil, errors.Wrap(err, "while encrypting secrets")
+			}
+			*s = enc
+		}
 	}
 	return json.Marshal(vars)
 }
@@ -435,50 +962,146 @@ func (c *Configuration) UnmarshalJSON(v []byte) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	vars := &persistentConfig{}
-	if err := json.Unmarshal(v, &vars); err != nil {
+	if err := c.unmarshal(v); err != nil {
 		return err
 	}
+	c.setLogDetails()
+	return nil
+}
 
-	if vars.Timeout == 0 {
-		vars.Timeout = 5
+// unmarshal sets the configuration to the one stored in v, with the environment variables applied. Unlike
+// UnmarshalJSON, it leaves the details for remote logging alone, so that it can decode configurations that are not
+// in use. The caller must hold the write lock.
+func (c *Configuration) unmarshal(v []byte) error {
+	decoded, err := decodeStrict(v)
+	if err != nil {
+		return err
 	}
+	vars := &decoded
+	for _, s := range vars.secrets() {
+		plain, err := c.secrets.Decrypt(*s)
+		if err != nil {
+			return err
+		}
+		*s = plain
+	}
+	migrate(vars)
+	if vars.DsnPassword != "" {
+		vars.Dsn.Password = vars.DsnPassword
+	}
+	for i := range vars.Users {
+		hash, err := hashPassword(vars.Users[i].Password)
+		if err != nil {
+			return errors.Wrap(err, "while hashing access password")
+		}
+		vars.Users[i].Password = hash
+		if vars.Users[i].Role == "" {
+			vars.Users[i].Role = RoleViewer
+		}
+	}
+	vars.setDefaults()
 
-	c.username = vars.Username
-	dlog.SetUsername(c.username)
+	return c.overrideEnvironment(vars)
+}
 
+// assign sets the configuration to the values in vars. The caller must hold the write lock.
+func (c *Configuration) assign(vars *persistentConfig) error {
+	c.username = vars.Username
 	c.password = vars.Password
-	c.proxy = vars.Proxy
+	c.environments = normalizeEnvironments(vars.Environments)
+	c.environment = findEnvironment(c.environments, vars.Environment).Name
+	c.proxy = rest.Proxy{
+		Mode:     vars.ProxyMode,
+		URL:      vars.Proxy,
+		Username: vars.ProxyUsername,
+		Password: vars.ProxyPassword,
+		NoProxy:  vars.NoProxy,
+	}
 	c.connection = vars.Dsn
 	c.visitorQuery = vars.VisitorQuery
 	c.radiologieQuery = vars.RadiologieQuery
 	c.labQuery = vars.LabQuery
 	c.consultQuery = vars.ConsultQuery
-	c.accessUsername = vars.AccessUsername
-	c.accessPassword = vars.AccessPassword
+	c.queryOptions = make(map[Dataset]db.QueryOptions)
+	for ds, opts := range vars.QueryOptions {
+		isolation, err := db.ParseIsolationLevel(opts.Isolation)
+		if err != nil {
+			return errors.Wrapf(err, "invalid query options for %s", ds)
+		}
+		c.queryOptions[ds] = db.QueryOptions{
+			Timeout:   time.Duration(opts.Timeout) * time.Second,
+			Isolation: isolation,
+			ReadOnly:  opts.ReadOnly,
+		}
+	}
+	c.users = vars.Users
+	c.tokens = vars.Tokens
+	c.accessBasicAuth = vars.AccessBasicAuth
 	c.timeout = time.Duration(vars.Timeout) * time.Second
+	c.pool = db.PoolSettings{
+		MaxOpen:     vars.MaxOpen,
+		MaxIdle:     vars.MaxIdle,
+		MaxLifetime: time.Duration(vars.MaxLifetime) * time.Second,
+	}
+	c.interval = time.Duration(vars.Interval) * time.Second
+	c.enabled = vars.Active
+	c.paused = vars.Paused
+	c.tracingEndpoint = vars.TracingEndpoint
+	c.historyRetention = time.Duration(vars.HistoryRetention) * 24 * time.Hour
+	c.maxVersions = vars.MaxVersions
 
 	return nil
 }
 
+// setLogDetails passes the credentials and server of the configuration on to remote logging, unless the configuration
+// is detached. The caller must hold the lock.
+func (c *Configuration) setLogDetails() {
+	if c.detached {
+		return
+	}
+	dlog.SetUsername(c.username)
+	dlog.SetServer(findEnvironment(c.environments, c.environment).URL)
+}
+
 func (c *Configuration) checkConnection(ctx context.Context) (connErr error, credErr error) {
+	ctx, span := trace.Start(ctx, "d2d.ping", trace.KindClient)
+	defer func() {
+		span.SetError(connErr)
+		span.SetError(credErr)
+		span.End()
+	}()
+
 	if c.username == "" || c.password == "" {
 		credErr = ErrD2DCredentialsNotConfigured
 	}
 
-	req, err := http.NewRequest(http.MethodGet, Server, nil)
+	server := findEnvironment(c.environments, c.environment).URL
+	req, err := http.NewRequest(http.MethodGet, server, nil)
 	if err != nil {
-		dlog.Error("Failed to initialize connection to %s: %v", Server, err)
+		dlog.Error("Failed to initialize connection to %s: %v", server, err)
 		return err, credErr
 	}
 	req.URL.Path = PathPing
-	req.SetBasicAuth(c.username, c.password)
 
-	res, err := rest.Do(ctx, c.proxy, req)
+	password, err := secret.Resolve(ctx, c.password)
+	if err != nil {
+		dlog.Error("Failed to resolve door2doc password: %v", err)
+		return nil, err
+	}
+	req.SetBasicAuth(c.username, password)
+
+	proxy, err := resolveProxy(ctx, c.proxy)
+	if err != nil {
+		dlog.Error("Failed to resolve proxy password: %v", err)
+		return err, credErr
+	}
+
+	res, err := rest.Do(ctx, proxy, req)
 	if err != nil {
-		dlog.Error("Failed to connect to %s: %v", Server, err)
+		dlog.Error("Failed to connect to %s: %v", server, err)
 		return ErrD2DConnectionFailed, credErr
 	}
+	span.SetAttribute("http.status_code", res.StatusCode)
 	_, err = io.Copy(ioutil.Discard, res.Body)
 	if err != nil {
 		dlog.Error("Failed to drain response: %v", err)
@@ -503,11 +1126,21 @@ func (c *Configuration) checkConnection(ctx context.Context) (connErr error, cre
 	}
 }
 
-type checker func(context.Context, *sql.Tx, string) (QueryResult, error)
+type checker func(context.Context, *sql.Tx, string, time.Duration) (QueryResult, error)
+
+func (c *Configuration) checkDatabase(ctx context.Context, ds Dataset, query string, opts db.QueryOptions, f checker) (queryDuration time.Duration, queryResult QueryResult, connErr, queryErr error) {
+	ctx, span := trace.Start(ctx, "validate.query", trace.KindInternal)
+	span.SetAttribute(trace.AttributeDataset, string(ds))
+	defer func() {
+		span.SetError(connErr)
+		span.SetError(queryErr)
+		span.End()
+	}()
 
-func (c *Configuration) checkDatabase(ctx context.Context, query string, f checker) (queryDuration time.Duration, queryResult QueryResult, connErr, queryErr error) {
 	if query == "" {
 		queryErr = ErrQueryNotConfigured
+	} else if opts.ReadOnly && !db.SupportsReadOnly(c.connection.Driver) {
+		queryErr = ErrReadOnlyNotSupported
 	}
 
 	if !c.connection.IsValid() {
@@ -515,16 +1148,26 @@ func (c *Configuration) checkDatabase(ctx context.Context, query string, f check
 		return
 	}
 
-	conn, err := sql.Open(c.connection.Driver, c.connection.DSN())
+	resolved, err := resolveConnection(ctx, c.connection)
+	if err != nil {
+		dlog.Error("Failed to resolve database password: %v", err)
+		connErr = err
+		return
+	}
+
+	conn, err := db.Open(resolved)
 	if err != nil {
 		dlog.Error("Failed to connect to database: %v", err)
 		connErr = &DatabaseInvalidError{Cause: err.Error()}
 		return
 	}
 
-	err = conn.PingContext(ctx)
+	pingCtx, pingSpan := trace.Start(ctx, "db.connect", trace.KindClient)
+	err = conn.PingContext(pingCtx)
+	pingSpan.SetError(err)
+	pingSpan.End()
 	if err != nil {
-		dlog.Error("Failed to ping database %s: %v", c.connection, err)
+		dlog.Error("Failed to ping database %s: %v", c.connection.String(), err)
 		connErr = &DatabaseInvalidError{Cause: err.Error()}
 		return
 	}
@@ -536,10 +1179,10 @@ func (c *Configuration) checkDatabase(ctx context.Context, query string, f check
 		return
 	}
 
-	tx, err := conn.BeginTx(ctx, nil)
+	tx, err := conn.BeginTx(ctx, opts.TxOptions())
 	if err != nil {
 		dlog.Error("Failed to start transaction: %v", err)
-		connErr = &DatabaseInvalidError{Cause: err.Error()}
+		queryErr = &TransactionError{Isolation: opts.Isolation, ReadOnly: opts.ReadOnly, Cause: err.Error()}
 		return
 	}
 	defer func() {
@@ -549,7 +1192,10 @@ func (c *Configuration) checkDatabase(ctx context.Context, query string, f check
 	}()
 
 	queryStart := time.Now()
-	queryResult, err = f(ctx, tx, query)
+	queryCtx, querySpan := trace.Start(ctx, "db.query", trace.KindClient)
+	queryResult, err = f(queryCtx, tx, query, opts.TimeoutOr(c.timeout))
+	querySpan.SetError(err)
+	querySpan.End()
 	_, errIsSelection := err.(*db.SelectionError)
 
 	switch {
@@ -570,10 +1216,28 @@ func (c *Configuration) checkDatabase(ctx context.Context, query string, f check
 	return
 }
 
+// Do sends an HTTP request to door2doc, using the configured credentials and proxy settings. The configuration is not
+// locked while the request is running.
 func (c *Configuration) Do(ctx context.Context, req *http.Request) (*http.Response, error) {
 	c.mu.RLock()
-	defer c.mu.RUnlock()
+	username, password, proxy := c.username, c.password, c.proxy
+	c.mu.RUnlock()
+
+	password, err := secret.Resolve(ctx, password)
+	if err != nil {
+		return nil, err
+	}
+	proxy, err = resolveProxy(ctx, proxy)
+	if err != nil {
+		return nil, err
+	}
+
+	req.SetBasicAuth(username, password)
+	return rest.Do(ctx, proxy, req)
+}
 
-	req.SetBasicAuth(c.username, c.password)
-	return rest.Do(ctx, c.proxy, req)
+// ResolvedConnection returns the database connection settings with the password resolved, if it is a secret
+// reference. The reference is resolved on every call, so that a rotated password is picked up on the next connection.
+func (c *Configuration) ResolvedConnection(ctx context.Context) (db.ConnectionData, error) {
+	return resolveConnection(ctx, c.Connection())
 }
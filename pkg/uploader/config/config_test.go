@@ -2,15 +2,22 @@ package config
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
 	_ "github.com/lib/pq"
+	_ "github.com/mattn/go-sqlite3"
 )
 
 const (
@@ -43,6 +50,9 @@ func TestConfiguration_Validate(t *testing.T) {
 	failing := httptest.NewServer(nil)
 	failing.Close()
 
+	sqlitePath, cleanup := createSQLite(t)
+	defer cleanup()
+
 	for name, test := range map[string]struct {
 		Given            func(cfg *Configuration)
 		Want             *ValidationResult
@@ -51,7 +61,7 @@ func TestConfiguration_Validate(t *testing.T) {
 	}{
 		"unconfigured, no access": {
 			Given: func(cfg *Configuration) {
-				Server = failing.URL
+				cfg.SetEnvironments([]Environment{{Name: "failing", URL: failing.URL}}, "failing")
 			},
 			Want: &ValidationResult{
 				DatabaseConnection: ErrDatabaseNotConfigured,
@@ -219,7 +229,7 @@ func TestConfiguration_Validate(t *testing.T) {
 				cfg.SetDSN("postgres", TestDSN)
 				cfg.SetCredentials(TestUser, TestPassword)
 				cfg.SetVisitorQuery(`select * from correct`)
-				cfg.SetAccessCredentials(TestUser, TestPassword)
+				cfg.SetUser(TestUser, TestPassword, RoleAdmin)
 			},
 			Want: &ValidationResult{
 				RadiologieQuery: ErrQueryNotConfigured,
@@ -237,12 +247,41 @@ func TestConfiguration_Validate(t *testing.T) {
 				cfg.SetRadiologieQuery(`select * from correct_radiologie`)
 				cfg.SetLabQuery(`select * from correct_lab`)
 				cfg.SetConsultQuery(`select * from correct_consult`)
-				cfg.SetAccessCredentials(TestUser, TestPassword)
+				cfg.SetUser(TestUser, TestPassword, RoleAdmin)
 			},
 			Want:             &ValidationResult{},
 			WantValid:        true,
 			RequiresDatabase: true,
 		},
+		"correct SQLite configuration with orders": {
+			Given: func(cfg *Configuration) {
+				cfg.SetConnection(db.ConnectionData{Driver: "sqlite3", Path: sqlitePath})
+				cfg.SetCredentials(TestUser, TestPassword)
+				cfg.SetVisitorQuery(`select * from correct`)
+				cfg.SetRadiologieQuery(`select * from correct_radiologie`)
+				cfg.SetLabQuery(`select * from correct_lab`)
+				cfg.SetConsultQuery(`select * from correct_consult`)
+				cfg.SetUser(TestUser, TestPassword, RoleAdmin)
+			},
+			Want:      &ValidationResult{},
+			WantValid: true,
+		},
+		"missing SQLite file": {
+			Given: func(cfg *Configuration) {
+				cfg.SetConnection(db.ConnectionData{Driver: "sqlite3", Path: sqlitePath + ".missing"})
+				cfg.SetCredentials(TestUser, TestPassword)
+				cfg.SetVisitorQuery(`select * from correct`)
+				cfg.SetRadiologieQuery(`select * from correct_radiologie`)
+				cfg.SetLabQuery(`select * from correct_lab`)
+				cfg.SetConsultQuery(`select * from correct_consult`)
+				cfg.SetUser(TestUser, TestPassword, RoleAdmin)
+			},
+			Want: &ValidationResult{
+				DatabaseConnection: &DatabaseInvalidError{
+					Cause: "stat " + sqlitePath + ".missing: no such file or directory",
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			if test.RequiresDatabase && testing.Short() {
@@ -252,8 +291,8 @@ func TestConfiguration_Validate(t *testing.T) {
 			ctx, timeout := context.WithTimeout(context.Background(), time.Second)
 			defer timeout()
 
-			Server = srv.URL
 			cfg := NewConfiguration()
+			cfg.SetEnvironments([]Environment{{Name: "test", URL: srv.URL}}, "test")
 
 			test.Given(cfg)
 			cfg.UpdateBaseValidation(ctx)
@@ -283,6 +322,63 @@ func TestConfiguration_Validate(t *testing.T) {
 	}
 }
 
+// createSQLite creates a SQLite database from the test fixture, so that the configuration can be validated without
+// a database server.
+func createSQLite(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "seh.db")
+
+	script, err := ioutil.ReadFile("../../../data/sql/sqlite/testdata.sql")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dlog.Close(conn)
+	if _, err := conn.Exec(string(script)); err != nil {
+		t.Fatal(err)
+	}
+
+	return path, func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestConfiguration_ValidateUnlocked(t *testing.T) {
+	cfg := NewConfiguration()
+	// the configuration is read while door2doc is being pinged
+	read := make(chan string, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		done := make(chan string, 1)
+		go func() {
+			username, _ := cfg.Credentials()
+			done <- username
+		}()
+		select {
+		case username := <-done:
+			read <- username
+		case <-time.After(time.Second):
+			read <- "locked"
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	cfg.SetEnvironments([]Environment{{Name: "test", URL: srv.URL}}, "test")
+	cfg.SetCredentials(TestUser, TestPassword)
+	cfg.UpdateBaseValidation(context.Background())
+	if got := <-read; got != TestUser {
+		t.Errorf("Credentials() during validation == %q, got %q", TestUser, got)
+	}
+}
+
 func TestConfigurationJSON(t *testing.T) {
 	defaultConnection := db.ConnectionData{Driver: "sqlserver"}
 	defaultTimeout := NewConfiguration().timeout
@@ -302,16 +398,56 @@ func TestConfigurationJSON(t *testing.T) {
 		}},
 		"query":         {visitorQuery: "query"},
 		"order queries": {radiologieQuery: "a", labQuery: "b", consultQuery: "c"},
-		"access":        {accessUsername: "username", accessPassword: "password", connection: db.ConnectionData{Driver: "sqlserver"}},
+		"access":        {users: []User{{Username: "username", Password: "$2a$04$PrrQhN6ghuqBBIyS0SWGZOQQCyMh9OSpa2XBZhJdjpH4NZj/LM5nO", Role: RoleOperator}}, accessBasicAuth: true, connection: db.ConnectionData{Driver: "sqlserver"}},
 		"timeout":       {timeout: 100 * time.Second},
+		"proxy": {proxy: rest.Proxy{
+			Mode:     rest.ProxyManual,
+			URL:      "http://proxy:8080",
+			Username: "user",
+			Password: "pass",
+			NoProxy:  ".door2doc.net",
+		}},
+		"pool":              {pool: db.PoolSettings{MaxOpen: 10, MaxIdle: 1, MaxLifetime: time.Hour}},
+		"schedule":          {interval: 5 * time.Minute, enabled: true, paused: true, historyRetention: 7 * 24 * time.Hour, maxVersions: 5},
+		"environment proxy": {proxy: rest.Proxy{Mode: rest.ProxyEnvironment}},
+		"environments": {
+			environments: []Environment{
+				{Name: EnvironmentProduction, URL: ProductionServer},
+				{Name: "acceptance", URL: "https://acceptance.example.com/"},
+			},
+			environment: "acceptance",
+		},
+		"query options": {queryOptions: map[Dataset]db.QueryOptions{
+			DatasetVisitor: {Timeout: time.Minute},
+			DatasetLab:     {Isolation: sql.LevelReadUncommitted, ReadOnly: true},
+		}},
 	} {
 		t.Run(name, func(t *testing.T) {
+			if test.queryOptions == nil {
+				test.queryOptions = make(map[Dataset]db.QueryOptions)
+			}
 			if test.connection == (db.ConnectionData{}) {
 				test.connection = defaultConnection
 			}
 			if test.timeout == 0 {
 				test.timeout = defaultTimeout
 			}
+			if test.pool == (db.PoolSettings{}) {
+				test.pool = db.DefaultPoolSettings()
+			}
+			if test.interval == 0 {
+				test.interval = time.Minute
+			}
+			if test.historyRetention == 0 {
+				test.historyRetention = 30 * 24 * time.Hour
+			}
+			if test.maxVersions == 0 {
+				test.maxVersions = DefaultMaxVersions
+			}
+			if test.environments == nil {
+				test.environments = DefaultEnvironments()
+				test.environment = EnvironmentProduction
+			}
 
 			bs, err := json.Marshal(test)
 			if err != nil {
@@ -329,3 +465,110 @@ func TestConfigurationJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigurationJSONLegacyProxy(t *testing.T) {
+	got := NewConfiguration()
+	if err := json.Unmarshal([]byte(`{"proxy": "http://proxy:8080"}`), got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := rest.Proxy{Mode: rest.ProxyManual, URL: "http://proxy:8080"}
+	if got.Proxy() != want {
+		t.Errorf("Proxy() == %v, got %v", want, got.Proxy())
+	}
+}
+
+func TestConfiguration_SetEnvironments(t *testing.T) {
+	for name, test := range map[string]struct {
+		Given    []Environment
+		Selected string
+		Want     []Environment
+		WantURL  string
+	}{
+		"production only": {
+			Selected: EnvironmentProduction,
+			Want:     DefaultEnvironments(),
+			WantURL:  ProductionServer,
+		},
+		"test environment": {
+			Given:    []Environment{{Name: "test", URL: "https://test.example.com/"}},
+			Selected: "test",
+			Want:     []Environment{{Name: EnvironmentProduction, URL: ProductionServer}, {Name: "test", URL: "https://test.example.com/"}},
+			WantURL:  "https://test.example.com/",
+		},
+		"unknown environment": {
+			Given:    []Environment{{Name: "test", URL: "https://test.example.com/"}},
+			Selected: "acceptance",
+			Want:     []Environment{{Name: EnvironmentProduction, URL: ProductionServer}, {Name: "test", URL: "https://test.example.com/"}},
+			WantURL:  ProductionServer,
+		},
+		"incomplete environments": {
+			Given:    []Environment{{Name: "test"}, {URL: "https://test.example.com/"}, {Name: " acc ", URL: "https://acc.example.com/"}},
+			Selected: "acc",
+			Want:     []Environment{{Name: EnvironmentProduction, URL: ProductionServer}, {Name: "acc", URL: "https://acc.example.com/"}},
+			WantURL:  "https://acc.example.com/",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := NewConfiguration()
+			cfg.SetEnvironments(test.Given, test.Selected)
+
+			if got := cfg.Environments(); !reflect.DeepEqual(got, test.Want) {
+				t.Errorf("Environments() == %v, got %v", test.Want, got)
+			}
+			if got := cfg.Server(); got != test.WantURL {
+				t.Errorf("Server() == %q, got %q", test.WantURL, got)
+			}
+		})
+	}
+}
+
+func TestConfigurationFields(t *testing.T) {
+	cfg := NewConfiguration()
+	cfg.SetConnection(db.ConnectionData{Driver: "postgres", Host: "localhost", Username: "user", Password: "secret"})
+	if err := cfg.SetUser("admin", "$2a$04$PrrQhN6DPYhyvf7jZKXjGuGIoBA0trLMfJ7GxxbKX5GnBr99Uap/q", RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+
+	fields := cfg.Fields()
+	for field, want := range map[string]string{
+		"dsn.host":                    "localhost",
+		"dsnPassword":                 "secret",
+		"users.admin.role":            "admin",
+		"environments.production.url": ProductionServer,
+	} {
+		if got := fields[field]; got != want {
+			t.Errorf("Fields()[%q] == %q, got %q", field, want, got)
+		}
+	}
+	for field, want := range map[string]bool{
+		"dsnPassword":          true,
+		"password":             true,
+		"users.admin.password": true,
+		"users.admin.role":     false,
+		"dsn.username":         false,
+	} {
+		if got := IsSecretField(field); got != want {
+			t.Errorf("IsSecretField(%q) == %v, got %v", field, want, got)
+		}
+	}
+}
+
+func TestConfiguration_checkDatabaseReadOnly(t *testing.T) {
+	for name, test := range map[string]struct {
+		Driver string
+		Want   error
+	}{
+		"postgres":  {Driver: "postgres"},
+		"sqlserver": {Driver: "sqlserver", Want: ErrReadOnlyNotSupported},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := NewConfiguration()
+			cfg.SetConnection(db.ConnectionData{Driver: test.Driver, Host: "127.0.0.1", Port: "1"})
+			_, _, _, queryErr := cfg.checkDatabase(context.Background(), DatasetVisitor, "SELECT 1", db.QueryOptions{ReadOnly: true}, nil)
+			if queryErr != test.Want {
+				t.Errorf("checkDatabase() == %v, got %v", test.Want, queryErr)
+			}
+		})
+	}
+}
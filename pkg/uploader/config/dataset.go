@@ -0,0 +1,121 @@
+package config
+
+import (
+	"context"
+	"time"
+)
+
+// Dataset identifies one of the queries that are uploaded to door2doc.
+type Dataset string
+
+const (
+	DatasetVisitor    Dataset = "visitor"
+	DatasetRadiologie Dataset = "radiologie"
+	DatasetLab        Dataset = "lab"
+	DatasetConsult    Dataset = "consult"
+)
+
+// Datasets lists all datasets, in the order they are uploaded.
+var Datasets = []Dataset{DatasetVisitor, DatasetRadiologie, DatasetLab, DatasetConsult}
+
+// Path returns the upload path of the dataset.
+func (d Dataset) Path() string {
+	switch d {
+	case DatasetVisitor:
+		return PathVisitorUpload
+	case DatasetRadiologie:
+		return PathRadiologieUpload
+	case DatasetLab:
+		return PathLabUpload
+	case DatasetConsult:
+		return PathConsultUpload
+	}
+	return ""
+}
+
+// DatasetForPath returns the dataset that is uploaded to path, or false if there is none.
+func DatasetForPath(path string) (Dataset, bool) {
+	for _, ds := range Datasets {
+		if ds.Path() == path {
+			return ds, true
+		}
+	}
+	return "", false
+}
+
+// IsValid returns whether d is one of the known datasets.
+func (d Dataset) IsValid() bool {
+	for _, ds := range Datasets {
+		if d == ds {
+			return true
+		}
+	}
+	return false
+}
+
+// Field returns the name of the setting with the query of the dataset, as returned by Fields.
+func (d Dataset) Field() string {
+	if d == DatasetVisitor {
+		return "query"
+	}
+	return string(d)
+}
+
+// Query returns the query of a dataset.
+func (c *Configuration) Query(ds Dataset) string {
+	switch ds {
+	case DatasetVisitor:
+		return c.VisitorQuery()
+	case DatasetRadiologie:
+		return c.RadiologieQuery()
+	case DatasetLab:
+		return c.LabQuery()
+	case DatasetConsult:
+		return c.ConsultQuery()
+	}
+	return ""
+}
+
+// SetQuery sets the query of a dataset.
+func (c *Configuration) SetQuery(ds Dataset, query string) {
+	switch ds {
+	case DatasetVisitor:
+		c.SetVisitorQuery(query)
+	case DatasetRadiologie:
+		c.SetRadiologieQuery(query)
+	case DatasetLab:
+		c.SetLabQuery(query)
+	case DatasetConsult:
+		c.SetConsultQuery(query)
+	}
+}
+
+// UpdateQueryValidation validates the query of a dataset. The visitor query is part of the base configuration, which
+// is validated as a whole.
+func (c *Configuration) UpdateQueryValidation(ctx context.Context, ds Dataset) {
+	switch ds {
+	case DatasetVisitor:
+		c.UpdateBaseValidation(ctx)
+	case DatasetRadiologie:
+		c.UpdateRadiologieValidation(ctx)
+	case DatasetLab:
+		c.UpdateLabValidation(ctx)
+	case DatasetConsult:
+		c.UpdateConsultValidation(ctx)
+	}
+}
+
+// Query returns the result of validating the query of a dataset.
+func (v *ValidationResult) Query(ds Dataset) (time.Duration, QueryResult, error) {
+	switch ds {
+	case DatasetVisitor:
+		return v.VisitorQueryDuration, v.VisitorQueryResults, v.VisitorQuery
+	case DatasetRadiologie:
+		return v.RadiologieQueryDuration, v.RadiologieQueryResults, v.RadiologieQuery
+	case DatasetLab:
+		return v.LabQueryDuration, v.LabQueryResults, v.LabQuery
+	case DatasetConsult:
+		return v.ConsultQueryDuration, v.ConsultQueryResults, v.ConsultQuery
+	}
+	return 0, nil, nil
+}
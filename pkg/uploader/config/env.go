@@ -0,0 +1,380 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/pkg/errors"
+)
+
+// envFileSuffix marks an environment variable that contains the path of a file with the value, rather than the value
+// itself, like D2D_PASSWORD_FILE.
+const envFileSuffix = "_FILE"
+
+// override is a setting in the configuration file that can be overridden with an environment variable.
+type override struct {
+	Env string
+	// Field is the name of the setting, as returned by Fields. Overriding a field locks all fields nested in it.
+	Field string
+
+	// set sets the setting in vars to the value of the environment variable
+	set func(vars *persistentConfig, value string) error
+	// keep copies the setting from src to dst
+	keep func(dst, src *persistentConfig)
+}
+
+func stringOverride(env, field string, f func(vars *persistentConfig) *string) override {
+	return override{
+		Env:   env,
+		Field: field,
+		set: func(vars *persistentConfig, value string) error {
+			*f(vars) = value
+			return nil
+		},
+		keep: func(dst, src *persistentConfig) {
+			*f(dst) = *f(src)
+		},
+	}
+}
+
+func intOverride(env, field string, f func(vars *persistentConfig) *int) override {
+	return override{
+		Env:   env,
+		Field: field,
+		set: func(vars *persistentConfig, value string) error {
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return err
+			}
+			*f(vars) = n
+			return nil
+		},
+		keep: func(dst, src *persistentConfig) {
+			*f(dst) = *f(src)
+		},
+	}
+}
+
+func boolOverride(env, field string, f func(vars *persistentConfig) *bool) override {
+	return override{
+		Env:   env,
+		Field: field,
+		set: func(vars *persistentConfig, value string) error {
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return err
+			}
+			*f(vars) = b
+			return nil
+		},
+		keep: func(dst, src *persistentConfig) {
+			*f(dst) = *f(src)
+		},
+	}
+}
+
+// queryOptionOverrides returns the overrides of the options of the query of a dataset.
+func queryOptionOverrides(ds Dataset) []override {
+	option := func(env, field string, set func(opts *persistentQueryOptions, value string) error, keep func(dst *persistentQueryOptions, src persistentQueryOptions)) override {
+		return override{
+			Env:   "D2D_" + strings.ToUpper(string(ds)) + "_QUERY_" + env,
+			Field: "queryOptions." + string(ds) + "." + field,
+			set: func(vars *persistentConfig, value string) error {
+				opts := vars.QueryOptions[ds]
+				if err := set(&opts, value); err != nil {
+					return err
+				}
+				vars.setQueryOptions(ds, opts)
+				return nil
+			},
+			keep: func(dst, src *persistentConfig) {
+				opts := dst.QueryOptions[ds]
+				keep(&opts, src.QueryOptions[ds])
+				dst.setQueryOptions(ds, opts)
+			},
+		}
+	}
+
+	return []override{
+		option("TIMEOUT", "timeout", func(opts *persistentQueryOptions, value string) (err error) {
+			opts.Timeout, err = strconv.Atoi(value)
+			return err
+		}, func(dst *persistentQueryOptions, src persistentQueryOptions) {
+			dst.Timeout = src.Timeout
+		}),
+		option("ISOLATION", "isolation", func(opts *persistentQueryOptions, value string) error {
+			if _, err := db.ParseIsolationLevel(value); err != nil {
+				return err
+			}
+			opts.Isolation = value
+			return nil
+		}, func(dst *persistentQueryOptions, src persistentQueryOptions) {
+			dst.Isolation = src.Isolation
+		}),
+		option("READ_ONLY", "readOnly", func(opts *persistentQueryOptions, value string) (err error) {
+			opts.ReadOnly, err = strconv.ParseBool(value)
+			return err
+		}, func(dst *persistentQueryOptions, src persistentQueryOptions) {
+			dst.ReadOnly = src.ReadOnly
+		}),
+	}
+}
+
+// overrides lists all settings that can be overridden with environment variables, in the order they are applied.
+// Users of the web interface are managed in the web interface only.
+var overrides = func() []override {
+	res := []override{
+		stringOverride("D2D_USERNAME", "username", func(vars *persistentConfig) *string { return &vars.Username }),
+		stringOverride("D2D_PASSWORD", "password", func(vars *persistentConfig) *string { return &vars.Password }),
+		{
+			Env:   "D2D_ENVIRONMENTS",
+			Field: "environments",
+			set: func(vars *persistentConfig, value string) error {
+				vars.Environments = nil
+				for _, s := range strings.Split(value, ",") {
+					if strings.TrimSpace(s) == "" {
+						continue
+					}
+					parts := strings.SplitN(s, "=", 2)
+					if len(parts) != 2 {
+						return errors.Errorf("expected name=url, got %q", s)
+					}
+					vars.Environments = append(vars.Environments, Environment{Name: strings.TrimSpace(parts[0]), URL: strings.TrimSpace(parts[1])})
+				}
+				return nil
+			},
+			keep: func(dst, src *persistentConfig) {
+				dst.Environments = src.Environments
+			},
+		},
+		stringOverride("D2D_ENVIRONMENT", "environment", func(vars *persistentConfig) *string { return &vars.Environment }),
+		stringOverride("D2D_PROXY_MODE", "proxyMode", func(vars *persistentConfig) *string { return &vars.ProxyMode }),
+		stringOverride("D2D_PROXY", "proxy", func(vars *persistentConfig) *string { return &vars.Proxy }),
+		stringOverride("D2D_PROXY_USERNAME", "proxyUsername", func(vars *persistentConfig) *string { return &vars.ProxyUsername }),
+		stringOverride("D2D_PROXY_PASSWORD", "proxyPassword", func(vars *persistentConfig) *string { return &vars.ProxyPassword }),
+		stringOverride("D2D_NO_PROXY", "noProxy", func(vars *persistentConfig) *string { return &vars.NoProxy }),
+		{
+			Env:   "D2D_DSN",
+			Field: "dsn",
+			// the password is kept if the connection string has none, so that it can be set with D2D_DB_PASSWORD or in
+			// the configuration file instead
+			set: func(vars *persistentConfig, value string) error {
+				var cd db.ConnectionData
+				if err := cd.UnmarshalText([]byte(value)); err != nil {
+					return err
+				}
+				if cd.Password == "" {
+					cd.Password = vars.Dsn.Password
+				}
+				vars.Dsn = cd
+				return nil
+			},
+			keep: func(dst, src *persistentConfig) {
+				dst.Dsn = src.Dsn
+			},
+		},
+		stringOverride("D2D_DB_PASSWORD", "dsnPassword", func(vars *persistentConfig) *string { return &vars.Dsn.Password }),
+		intOverride("D2D_TIMEOUT", "timeout", func(vars *persistentConfig) *int { return &vars.Timeout }),
+		intOverride("D2D_MAX_OPEN_CONNECTIONS", "maxOpenConnections", func(vars *persistentConfig) *int { return &vars.MaxOpen }),
+		intOverride("D2D_MAX_IDLE_CONNECTIONS", "maxIdleConnections", func(vars *persistentConfig) *int { return &vars.MaxIdle }),
+		intOverride("D2D_CONNECTION_LIFETIME", "connectionLifetime", func(vars *persistentConfig) *int { return &vars.MaxLifetime }),
+		stringOverride("D2D_VISITOR_QUERY", "query", func(vars *persistentConfig) *string { return &vars.VisitorQuery }),
+		stringOverride("D2D_RADIOLOGIE_QUERY", "radiologie", func(vars *persistentConfig) *string { return &vars.RadiologieQuery }),
+		stringOverride("D2D_LAB_QUERY", "lab", func(vars *persistentConfig) *string { return &vars.LabQuery }),
+		stringOverride("D2D_CONSULT_QUERY", "consult", func(vars *persistentConfig) *string { return &vars.ConsultQuery }),
+		intOverride("D2D_INTERVAL", "interval", func(vars *persistentConfig) *int { return &vars.Interval }),
+		boolOverride("D2D_ACTIVE", "active", func(vars *persistentConfig) *bool { return &vars.Active }),
+		intOverride("D2D_HISTORY_RETENTION", "historyRetention", func(vars *persistentConfig) *int { return &vars.HistoryRetention }),
+		intOverride("D2D_MAX_VERSIONS", "maxVersions", func(vars *persistentConfig) *int { return &vars.MaxVersions }),
+		stringOverride("D2D_TRACING_ENDPOINT", "tracingEndpoint", func(vars *persistentConfig) *string { return &vars.TracingEndpoint }),
+		boolOverride("D2D_ACCESS_BASIC_AUTH", "accessBasicAuth", func(vars *persistentConfig) *bool { return &vars.AccessBasicAuth }),
+	}
+	for _, ds := range Datasets {
+		res = append(res, queryOptionOverrides(ds)...)
+	}
+	return res
+}()
+
+// EnvironmentVariables returns the names of all environment variables that override settings, sorted by name.
+func EnvironmentVariables() []string {
+	var res []string
+	for _, o := range overrides {
+		res = append(res, o.Env)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// lookupEnv returns the value of an environment variable, or the contents of the file named in the variable with
+// suffix _FILE. Trailing line breaks are removed from the contents of files.
+func lookupEnv(env string) (string, bool, error) {
+	if value, ok := os.LookupEnv(env); ok {
+		return value, true, nil
+	}
+	path, ok := os.LookupEnv(env + envFileSuffix)
+	if !ok {
+		return "", false, nil
+	}
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", false, &EnvironmentError{Env: env + envFileSuffix, Cause: err.Error()}
+	}
+	return strings.TrimRight(string(bs), "\r\n"), true, nil
+}
+
+// lockedSetting is a setting that is overridden by an environment variable.
+type lockedSetting struct {
+	override
+	value string
+}
+
+// environmentOverrides returns the settings that are overridden by environment variables.
+func environmentOverrides() ([]lockedSetting, error) {
+	var res []lockedSetting
+	for _, o := range overrides {
+		value, ok, err := lookupEnv(o.Env)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			res = append(res, lockedSetting{override: o, value: value})
+		}
+	}
+	return res, nil
+}
+
+// applyLocked sets the locked settings in vars.
+func applyLocked(vars *persistentConfig, locked []lockedSetting) error {
+	for _, l := range locked {
+		if err := l.set(vars, l.value); err != nil {
+			return &EnvironmentError{Env: l.Env, Cause: err.Error()}
+		}
+	}
+	return nil
+}
+
+// Locked returns the settings that are overridden by environment variables, and cannot be changed in the web
+// interface, by field name as returned by Fields.
+func (c *Configuration) Locked() map[string]string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	res := make(map[string]string)
+	for _, l := range c.locked {
+		res[l.Field] = l.Env
+	}
+	return res
+}
+
+// LockedBy returns the environment variable that overrides a field as returned by Fields, or an empty string if the
+// field is not overridden.
+func (c *Configuration) LockedBy(field string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return lockedBy(c.locked, field)
+}
+
+// lockedBy returns the environment variable of the setting in locked that overrides a field, or an empty string.
+func lockedBy(locked []lockedSetting, field string) string {
+	for _, l := range locked {
+		if field == l.Field || strings.HasPrefix(field, l.Field+".") {
+			return l.Env
+		}
+	}
+	return ""
+}
+
+// LockedChange returns the field, as returned by Fields, and the environment variable of a setting that change would
+// modify although it is overridden, or empty strings if change leaves all overridden settings alone. change is applied
+// to a detached copy of the configuration without overrides, so that the current configuration is not changed, and
+// changes to overridden settings are not undone before they can be detected.
+func (c *Configuration) LockedChange(change func(cfg *Configuration)) (field, env string) {
+	c.mu.RLock()
+	vars := c.persistent()
+	locked := c.locked
+	c.mu.RUnlock()
+	if len(locked) == 0 {
+		return "", ""
+	}
+
+	candidate := NewConfiguration()
+	candidate.detached = true
+	// the values were assigned successfully before
+	_ = candidate.assign(&vars)
+	before := candidate.Fields()
+	change(candidate)
+	after := candidate.Fields()
+
+	var changed []string
+	for f, value := range after {
+		if before[f] != value {
+			changed = append(changed, f)
+		}
+	}
+	for f := range before {
+		if _, ok := after[f]; !ok {
+			changed = append(changed, f)
+		}
+	}
+	sort.Strings(changed)
+	for _, f := range changed {
+		if env := lockedBy(locked, f); env != "" {
+			return f, env
+		}
+	}
+	return "", ""
+}
+
+// enforceLocked undoes changes to the settings that are overridden by environment variables. The caller must hold the
+// write lock.
+func (c *Configuration) enforceLocked() {
+	if len(c.locked) == 0 {
+		return
+	}
+	vars := c.persistent()
+	// the values were applied successfully before
+	_ = applyLocked(&vars, c.locked)
+	_ = c.assign(&vars)
+}
+
+// overrideEnvironment applies the environment variables to the values read from the configuration file, and assigns
+// the result. The values from the file are kept, so that they are saved instead of the values of the environment
+// variables. The caller must hold the write lock.
+func (c *Configuration) overrideEnvironment(vars *persistentConfig) error {
+	locked, err := environmentOverrides()
+	if err != nil {
+		return err
+	}
+	// the values from the file are only needed for the settings that are overridden
+	var file persistentConfig
+	if len(locked) > 0 {
+		file = *vars
+		file.QueryOptions = make(map[Dataset]persistentQueryOptions)
+		for ds, opts := range vars.QueryOptions {
+			file.QueryOptions[ds] = opts
+		}
+	}
+	if err := applyLocked(vars, locked); err != nil {
+		return err
+	}
+	if err := c.assign(vars); err != nil {
+		return err
+	}
+	c.file, c.locked = file, locked
+	return nil
+}
+
+// stored returns the configuration values as they are persisted, with the values from the configuration file for the
+// settings that are overridden by environment variables. The caller must hold the lock.
+func (c *Configuration) stored() persistentConfig {
+	vars := c.persistent()
+	for _, l := range c.locked {
+		l.keep(&vars, &c.file)
+	}
+	return vars
+}
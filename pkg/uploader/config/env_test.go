@@ -0,0 +1,177 @@
+package config
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// setenv sets environment variables, and returns a function to unset them again.
+func setenv(t *testing.T, vars map[string]string) func() {
+	for k, v := range vars {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return func() {
+		for k := range vars {
+			_ = os.Unsetenv(k)
+		}
+	}
+}
+
+func TestEnvironmentOverrides(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	passwordFile := filepath.Join(dir, "password")
+	if err := ioutil.WriteFile(passwordFile, []byte("from file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	file := `{"username": "file", "password": "file", "timeout": 10, "query": "select 1", "dsn": "postgres://pg:secret@db/seh"}`
+
+	for name, test := range map[string]struct {
+		env    map[string]string
+		want   map[string]string
+		locked map[string]string
+	}{
+		"none": {
+			want:   map[string]string{"username": "file", "timeout": "10", "query": "select 1", "dsn.host": "db"},
+			locked: map[string]string{},
+		},
+		"strings": {
+			env:    map[string]string{"D2D_USERNAME": "env", "D2D_VISITOR_QUERY": "select 2"},
+			want:   map[string]string{"username": "env", "query": "select 2", "password": "file"},
+			locked: map[string]string{"username": "D2D_USERNAME", "query": "D2D_VISITOR_QUERY"},
+		},
+		"file": {
+			env:    map[string]string{"D2D_PASSWORD_FILE": passwordFile},
+			want:   map[string]string{"password": "from file"},
+			locked: map[string]string{"password": "D2D_PASSWORD"},
+		},
+		"numbers": {
+			env:  map[string]string{"D2D_TIMEOUT": "30", "D2D_MAX_OPEN_CONNECTIONS": "7", "D2D_ACCESS_BASIC_AUTH": "true"},
+			want: map[string]string{"timeout": "30", "maxOpenConnections": "7", "accessBasicAuth": "true"},
+		},
+		"connection": {
+			env:    map[string]string{"D2D_DSN": "postgres://other@server/db"},
+			want:   map[string]string{"dsn.host": "server", "dsn.username": "other", "dsnPassword": "secret"},
+			locked: map[string]string{"dsn": "D2D_DSN"},
+		},
+		"connection password": {
+			env:  map[string]string{"D2D_DSN": "postgres://other:pw@server/db", "D2D_DB_PASSWORD": "override"},
+			want: map[string]string{"dsnPassword": "override"},
+		},
+		"environments": {
+			env:  map[string]string{"D2D_ENVIRONMENTS": "acceptance=https://acc.example.com/", "D2D_ENVIRONMENT": "acceptance"},
+			want: map[string]string{"environment": "acceptance", "environments.acceptance.url": "https://acc.example.com/"},
+		},
+		"query options": {
+			env:  map[string]string{"D2D_LAB_QUERY_TIMEOUT": "60", "D2D_LAB_QUERY_ISOLATION": "Snapshot", "D2D_LAB_QUERY_READ_ONLY": "1"},
+			want: map[string]string{"queryOptions.lab.timeout": "60", "queryOptions.lab.isolation": "Snapshot", "queryOptions.lab.readOnly": "true"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer setenv(t, test.env)()
+
+			cfg := NewConfiguration()
+			if err := json.Unmarshal([]byte(file), cfg); err != nil {
+				t.Fatal(err)
+			}
+			fields := cfg.Fields()
+			for field, want := range test.want {
+				if got := fields[field]; got != want {
+					t.Errorf("Fields()[%s] == %q, got %q", field, want, got)
+				}
+			}
+			if test.locked != nil {
+				if got := cfg.Locked(); len(got) != len(test.locked) {
+					t.Errorf("Locked() == %v, got %v", test.locked, got)
+				}
+				for field, want := range test.locked {
+					if got := cfg.LockedBy(field); got != want {
+						t.Errorf("LockedBy(%s) == %q, got %q", field, want, got)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEnvironmentOverridesInvalid(t *testing.T) {
+	for name, env := range map[string]map[string]string{
+		"number":      {"D2D_TIMEOUT": "soon"},
+		"boolean":     {"D2D_ACCESS_BASIC_AUTH": "maybe"},
+		"isolation":   {"D2D_VISITOR_QUERY_ISOLATION": "Sometimes"},
+		"environment": {"D2D_ENVIRONMENTS": "acceptance"},
+		"file":        {"D2D_PASSWORD_FILE": "/nonexistent/password"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			defer setenv(t, env)()
+
+			err := json.Unmarshal([]byte(`{}`), NewConfiguration())
+			if _, ok := err.(*EnvironmentError); !ok {
+				t.Errorf("Unmarshal() == *EnvironmentError, got %v", err)
+			}
+		})
+	}
+}
+
+func TestEnvironmentOverridesStored(t *testing.T) {
+	defer setenv(t, map[string]string{
+		"D2D_PASSWORD":          "env",
+		"D2D_DSN":               "postgres://env@envdb/seh",
+		"D2D_LAB_QUERY":         "select 'env'",
+		"D2D_LAB_QUERY_TIMEOUT": "60",
+	})()
+
+	cfg := NewConfiguration()
+	file := `{"username": "file", "password": "file", "lab": "select 'file'", "dsn": "postgres://pg@filedb/seh", "queryOptions": {"lab": {"readOnly": true}}}`
+	if err := json.Unmarshal([]byte(file), cfg); err != nil {
+		t.Fatal(err)
+	}
+
+	// changes to locked settings are undone, other changes are kept
+	cfg.SetCredentials("changed", "changed")
+	cfg.SetLabQuery("select 'changed'")
+	cfg.SetQueryOptions(DatasetLab, cfg.QueryOptions(DatasetLab))
+	if username, password := cfg.Credentials(); username != "changed" || password != "env" {
+		t.Errorf("Credentials() == changed, env, got %s, %s", username, password)
+	}
+	if got := cfg.LabQuery(); got != "select 'env'" {
+		t.Errorf("LabQuery() == %q, got %q", "select 'env'", got)
+	}
+	if got := cfg.Connection().Host; got != "envdb" {
+		t.Errorf("Connection().Host == envdb, got %s", got)
+	}
+
+	// the values from the file are saved instead of those from the environment
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vars persistentConfig
+	if err := json.Unmarshal(bs, &vars); err != nil {
+		t.Fatal(err)
+	}
+	if vars.Username != "changed" {
+		t.Errorf("stored username == changed, got %s", vars.Username)
+	}
+	if vars.Password != "file" {
+		t.Errorf("stored password == file, got %s", vars.Password)
+	}
+	if vars.LabQuery != "select 'file'" {
+		t.Errorf("stored lab query == %q, got %q", "select 'file'", vars.LabQuery)
+	}
+	if vars.Dsn.Host != "filedb" {
+		t.Errorf("stored dsn host == filedb, got %s", vars.Dsn.Host)
+	}
+	if want := (persistentQueryOptions{Isolation: "Default", ReadOnly: true}); vars.QueryOptions[DatasetLab] != want {
+		t.Errorf("stored lab query options == %v, got %v", want, vars.QueryOptions[DatasetLab])
+	}
+}
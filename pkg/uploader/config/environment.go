@@ -0,0 +1,63 @@
+package config
+
+import (
+	"strings"
+)
+
+const (
+	// EnvironmentProduction is the name of the door2doc production environment.
+	EnvironmentProduction = "production"
+	// ProductionServer is the URL of the door2doc production environment.
+	ProductionServer = "https://integration.door2doc.net/"
+)
+
+// Environment is a named door2doc server that uploads can be sent to.
+type Environment struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// DefaultEnvironments returns the environments that are available in a new configuration.
+func DefaultEnvironments() []Environment {
+	return []Environment{
+		{Name: EnvironmentProduction, URL: ProductionServer},
+	}
+}
+
+// normalizeEnvironments drops incomplete environments, and makes sure the production environment is always available.
+func normalizeEnvironments(envs []Environment) []Environment {
+	var (
+		res        []Environment
+		production bool
+		seen       = make(map[string]bool)
+	)
+	for _, env := range envs {
+		env.Name = strings.TrimSpace(env.Name)
+		env.URL = strings.TrimSpace(env.URL)
+		if env.Name == "" || env.URL == "" || seen[env.Name] {
+			continue
+		}
+		seen[env.Name] = true
+		if env.Name == EnvironmentProduction {
+			production = true
+		}
+		res = append(res, env)
+	}
+	if !production {
+		res = append(DefaultEnvironments(), res...)
+	}
+	return res
+}
+
+// findEnvironment returns the environment with the given name, or the production environment if it does not exist.
+func findEnvironment(envs []Environment, name string) Environment {
+	for _, env := range envs {
+		if env.Name == name {
+			return env
+		}
+	}
+	if name != EnvironmentProduction {
+		return findEnvironment(envs, EnvironmentProduction)
+	}
+	return Environment{Name: EnvironmentProduction, URL: ProductionServer}
+}
@@ -1,7 +1,9 @@
 package config
 
 import (
+	"database/sql"
 	"fmt"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -14,8 +16,62 @@ var (
 	ErrD2DCredentialsInvalid       = errors.New("credentials invalid")
 	ErrAccessNotConfigured         = errors.New("access credentials have not been configured")
 	ErrInvalidTimeout              = errors.New("invalid query timeout")
+	ErrUsernameRequired            = errors.New("username required")
+	ErrPasswordRequired            = errors.New("password required for new user")
+	ErrInvalidRole                 = errors.New("invalid role")
+	ErrNoAdministrator             = errors.New("at least one administrator is required")
+	ErrVersionNotFound             = errors.New("configuration version not found")
+	ErrInvalidBundle               = errors.New("not a configuration bundle")
+	ErrUnsupportedBundleVersion    = errors.New("configuration bundle was exported by a newer version")
+	ErrPassphraseRequired          = errors.New("passphrase required to decrypt configuration bundle")
+	ErrWrongPassphrase             = errors.New("wrong passphrase for configuration bundle")
+	ErrTokenNameRequired           = errors.New("token name required")
+	ErrTokenExists                 = errors.New("a token with this name already exists")
+	ErrReadOnlyNotSupported        = errors.New("read-only transactions are not supported by the database driver")
 )
 
+// InvalidConfigurationError indicates that a configuration was not applied, because it did not pass validation.
+type InvalidConfigurationError struct {
+	Validation *ValidationResult
+}
+
+func (e *InvalidConfigurationError) Error() string {
+	return fmt.Sprintf("invalid configuration: %v", e.Validation.Err())
+}
+
+// EnvironmentError indicates that an environment variable that overrides a setting has an invalid value.
+type EnvironmentError struct {
+	Env   string
+	Cause string
+}
+
+func (e *EnvironmentError) Error() string {
+	return fmt.Sprintf("invalid value of environment variable %s: %s", e.Env, e.Cause)
+}
+
+// SchemaError indicates that the configuration file does not match the schema, or contains an invalid value. Line and
+// Column are zero if the position in the file is unknown.
+type SchemaError struct {
+	Field  string
+	Line   int
+	Column int
+	Msg    string
+}
+
+func (e *SchemaError) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid configuration file")
+	if e.Line > 0 {
+		fmt.Fprintf(&b, " at line %d, column %d", e.Line, e.Column)
+	}
+	b.WriteString(": ")
+	if e.Field != "" {
+		b.WriteString(e.Field + ": ")
+	}
+	b.WriteString(e.Msg)
+	return b.String()
+}
+
 // D2DCredentialsStatusError indicates a general error while connecting to the door2doc cloud.
 type D2DCredentialsStatusError struct {
 	StatusCode int
@@ -42,3 +98,15 @@ type QueryError struct {
 func (e *QueryError) Error() string {
 	return fmt.Sprintf("general query error: %s", e.Cause)
 }
+
+// TransactionError indicates that the transaction to run the query in could not be started, usually because the
+// database does not support the requested isolation level.
+type TransactionError struct {
+	Isolation sql.IsolationLevel
+	ReadOnly  bool
+	Cause     string
+}
+
+func (e *TransactionError) Error() string {
+	return fmt.Sprintf("failed to start %s transaction (read-only: %t): %s", e.Isolation, e.ReadOnly, e.Cause)
+}
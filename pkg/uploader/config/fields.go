@@ -0,0 +1,81 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Fields returns the configuration as a flat list of fields, named after the keys in the configuration file, with
+// nested values separated by dots. Users, tokens and environments are keyed by name, and query options by dataset, so that
+// removing one does not change the names of the others. Secrets are included as stored, so the result must never be
+// shown as is; see IsSecretField.
+func (c *Configuration) Fields() map[string]string {
+	c.mu.RLock()
+	vars := c.persistent()
+	c.mu.RUnlock()
+
+	res := map[string]string{
+		"username":           vars.Username,
+		"password":           vars.Password,
+		"environment":        vars.Environment,
+		"proxy":              vars.Proxy,
+		"proxyMode":          vars.ProxyMode,
+		"proxyUsername":      vars.ProxyUsername,
+		"proxyPassword":      vars.ProxyPassword,
+		"noProxy":            vars.NoProxy,
+		"dsn.driver":         vars.Dsn.Driver,
+		"dsn.host":           vars.Dsn.Host,
+		"dsn.port":           vars.Dsn.Port,
+		"dsn.instance":       vars.Dsn.Instance,
+		"dsn.database":       vars.Dsn.Database,
+		"dsn.username":       vars.Dsn.Username,
+		"dsn.params":         vars.Dsn.Params,
+		"dsn.path":           vars.Dsn.Path,
+		"dsnPassword":        vars.Dsn.Password,
+		"timeout":            strconv.Itoa(vars.Timeout),
+		"maxOpenConnections": strconv.Itoa(vars.MaxOpen),
+		"maxIdleConnections": strconv.Itoa(vars.MaxIdle),
+		"connectionLifetime": strconv.Itoa(vars.MaxLifetime),
+		"query":              vars.VisitorQuery,
+		"radiologie":         vars.RadiologieQuery,
+		"lab":                vars.LabQuery,
+		"consult":            vars.ConsultQuery,
+		"accessBasicAuth":    strconv.FormatBool(vars.AccessBasicAuth),
+		"interval":           strconv.Itoa(vars.Interval),
+		"active":             strconv.FormatBool(vars.Active),
+		"paused":             strconv.FormatBool(vars.Paused),
+		"tracingEndpoint":    vars.TracingEndpoint,
+		"historyRetention":   strconv.Itoa(vars.HistoryRetention),
+		"maxVersions":        strconv.Itoa(vars.MaxVersions),
+	}
+	for _, env := range vars.Environments {
+		res["environments."+env.Name+".url"] = env.URL
+	}
+	for ds, opts := range vars.QueryOptions {
+		prefix := "queryOptions." + string(ds) + "."
+		res[prefix+"timeout"] = strconv.Itoa(opts.Timeout)
+		res[prefix+"isolation"] = opts.Isolation
+		res[prefix+"readOnly"] = strconv.FormatBool(opts.ReadOnly)
+	}
+	for _, u := range vars.Users {
+		prefix := "users." + u.Username + "."
+		res[prefix+"password"] = u.Password
+		res[prefix+"role"] = string(u.Role)
+	}
+	for _, t := range vars.Tokens {
+		prefix := "tokens." + t.Name + "."
+		res[prefix+"hash"] = t.Hash
+		res[prefix+"role"] = string(t.Role)
+	}
+	return res
+}
+
+// IsSecretField returns whether the field with the given name, as returned by Fields, contains a secret.
+func IsSecretField(field string) bool {
+	switch field {
+	case "password", "proxyPassword", "dsnPassword":
+		return true
+	}
+	return strings.HasPrefix(field, "users.") && strings.HasSuffix(field, ".password") ||
+		strings.HasPrefix(field, "tokens.") && strings.HasSuffix(field, ".hash")
+}
@@ -0,0 +1,331 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+)
+
+// migrations upgrade a configuration file from one schema version to the next: migrations[0] upgrades files without a
+// schema version to version 1, and so on. They run after secrets have been decrypted.
+var migrations = []func(vars *persistentConfig){
+	// version 1 introduced the schema version, users with roles and proxy modes
+	func(vars *persistentConfig) {
+		if vars.AccessUsername != "" && vars.AccessPassword != "" && len(vars.Users) == 0 {
+			vars.Users = []User{{Username: vars.AccessUsername, Password: vars.AccessPassword, Role: RoleAdmin}}
+		}
+		vars.AccessUsername, vars.AccessPassword = "", ""
+		if vars.ProxyMode == rest.ProxyNone && vars.Proxy != "" {
+			// configuration files from before the introduction of proxy modes only contain the proxy URL
+			vars.ProxyMode = rest.ProxyManual
+		}
+	},
+}
+
+// unknownFieldPrefix starts the message of the error returned by encoding/json for keys that are not in the schema.
+const unknownFieldPrefix = `json: unknown field "`
+
+// SchemaVersion is the version of the format of the configuration file that is written.
+var SchemaVersion = len(migrations)
+
+// defaultPersistentConfig returns the values of settings that are missing from the configuration file.
+func defaultPersistentConfig() persistentConfig {
+	pool := db.DefaultPoolSettings()
+	return persistentConfig{
+		Environment: EnvironmentProduction,
+		Timeout:     5,
+		MaxOpen:     pool.MaxOpen,
+		MaxIdle:     pool.MaxIdle,
+		MaxLifetime: int(pool.MaxLifetime / time.Second),
+		Interval:    int(time.Minute / time.Second),
+		Active:      true,
+		// days
+		HistoryRetention: 30,
+		MaxVersions:      DefaultMaxVersions,
+	}
+}
+
+// setDefaults replaces the settings for which zero is not a meaningful value by their defaults. Zero idle connections
+// is meaningful, so a missing maxIdleConnections is defaulted by decodeStrict instead.
+func (p *persistentConfig) setDefaults() {
+	defaults := defaultPersistentConfig()
+	for _, n := range []struct{ value, def *int }{
+		{&p.Timeout, &defaults.Timeout},
+		{&p.MaxOpen, &defaults.MaxOpen},
+		{&p.MaxLifetime, &defaults.MaxLifetime},
+		{&p.Interval, &defaults.Interval},
+		{&p.HistoryRetention, &defaults.HistoryRetention},
+		{&p.MaxVersions, &defaults.MaxVersions},
+	} {
+		if *n.value == 0 {
+			*n.value = *n.def
+		}
+	}
+}
+
+// migrate upgrades vars to the current schema version.
+func migrate(vars *persistentConfig) {
+	for v := vars.Schema; v < len(migrations); v++ {
+		migrations[v](vars)
+	}
+	vars.Schema = SchemaVersion
+}
+
+// decodeStrict decodes a configuration file. Unlike json.Unmarshal, it rejects unknown keys, and checks that all values
+// are valid, so that a mistake in the file is reported instead of silently ignored. Missing settings get their default
+// values. Errors are of type *SchemaError, with the line of the offending setting where possible.
+func decodeStrict(bs []byte) (persistentConfig, error) {
+	vars := defaultPersistentConfig()
+
+	dec := json.NewDecoder(bytes.NewReader(bs))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&vars); err != nil {
+		return vars, decodeError(bs, err)
+	}
+	if dec.More() {
+		return vars, &SchemaError{Msg: "unexpected data after the configuration"}
+	}
+
+	if field, msg := vars.check(); field != "" {
+		e := &SchemaError{Field: field, Msg: msg}
+		e.Line, e.Column = position(bs, offsetOf(bs, field))
+		return vars, e
+	}
+	return vars, nil
+}
+
+// decodeError converts an error from decoding the configuration file into a *SchemaError.
+func decodeError(bs []byte, err error) error {
+	switch e := err.(type) {
+	case *json.SyntaxError:
+		res := &SchemaError{Msg: e.Error()}
+		res.Line, res.Column = position(bs, int(e.Offset))
+		return res
+	case *json.UnmarshalTypeError:
+		res := &SchemaError{Field: e.Field, Msg: fmt.Sprintf("expected %s, got %s", describeType(e.Type), e.Value)}
+		res.Line, res.Column = position(bs, offsetOf(bs, e.Field))
+		return res
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return &SchemaError{Msg: "the configuration file is incomplete"}
+	}
+	if msg := err.Error(); strings.HasPrefix(msg, unknownFieldPrefix) {
+		field := strings.TrimSuffix(strings.TrimPrefix(msg, unknownFieldPrefix), `"`)
+		res := &SchemaError{Field: field, Msg: "unknown setting"}
+		res.Line, res.Column = position(bs, keyIndex(bs, 0, field))
+		return res
+	}
+	return &SchemaError{Msg: err.Error()}
+}
+
+// describeType returns a description of the type of value expected for a setting.
+func describeType(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Bool:
+		return "true or false"
+	case reflect.Int, reflect.Int64:
+		return "a whole number"
+	case reflect.String:
+		return "text"
+	case reflect.Slice:
+		return "a list"
+	case reflect.Map, reflect.Struct:
+		return "an object"
+	}
+	return t.String()
+}
+
+// check returns the name of the first setting with an invalid value, and the reason it is invalid.
+func (p *persistentConfig) check() (string, string) {
+	if p.Schema < 0 || p.Schema > SchemaVersion {
+		return "schema", fmt.Sprintf("version %d is not supported by this version of the service, which supports up to version %d", p.Schema, SchemaVersion)
+	}
+
+	for i, env := range p.Environments {
+		field := fmt.Sprintf("environments[%d]", i)
+		if strings.TrimSpace(env.Name) == "" {
+			return field + ".name", "name required"
+		}
+		if u, err := url.Parse(env.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return field + ".url", "expected an http or https URL"
+		}
+	}
+	if p.Environment != "" && findEnvironment(normalizeEnvironments(p.Environments), p.Environment).Name != p.Environment {
+		return "environment", fmt.Sprintf("unknown environment %q", p.Environment)
+	}
+
+	switch p.ProxyMode {
+	case rest.ProxyNone, rest.ProxyManual, rest.ProxyEnvironment:
+	default:
+		return "proxyMode", fmt.Sprintf("expected %q, %q or %q", rest.ProxyNone, rest.ProxyManual, rest.ProxyEnvironment)
+	}
+	if p.Proxy != "" {
+		if u, err := url.Parse(p.Proxy); err != nil || u.Host == "" {
+			return "proxy", "expected a URL like http://server:port"
+		}
+	}
+
+	if p.TracingEndpoint != "" {
+		if u, err := url.Parse(p.TracingEndpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "tracingEndpoint", "expected a URL like http://localhost:4318"
+		}
+	}
+
+	for field, n := range map[string]int{
+		"timeout":            p.Timeout,
+		"maxOpenConnections": p.MaxOpen,
+		"maxIdleConnections": p.MaxIdle,
+		"connectionLifetime": p.MaxLifetime,
+		"interval":           p.Interval,
+		"historyRetention":   p.HistoryRetention,
+		"maxVersions":        p.MaxVersions,
+	} {
+		if n < 0 {
+			return field, "must not be negative"
+		}
+	}
+
+	for ds, opts := range p.QueryOptions {
+		field := "queryOptions." + string(ds)
+		if !ds.IsValid() {
+			return field, "unknown dataset"
+		}
+		if opts.Timeout < 0 {
+			return field + ".timeout", "must not be negative"
+		}
+		if _, err := db.ParseIsolationLevel(opts.Isolation); err != nil {
+			return field + ".isolation", err.Error()
+		}
+	}
+
+	for i, u := range p.Users {
+		field := fmt.Sprintf("users[%d]", i)
+		if strings.TrimSpace(u.Username) == "" {
+			return field + ".username", "username required"
+		}
+		if u.Role != "" && !u.Role.IsValid() {
+			return field + ".role", fmt.Sprintf("expected %q, %q or %q", RoleViewer, RoleOperator, RoleAdmin)
+		}
+	}
+
+	for i, token := range p.Tokens {
+		field := fmt.Sprintf("tokens[%d]", i)
+		if strings.TrimSpace(token.Name) == "" {
+			return field + ".name", "name required"
+		}
+		if !isAPITokenHash(token.Hash) {
+			return field + ".hash", "expected the SHA-256 hash of the token, in hexadecimal"
+		}
+		if !token.Role.IsValid() {
+			return field + ".role", fmt.Sprintf("expected %q, %q or %q", RoleViewer, RoleOperator, RoleAdmin)
+		}
+	}
+	return "", ""
+}
+
+// offsetOf returns the offset of the key of a setting in a configuration file, or -1 if it cannot be found. The setting
+// is named like "queryOptions.lab.timeout" or "users[1].role".
+func offsetOf(bs []byte, field string) int {
+	offset := 0
+	for _, segment := range strings.Split(field, ".") {
+		name, index := segment, -1
+		if i := strings.IndexByte(segment, '['); i >= 0 && strings.HasSuffix(segment, "]") {
+			n, err := strconv.Atoi(segment[i+1 : len(segment)-1])
+			if err != nil {
+				return -1
+			}
+			name, index = segment[:i], n
+		}
+
+		if offset = keyIndex(bs, offset, name); offset < 0 {
+			return -1
+		}
+		if index >= 0 {
+			if offset = elementIndex(bs, offset, index); offset < 0 {
+				return -1
+			}
+		}
+	}
+	return offset
+}
+
+// keyIndex returns the offset of the first object key name in bs at or after offset, or -1 if there is none.
+func keyIndex(bs []byte, offset int, name string) int {
+	key := []byte(strconv.Quote(name))
+	for offset < len(bs) {
+		i := bytes.Index(bs[offset:], key)
+		if i < 0 {
+			return -1
+		}
+		start := offset + i
+		offset = start + len(key)
+		// only a string followed by a colon is a key, rather than a value
+		if rest := bytes.TrimLeft(bs[offset:], " \t\r\n"); len(rest) > 0 && rest[0] == ':' {
+			return start
+		}
+	}
+	return -1
+}
+
+// elementIndex returns the offset of element n of the first array after offset, or -1 if there is none.
+func elementIndex(bs []byte, offset, n int) int {
+	depth, inString, escaped, next := 0, false, false, true
+	for i := offset; i < len(bs); i++ {
+		b := bs[i]
+		if inString {
+			switch {
+			case escaped:
+				escaped = false
+			case b == '\\':
+				escaped = true
+			case b == '"':
+				inString = false
+			}
+			continue
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n', ':':
+			continue
+		}
+
+		if depth == 1 && next && b != ']' {
+			if n == 0 {
+				return i
+			}
+			n--
+			next = false
+		}
+		switch b {
+		case '"':
+			inString = true
+		case '[', '{':
+			depth++
+		case ']', '}':
+			if depth--; depth <= 0 {
+				return -1
+			}
+		case ',':
+			next = depth == 1 || next
+		}
+	}
+	return -1
+}
+
+// position returns the line and column of an offset in bs, both starting at 1, or zeroes if the offset is unknown.
+func position(bs []byte, offset int) (int, int) {
+	if offset < 0 || offset > len(bs) {
+		return 0, 0
+	}
+	line := 1 + bytes.Count(bs[:offset], []byte("\n"))
+	column := offset - bytes.LastIndexByte(bs[:offset], '\n')
+	return line, column
+}
@@ -0,0 +1,188 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+)
+
+func TestDecodeStrict(t *testing.T) {
+	for name, test := range map[string]struct {
+		file  string
+		field string
+		line  int
+	}{
+		"valid": {
+			file: `{"schema": 1, "username": "user", "queryOptions": {"lab": {"isolation": "Snapshot"}}, "users": [{"username": "admin", "role": "admin"}]}`,
+		},
+		"empty": {
+			file: `{}`,
+		},
+		"syntax": {
+			file: "{\n  \"username\": \"user\"\n  \"password\": \"pass\"\n}",
+			line: 3,
+		},
+		"incomplete": {
+			file: `{"username": `,
+		},
+		"unknown field": {
+			file:  "{\n  \"username\": \"user\",\n  \"usernme\": \"user\"\n}",
+			field: "usernme",
+			line:  3,
+		},
+		"unknown nested field": {
+			file:  "{\n  \"users\": [\n    {\"username\": \"admin\", \"rol\": \"admin\"}\n  ]\n}",
+			field: "rol",
+			line:  3,
+		},
+		"type": {
+			file:  "{\n  \"username\": \"user\",\n  \"timeout\": \"10\"\n}",
+			field: "timeout",
+			line:  3,
+		},
+		"newer schema": {
+			file:  `{"schema": 99}`,
+			field: "schema",
+			line:  1,
+		},
+		"unknown environment": {
+			file:  "{\n  \"environment\": \"acceptance\"\n}",
+			field: "environment",
+			line:  2,
+		},
+		"environment url": {
+			file:  "{\n  \"environments\": [\n    {\"name\": \"a\", \"url\": \"https://a.example.com/\"},\n    {\"name\": \"b\", \"url\": \"b.example.com\"}\n  ]\n}",
+			field: "environments[1].url",
+			line:  4,
+		},
+		"proxy mode": {
+			file:  `{"proxyMode": "automatic"}`,
+			field: "proxyMode",
+			line:  1,
+		},
+		"tracing endpoint": {
+			file:  "{\n  \"tracingEndpoint\": \"localhost:4318\"\n}",
+			field: "tracingEndpoint",
+			line:  2,
+		},
+		"negative interval": {
+			file:  "{\n  \"interval\": -1\n}",
+			field: "interval",
+			line:  2,
+		},
+		"unknown dataset": {
+			file:  "{\n  \"queryOptions\": {\n    \"xray\": {}\n  }\n}",
+			field: "queryOptions.xray",
+			line:  3,
+		},
+		"isolation": {
+			file:  "{\n  \"lab\": \"select 1\",\n  \"queryOptions\": {\n    \"lab\": {\"isolation\": \"Sometimes\"}\n  }\n}",
+			field: "queryOptions.lab.isolation",
+			line:  4,
+		},
+		"role": {
+			file:  "{\n  \"users\": [\n    {\"username\": \"admin\", \"role\": \"admin\"},\n    {\"username\": \"root\", \"role\": \"root\"}\n  ]\n}",
+			field: "users[1].role",
+			line:  4,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := decodeStrict([]byte(test.file))
+			if name == "valid" || name == "empty" {
+				if err != nil {
+					t.Errorf("decodeStrict() == nil, got %v", err)
+				}
+				return
+			}
+			e, ok := err.(*SchemaError)
+			if !ok {
+				t.Fatalf("decodeStrict() == *SchemaError, got %v", err)
+			}
+			if e.Field != test.field || e.Line != test.line {
+				t.Errorf("decodeStrict() == error in %q at line %d, got %q at line %d: %v", test.field, test.line, e.Field, e.Line, e)
+			}
+		})
+	}
+}
+
+func TestSchemaDefaults(t *testing.T) {
+	cfg := NewConfiguration()
+	cfg.SetPaused(true)
+	if err := json.Unmarshal([]byte(`{"username": "user"}`), cfg); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Interval(); got != time.Minute {
+		t.Errorf("Interval() == %v, got %v", time.Minute, got)
+	}
+	if !cfg.Enabled() {
+		t.Errorf("Enabled() == true, got false")
+	}
+	if cfg.Paused() {
+		t.Errorf("Paused() == false, got true")
+	}
+	if got := cfg.Timeout(); got != 5*time.Second {
+		t.Errorf("Timeout() == %v, got %v", 5*time.Second, got)
+	}
+
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vars map[string]interface{}
+	if err := json.Unmarshal(bs, &vars); err != nil {
+		t.Fatal(err)
+	}
+	for key, want := range map[string]interface{}{"schema": float64(SchemaVersion), "interval": float64(60), "active": true, "paused": false} {
+		if got := vars[key]; got != want {
+			t.Errorf("stored %s == %v, got %v", key, want, got)
+		}
+	}
+}
+
+func TestSchemaDefaults_MaxIdle(t *testing.T) {
+	for name, test := range map[string]struct {
+		file string
+		want int
+	}{
+		"missing": {file: `{}`, want: db.DefaultPoolSettings().MaxIdle},
+		"zero":    {file: `{"maxIdleConnections": 0}`, want: 0},
+		"set":     {file: `{"maxIdleConnections": 5}`, want: 5},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := NewConfiguration()
+			if err := json.Unmarshal([]byte(test.file), cfg); err != nil {
+				t.Fatal(err)
+			}
+			if got := cfg.Pool().MaxIdle; got != test.want {
+				t.Errorf("Pool().MaxIdle == %d, got %d", test.want, got)
+			}
+		})
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	cfg := NewConfiguration()
+	file := `{"proxy": "http://proxy:8080", "accessUsername": "admin", "accessPassword": "secret"}`
+	if err := json.Unmarshal([]byte(file), cfg); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Proxy().Mode; got != rest.ProxyManual {
+		t.Errorf("Proxy().Mode == %q, got %q", rest.ProxyManual, got)
+	}
+	if users := cfg.Users(); len(users) != 1 || users[0].Username != "admin" || users[0].Role != RoleAdmin {
+		t.Errorf("Users() == [admin], got %v", users)
+	}
+
+	// files with the current schema are not migrated again
+	cfg = NewConfiguration()
+	file = `{"schema": 1, "proxy": "http://proxy:8080"}`
+	if err := json.Unmarshal([]byte(file), cfg); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Proxy().Mode; got != rest.ProxyNone {
+		t.Errorf("Proxy().Mode == %q, got %q", rest.ProxyNone, got)
+	}
+}
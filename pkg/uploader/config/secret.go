@@ -0,0 +1,162 @@
+package config
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/secret"
+	"github.com/pkg/errors"
+)
+
+const (
+	// keyFile is the name of the file containing the key to encrypt secrets with. It is stored next to the
+	// configuration file, and never leaves the machine.
+	keyFile = "door2doc.key"
+	keySize = 32
+
+	// encryptedPrefix marks an encrypted value in the configuration file.
+	encryptedPrefix = "enc:v1:"
+)
+
+// ErrNoSecretKey indicates that data could not be encrypted because the key has not been loaded, which happens when the
+// configuration is loaded from or saved to a file.
+var ErrNoSecretKey = errors.New("no key to encrypt with")
+
+// ErrSecretKeyMismatch indicates that the configuration contains secrets that cannot be decrypted with the key file,
+// usually because the configuration file was copied from another machine.
+var ErrSecretKeyMismatch = errors.New("secrets in configuration cannot be decrypted with the local key file")
+
+// secretBox encrypts and decrypts secrets in the configuration file using AES-GCM.
+type secretBox struct {
+	aead cipher.AEAD
+}
+
+func newSecretBox(key []byte) (*secretBox, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &secretBox{aead: aead}, nil
+}
+
+// loadSecretBox reads the key file from dir, creating a new random key if it does not exist yet.
+func loadSecretBox(dir string) (*secretBox, error) {
+	path := filepath.Join(dir, keyFile)
+	key, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		key = make([]byte, keySize)
+		if _, err := io.ReadFull(rand.Reader, key); err != nil {
+			return nil, errors.Wrap(err, "while generating key")
+		}
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, errors.Wrap(err, "while creating configuration folder")
+		}
+		if err := ioutil.WriteFile(path, key, 0600); err != nil {
+			return nil, errors.Wrap(err, "while writing key file")
+		}
+	} else if err != nil {
+		return nil, errors.Wrap(err, "while reading key file")
+	}
+
+	if len(key) != keySize {
+		return nil, errors.Errorf("invalid key file %s", path)
+	}
+	return newSecretBox(key)
+}
+
+// isEncrypted returns whether s is an encrypted secret.
+func isEncrypted(s string) bool {
+	return strings.HasPrefix(s, encryptedPrefix)
+}
+
+// Encrypt encrypts a secret. Empty secrets are not encrypted, so that it remains visible that they are not set.
+func (b *secretBox) Encrypt(plain string) (string, error) {
+	if plain == "" {
+		return "", nil
+	}
+
+	nonce := make([]byte, b.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := b.aead.Seal(nonce, nonce, []byte(plain), nil)
+	return encryptedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a secret. Values without the encryption prefix are returned as is.
+func (b *secretBox) Decrypt(s string) (string, error) {
+	if !isEncrypted(s) {
+		return s, nil
+	}
+	if b == nil {
+		return "", ErrSecretKeyMismatch
+	}
+
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, encryptedPrefix))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid encrypted secret")
+	}
+	n := b.aead.NonceSize()
+	if len(sealed) < n {
+		return "", errors.New("invalid encrypted secret")
+	}
+	plain, err := b.aead.Open(nil, sealed[:n], sealed[n:], nil)
+	if err != nil {
+		return "", ErrSecretKeyMismatch
+	}
+	return string(plain), nil
+}
+
+// Encrypt encrypts data that must not be stored in plain text, such as the payloads in the history, with the key that
+// secrets in the configuration are encrypted with.
+func (c *Configuration) Encrypt(plain string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.secrets == nil {
+		return "", ErrNoSecretKey
+	}
+	return c.secrets.Encrypt(plain)
+}
+
+// Decrypt decrypts data encrypted with Encrypt. Data that is not encrypted is returned as is.
+func (c *Configuration) Decrypt(s string) (string, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.secrets.Decrypt(s)
+}
+
+// resolveProxy returns p with its password resolved, if it is a secret reference.
+func resolveProxy(ctx context.Context, p rest.Proxy) (rest.Proxy, error) {
+	password, err := secret.Resolve(ctx, p.Password)
+	if err != nil {
+		return p, err
+	}
+	p.Password = password
+	return p, nil
+}
+
+// resolveConnection returns conn with its password resolved, if it is a secret reference.
+func resolveConnection(ctx context.Context, conn db.ConnectionData) (db.ConnectionData, error) {
+	password, err := secret.Resolve(ctx, conn.Password)
+	if err != nil {
+		return conn, err
+	}
+	conn.Password = password
+	return conn, nil
+}
@@ -0,0 +1,183 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+)
+
+func TestLoadSecretBox(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	box, err := loadSecretBox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := box.Encrypt("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !isEncrypted(enc) {
+		t.Errorf("Encrypt() == encrypted value, got %s", enc)
+	}
+
+	// loading again uses the existing key
+	box, err = loadSecretBox(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := box.Decrypt(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "secret" {
+		t.Errorf("Decrypt() == secret, got %s", got)
+	}
+
+	other, err := newSecretBox(bytes.Repeat([]byte{1}, keySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.Decrypt(enc); err != ErrSecretKeyMismatch {
+		t.Errorf("Decrypt() == ErrSecretKeyMismatch, got %v", err)
+	}
+	if got, err := other.Decrypt("plain"); err != nil || got != "plain" {
+		t.Errorf("Decrypt() == plain, nil; got %s, %v", got, err)
+	}
+}
+
+func TestConfiguration_Encrypt(t *testing.T) {
+	c := NewConfiguration()
+	if _, err := c.Encrypt("payload"); err != ErrNoSecretKey {
+		t.Errorf("Encrypt() without key == ErrNoSecretKey, got %v", err)
+	}
+
+	box, err := newSecretBox(bytes.Repeat([]byte{3}, keySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.secrets = box
+	enc, err := c.Encrypt("payload")
+	if err != nil || !isEncrypted(enc) {
+		t.Fatalf("Encrypt() == encrypted value, got %s, %v", enc, err)
+	}
+	if got, err := c.Decrypt(enc); err != nil || got != "payload" {
+		t.Errorf("Decrypt() == payload, got %s, %v", got, err)
+	}
+}
+
+func TestConfigurationJSONEncryptsSecrets(t *testing.T) {
+	box, err := newSecretBox(bytes.Repeat([]byte{2}, keySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := NewConfiguration()
+	cfg.secrets = box
+	cfg.SetCredentials("user", "d2d-secret")
+	cfg.SetUser("admin", "access-secret", RoleAdmin)
+	cfg.SetProxy(rest.Proxy{Mode: rest.ProxyManual, URL: "http://proxy:8080", Username: "proxy", Password: "proxy-secret"})
+	cfg.SetConnection(db.ConnectionData{Driver: "postgres", Host: "localhost", Database: "seh", Username: "pguser", Password: "db-secret"})
+
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"d2d-secret", "access-secret", "proxy-secret", "db-secret"} {
+		if bytes.Contains(bs, []byte(secret)) {
+			t.Errorf("MarshalJSON() contains %s in plain text: %s", secret, bs)
+		}
+	}
+	var vars persistentConfig
+	if err := json.Unmarshal(bs, &vars); err != nil {
+		t.Fatal(err)
+	}
+	if vars.hasPlainTextSecrets() {
+		t.Error("hasPlainTextSecrets() == false, got true")
+	}
+
+	got := NewConfiguration()
+	got.secrets = box
+	if err := json.Unmarshal(bs, got); err != nil {
+		t.Fatal(err)
+	}
+	if _, password := got.Credentials(); password != "d2d-secret" {
+		t.Errorf("Credentials() == _, d2d-secret; got %s", password)
+	}
+	if _, ok, err := got.AuthenticateUser(context.Background(), "admin", "access-secret"); !ok || err != nil {
+		t.Errorf("AuthenticateUser() == _, true, nil; got %v, %v", ok, err)
+	}
+	if got.Proxy() != cfg.Proxy() {
+		t.Errorf("Proxy() == %v, got %v", cfg.Proxy(), got.Proxy())
+	}
+	if got.Connection() != cfg.Connection() {
+		t.Errorf("Connection() == %v, got %v", cfg.Connection(), got.Connection())
+	}
+
+	if err := json.Unmarshal(bs, NewConfiguration()); err != ErrSecretKeyMismatch {
+		t.Errorf("UnmarshalJSON() without key == ErrSecretKeyMismatch, got %v", err)
+	}
+}
+
+func TestConfigurationJSONPlainTextSecrets(t *testing.T) {
+	var vars persistentConfig
+	if err := json.Unmarshal([]byte(`{"password": "plain"}`), &vars); err != nil {
+		t.Fatal(err)
+	}
+	if !vars.hasPlainTextSecrets() {
+		t.Error("hasPlainTextSecrets() == true, got false")
+	}
+}
+
+func TestConfigurationJSONSecretReferences(t *testing.T) {
+	box, err := newSecretBox(bytes.Repeat([]byte{3}, keySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := NewConfiguration()
+	cfg.secrets = box
+	cfg.SetCredentials("user", "env:D2D_PASSWORD")
+	cfg.SetConnection(db.ConnectionData{Driver: "postgres", Host: "localhost", Database: "seh", Username: "pguser", Password: "vault:secret/d2d#password"})
+
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var vars persistentConfig
+	if err := json.Unmarshal(bs, &vars); err != nil {
+		t.Fatal(err)
+	}
+	if vars.Password != "env:D2D_PASSWORD" {
+		t.Errorf("MarshalJSON() password == env:D2D_PASSWORD, got %s", vars.Password)
+	}
+	if vars.DsnPassword != "vault:secret/d2d#password" {
+		t.Errorf("MarshalJSON() dsnPassword == vault:secret/d2d#password, got %s", vars.DsnPassword)
+	}
+	if vars.hasPlainTextSecrets() {
+		t.Error("hasPlainTextSecrets() == false, got true")
+	}
+
+	if err := os.Setenv("D2D_TEST_DB_PASSWORD", "rotated"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("D2D_TEST_DB_PASSWORD")
+	cfg.SetConnection(db.ConnectionData{Driver: "postgres", Host: "localhost", Database: "seh", Username: "pguser", Password: "env:D2D_TEST_DB_PASSWORD"})
+	conn, err := cfg.ResolvedConnection(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.Password != "rotated" {
+		t.Errorf("ResolvedConnection() password == rotated, got %s", conn.Password)
+	}
+}
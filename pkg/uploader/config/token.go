@@ -0,0 +1,107 @@
+package config
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"io"
+	"strings"
+	"time"
+)
+
+// apiTokenPrefix starts every API token, so that tokens are easy to recognize, for example by secret scanners.
+const apiTokenPrefix = "d2d_"
+
+// APIToken grants scripts access to the JSON API. Only the SHA-256 hash of the token is stored, so the token itself is
+// shown once, when it is created. Tokens have 256 bits of entropy, so a fast hash suffices.
+type APIToken struct {
+	Name    string    `json:"name"`
+	Hash    string    `json:"hash"`
+	Role    Role      `json:"role"`
+	Created time.Time `json:"created"`
+}
+
+// HashAPIToken returns the hash of an API token as it is stored in the configuration.
+func HashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// isAPITokenHash returns whether s looks like the hash of an API token.
+func isAPITokenHash(s string) bool {
+	bs, err := hex.DecodeString(s)
+	return err == nil && len(bs) == sha256.Size
+}
+
+// APITokens returns the tokens that grant access to the JSON API.
+func (c *Configuration) APITokens() []APIToken {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]APIToken(nil), c.tokens...)
+}
+
+// CreateAPIToken adds a token for the JSON API with the given name and role, and returns the token. The token cannot
+// be retrieved afterwards.
+func (c *Configuration) CreateAPIToken(name string, role Role) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", ErrTokenNameRequired
+	}
+	if !role.IsValid() {
+		return "", ErrInvalidRole
+	}
+	bs := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, bs); err != nil {
+		return "", err
+	}
+	token := apiTokenPrefix + base64.RawURLEncoding.EncodeToString(bs)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if findAPIToken(c.tokens, name) >= 0 {
+		return "", ErrTokenExists
+	}
+	c.tokens = append(append([]APIToken(nil), c.tokens...), APIToken{
+		Name:    name,
+		Hash:    HashAPIToken(token),
+		Role:    role,
+		Created: time.Now().UTC().Truncate(time.Second),
+	})
+	return token, nil
+}
+
+// DeleteAPIToken revokes the token with the given name.
+func (c *Configuration) DeleteAPIToken(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if i := findAPIToken(c.tokens, name); i >= 0 {
+		c.tokens = append(append([]APIToken(nil), c.tokens[:i]...), c.tokens[i+1:]...)
+	}
+}
+
+// AuthenticateAPIToken returns the API token that matches token, or false if there is none.
+func (c *Configuration) AuthenticateAPIToken(token string) (APIToken, bool) {
+	hash := []byte(HashAPIToken(token))
+
+	var res APIToken
+	found := false
+	for _, t := range c.APITokens() {
+		if subtle.ConstantTimeCompare([]byte(t.Hash), hash) == 1 {
+			res, found = t, true
+		}
+	}
+	return res, found
+}
+
+func findAPIToken(tokens []APIToken, name string) int {
+	for i, t := range tokens {
+		if t.Name == name {
+			return i
+		}
+	}
+	return -1
+}
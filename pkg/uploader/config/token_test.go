@@ -0,0 +1,65 @@
+package config
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAPITokens(t *testing.T) {
+	cfg := NewConfiguration()
+	token, err := cfg.CreateAPIToken("monitor", RoleViewer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(token, apiTokenPrefix) {
+		t.Errorf("CreateAPIToken() == %s..., got %s", apiTokenPrefix, token)
+	}
+
+	for name, test := range map[string]struct {
+		Name string
+		Role Role
+		Want error
+	}{
+		"duplicate":    {Name: "monitor", Role: RoleAdmin, Want: ErrTokenExists},
+		"no name":      {Name: " ", Role: RoleAdmin, Want: ErrTokenNameRequired},
+		"invalid role": {Name: "deploy", Role: "root", Want: ErrInvalidRole},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := cfg.CreateAPIToken(test.Name, test.Role); err != test.Want {
+				t.Errorf("CreateAPIToken() == %v, got %v", test.Want, err)
+			}
+		})
+	}
+
+	if got, ok := cfg.AuthenticateAPIToken(token); !ok || got.Name != "monitor" || got.Role != RoleViewer {
+		t.Errorf("AuthenticateAPIToken() == monitor, got %v, %v", got, ok)
+	}
+	if _, ok := cfg.AuthenticateAPIToken(token + "x"); ok {
+		t.Errorf("AuthenticateAPIToken(invalid) == false, got true")
+	}
+
+	// only the hash is stored, and it survives a round trip through the configuration file
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bs), token) {
+		t.Errorf("stored configuration contains the token")
+	}
+	loaded := NewConfiguration()
+	if err := json.Unmarshal(bs, loaded); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := loaded.AuthenticateAPIToken(token); !ok {
+		t.Errorf("AuthenticateAPIToken() after loading == true, got false")
+	}
+
+	cfg.DeleteAPIToken("monitor")
+	if _, ok := cfg.AuthenticateAPIToken(token); ok {
+		t.Errorf("AuthenticateAPIToken() after DeleteAPIToken() == false, got true")
+	}
+	if got := len(cfg.APITokens()); got != 0 {
+		t.Errorf("len(APITokens()) == 0, got %d", got)
+	}
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+)
+
+const (
+	// DefaultMaxVersions is the number of versions of the configuration that are kept, unless configured otherwise.
+	DefaultMaxVersions = 20
+
+	// versionsFolder is the folder within the configuration folder that contains the versions.
+	versionsFolder = "versions"
+	// versionLayout is the layout of the timestamp that identifies a version, which sorts in chronological order.
+	versionLayout = "20060102T150405.000000000Z"
+	versionPrefix = "door2doc-"
+	versionSuffix = ".json"
+)
+
+// Version is a copy of the configuration file, as it was saved at some point.
+type Version struct {
+	ID   string
+	Time time.Time
+}
+
+// parseVersion returns the version stored in a file, or false if the file is not a version.
+func parseVersion(name string) (Version, bool) {
+	if !strings.HasPrefix(name, versionPrefix) || !strings.HasSuffix(name, versionSuffix) {
+		return Version{}, false
+	}
+	id := strings.TrimSuffix(strings.TrimPrefix(name, versionPrefix), versionSuffix)
+	t, err := time.Parse(versionLayout, id)
+	if err != nil {
+		return Version{}, false
+	}
+	return Version{ID: id, Time: t}, true
+}
+
+// listVersions returns the versions kept in dir, newest first.
+func listVersions(dir string) ([]Version, error) {
+	files, err := ioutil.ReadDir(filepath.Join(dir, versionsFolder))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Version
+	for _, f := range files {
+		if v, ok := parseVersion(f.Name()); ok {
+			res = append(res, v)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID > res[j].ID
+	})
+	return res, nil
+}
+
+// writeVersion keeps bs as the version saved at time t, and removes the oldest versions beyond the newest max.
+func writeVersion(dir string, bs []byte, t time.Time, max int) error {
+	folder := filepath.Join(dir, versionsFolder)
+	if err := os.MkdirAll(folder, 0700); err != nil {
+		return err
+	}
+	name := versionPrefix + t.UTC().Format(versionLayout) + versionSuffix
+	if err := ioutil.WriteFile(filepath.Join(folder, name), bs, 0600); err != nil {
+		return err
+	}
+
+	versions, err := listVersions(dir)
+	if err != nil {
+		return err
+	}
+	for i := max; i < len(versions); i++ {
+		if err := os.Remove(filepath.Join(folder, versionPrefix+versions[i].ID+versionSuffix)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// isLatestVersion returns whether bs is the same as the newest version kept in dir.
+func isLatestVersion(dir string, bs []byte) bool {
+	versions, err := listVersions(dir)
+	if err != nil || len(versions) == 0 {
+		return false
+	}
+	latest, err := readVersion(dir, versions[0].ID)
+	return err == nil && bytes.Equal(latest, bs)
+}
+
+// readVersion returns the contents of a version kept in dir.
+func readVersion(dir, id string) ([]byte, error) {
+	// only accept well-formed IDs, so that no other files can be read
+	if _, ok := parseVersion(versionPrefix + id + versionSuffix); !ok {
+		return nil, ErrVersionNotFound
+	}
+	bs, err := ioutil.ReadFile(filepath.Join(dir, versionsFolder, versionPrefix+id+versionSuffix))
+	if os.IsNotExist(err) {
+		return nil, ErrVersionNotFound
+	}
+	return bs, err
+}
+
+// Versions returns the versions of the configuration that are kept, newest first. The newest version is the current
+// configuration.
+func (c *Configuration) Versions() ([]Version, error) {
+	dir := c.Dir()
+	if dir == "" {
+		return nil, nil
+	}
+	return listVersions(dir)
+}
+
+// VersionFields returns the fields of a version of the configuration, like Fields does for the current one.
+func (c *Configuration) VersionFields(id string) (map[string]string, error) {
+	bs, err := readVersion(c.Dir(), id)
+	if err != nil {
+		return nil, err
+	}
+	candidate, err := c.decode(bs)
+	if err != nil {
+		return nil, err
+	}
+	return candidate.Fields(), nil
+}
+
+// Rollback replaces the configuration by an earlier version, which is validated first. The configuration is only
+// replaced if the version is valid, and is saved as a new version.
+func (c *Configuration) Rollback(ctx context.Context, id string) error {
+	dir := c.Dir()
+	if dir == "" {
+		return ErrVersionNotFound
+	}
+	bs, err := readVersion(dir, id)
+	if err != nil {
+		return err
+	}
+	if err := c.apply(ctx, bs); err != nil {
+		return err
+	}
+	dlog.Info("Rolled back configuration to version %s", id)
+	return c.Save()
+}
+
+// decode returns the configuration stored in bs, without changing the current configuration. Secrets are decrypted
+// with the key of the current configuration.
+func (c *Configuration) decode(bs []byte) (*Configuration, error) {
+	candidate := NewConfiguration()
+	candidate.detached = true
+	c.mu.RLock()
+	candidate.secrets = c.secrets
+	c.mu.RUnlock()
+
+	candidate.mu.Lock()
+	defer candidate.mu.Unlock()
+	if err := candidate.unmarshal(bs); err != nil {
+		return nil, err
+	}
+	return candidate, nil
+}
+
+// apply validates the configuration stored in bs, and replaces the current configuration by it if it is valid.
+func (c *Configuration) apply(ctx context.Context, bs []byte) error {
+	candidate, err := c.decode(bs)
+	if err != nil {
+		return err
+	}
+	candidate.UpdateBaseValidation(ctx)
+	res := candidate.Validate()
+	if !res.IsValid() {
+		return &InvalidConfigurationError{Validation: res}
+	}
+
+	// replace the configuration at once, so that it is never seen partially replaced
+	candidate.mu.RLock()
+	vars := candidate.persistent()
+	file, locked := candidate.file, candidate.locked
+	candidate.mu.RUnlock()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.assign(&vars); err != nil {
+		return err
+	}
+	c.file, c.locked = file, locked
+	c.setLogDetails()
+	c.validationResult = res
+	c.active = true
+	return nil
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+)
+
+func TestWriteVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	const max = 3
+	start := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i := 0; i < max+5; i++ {
+		if err := writeVersion(dir, []byte(`{}`), start.Add(time.Duration(i)*time.Minute), max); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	versions, err := listVersions(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != max {
+		t.Fatalf("listVersions() == %d versions, got %d", max, len(versions))
+	}
+	if want := start.Add((max + 4) * time.Minute); !versions[0].Time.Equal(want) {
+		t.Errorf("listVersions()[0].Time == %v, got %v", want, versions[0].Time)
+	}
+	if want := start.Add(5 * time.Minute); !versions[max-1].Time.Equal(want) {
+		t.Errorf("listVersions()[%d].Time == %v, got %v", max-1, want, versions[max-1].Time)
+	}
+
+	for _, id := range []string{"../door2doc", "20200301T120000.000000000Z", ""} {
+		if _, err := readVersion(dir, id); err != ErrVersionNotFound {
+			t.Errorf("readVersion(%q) == _, ErrVersionNotFound, got %v", id, err)
+		}
+	}
+}
+
+func TestRollback(t *testing.T) {
+	srv := httptest.NewServer(DummyHandler())
+	defer srv.Close()
+
+	sqlitePath, cleanup := createSQLite(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cfg := NewConfiguration()
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	cfg.SetEnvironments([]Environment{{Name: "test", URL: srv.URL}}, "test")
+	cfg.SetConnection(db.ConnectionData{Driver: "sqlite3", Path: sqlitePath})
+	cfg.SetCredentials(TestUser, TestPassword)
+	cfg.SetVisitorQuery(`select * from correct`)
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	cfg.SetVisitorQuery(`select * from missing`)
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	cfg.SetVisitorQuery(`select * from correct -- edited`)
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	versions, err := listVersions(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 3 {
+		t.Fatalf("listVersions() == 3 versions, got %d", len(versions))
+	}
+	first, invalid := versions[2].ID, versions[1].ID
+
+	fields, err := cfg.VersionFields(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := fields["query"]; got != `select * from correct` {
+		t.Errorf("VersionFields()[query] == %q, got %q", `select * from correct`, got)
+	}
+
+	if err := cfg.Rollback(ctx, invalid); err == nil {
+		t.Errorf("Rollback() to invalid version == *InvalidConfigurationError, got %v", err)
+	} else if _, ok := err.(*InvalidConfigurationError); !ok {
+		t.Errorf("Rollback() to invalid version == *InvalidConfigurationError, got %v", err)
+	}
+	if got := cfg.VisitorQuery(); got != `select * from correct -- edited` {
+		t.Errorf("VisitorQuery() after rejected rollback == %q, got %q", `select * from correct -- edited`, got)
+	}
+
+	if err := cfg.Rollback(ctx, first); err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.VisitorQuery(); got != `select * from correct` {
+		t.Errorf("VisitorQuery() after rollback == %q, got %q", `select * from correct`, got)
+	}
+	if !cfg.Validate().IsValid() {
+		t.Errorf("Validate() after rollback is valid, got %v", cfg.Validate().Err())
+	}
+	if versions, err := listVersions(dir); err != nil || len(versions) != 4 {
+		t.Errorf("listVersions() after rollback == 4 versions, got %d, %v", len(versions), err)
+	}
+}
@@ -0,0 +1,100 @@
+package config
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+)
+
+// WatchInterval is how often the configuration file is checked for changes.
+const WatchInterval = 5 * time.Second
+
+// ReloadStatus is the result of reloading the configuration file after it was changed on disk.
+type ReloadStatus struct {
+	Time time.Time
+	// Error is the reason the changed file was rejected, or nil if it was applied.
+	Error error
+}
+
+// LastReload returns the result of the last reload after the configuration file was changed on disk, or nil if it has
+// not been changed since the service started.
+func (c *Configuration) LastReload() *ReloadStatus {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.lastReload
+}
+
+// Watch checks the configuration file for changes every interval until ctx is done, and reloads it when it was changed
+// by something else than this configuration, like a configuration management tool. Changes are validated first; an
+// invalid file is rejected, and the current configuration is kept.
+func (c *Configuration) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if _, err := c.reloadIfChanged(ctx); err != nil {
+			dlog.Error("Rejected changes to %s, keeping the current configuration: %v", c.Path(), err)
+		}
+	}
+}
+
+// reloadIfChanged reloads the configuration file if its contents differ from what was last read or written, and
+// returns whether it did. A rejected file is not reloaded again until it changes once more.
+func (c *Configuration) reloadIfChanged(ctx context.Context) (bool, error) {
+	path := c.Path()
+	if path == "" {
+		return false, nil
+	}
+	bs, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		// the file may be replaced rather than written in place; it will show up again
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	sum := sha256.Sum256(bs)
+	c.mu.RLock()
+	unchanged := sum == c.sum
+	c.mu.RUnlock()
+	if unchanged {
+		return false, nil
+	}
+	c.setSum(bs)
+
+	err = c.apply(ctx, bs)
+	c.mu.Lock()
+	c.lastReload = &ReloadStatus{Time: time.Now(), Error: err}
+	c.mu.Unlock()
+	if err != nil {
+		return true, err
+	}
+	dlog.Info("Reloaded %s after it was changed", path)
+
+	var vars persistentConfig
+	if err := json.Unmarshal(bs, &vars); err == nil && (vars.hasPlainTextSecrets() || vars.hasUnhashedAccessPassword()) {
+		dlog.Info("Encrypting secrets and hashing passwords in %s", path)
+		if err := c.Save(); err != nil {
+			dlog.Error("Failed to encrypt secrets in %s: %v", path, err)
+		}
+		return true, nil
+	}
+	if dir := filepath.Dir(path); !isLatestVersion(dir, bs) {
+		if err := writeVersion(dir, bs, time.Now(), c.MaxVersions()); err != nil {
+			dlog.Warning("Failed to keep a copy of the configuration: %v", err)
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,104 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+)
+
+func TestReloadIfChanged(t *testing.T) {
+	srv := httptest.NewServer(DummyHandler())
+	defer srv.Close()
+
+	sqlitePath, cleanup := createSQLite(t)
+	defer cleanup()
+
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "door2doc.json")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	cfg := NewConfiguration()
+	cfg.SetPath(path)
+	cfg.SetEnvironments([]Environment{{Name: "test", URL: srv.URL}}, "test")
+	cfg.SetConnection(db.ConnectionData{Driver: "sqlite3", Path: sqlitePath})
+	cfg.SetCredentials(TestUser, TestPassword)
+	cfg.SetVisitorQuery(`select * from correct`)
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// edit writes the configuration file with another visitor query, like an external tool would
+	edit := func(query string) {
+		bs, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var vars map[string]interface{}
+		if err := json.Unmarshal(bs, &vars); err != nil {
+			t.Fatal(err)
+		}
+		vars["query"] = query
+		if bs, err = json.Marshal(vars); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, bs, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if changed, err := cfg.reloadIfChanged(ctx); changed || err != nil {
+		t.Errorf("reloadIfChanged() after Save == false, nil, got %v, %v", changed, err)
+	}
+
+	edit(`select * from correct -- edited`)
+	if changed, err := cfg.reloadIfChanged(ctx); !changed || err != nil {
+		t.Errorf("reloadIfChanged() after valid edit == true, nil, got %v, %v", changed, err)
+	}
+	if got := cfg.VisitorQuery(); got != `select * from correct -- edited` {
+		t.Errorf("VisitorQuery() after valid edit == %q, got %q", `select * from correct -- edited`, got)
+	}
+	if versions, err := listVersions(dir); err != nil || len(versions) != 2 {
+		t.Errorf("listVersions() after valid edit == 2 versions, got %d, %v", len(versions), err)
+	}
+
+	edit(`select * from missing`)
+	changed, err := cfg.reloadIfChanged(ctx)
+	if _, ok := err.(*InvalidConfigurationError); !changed || !ok {
+		t.Errorf("reloadIfChanged() after invalid edit == true, *InvalidConfigurationError, got %v, %v", changed, err)
+	}
+	if got := cfg.VisitorQuery(); got != `select * from correct -- edited` {
+		t.Errorf("VisitorQuery() after invalid edit == %q, got %q", `select * from correct -- edited`, got)
+	}
+	if !cfg.Validate().IsValid() {
+		t.Errorf("Validate() after invalid edit is valid, got %v", cfg.Validate().Err())
+	}
+	if status := cfg.LastReload(); status == nil || status.Error == nil {
+		t.Errorf("LastReload() after invalid edit has an error, got %v", status)
+	}
+	if changed, err := cfg.reloadIfChanged(ctx); changed || err != nil {
+		t.Errorf("reloadIfChanged() after rejected edit == false, nil, got %v, %v", changed, err)
+	}
+
+	if err := ioutil.WriteFile(path, []byte(`{"query": `), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if changed, err := cfg.reloadIfChanged(ctx); !changed || err == nil {
+		t.Errorf("reloadIfChanged() after incomplete write == true, error, got %v, %v", changed, err)
+	}
+	if got := cfg.VisitorQuery(); got != `select * from correct -- edited` {
+		t.Errorf("VisitorQuery() after incomplete write == %q, got %q", `select * from correct -- edited`, got)
+	}
+}
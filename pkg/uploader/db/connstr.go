@@ -0,0 +1,267 @@
+package db
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// ConnectionStringError indicates a syntax error in a connection string.
+type ConnectionStringError struct {
+	// Position is the 1-based position of the character at which the problem was detected.
+	Position int
+	Msg      string
+}
+
+func (e *ConnectionStringError) Error() string {
+	return fmt.Sprintf("invalid connection string at position %d: %s", e.Position, e.Msg)
+}
+
+func connStrError(s string, offset int, format string, args ...interface{}) error {
+	return &ConnectionStringError{
+		Position: len([]rune(s[:offset])) + 1,
+		Msg:      fmt.Sprintf(format, args...),
+	}
+}
+
+// keyValue is a single setting in a connection string. Offset is the byte offset of the value in the connection
+// string, so that errors in the value can be reported at the correct position.
+type keyValue struct {
+	Key    string
+	Value  string
+	Offset int
+}
+
+// parseADO parses an ADO.NET connection string: key=value pairs separated by semicolons. Values may be enclosed in
+// single or double quotes, in which case a doubled quote denotes a literal quote, or in ODBC-style braces, in which
+// case '}}' denotes a literal '}'. An '==' in a key denotes a literal '='.
+func parseADO(s string) ([]keyValue, error) {
+	var res []keyValue
+
+	i := 0
+	for {
+		// skip separators
+		for i < len(s) && (s[i] == ';' || isSpace(s[i])) {
+			i++
+		}
+		if i == len(s) {
+			return res, nil
+		}
+
+		// key
+		keyStart := i
+		var key strings.Builder
+		for {
+			if i == len(s) || s[i] == ';' {
+				return nil, connStrError(s, keyStart, "missing '=' after key %q", strings.TrimSpace(key.String()))
+			}
+			if s[i] == '=' {
+				if i+1 < len(s) && s[i+1] == '=' {
+					key.WriteByte('=')
+					i += 2
+					continue
+				}
+				i++
+				break
+			}
+			key.WriteByte(s[i])
+			i++
+		}
+
+		// value
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+		valueStart := i
+		var value strings.Builder
+		bare := false
+		switch {
+		case i == len(s):
+		case s[i] == '"' || s[i] == '\'':
+			quote := s[i]
+			i++
+			for {
+				if i == len(s) {
+					return nil, connStrError(s, valueStart, "unterminated quoted value")
+				}
+				if s[i] == quote {
+					if i+1 < len(s) && s[i+1] == quote {
+						value.WriteByte(quote)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteByte(s[i])
+				i++
+			}
+			if err := expectEndOfValue(s, &i); err != nil {
+				return nil, err
+			}
+		case s[i] == '{':
+			i++
+			for {
+				if i == len(s) {
+					return nil, connStrError(s, valueStart, "unterminated braced value")
+				}
+				if s[i] == '}' {
+					if i+1 < len(s) && s[i+1] == '}' {
+						value.WriteByte('}')
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteByte(s[i])
+				i++
+			}
+			if err := expectEndOfValue(s, &i); err != nil {
+				return nil, err
+			}
+		default:
+			bare = true
+			for i < len(s) && s[i] != ';' {
+				value.WriteByte(s[i])
+				i++
+			}
+		}
+
+		kv := keyValue{Key: strings.TrimSpace(key.String()), Value: value.String(), Offset: valueStart}
+		if kv.Key == "" {
+			return nil, connStrError(s, keyStart, "empty key")
+		}
+		if bare {
+			kv.Value = strings.TrimRightFunc(kv.Value, unicode.IsSpace)
+		}
+		res = append(res, kv)
+	}
+}
+
+// expectEndOfValue skips whitespace after a quoted value, and checks that the value is followed by a semicolon or
+// the end of the connection string.
+func expectEndOfValue(s string, i *int) error {
+	for *i < len(s) && isSpace(s[*i]) {
+		*i++
+	}
+	if *i < len(s) && s[*i] != ';' {
+		return connStrError(s, *i, "unexpected %q after quoted value, expected ';'", s[*i])
+	}
+	return nil
+}
+
+// formatADO formats a key=value pair of an ADO.NET connection string, quoting the value if required.
+func formatADO(key, value string) string {
+	key = strings.Replace(key, "=", "==", -1)
+	if needsQuotingADO(value) {
+		value = `"` + strings.Replace(value, `"`, `""`, -1) + `"`
+	}
+	return key + "=" + value
+}
+
+func needsQuotingADO(value string) bool {
+	if value == "" {
+		return false
+	}
+	switch value[0] {
+	case '"', '\'', '{':
+		return true
+	}
+	return strings.ContainsRune(value, ';') || strings.TrimSpace(value) != value
+}
+
+// formatODBC formats a key=value pair in the ODBC syntax understood by the SQL Server driver, which, unlike the ADO.NET
+// syntax, the driver can unescape.
+func formatODBC(key, value string) string {
+	return key + "={" + strings.Replace(value, "}", "}}", -1) + "}"
+}
+
+// parsePostgresKeywords parses a libpq keyword/value connection string: key=value pairs separated by whitespace.
+// Values may be enclosed in single quotes; a backslash escapes the next character.
+func parsePostgresKeywords(s string) ([]keyValue, error) {
+	var res []keyValue
+
+	i := 0
+	for {
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+		if i == len(s) {
+			return res, nil
+		}
+
+		keyStart := i
+		for i < len(s) && s[i] != '=' && !isSpace(s[i]) {
+			i++
+		}
+		key := s[keyStart:i]
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+		if i == len(s) || s[i] != '=' {
+			return nil, connStrError(s, keyStart, "missing '=' after key %q", key)
+		}
+		i++
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+
+		valueStart := i
+		var value strings.Builder
+		quoted := i < len(s) && s[i] == '\''
+		if quoted {
+			i++
+		}
+		for {
+			if i == len(s) {
+				if quoted {
+					return nil, connStrError(s, valueStart, "unterminated quoted value")
+				}
+				break
+			}
+			c := s[i]
+			if quoted && c == '\'' {
+				i++
+				break
+			}
+			if !quoted && isSpace(c) {
+				break
+			}
+			if c == '\\' {
+				i++
+				if i == len(s) {
+					return nil, connStrError(s, i-1, "unexpected end after '\\'")
+				}
+				c = s[i]
+			}
+			value.WriteByte(c)
+			i++
+		}
+		if quoted && i < len(s) && !isSpace(s[i]) {
+			return nil, connStrError(s, i, "unexpected %q after quoted value, expected whitespace", s[i])
+		}
+
+		res = append(res, keyValue{Key: key, Value: value.String(), Offset: valueStart})
+	}
+}
+
+// isPostgresKeywords reports whether s looks like a libpq keyword/value connection string rather than an ADO.NET
+// connection string. Both consist of key=value pairs, so the decision is based on the first key.
+func isPostgresKeywords(s string) bool {
+	i := strings.IndexByte(s, '=')
+	if i < 0 {
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(s[:i])) {
+	case "host", "hostaddr", "dbname", "user", "sslmode":
+		return true
+	case "port", "password":
+		return !strings.ContainsRune(s, ';')
+	}
+	return false
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+var _ mysql.MySQLDriver
+
+// MySQLConnection connects to the MariaDB service of docker-compose.yml, which loads data/sql/mysql/testdata.sql. The
+// test data is stored in UTC.
+var MySQLConnection = ConnectionData{
+	Driver:   "mysql",
+	Host:     "localhost",
+	Port:     "3307",
+	Database: "mydb",
+	Username: "myuser",
+	Password: "pwd",
+	Params:   "loc=UTC",
+}
+
+// setupMySQL starts a transaction on the MySQL test database.
+func setupMySQL(ctx context.Context, t *testing.T) (*sql.Tx, context.CancelFunc) {
+	if testing.Short() {
+		t.Skip("uses database")
+	}
+
+	db, err := Open(MySQLConnection)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return tx, func() {
+		if err := tx.Rollback(); err != nil {
+			t.Error(err)
+		}
+		if err := db.Close(); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestExecuteQueriesMySQL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	tx, cancel := setupMySQL(ctx, t)
+	defer cancel()
+
+	testExecuteQueries(ctx, t, tx)
+}
@@ -0,0 +1,64 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IsolationLevels lists the transaction isolation levels that can be configured for a query.
+var IsolationLevels = []sql.IsolationLevel{
+	sql.LevelDefault,
+	sql.LevelReadUncommitted,
+	sql.LevelReadCommitted,
+	sql.LevelRepeatableRead,
+	sql.LevelSnapshot,
+	sql.LevelSerializable,
+}
+
+// QueryOptions contains the options for running a single query.
+type QueryOptions struct {
+	// Timeout of the query. If zero, the global query timeout is used.
+	Timeout time.Duration
+	// Isolation is the isolation level of the transaction the query runs in.
+	Isolation sql.IsolationLevel
+	// ReadOnly marks the transaction as read-only.
+	ReadOnly bool
+}
+
+// TxOptions returns the transaction options for running the query.
+func (o QueryOptions) TxOptions() *sql.TxOptions {
+	return &sql.TxOptions{
+		Isolation: o.Isolation,
+		ReadOnly:  o.ReadOnly,
+	}
+}
+
+// SupportsReadOnly returns whether queries can run in a read-only transaction with the driver. The SQL Server driver
+// refuses to start read-only transactions at all.
+func SupportsReadOnly(driver string) bool {
+	return driver != "sqlserver"
+}
+
+// TimeoutOr returns the query timeout, or def if no timeout has been set.
+func (o QueryOptions) TimeoutOr(def time.Duration) time.Duration {
+	if o.Timeout > 0 {
+		return o.Timeout
+	}
+	return def
+}
+
+// ParseIsolationLevel converts the name of an isolation level, as returned by sql.IsolationLevel.String, back to its
+// value. The comparison is case-insensitive, and an empty name results in the default isolation level.
+func ParseIsolationLevel(s string) (sql.IsolationLevel, error) {
+	if s == "" {
+		return sql.LevelDefault, nil
+	}
+	for _, level := range IsolationLevels {
+		if strings.EqualFold(level.String(), s) {
+			return level, nil
+		}
+	}
+	return sql.LevelDefault, fmt.Errorf("unknown isolation level %q", s)
+}
@@ -0,0 +1,56 @@
+package db
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestParseIsolationLevel(t *testing.T) {
+	for _, level := range IsolationLevels {
+		t.Run(level.String(), func(t *testing.T) {
+			got, err := ParseIsolationLevel(level.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != level {
+				t.Errorf("ParseIsolationLevel() == %v, got %v", level, got)
+			}
+		})
+	}
+
+	t.Run("case insensitive", func(t *testing.T) {
+		got, err := ParseIsolationLevel("read uncommitted")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != sql.LevelReadUncommitted {
+			t.Errorf("ParseIsolationLevel() == %v, got %v", sql.LevelReadUncommitted, got)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		got, err := ParseIsolationLevel("")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != sql.LevelDefault {
+			t.Errorf("ParseIsolationLevel() == %v, got %v", sql.LevelDefault, got)
+		}
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		if _, err := ParseIsolationLevel("dirty"); err == nil {
+			t.Error("ParseIsolationLevel() == error, got nil")
+		}
+	})
+}
+
+func TestQueryOptions_TimeoutOr(t *testing.T) {
+	if got := (QueryOptions{}).TimeoutOr(time.Second); got != time.Second {
+		t.Errorf("TimeoutOr() == %v, got %v", time.Second, got)
+	}
+	if got := (QueryOptions{Timeout: time.Minute}).TimeoutOr(time.Second); got != time.Minute {
+		t.Errorf("TimeoutOr() == %v, got %v", time.Minute, got)
+	}
+}
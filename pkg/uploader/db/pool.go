@@ -0,0 +1,32 @@
+package db
+
+import (
+	"database/sql"
+	"time"
+)
+
+// PoolSettings contains the limits of the connection pool to the source database.
+type PoolSettings struct {
+	// MaxOpen is the maximum number of open connections.
+	MaxOpen int
+	// MaxIdle is the maximum number of idle connections.
+	MaxIdle int
+	// MaxLifetime is the maximum amount of time a connection may be reused.
+	MaxLifetime time.Duration
+}
+
+// DefaultPoolSettings returns the pool settings used when none are configured.
+func DefaultPoolSettings() PoolSettings {
+	return PoolSettings{
+		MaxOpen:     2,
+		MaxIdle:     2,
+		MaxLifetime: 30 * time.Minute,
+	}
+}
+
+// Apply configures the connection pool of conn.
+func (p PoolSettings) Apply(conn *sql.DB) {
+	conn.SetMaxOpenConns(p.MaxOpen)
+	conn.SetMaxIdleConns(p.MaxIdle)
+	conn.SetConnMaxLifetime(p.MaxLifetime)
+}
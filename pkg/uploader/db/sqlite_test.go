@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/mattn/go-sqlite3"
+)
+
+var _ sqlite3.SQLiteDriver
+
+// SQLiteFixture contains the test data for SQLite, equivalent to the Postgres test data.
+const SQLiteFixture = "../../../data/sql/sqlite/testdata.sql"
+
+// setupSQLite creates a SQLite database in a temporary directory from the test fixture. Unlike setup, it does not
+// require a running database server.
+func setupSQLite(ctx context.Context, t *testing.T) (ConnectionData, *sql.Tx, context.CancelFunc) {
+	dir, err := ioutil.TempDir("", "d2d-sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conn := ConnectionData{Driver: "sqlite3", Path: filepath.Join(dir, "seh.db")}
+
+	script, err := ioutil.ReadFile(SQLiteFixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// SQLite only creates missing files if the driver is used directly
+	db, err := sql.Open(conn.Driver, conn.DSN())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecContext(ctx, string(script)); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return conn, tx, func() {
+		if err := tx.Rollback(); err != nil {
+			t.Error(err)
+		}
+		if err := db.Close(); err != nil {
+			t.Error(err)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestOpenSQLite(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	conn, _, cancel := setupSQLite(ctx, t)
+	defer cancel()
+
+	db, err := Open(conn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.PingContext(ctx); err != nil {
+		t.Error(err)
+	}
+	if err := db.Close(); err != nil {
+		t.Error(err)
+	}
+
+	missing := ConnectionData{Driver: "sqlite3", Path: conn.Path + ".missing"}
+	if _, err := Open(missing); err == nil {
+		t.Error("Open() == error, got nil")
+	}
+	if _, err := os.Stat(missing.Path); !os.IsNotExist(err) {
+		t.Errorf("Open() created %s", missing.Path)
+	}
+}
+
+func TestExecuteQueriesSQLite(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	_, tx, cancel := setupSQLite(ctx, t)
+	defer cancel()
+
+	testExecuteQueries(ctx, t, tx)
+}
+
+// testExecuteQueries runs the queries of all datasets against the test data in tx, which is the same for SQLite and
+// MySQL.
+func testExecuteQueries(ctx context.Context, t *testing.T, tx *sql.Tx) {
+	start := time.Date(1977, time.July, 24, 12, 0, 0, 0, time.UTC)
+
+	t.Run("visitor", func(t *testing.T) {
+		got, err := ExecuteVisitorQuery(ctx, tx, `select * from correct where id = 1`, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := VisitorRecords{{
+			Bezoeknummer:     328996,
+			MutatieID:        1091568,
+			Locatie:          "A",
+			Afdeling:         "seh",
+			Aangemeld:        time.Date(2017, time.July, 13, 13, 0, 0, 0, time.UTC),
+			BinnenkomstDatum: "2017-07-13",
+			BinnenkomstTijd:  "23:18",
+			NaarKamerTijd:    "23:18",
+			BijArtsTijd:      "02:40",
+			GereedOpnameTijd: "02:06",
+			VertrekTijd:      "04:34",
+			EindTijd:         "04:34",
+			Ingangsklacht:    "Pneumonie",
+			Specialisme:      "04",
+			Vervoerder:       "2",
+			Geboortedatum:    start,
+		}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ExecuteVisitorQuery() == \n\t%v, got \n\t%v", want, got)
+		}
+	})
+
+	t.Run("radiologie", func(t *testing.T) {
+		got, err := ExecuteRadiologieQuery(ctx, tx, `select * from correct_radiologie`, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := RadiologieOrders{{Bezoeknummer: 1, Ordernummer: 2, Status: "status", Start: &start, Module: "module"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ExecuteRadiologieQuery() == \n\t%v, got \n\t%v", want, got)
+		}
+	})
+
+	t.Run("lab", func(t *testing.T) {
+		got, err := ExecuteLabQuery(ctx, tx, `select * from correct_lab`, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := LabOrders{{Bezoeknummer: 1, Ordernummer: 3, Status: "status", Start: &start}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ExecuteLabQuery() == \n\t%v, got \n\t%v", want, got)
+		}
+	})
+
+	t.Run("consult", func(t *testing.T) {
+		got, err := ExecuteConsultQuery(ctx, tx, `select * from correct_consult`, time.Second)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := ConsultOrders{{Bezoeknummer: 1, Ordernummer: 4, Status: "status", Start: &start, Specialisme: "specialisme"}}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("ExecuteConsultQuery() == \n\t%v, got \n\t%v", want, got)
+		}
+	})
+}
@@ -0,0 +1,181 @@
+// Package diff compares texts line by line, to show what changed between two versions of a query or a payload.
+package diff
+
+import (
+	"strings"
+)
+
+// MaxCells limits the size of the table used to compare two texts. Texts with more differing lines than fit in the
+// table are shown as completely replaced.
+const MaxCells = 4 << 20
+
+// Op is the operation that turns the old text into the new one.
+type Op int
+
+const (
+	// Equal lines are in both texts.
+	Equal Op = iota
+	// Delete lines are only in the old text.
+	Delete
+	// Insert lines are only in the new text.
+	Insert
+)
+
+// String returns the prefix of the operation in a unified diff.
+func (op Op) String() string {
+	switch op {
+	case Delete:
+		return "-"
+	case Insert:
+		return "+"
+	}
+	return " "
+}
+
+// Line is a single line of a diff.
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines returns the differences between the texts a and b, as a list of lines that are equal, deleted from a or
+// inserted into b.
+func Lines(a, b string) []Line {
+	return compare(split(a), split(b))
+}
+
+// Changed returns whether lines contain any changes.
+func Changed(lines []Line) bool {
+	for _, l := range lines {
+		if l.Op != Equal {
+			return true
+		}
+	}
+	return false
+}
+
+// Hunk is a run of changed lines, surrounded by lines that are equal.
+type Hunk struct {
+	// From and To are the numbers of the first line of the hunk in the old and the new text, starting at 1.
+	From  int
+	To    int
+	Lines []Line
+}
+
+// Hunks groups the changes in lines into hunks, with at most context equal lines before and after each change. Changes
+// that are separated by at most twice the context are in the same hunk. Lines without changes have no hunks.
+func Hunks(lines []Line, context int) []Hunk {
+	// the line numbers in the old and the new text of each line
+	from, to := make([]int, len(lines)), make([]int, len(lines))
+	f, t := 1, 1
+	for i, l := range lines {
+		from[i], to[i] = f, t
+		if l.Op != Insert {
+			f++
+		}
+		if l.Op != Delete {
+			t++
+		}
+	}
+
+	var res []Hunk
+	// the current hunk is lines[start:end], if end > 0
+	start, end := 0, 0
+	for i, l := range lines {
+		if l.Op == Equal {
+			continue
+		}
+		s, e := i-context, i+context+1
+		if s < 0 {
+			s = 0
+		}
+		if e > len(lines) {
+			e = len(lines)
+		}
+		if end > 0 && s <= end {
+			end = e
+			continue
+		}
+		if end > 0 {
+			res = append(res, Hunk{From: from[start], To: to[start], Lines: lines[start:end]})
+		}
+		start, end = s, e
+	}
+	if end > 0 {
+		res = append(res, Hunk{From: from[start], To: to[start], Lines: lines[start:end]})
+	}
+	return res
+}
+
+// split splits s into lines. An empty text has no lines at all.
+func split(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(s, "\n"), "\n")
+}
+
+func compare(a, b []string) []Line {
+	// lines in common at the start and end are not part of the comparison, which keeps the table small
+	var prefix, suffix []Line
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		prefix = append(prefix, Line{Op: Equal, Text: a[0]})
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		suffix = append([]Line{{Op: Equal, Text: a[len(a)-1]}}, suffix...)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	res := prefix
+	if (len(a)+1)*(len(b)+1) > MaxCells {
+		for _, s := range a {
+			res = append(res, Line{Op: Delete, Text: s})
+		}
+		for _, s := range b {
+			res = append(res, Line{Op: Insert, Text: s})
+		}
+		return append(res, suffix...)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			res = append(res, Line{Op: Equal, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			res = append(res, Line{Op: Delete, Text: a[i]})
+			i++
+		default:
+			res = append(res, Line{Op: Insert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		res = append(res, Line{Op: Delete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		res = append(res, Line{Op: Insert, Text: b[j]})
+	}
+	return append(res, suffix...)
+}
@@ -0,0 +1,108 @@
+package diff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	for name, test := range map[string]struct {
+		A, B string
+		Want []Line
+	}{
+		"empty": {},
+		"equal": {
+			A:    "SELECT *\nFROM bezoeken",
+			B:    "SELECT *\nFROM bezoeken\n",
+			Want: []Line{{Equal, "SELECT *"}, {Equal, "FROM bezoeken"}},
+		},
+		"added": {
+			B:    "SELECT 1",
+			Want: []Line{{Insert, "SELECT 1"}},
+		},
+		"removed": {
+			A:    "SELECT 1",
+			Want: []Line{{Delete, "SELECT 1"}},
+		},
+		"changed line": {
+			A:    "SELECT *\nFROM bezoeken\nWHERE id > 1",
+			B:    "SELECT *\nFROM bezoeken\nWHERE id > 2",
+			Want: []Line{{Equal, "SELECT *"}, {Equal, "FROM bezoeken"}, {Delete, "WHERE id > 1"}, {Insert, "WHERE id > 2"}},
+		},
+		"inserted in the middle": {
+			A:    "a\nb\nc\nd",
+			B:    "a\nx\nb\nc\ny\nd",
+			Want: []Line{{Equal, "a"}, {Insert, "x"}, {Equal, "b"}, {Equal, "c"}, {Insert, "y"}, {Equal, "d"}},
+		},
+		"moved": {
+			A:    "a\nb\nc",
+			B:    "c\na\nb",
+			Want: []Line{{Insert, "c"}, {Equal, "a"}, {Equal, "b"}, {Delete, "c"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := Lines(test.A, test.B)
+			if !reflect.DeepEqual(got, test.Want) {
+				t.Errorf("Lines() == %v, got %v", test.Want, got)
+			}
+			if changed := test.A != test.B && strings.TrimSuffix(test.A, "\n") != strings.TrimSuffix(test.B, "\n"); Changed(got) != changed {
+				t.Errorf("Changed() == %v, got %v", changed, !changed)
+			}
+		})
+	}
+}
+
+func TestLinesLarge(t *testing.T) {
+	var a, b []string
+	for i := 0; i < 3000; i++ {
+		a = append(a, "a")
+		b = append(b, "b")
+	}
+	got := Lines("first\n"+strings.Join(a, "\n"), "first\n"+strings.Join(b, "\n"))
+	if len(got) != 6001 || got[0] != (Line{Equal, "first"}) || got[1].Op != Delete || got[6000].Op != Insert {
+		t.Errorf("Lines() of large texts == first line equal, then all deleted and inserted, got %d lines", len(got))
+	}
+}
+
+func TestHunks(t *testing.T) {
+	lines := func(ops string) []Line {
+		var res []Line
+		for i, op := range ops {
+			l := Line{Op: Equal, Text: string('a' + rune(i))}
+			switch op {
+			case '-':
+				l.Op = Delete
+			case '+':
+				l.Op = Insert
+			}
+			res = append(res, l)
+		}
+		return res
+	}
+
+	// a hunk is described by its line numbers and the range of the input it contains
+	type hunk struct{ From, To, Start, End int }
+	for name, test := range map[string]struct {
+		Ops  string
+		Want []hunk
+	}{
+		"no changes":           {Ops: "     "},
+		"single change":        {Ops: "     -+     ", Want: []hunk{{3, 3, 2, 10}}},
+		"at the start and end": {Ops: "+        -", Want: []hunk{{1, 1, 0, 4}, {6, 7, 6, 10}}},
+		"close together":       {Ops: "  -      +  ", Want: []hunk{{1, 1, 0, 12}}},
+		"far apart":            {Ops: "  -       +  ", Want: []hunk{{1, 1, 0, 6}, {8, 7, 7, 13}}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			in := lines(test.Ops)
+			var want []Hunk
+			for _, h := range test.Want {
+				want = append(want, Hunk{From: h.From, To: h.To, Lines: in[h.Start:h.End]})
+			}
+			got := Hunks(in, 3)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Hunks() == %v, got %v", want, got)
+			}
+		})
+	}
+}
@@ -7,13 +7,21 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"sync"
 
 	"github.com/kardianos/service"
 )
 
+const pathFeedback = "/services/v3/upload/feedback"
+
 var (
-	svc      service.Logger
+	svc service.Logger
+
+	// mu guards the details for error reports, which are changed while errors are being logged
+	mu       sync.RWMutex
 	username string
+	server   = "https://integration.door2doc.net/"
+	do       = http.DefaultClient.Do
 )
 
 // SetService uses the service log for all subsequent logging.
@@ -27,9 +35,26 @@ func SetService(s service.Service) {
 
 // SetUsername sets the username scope for all subsequent logging
 func SetUsername(s string) {
+	mu.Lock()
+	defer mu.Unlock()
 	username = s
 }
 
+// SetServer sets the door2doc server that receives all subsequent error reports
+func SetServer(s string) {
+	mu.Lock()
+	defer mu.Unlock()
+	server = s
+}
+
+// SetHTTPClient sets the function used to submit errors to door2doc, so the feedback uses the same proxy settings as
+// all other HTTP requests.
+func SetHTTPClient(f func(*http.Request) (*http.Response, error)) {
+	mu.Lock()
+	defer mu.Unlock()
+	do = f
+}
+
 func Info(pattern string, args ...interface{}) {
 	if svc == nil {
 		log.Printf(pattern, args...)
@@ -43,11 +68,26 @@ func Info(pattern string, args ...interface{}) {
 	}
 }
 
+func Warning(pattern string, args ...interface{}) {
+	if svc == nil {
+		log.Printf(pattern, args...)
+		return
+	}
+
+	err := svc.Warningf(pattern, args...)
+	if err != nil {
+		log.Printf(pattern, args...)
+		log.Println(err)
+	}
+}
+
 func Error(pattern string, args ...interface{}) {
 	msg := fmt.Sprintf(pattern, args...)
 
 	if svc != nil {
-		go submitError(username, msg)
+		mu.RLock()
+		go submitError(server, username, msg, do)
+		mu.RUnlock()
 	}
 
 	if svc == nil {
@@ -62,17 +102,18 @@ func Error(pattern string, args ...interface{}) {
 	}
 }
 
-func submitError(user string, msg string) {
-	req, err := http.NewRequest(http.MethodPost, "https://integration.door2doc.net/services/v3/upload/feedback", nil)
+func submitError(server, user, msg string, do func(*http.Request) (*http.Response, error)) {
+	req, err := http.NewRequest(http.MethodPost, server, nil)
 	if err != nil {
 		return
 	}
+	req.URL.Path = pathFeedback
 	q := req.URL.Query()
 	q.Add("username", user)
 	q.Add("message", msg)
 	req.URL.RawQuery = q.Encode()
 
-	res, err := http.DefaultClient.Do(req)
+	res, err := do(req)
 	if err != nil {
 		return
 	}
@@ -1,6 +1,7 @@
 package history
 
 import (
+	"net/http"
 	"sync"
 	"time"
 )
@@ -9,8 +10,12 @@ const MaxHistory = 10
 
 // History keeps track of recent events.
 type History struct {
-	mu     sync.Mutex
-	events []*Event
+	mu        sync.Mutex
+	events    []*Event
+	observers []func(Event)
+	lastID    int64
+	// store keeps finished events beyond the most recent ones, if set
+	store *Store
 }
 
 func New() *History {
@@ -19,20 +24,59 @@ func New() *History {
 
 // Event is a single line in the history.
 type Event struct {
+	// ID identifies the event. IDs increase with the time the events started.
+	ID             int64
 	Type           string
+	Environment    string
 	Time           time.Time
 	QueryDuration  time.Duration
 	UploadDuration time.Duration
-	Size           int
-	JSON           string
-	Error          error
+	// Size is the number of records.
+	Size int
+	// Bytes is the size of the JSON payload.
+	Bytes int
+	JSON  string
+	// Response is the response of door2doc to the upload, if it was sent.
+	Response *Response
+	Error    error
+	// ErrorClass is the stage at which the upload failed, if it did.
+	ErrorClass string
+	// Finished is when the upload finished, successfully or not.
+	Finished time.Time
+}
+
+// Response is the response of door2doc to an upload.
+type Response struct {
+	Status string      `json:"status"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body"`
+}
+
+// Classes of errors, by the stage at which the upload failed.
+const (
+	ErrorClassDatabase   = "database"
+	ErrorClassQuery      = "query"
+	ErrorClassEncoding   = "encoding"
+	ErrorClassConnection = "connection"
+	ErrorClassResponse   = "response"
+)
+
+// Succeeded returns whether the upload has finished without error. Events are added when an upload starts, so an
+// upload that is still running has not succeeded yet.
+func (e *Event) Succeeded() bool {
+	return e.Error == nil && e.UploadDuration > 0
 }
 
 func (h *History) NewEvent(typ string) *Event {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 
-	e := &Event{Time: time.Now(), Type: typ}
+	now := time.Now()
+	h.lastID++
+	if id := now.UnixNano(); id > h.lastID {
+		h.lastID = id
+	}
+	e := &Event{ID: h.lastID, Time: now, Type: typ}
 
 	h.events = append([]*Event{e}, h.events...)
 	if len(h.events) > MaxHistory {
@@ -50,3 +94,24 @@ func (h *History) Events() []*Event {
 	copy(res, h.events)
 	return res
 }
+
+// Observe registers f to be called with a copy of every event when it has finished.
+func (h *History) Observe(f func(Event)) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.observers = append(h.observers, f)
+}
+
+// Finish records that the upload of e has finished, and passes it on to the observers.
+func (h *History) Finish(e *Event) {
+	e.Finished = time.Now()
+
+	h.mu.Lock()
+	observers := h.observers
+	h.mu.Unlock()
+
+	for _, f := range observers {
+		f(*e)
+	}
+}
@@ -63,3 +63,21 @@ func TestHistory_Events(t *testing.T) {
 		}
 	})
 }
+
+func TestHistory_Observe(t *testing.T) {
+	h := New()
+	var got []Event
+	h.Observe(func(e Event) {
+		got = append(got, e)
+	})
+
+	e := h.NewEvent("x")
+	e.Size = 3
+	if len(got) != 0 {
+		t.Fatalf("observed %d events before Finish(), got %d", 0, len(got))
+	}
+	h.Finish(e)
+	if len(got) != 1 || got[0].Size != 3 || got[0].Finished.IsZero() {
+		t.Errorf("Finish() == finished event, got %v", got)
+	}
+}
@@ -0,0 +1,618 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// FileName is the name of the history file in the configuration folder.
+	FileName = "door2doc-history.jsonl"
+
+	// DefaultRetention is how long events are kept if no retention is configured.
+	DefaultRetention = 30 * 24 * time.Hour
+
+	// MaxDetails is the number of most recent payloads and responses that are kept per dataset.
+	MaxDetails = 100
+
+	// maxLine is the maximum length of a line in the history file.
+	maxLine = 1 << 20
+
+	// pruneInterval is the minimum time between removing expired events from the file.
+	pruneInterval = time.Hour
+)
+
+// Statuses to filter events by.
+const (
+	StatusSucceeded = "succeeded"
+	StatusFailed    = "failed"
+)
+
+// Record is a finished event as stored in the history file.
+type Record struct {
+	ID             int64         `json:"id"`
+	Type           string        `json:"type"`
+	Environment    string        `json:"environment"`
+	Time           time.Time     `json:"time"`
+	Finished       time.Time     `json:"finished"`
+	QueryDuration  time.Duration `json:"queryDuration"`
+	UploadDuration time.Duration `json:"uploadDuration"`
+	Size           int           `json:"size"`
+	Bytes          int           `json:"bytes"`
+	Error          string        `json:"error,omitempty"`
+	ErrorClass     string        `json:"errorClass,omitempty"`
+}
+
+// NewRecord returns the record of a finished event.
+func NewRecord(e Event) Record {
+	r := Record{
+		ID:             e.ID,
+		Type:           e.Type,
+		Environment:    e.Environment,
+		Time:           e.Time,
+		Finished:       e.Finished,
+		QueryDuration:  e.QueryDuration,
+		UploadDuration: e.UploadDuration,
+		Size:           e.Size,
+		Bytes:          e.Bytes,
+		ErrorClass:     e.ErrorClass,
+	}
+	if e.Error != nil {
+		r.Error = e.Error.Error()
+	}
+	return r
+}
+
+// Succeeded returns whether the upload succeeded.
+func (r Record) Succeeded() bool {
+	return r.Error == ""
+}
+
+// Detail is the payload and response of a finished event. Details are stored apart from the records, since they are
+// much larger, and are kept for the most recent events only.
+type Detail struct {
+	Payload  string    `json:"payload,omitempty"`
+	Response *Response `json:"response,omitempty"`
+}
+
+// NewDetail returns the detail of a finished event.
+func NewDetail(e Event) Detail {
+	return Detail{Payload: e.JSON, Response: e.Response}
+}
+
+// IsZero returns whether d has neither payload nor response.
+func (d Detail) IsZero() bool {
+	return d.Payload == "" && d.Response == nil
+}
+
+// Filter selects events. Zero fields match all events.
+type Filter struct {
+	// Type is the upload path of the dataset.
+	Type string
+	// Status is StatusSucceeded or StatusFailed.
+	Status string
+	// From and To limit the time the events started, From inclusive and To exclusive.
+	From time.Time
+	To   time.Time
+}
+
+// Matches returns whether the filter selects r.
+func (f Filter) Matches(r Record) bool {
+	return f.matches(r.Type, r.Time, r.Succeeded())
+}
+
+func (f Filter) matches(typ string, t time.Time, succeeded bool) bool {
+	switch {
+	case f.Type != "" && typ != f.Type:
+		return false
+	case f.Status == StatusSucceeded && !succeeded:
+		return false
+	case f.Status == StatusFailed && succeeded:
+		return false
+	case !f.From.IsZero() && t.Before(f.From):
+		return false
+	case !f.To.IsZero() && !t.Before(f.To):
+		return false
+	}
+	return true
+}
+
+// Cipher encrypts the details of events before they are written, and decrypts them when they are read.
+type Cipher interface {
+	Encrypt(plain string) (string, error)
+	Decrypt(s string) (string, error)
+}
+
+// Store keeps finished events in a file with one JSON object per line, for as long as the retention. The details of
+// the most recent events are kept in a folder next to it, with a file per event.
+type Store struct {
+	mu        sync.Mutex
+	path      string
+	details   string
+	retention func() time.Duration
+	cipher    Cipher
+	pruned    time.Time
+	// index contains an entry per record in the file, oldest first, once it has been read
+	index   []entry
+	indexed bool
+}
+
+// entry indexes a record in the file, with the fields needed to filter records, so that queries only need to decode
+// the records they return.
+type entry struct {
+	id        int64
+	typ       string
+	time      time.Time
+	succeeded bool
+	// offset is the position of the record in the file
+	offset int64
+}
+
+func newEntry(r Record, offset int64) entry {
+	return entry{id: r.ID, typ: r.Type, time: r.Time, succeeded: r.Succeeded(), offset: offset}
+}
+
+// NewStore returns a store in the file at path. Events are removed once they are older than retention; if it returns
+// zero, DefaultRetention is used. Details contain patient data, and are encrypted with cipher; without cipher, they
+// are stored in plain text.
+func NewStore(path string, retention func() time.Duration, cipher Cipher) *Store {
+	return &Store{path: path, details: strings.TrimSuffix(path, filepath.Ext(path)), retention: retention, cipher: cipher}
+}
+
+// Observe stores a finished event. It is meant to be registered with History.Observe.
+func (s *Store) Observe(e Event) {
+	if d := NewDetail(e); !d.IsZero() {
+		if err := s.SetDetail(e.ID, d); err != nil {
+			dlog.Error("Failed to write history: %v", err)
+		}
+	}
+	if err := s.Append(NewRecord(e)); err != nil {
+		dlog.Error("Failed to write history: %v", err)
+	}
+}
+
+// Append adds a record to the end of the file. Expired records are removed at most once per hour.
+func (s *Store) Append(r Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.loadIndex(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0700); err != nil {
+		return errors.Wrap(err, "while creating folder for history")
+	}
+	bs, err := json.Marshal(r)
+	if err != nil {
+		return errors.Wrap(err, "while writing history")
+	}
+	bs = append(bs, '\n')
+
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, "while opening history")
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "while opening history")
+	}
+	offset := info.Size()
+	if offset > 0 {
+		// a line that was cut short, for example by a crash while writing it, is terminated first, so that it does not
+		// make this record unreadable as well
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, offset-1); err == nil && last[0] != '\n' {
+			bs = append([]byte{'\n'}, bs...)
+			offset++
+		}
+	}
+	if _, err := f.Write(bs); err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "while writing history")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "while writing history")
+	}
+	s.index = append(s.index, newEntry(r, offset))
+
+	now := time.Now()
+	if now.Sub(s.pruned) < pruneInterval {
+		return nil
+	}
+	s.pruned = now
+	return s.prune(now)
+}
+
+// loadIndex reads the index from the file, if that has not been done yet.
+func (s *Store) loadIndex() error {
+	if s.indexed {
+		return nil
+	}
+	records, offsets, err := s.read()
+	if err != nil {
+		return err
+	}
+	s.index = make([]entry, len(records))
+	for i, r := range records {
+		s.index[i] = newEntry(r, offsets[i])
+	}
+	s.indexed = true
+	return nil
+}
+
+func (s *Store) expiry(now time.Time) time.Time {
+	retention := DefaultRetention
+	if s.retention != nil && s.retention() > 0 {
+		retention = s.retention()
+	}
+	return now.Add(-retention)
+}
+
+// prune rewrites the file without the records that have expired at now, and removes the details of all but the most
+// recent records.
+func (s *Store) prune(now time.Time) error {
+	expiry := s.expiry(now)
+	keep := s.index[:0:0]
+	for _, e := range s.index {
+		if !e.time.Before(expiry) {
+			keep = append(keep, e)
+		}
+	}
+	if err := s.pruneDetails(keep); err != nil {
+		return err
+	}
+	if len(keep) == len(s.index) {
+		return nil
+	}
+
+	records, _, err := s.read()
+	if err != nil {
+		return err
+	}
+	tmp := s.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_WRONLY|os.O_TRUNC|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, "while pruning history")
+	}
+	var index []entry
+	var offset int64
+	for _, r := range records {
+		if r.Time.Before(expiry) {
+			continue
+		}
+		bs, err := json.Marshal(r)
+		if err == nil {
+			_, err = f.Write(append(bs, '\n'))
+		}
+		if err != nil {
+			_ = f.Close()
+			return errors.Wrap(err, "while pruning history")
+		}
+		index = append(index, newEntry(r, offset))
+		offset += int64(len(bs)) + 1
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "while pruning history")
+	}
+	if err := os.Rename(tmp, s.path); err != nil {
+		return errors.Wrap(err, "while pruning history")
+	}
+	s.index = index
+	return nil
+}
+
+// pruneDetails removes the details of all records, except those of the last MaxDetails records of each type.
+func (s *Store) pruneDetails(index []entry) error {
+	files, err := ioutil.ReadDir(s.details)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "while pruning history")
+	}
+
+	keep := make(map[string]bool)
+	count := make(map[string]int)
+	for i := len(index) - 1; i >= 0; i-- {
+		if e := index[i]; count[e.typ] < MaxDetails {
+			count[e.typ]++
+			keep[s.detailName(e.id)] = true
+		}
+	}
+	for _, f := range files {
+		if !keep[f.Name()] {
+			if err := os.Remove(filepath.Join(s.details, f.Name())); err != nil {
+				return errors.Wrap(err, "while pruning history")
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Store) detailName(id int64) string {
+	return strconv.FormatInt(id, 10) + ".json"
+}
+
+// SetDetail stores the detail of the event with the given id.
+func (s *Store) SetDetail(id int64, d Detail) error {
+	if err := os.MkdirAll(s.details, 0700); err != nil {
+		return errors.Wrap(err, "while creating folder for history")
+	}
+	bs, err := json.Marshal(d)
+	if err != nil {
+		return errors.Wrap(err, "while writing history")
+	}
+	if s.cipher != nil {
+		enc, err := s.cipher.Encrypt(string(bs))
+		if err != nil {
+			return errors.Wrap(err, "while encrypting history")
+		}
+		bs = []byte(enc)
+	}
+	return errors.Wrap(ioutil.WriteFile(filepath.Join(s.details, s.detailName(id)), bs, 0600), "while writing history")
+}
+
+// Detail returns the detail of the event with the given id. The boolean is false if it is not kept.
+func (s *Store) Detail(id int64) (Detail, bool, error) {
+	var d Detail
+	bs, err := ioutil.ReadFile(filepath.Join(s.details, s.detailName(id)))
+	if os.IsNotExist(err) {
+		return d, false, nil
+	}
+	if err != nil {
+		return d, false, errors.Wrap(err, "while reading history")
+	}
+	if s.cipher != nil {
+		plain, err := s.cipher.Decrypt(string(bs))
+		if err != nil {
+			return d, false, errors.Wrap(err, "while decrypting history")
+		}
+		bs = []byte(plain)
+	}
+	return d, true, errors.Wrap(json.Unmarshal(bs, &d), "while reading history")
+}
+
+// read returns all records in the file, oldest first, and their offsets in the file. Lines that cannot be decoded,
+// for example because writing them was cut short, are skipped.
+func (s *Store) read() ([]Record, []int64, error) {
+	f, err := os.Open(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "while opening history")
+	}
+	defer f.Close()
+
+	var records []Record
+	var offsets []int64
+	var offset int64
+	sc := bufio.NewScanner(f)
+	sc.Buffer(nil, maxLine)
+	for sc.Scan() {
+		line := sc.Bytes()
+		start := offset
+		offset += int64(len(line)) + 1
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var r Record
+		if err := json.Unmarshal(line, &r); err != nil {
+			dlog.Error("Skipping invalid line in history at offset %d: %v", start, err)
+			continue
+		}
+		records = append(records, r)
+		offsets = append(offsets, start)
+	}
+	if err := sc.Err(); err != nil {
+		return records, offsets, errors.Wrap(err, "while reading history")
+	}
+	return records, offsets, nil
+}
+
+// readAt returns the record at offset in f.
+func readAt(f *os.File, offset int64) (Record, error) {
+	var r Record
+	line, err := bufio.NewReader(io.NewSectionReader(f, offset, maxLine)).ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return r, errors.Wrap(err, "while reading history")
+	}
+	return r, errors.Wrap(json.Unmarshal(line, &r), "while reading history")
+}
+
+// records returns the records of the given entries.
+func (s *Store) records(entries []entry) ([]Record, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, errors.Wrap(err, "while opening history")
+	}
+	defer f.Close()
+
+	res := make([]Record, len(entries))
+	for i, e := range entries {
+		if res[i], err = readAt(f, e.offset); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Query returns the records that match f, newest first, skipping offset records and returning at most limit. It also
+// returns the number of matching records. Records that have expired, but have not been pruned yet, are not returned.
+func (s *Store) Query(f Filter, offset, limit int) ([]Record, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.loadIndex(); err != nil {
+		return nil, 0, err
+	}
+	expiry := s.expiry(time.Now())
+	var total int
+	var matches []entry
+	for i := len(s.index) - 1; i >= 0; i-- {
+		e := s.index[i]
+		if e.time.Before(expiry) || !f.matches(e.typ, e.time, e.succeeded) {
+			continue
+		}
+		if total >= offset && total < offset+limit {
+			matches = append(matches, e)
+		}
+		total++
+	}
+	records, err := s.records(matches)
+	return records, total, err
+}
+
+// Lookup returns the record with the given id. The boolean is false if there is no such record, or it has expired.
+func (s *Store) Lookup(id int64) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.loadIndex(); err != nil {
+		return Record{}, false, err
+	}
+	for i := len(s.index) - 1; i >= 0; i-- {
+		if e := s.index[i]; e.id == id {
+			if e.time.Before(s.expiry(time.Now())) {
+				return Record{}, false, nil
+			}
+			records, err := s.records([]entry{e})
+			if err != nil {
+				return Record{}, false, err
+			}
+			return records[0], true, nil
+		}
+	}
+	return Record{}, false, nil
+}
+
+// Previous returns the most recent record of the same type as r that started before it, and has a payload, together
+// with its detail. The boolean is false if there is no such record.
+func (s *Store) Previous(r Record) (Record, Detail, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.loadIndex(); err != nil {
+		return Record{}, Detail{}, false, err
+	}
+	// only the details of the last MaxDetails records of a type are kept, so there is no need to look any further
+	checked := 0
+	for i := len(s.index) - 1; i >= 0 && checked < MaxDetails; i-- {
+		e := s.index[i]
+		if e.typ != r.Type || e.id >= r.ID {
+			continue
+		}
+		checked++
+		d, ok, err := s.Detail(e.id)
+		if err != nil {
+			return Record{}, d, false, err
+		}
+		if ok && d.Payload != "" {
+			records, err := s.records([]entry{e})
+			if err != nil {
+				return Record{}, d, false, err
+			}
+			return records[0], d, true, nil
+		}
+	}
+	return Record{}, Detail{}, false, nil
+}
+
+func page(records []Record, offset, limit int) []Record {
+	if offset >= len(records) {
+		return nil
+	}
+	records = records[offset:]
+	if limit < len(records) {
+		records = records[:limit]
+	}
+	return records
+}
+
+// SetStore keeps all finished events in s, in addition to the most recent ones in memory.
+func (h *History) SetStore(s *Store) {
+	h.mu.Lock()
+	h.store = s
+	h.mu.Unlock()
+
+	h.Observe(s.Observe)
+}
+
+// Query returns the finished events that match f, newest first, like Store.Query. Without store, only the most recent
+// events are searched.
+func (h *History) Query(f Filter, offset, limit int) ([]Record, int, error) {
+	h.mu.Lock()
+	s := h.store
+	h.mu.Unlock()
+	if s != nil {
+		return s.Query(f, offset, limit)
+	}
+
+	var matches []Record
+	for _, e := range h.Events() {
+		if e.Finished.IsZero() {
+			continue
+		}
+		if r := NewRecord(*e); f.Matches(r) {
+			matches = append(matches, r)
+		}
+	}
+	return page(matches, offset, limit), len(matches), nil
+}
+
+// Lookup returns the finished event with the given id, and its detail. The boolean is false if there is no such event.
+// Without store, only the most recent events are searched.
+func (h *History) Lookup(id int64) (Record, Detail, bool, error) {
+	h.mu.Lock()
+	s := h.store
+	h.mu.Unlock()
+	if s != nil {
+		r, ok, err := s.Lookup(id)
+		if !ok || err != nil {
+			return r, Detail{}, false, err
+		}
+		d, _, err := s.Detail(id)
+		return r, d, true, err
+	}
+
+	for _, e := range h.Events() {
+		if e.ID == id && !e.Finished.IsZero() {
+			return NewRecord(*e), NewDetail(*e), true, nil
+		}
+	}
+	return Record{}, Detail{}, false, nil
+}
+
+// Previous returns the most recent finished event of the same type as r that started before it, and has a payload,
+// like Store.Previous. Without store, only the most recent events are searched.
+func (h *History) Previous(r Record) (Record, Detail, bool, error) {
+	h.mu.Lock()
+	s := h.store
+	h.mu.Unlock()
+	if s != nil {
+		return s.Previous(r)
+	}
+
+	for _, e := range h.Events() {
+		if e.Type == r.Type && e.ID < r.ID && !e.Finished.IsZero() && e.JSON != "" {
+			return NewRecord(*e), NewDetail(*e), true, nil
+		}
+	}
+	return Record{}, Detail{}, false, nil
+}
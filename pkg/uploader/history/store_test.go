@@ -0,0 +1,253 @@
+package history
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	now := time.Now().Truncate(time.Second)
+	retention := 24 * time.Hour
+	s := NewStore(filepath.Join(dir, "history", FileName), func() time.Duration { return retention }, nil)
+
+	// an expired record, and a succeeded and failed record per type per minute
+	records := []Record{{ID: 1, Type: "/a", Time: now.Add(-48 * time.Hour)}}
+	for i := 0; i < 10; i++ {
+		for _, typ := range []string{"/a", "/b"} {
+			at := now.Add(time.Duration(i-10) * time.Minute)
+			records = append(records,
+				Record{ID: int64(len(records) + 1), Type: typ, Time: at, Size: 1},
+				Record{ID: int64(len(records) + 2), Type: typ, Time: at, Error: "failed"},
+			)
+		}
+	}
+	for _, r := range records {
+		if err := s.Append(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, test := range map[string]struct {
+		Filter Filter
+		Offset int
+		Limit  int
+		Total  int
+		First  int64
+		Len    int
+	}{
+		"all":       {Limit: 100, Total: 40, First: 41, Len: 40},
+		"paginated": {Offset: 10, Limit: 10, Total: 40, First: 31, Len: 10},
+		"last page": {Offset: 35, Limit: 10, Total: 40, First: 6, Len: 5},
+		"past end":  {Offset: 50, Limit: 10, Total: 40},
+		"type":      {Filter: Filter{Type: "/b"}, Limit: 100, Total: 20, First: 41, Len: 20},
+		"succeeded": {Filter: Filter{Type: "/a", Status: StatusSucceeded}, Limit: 100, Total: 10, First: 38, Len: 10},
+		"failed":    {Filter: Filter{Status: StatusFailed}, Limit: 100, Total: 20, First: 41, Len: 20},
+		"time range": {
+			Filter: Filter{From: now.Add(-5 * time.Minute), To: now.Add(-3 * time.Minute)},
+			Limit:  100, Total: 8, First: 29, Len: 8,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, total, err := s.Query(test.Filter, test.Offset, test.Limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if total != test.Total || len(got) != test.Len {
+				t.Fatalf("Query() == %d of %d records, got %d of %d", test.Len, test.Total, len(got), total)
+			}
+			if len(got) > 0 && got[0].ID != test.First {
+				t.Errorf("Query()[0].ID == %d, got %d", test.First, got[0].ID)
+			}
+			for i := 1; i < len(got); i++ {
+				if got[i].Time.After(got[i-1].Time) {
+					t.Errorf("Query() returns newest first, got %v before %v", got[i-1].Time, got[i].Time)
+				}
+			}
+		})
+	}
+
+	// the expired record was pruned when the first record was appended
+	all, _, err := s.read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != len(records)-1 {
+		t.Errorf("len(read()) == %d, got %d", len(records)-1, len(all))
+	}
+}
+
+func TestHistory_Query(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	h := New()
+	finish := func(typ string, err error) {
+		e := h.NewEvent(typ)
+		e.Error = err
+		if err == nil {
+			e.UploadDuration = time.Second
+		}
+		h.Finish(e)
+	}
+
+	// without store, the events in memory are searched
+	finish("/a", nil)
+	finish("/a", errors.New("failed"))
+	h.NewEvent("/a")
+	if got, total, err := h.Query(Filter{Status: StatusFailed}, 0, 10); err != nil || total != 1 || len(got) != 1 || got[0].Error != "failed" {
+		t.Errorf("Query() == 1 failed record, got %v, %d, %v", got, total, err)
+	}
+	if _, total, _ := h.Query(Filter{}, 0, 10); total != 2 {
+		t.Errorf("Query() == 2 finished records, got %d", total)
+	}
+	last := h.Events()[1]
+	if r, _, ok, err := h.Lookup(last.ID); err != nil || !ok || r.Error != "failed" {
+		t.Errorf("Lookup() == failed record, got %v, %v, %v", r, ok, err)
+	}
+	if _, _, ok, _ := h.Lookup(h.Events()[0].ID); ok {
+		t.Errorf("Lookup() of a running event == false, got %v", ok)
+	}
+
+	// with store, events finished since are persisted
+	h.SetStore(NewStore(filepath.Join(dir, FileName), nil, nil))
+	for i := 0; i < MaxHistory+1; i++ {
+		finish("/b", nil)
+	}
+	if _, total, err := h.Query(Filter{Type: "/b"}, 0, 10); err != nil || total != MaxHistory+1 {
+		t.Errorf("Query() == %d records, got %d, %v", MaxHistory+1, total, err)
+	}
+}
+
+// testCipher encodes instead of encrypts, which is enough to tell whether details are passed through it.
+type testCipher struct{}
+
+func (testCipher) Encrypt(plain string) (string, error) {
+	return "test:" + base64.StdEncoding.EncodeToString([]byte(plain)), nil
+}
+
+func (testCipher) Decrypt(s string) (string, error) {
+	bs, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(s, "test:"))
+	return string(bs), err
+}
+
+func TestStore_Detail(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := NewStore(filepath.Join(dir, FileName), nil, testCipher{})
+	now := time.Now()
+	observe := func(id int64, typ, payload string) {
+		e := Event{ID: id, Type: typ, Time: now.Add(time.Duration(id) * time.Second), JSON: payload}
+		if payload != "" {
+			e.Response = &Response{Status: "200 OK", Body: "{}"}
+		}
+		s.Observe(e)
+	}
+
+	// a payload for every /a event, with a failed event without payload in between
+	for id := int64(1); id <= MaxDetails+2; id++ {
+		observe(id, "/a", strconv.FormatInt(id, 10))
+	}
+	observe(MaxDetails+3, "/a", "")
+	observe(MaxDetails+4, "/b", "b")
+	observe(MaxDetails+5, "/a", "last")
+
+	// details are not stored in plain text
+	bs, err := ioutil.ReadFile(filepath.Join(dir, "door2doc-history", strconv.Itoa(MaxDetails+5)+".json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(bs), "last") || !strings.HasPrefix(string(bs), "test:") {
+		t.Errorf("detail file is encrypted, got %s", bs)
+	}
+
+	r, ok, err := s.Lookup(MaxDetails + 5)
+	if err != nil || !ok || r.Type != "/a" {
+		t.Fatalf("Lookup() == /a record, got %v, %v, %v", r, ok, err)
+	}
+	if d, ok, err := s.Detail(r.ID); err != nil || !ok || d.Payload != "last" || d.Response == nil || d.Response.Status != "200 OK" {
+		t.Errorf("Detail() == last payload with response, got %+v, %v, %v", d, ok, err)
+	}
+	if _, ok, err := s.Lookup(1000); err != nil || ok {
+		t.Errorf("Lookup() of unknown id == false, got %v, %v", ok, err)
+	}
+
+	// the previous event of the same type with a payload is returned
+	prev, d, ok, err := s.Previous(r)
+	if err != nil || !ok || prev.ID != MaxDetails+2 || d.Payload != strconv.Itoa(MaxDetails+2) {
+		t.Errorf("Previous() == event %d, got %v, %+v, %v, %v", MaxDetails+2, prev, d, ok, err)
+	}
+
+	// only the details of the most recent events of each type are kept, counting the event without payload
+	if err := s.prune(now); err != nil {
+		t.Fatal(err)
+	}
+	for id, want := range map[int64]bool{1: false, 4: false, 5: true, MaxDetails + 4: true, MaxDetails + 5: true} {
+		if _, ok, err := s.Detail(id); err != nil || ok != want {
+			t.Errorf("Detail(%d) is kept == %v, got %v, %v", id, want, ok, err)
+		}
+	}
+	if _, _, ok, err := s.Previous(Record{ID: 5, Type: "/a"}); err != nil || ok {
+		t.Errorf("Previous() of the oldest kept detail == false, got %v, %v", ok, err)
+	}
+}
+
+func TestStore_TruncatedLine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// a record, and one that was cut short while writing it
+	now := time.Now()
+	path := filepath.Join(dir, FileName)
+	first, err := json.Marshal(Record{ID: 1, Type: "/a", Time: now})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, append(first, []byte("\n{\"id\":2,\"type\":\"/a\",\"ti")...), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewStore(path, nil, nil)
+	if got, total, err := s.Query(Filter{}, 0, 10); err != nil || total != 1 || got[0].ID != 1 {
+		t.Fatalf("Query() == record 1, got %v, %d, %v", got, total, err)
+	}
+
+	// records appended after the truncated line can be read, also when the file is read again
+	if err := s.Append(Record{ID: 3, Type: "/a", Time: now}); err != nil {
+		t.Fatal(err)
+	}
+	for name, s := range map[string]*Store{"same store": s, "new store": NewStore(path, nil, nil)} {
+		t.Run(name, func(t *testing.T) {
+			got, total, err := s.Query(Filter{}, 0, 10)
+			if err != nil || total != 2 || got[0].ID != 3 || got[1].ID != 1 {
+				t.Errorf("Query() == records 3 and 1, got %v, %d, %v", got, total, err)
+			}
+			if r, ok, err := s.Lookup(3); err != nil || !ok || r.ID != 3 {
+				t.Errorf("Lookup() == record 3, got %v, %v, %v", r, ok, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package metrics
+
+// histogram counts observations in buckets with fixed upper bounds.
+type histogram struct {
+	bounds []float64
+	// counts holds the number of observations per bucket, not cumulative. Observations above the last bound are only
+	// included in count.
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogram(bounds []float64) *histogram {
+	return &histogram{
+		bounds: bounds,
+		counts: make([]uint64, len(bounds)),
+	}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, bound := range h.bounds {
+		if v <= bound {
+			h.counts[i]++
+			break
+		}
+	}
+	h.count++
+	h.sum += v
+}
@@ -0,0 +1,290 @@
+// Package metrics exposes statistics of the uploads in the Prometheus text format. The statistics are collected from
+// the events in the history.
+package metrics
+
+import (
+	"bufio"
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+// contentType is the content type of the Prometheus text format.
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+var (
+	// durationBuckets are the upper bounds of the buckets of the duration histograms, in seconds.
+	durationBuckets = []float64{0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120, 300}
+	// byteBuckets are the upper bounds of the buckets of the payload size histogram, in bytes.
+	byteBuckets = []float64{1e3, 1e4, 1e5, 1e6, 1e7, 1e8}
+)
+
+// BuildInfo describes the build of the service.
+type BuildInfo struct {
+	Version   string
+	GitCommit string
+	Built     string
+}
+
+// Metrics collects statistics of the uploads, and serves them in the Prometheus text format.
+type Metrics struct {
+	build   BuildInfo
+	dbStats func() (sql.DBStats, bool)
+	// started is when the service started, which counts as the last success until a dataset was uploaded
+	started time.Time
+
+	mu       sync.Mutex
+	datasets map[string]*datasetMetrics
+}
+
+type datasetMetrics struct {
+	uploads        uint64
+	failures       map[string]uint64
+	rowsRead       uint64
+	rowsUploaded   uint64
+	queryDuration  *histogram
+	uploadDuration *histogram
+	payloadBytes   *histogram
+	lastSuccess    time.Time
+}
+
+// New returns metrics for the given build. The statistics of the database connection pool are taken from dbStats,
+// if not nil.
+func New(build BuildInfo, dbStats func() (sql.DBStats, bool)) *Metrics {
+	return &Metrics{
+		build:    build,
+		dbStats:  dbStats,
+		started:  time.Now(),
+		datasets: make(map[string]*datasetMetrics),
+	}
+}
+
+// Observe adds a finished upload to the statistics. It is meant to be registered with history.History.Observe.
+func (m *Metrics) Observe(e history.Event) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	name := e.Type
+	if ds, ok := config.DatasetForPath(e.Type); ok {
+		name = string(ds)
+	}
+	d, ok := m.datasets[name]
+	if !ok {
+		d = &datasetMetrics{
+			failures:       make(map[string]uint64),
+			queryDuration:  newHistogram(durationBuckets),
+			uploadDuration: newHistogram(durationBuckets),
+			payloadBytes:   newHistogram(byteBuckets),
+		}
+		m.datasets[name] = d
+	}
+
+	d.uploads++
+	if e.Error != nil {
+		class := e.ErrorClass
+		if class == "" {
+			class = "other"
+		}
+		d.failures[class]++
+	}
+	if e.QueryDuration > 0 {
+		d.queryDuration.observe(e.QueryDuration.Seconds())
+		d.rowsRead += uint64(e.Size)
+	}
+	if e.Bytes > 0 {
+		d.payloadBytes.observe(float64(e.Bytes))
+	}
+	if e.Succeeded() {
+		d.uploadDuration.observe(e.UploadDuration.Seconds())
+		d.rowsUploaded += uint64(e.Size)
+		d.lastSuccess = e.Finished
+	}
+}
+
+// ServeHTTP serves the metrics.
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	if err := m.Write(w, time.Now()); err != nil {
+		dlog.Error("Error while writing metrics: %v", err)
+	}
+}
+
+// Write writes the metrics at now in the Prometheus text format.
+func (m *Metrics) Write(w io.Writer, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	e := &exposition{w: bw}
+
+	e.family("d2d_build_info", "gauge", "Build of the upload service.")
+	e.sample("d2d_build_info", labels{
+		"version", m.build.Version,
+		"commit", m.build.GitCommit,
+		"built", m.build.Built,
+		"goversion", runtime.Version(),
+	}, 1)
+
+	m.writeUploads(e, now)
+	m.writeDBStats(e)
+
+	if e.err != nil {
+		return e.err
+	}
+	return bw.Flush()
+}
+
+func (m *Metrics) writeUploads(e *exposition, now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	names := make([]string, 0, len(m.datasets))
+	for name := range m.datasets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	e.family("d2d_uploads_total", "counter", "Uploads that were attempted, by dataset.")
+	for _, name := range names {
+		e.sample("d2d_uploads_total", labels{"dataset", name}, float64(m.datasets[name].uploads))
+	}
+
+	e.family("d2d_upload_failures_total", "counter", "Uploads that failed, by dataset and the stage at which they failed.")
+	for _, name := range names {
+		d := m.datasets[name]
+		classes := make([]string, 0, len(d.failures))
+		for class := range d.failures {
+			classes = append(classes, class)
+		}
+		sort.Strings(classes)
+		for _, class := range classes {
+			e.sample("d2d_upload_failures_total", labels{"dataset", name, "class", class}, float64(d.failures[class]))
+		}
+	}
+
+	e.family("d2d_rows_read_total", "counter", "Records read from the database, by dataset.")
+	for _, name := range names {
+		e.sample("d2d_rows_read_total", labels{"dataset", name}, float64(m.datasets[name].rowsRead))
+	}
+
+	e.family("d2d_rows_uploaded_total", "counter", "Records uploaded to door2doc, by dataset.")
+	for _, name := range names {
+		e.sample("d2d_rows_uploaded_total", labels{"dataset", name}, float64(m.datasets[name].rowsUploaded))
+	}
+
+	e.family("d2d_query_duration_seconds", "histogram", "Duration of the queries, by dataset.")
+	for _, name := range names {
+		e.histogram("d2d_query_duration_seconds", labels{"dataset", name}, m.datasets[name].queryDuration)
+	}
+
+	e.family("d2d_upload_duration_seconds", "histogram", "Duration of the successful uploads to door2doc, by dataset.")
+	for _, name := range names {
+		e.histogram("d2d_upload_duration_seconds", labels{"dataset", name}, m.datasets[name].uploadDuration)
+	}
+
+	e.family("d2d_payload_bytes", "histogram", "Size of the JSON payloads, by dataset.")
+	for _, name := range names {
+		e.histogram("d2d_payload_bytes", labels{"dataset", name}, m.datasets[name].payloadBytes)
+	}
+
+	e.family("d2d_seconds_since_last_success", "gauge", "Time since the last successful upload, or since the service started, by dataset.")
+	for _, name := range names {
+		last := m.datasets[name].lastSuccess
+		if last.IsZero() {
+			last = m.started
+		}
+		e.sample("d2d_seconds_since_last_success", labels{"dataset", name}, now.Sub(last).Seconds())
+	}
+}
+
+func (m *Metrics) writeDBStats(e *exposition) {
+	var stats sql.DBStats
+	if m.dbStats != nil {
+		stats, _ = m.dbStats()
+	}
+
+	for _, metric := range []struct {
+		name, typ, help string
+		value           float64
+	}{
+		{"d2d_db_max_open_connections", "gauge", "Maximum number of open connections to the database.", float64(stats.MaxOpenConnections)},
+		{"d2d_db_open_connections", "gauge", "Open connections to the database.", float64(stats.OpenConnections)},
+		{"d2d_db_in_use_connections", "gauge", "Connections to the database that are in use.", float64(stats.InUse)},
+		{"d2d_db_idle_connections", "gauge", "Idle connections to the database.", float64(stats.Idle)},
+		{"d2d_db_wait_count_total", "counter", "Times a query waited for a connection to the database.", float64(stats.WaitCount)},
+		{"d2d_db_wait_duration_seconds_total", "counter", "Time spent waiting for a connection to the database.", stats.WaitDuration.Seconds()},
+		{"d2d_db_max_idle_closed_total", "counter", "Connections closed because there were too many idle connections.", float64(stats.MaxIdleClosed)},
+		{"d2d_db_max_lifetime_closed_total", "counter", "Connections closed because they reached their maximum lifetime.", float64(stats.MaxLifetimeClosed)},
+	} {
+		e.family(metric.name, metric.typ, metric.help)
+		e.sample(metric.name, nil, metric.value)
+	}
+}
+
+// labels contains pairs of label names and values.
+type labels []string
+
+func (l labels) String() string {
+	if len(l) == 0 {
+		return ""
+	}
+	pairs := make([]string, 0, len(l)/2)
+	for i := 0; i+1 < len(l); i += 2 {
+		pairs = append(pairs, fmt.Sprintf("%s=\"%s\"", l[i], escapeLabel(l[i+1])))
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+// exposition writes metrics in the Prometheus text format. It keeps the first error, so that it only has to be
+// checked at the end.
+type exposition struct {
+	w   io.Writer
+	err error
+}
+
+func (e *exposition) printf(format string, args ...interface{}) {
+	if e.err == nil {
+		_, e.err = fmt.Fprintf(e.w, format, args...)
+	}
+}
+
+func (e *exposition) family(name, typ, help string) {
+	e.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+func (e *exposition) sample(name string, l labels, value float64) {
+	e.printf("%s%s %s\n", name, l, formatFloat(value))
+}
+
+func (e *exposition) histogram(name string, l labels, h *histogram) {
+	var cumulative uint64
+	for i, bound := range h.bounds {
+		cumulative += h.counts[i]
+		e.sample(name+"_bucket", append(l[:len(l):len(l)], "le", formatFloat(bound)), float64(cumulative))
+	}
+	e.sample(name+"_bucket", append(l[:len(l):len(l)], "le", "+Inf"), float64(h.count))
+	e.sample(name+"_sum", l, h.sum)
+	e.sample(name+"_count", l, float64(h.count))
+}
+
+func formatFloat(f float64) string {
+	return fmt.Sprintf("%g", f)
+}
@@ -0,0 +1,83 @@
+package metrics
+
+import (
+	"bytes"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+func TestMetrics(t *testing.T) {
+	now := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	m := New(BuildInfo{Version: "1.2.3", GitCommit: "abc", Built: "today"}, func() (sql.DBStats, bool) {
+		return sql.DBStats{MaxOpenConnections: 4, OpenConnections: 2, InUse: 1, Idle: 1}, true
+	})
+
+	h := history.New()
+	h.Observe(m.Observe)
+	for _, e := range []history.Event{
+		{Type: config.PathVisitorUpload, QueryDuration: 200 * time.Millisecond, UploadDuration: 2 * time.Second, Size: 10, Bytes: 2000},
+		{Type: config.PathVisitorUpload, QueryDuration: 300 * time.Millisecond, Size: 12, Bytes: 2400, Error: errors.New("503"), ErrorClass: history.ErrorClassResponse},
+		{Type: config.PathLabUpload, Error: errors.New("no connection"), ErrorClass: history.ErrorClassDatabase},
+	} {
+		evt := h.NewEvent(e.Type)
+		*evt = e
+		h.Finish(evt)
+	}
+	m.mu.Lock()
+	m.started = now.Add(-time.Hour)
+	m.datasets["visitor"].lastSuccess = now.Add(-90 * time.Second)
+	m.mu.Unlock()
+
+	buf := new(bytes.Buffer)
+	if err := m.Write(buf, now); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+	for _, want := range []string{
+		`d2d_build_info{version="1.2.3",commit="abc",built="today",goversion="`,
+		`d2d_uploads_total{dataset="visitor"} 2`,
+		`d2d_uploads_total{dataset="lab"} 1`,
+		`d2d_upload_failures_total{dataset="visitor",class="response"} 1`,
+		`d2d_upload_failures_total{dataset="lab",class="database"} 1`,
+		`d2d_rows_read_total{dataset="visitor"} 22`,
+		`d2d_rows_uploaded_total{dataset="visitor"} 10`,
+		`d2d_query_duration_seconds_bucket{dataset="visitor",le="0.25"} 1`,
+		`d2d_query_duration_seconds_bucket{dataset="visitor",le="0.5"} 2`,
+		`d2d_query_duration_seconds_bucket{dataset="visitor",le="+Inf"} 2`,
+		`d2d_query_duration_seconds_sum{dataset="visitor"} 0.5`,
+		`d2d_upload_duration_seconds_count{dataset="visitor"} 1`,
+		`d2d_payload_bytes_bucket{dataset="visitor",le="10000"} 2`,
+		`d2d_seconds_since_last_success{dataset="visitor"} 90`,
+		// lab never succeeded, so the time is counted from the start of the service
+		`d2d_seconds_since_last_success{dataset="lab"} 3600`,
+		`d2d_db_open_connections 2`,
+		`# TYPE d2d_payload_bytes histogram`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Write() contains %s, got\n%s", want, got)
+		}
+	}
+}
+
+func TestLabels(t *testing.T) {
+	for name, test := range map[string]struct {
+		Labels labels
+		Want   string
+	}{
+		"none":    {Want: ""},
+		"one":     {Labels: labels{"dataset", "lab"}, Want: `{dataset="lab"}`},
+		"escaped": {Labels: labels{"version", "a\"b\\c\nd"}, Want: `{version="a\"b\\c\nd"}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := test.Labels.String(); got != test.Want {
+				t.Errorf("String() == %s, got %s", test.Want, got)
+			}
+		})
+	}
+}
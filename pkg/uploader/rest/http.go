@@ -2,49 +2,142 @@ package rest
 
 import (
 	"context"
+	"net"
 	"net/http"
 	"net/url"
 	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/http/httpproxy"
 )
 
-var (
-	mu           sync.Mutex
-	currentProxy string
-	client       = new(http.Client)
+const (
+	// ProxyNone connects directly, without using a proxy server.
+	ProxyNone = ""
+	// ProxyManual uses the proxy server configured in Proxy.
+	ProxyManual = "manual"
+	// ProxyEnvironment uses the proxy server defined by the HTTP_PROXY, HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyEnvironment = "environment"
 )
 
-func Do(ctx context.Context, proxy string, req *http.Request) (*http.Response, error) {
-	mu.Lock()
-	defer mu.Unlock()
+// Proxy contains the proxy settings used for outgoing HTTP requests.
+type Proxy struct {
+	// Mode is one of ProxyNone, ProxyManual or ProxyEnvironment.
+	Mode string
+	// URL of the proxy server, used in ProxyManual mode.
+	URL string
+	// Username to authenticate with the proxy server, used in ProxyManual mode.
+	Username string
+	// Password to authenticate with the proxy server, used in ProxyManual mode.
+	Password string
+	// NoProxy is a comma separated list of hosts, domains and networks that are excluded from proxying. It has the
+	// same format as the NO_PROXY environment variable and is used in ProxyManual mode.
+	NoProxy string
+}
+
+// proxyFunc returns the function that selects the proxy server for a request, or nil if no proxy is used.
+func (p Proxy) proxyFunc() (func(*http.Request) (*url.URL, error), error) {
+	var cfg *httpproxy.Config
 
-	if currentProxy != proxy {
-		if err := updateClient(proxy); err != nil {
+	switch p.Mode {
+	case ProxyNone:
+		return nil, nil
+	case ProxyEnvironment:
+		cfg = httpproxy.FromEnvironment()
+	case ProxyManual:
+		if p.URL == "" {
+			return nil, nil
+		}
+		proxyURL, err := url.Parse(p.URL)
+		if err != nil {
 			return nil, err
 		}
+		if p.Username != "" {
+			proxyURL.User = url.UserPassword(p.Username, p.Password)
+		}
+		cfg = &httpproxy.Config{
+			HTTPProxy:  proxyURL.String(),
+			HTTPSProxy: proxyURL.String(),
+			NoProxy:    p.NoProxy,
+		}
+	default:
+		return nil, errors.Errorf("unknown proxy mode %q", p.Mode)
 	}
-	return client.Do(req.WithContext(ctx))
+
+	f := cfg.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return f(req.URL)
+	}, nil
 }
 
-func updateClient(newProxy string) error {
-	if currentProxy == newProxy {
-		return nil
+const (
+	// maxClients is the maximum number of clients kept in the client cache.
+	maxClients = 4
+
+	dialTimeout           = 10 * time.Second
+	keepAlive             = 30 * time.Second
+	tlsHandshakeTimeout   = 10 * time.Second
+	responseHeaderTimeout = time.Minute
+	idleConnTimeout       = 90 * time.Second
+	requestTimeout        = 5 * time.Minute
+)
+
+var (
+	mu      sync.Mutex
+	clients = make(map[Proxy]*http.Client)
+	// order in which the cached clients were created, oldest first
+	created []Proxy
+)
+
+// Do sends an HTTP request using the given proxy settings. Clients are cached per proxy configuration, so
+// connections are reused between requests. Requests can be sent concurrently.
+func Do(ctx context.Context, proxy Proxy, req *http.Request) (*http.Response, error) {
+	client, err := clientFor(proxy)
+	if err != nil {
+		return nil, err
 	}
+	return client.Do(req.WithContext(ctx))
+}
 
-	client.CloseIdleConnections()
+// clientFor returns the cached client for the given proxy settings, creating it if necessary.
+func clientFor(proxy Proxy) (*http.Client, error) {
+	mu.Lock()
+	defer mu.Unlock()
 
-	if newProxy == "" {
-		client = new(http.Client)
-		return nil
+	if client, ok := clients[proxy]; ok {
+		return client, nil
 	}
 
-	proxyURL, err := url.Parse(newProxy)
+	proxyFunc, err := proxy.proxyFunc()
 	if err != nil {
-		return err
+		return nil, err
 	}
-	client = &http.Client{
+
+	// evict the oldest client; requests that are still running on it will complete normally
+	if len(created) >= maxClients {
+		oldest := created[0]
+		created = created[1:]
+		clients[oldest].CloseIdleConnections()
+		delete(clients, oldest)
+	}
+
+	client := &http.Client{
+		Timeout: requestTimeout,
 		Transport: &http.Transport{
-			Proxy: http.ProxyURL(proxyURL),
+			Proxy: proxyFunc,
+			DialContext: (&net.Dialer{
+				Timeout:   dialTimeout,
+				KeepAlive: keepAlive,
+			}).DialContext,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       idleConnTimeout,
+			TLSHandshakeTimeout:   tlsHandshakeTimeout,
+			ResponseHeaderTimeout: responseHeaderTimeout,
+			ExpectContinueTimeout: time.Second,
 		},
 	}
-	return nil
+	clients[proxy] = client
+	created = append(created, proxy)
+	return client, nil
 }
@@ -0,0 +1,2 @@
+// Package secret resolves references to secrets that are stored outside of the configuration file.
+package secret
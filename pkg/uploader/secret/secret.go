@@ -0,0 +1,141 @@
+package secret
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+	"github.com/pkg/errors"
+)
+
+const (
+	// PrefixEnv refers to an environment variable, as in env:D2D_PASSWORD.
+	PrefixEnv = "env:"
+	// PrefixFile refers to the contents of a file, as in file:/run/secrets/db.
+	PrefixFile = "file:"
+	// PrefixVault refers to a field of a secret in a Vault KV version 2 secrets engine, as in
+	// vault:secret/d2d#password. The first element of the path is the mount point of the secrets engine.
+	PrefixVault = "vault:"
+
+	// EnvVaultAddr is the environment variable containing the address of the Vault server.
+	EnvVaultAddr = "VAULT_ADDR"
+	// EnvVaultToken is the environment variable containing the token to authenticate with Vault.
+	EnvVaultToken = "VAULT_TOKEN"
+	// EnvVaultNamespace is the environment variable containing the optional Vault Enterprise namespace.
+	EnvVaultNamespace = "VAULT_NAMESPACE"
+)
+
+// Error indicates that a secret reference could not be resolved.
+type Error struct {
+	Reference string
+	Cause     string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("failed to resolve secret %s: %s", e.Reference, e.Cause)
+}
+
+// IsReference returns whether value refers to a secret stored elsewhere, rather than being the secret itself.
+func IsReference(value string) bool {
+	return strings.HasPrefix(value, PrefixEnv) ||
+		strings.HasPrefix(value, PrefixFile) ||
+		strings.HasPrefix(value, PrefixVault)
+}
+
+// Resolve returns the secret value refers to. Values that are not a reference are returned as is. References are
+// resolved on every call, so that rotated secrets are picked up without a restart.
+func Resolve(ctx context.Context, value string) (string, error) {
+	var (
+		res string
+		err error
+	)
+	switch {
+	case strings.HasPrefix(value, PrefixEnv):
+		res, err = resolveEnv(strings.TrimPrefix(value, PrefixEnv))
+	case strings.HasPrefix(value, PrefixFile):
+		res, err = resolveFile(strings.TrimPrefix(value, PrefixFile))
+	case strings.HasPrefix(value, PrefixVault):
+		res, err = resolveVault(ctx, strings.TrimPrefix(value, PrefixVault))
+	default:
+		return value, nil
+	}
+	if err != nil {
+		return "", &Error{Reference: value, Cause: err.Error()}
+	}
+	return res, nil
+}
+
+func resolveEnv(name string) (string, error) {
+	res, ok := os.LookupEnv(name)
+	if !ok {
+		return "", errors.New("environment variable not set")
+	}
+	return res, nil
+}
+
+func resolveFile(path string) (string, error) {
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	// files written by editors and secret stores usually end with a newline, which is not part of the secret
+	return strings.TrimRight(string(bs), "\r\n"), nil
+}
+
+// resolveVault reads a field of a secret using the HTTP API of the KV version 2 secrets engine.
+func resolveVault(ctx context.Context, ref string) (string, error) {
+	i := strings.LastIndexByte(ref, '#')
+	if i < 0 {
+		return "", errors.New("missing field, expected vault:mount/path#field")
+	}
+	path, field := strings.Trim(ref[:i], "/"), ref[i+1:]
+	parts := strings.SplitN(path, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || field == "" {
+		return "", errors.New("invalid reference, expected vault:mount/path#field")
+	}
+
+	addr := os.Getenv(EnvVaultAddr)
+	if addr == "" {
+		return "", errors.Errorf("%s not set", EnvVaultAddr)
+	}
+	req, err := http.NewRequest(http.MethodGet, strings.TrimRight(addr, "/")+"/v1/"+parts[0]+"/data/"+parts[1], nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("X-Vault-Token", os.Getenv(EnvVaultToken))
+	if ns := os.Getenv(EnvVaultNamespace); ns != "" {
+		req.Header.Set("X-Vault-Namespace", ns)
+	}
+
+	res, err := rest.Do(ctx, rest.Proxy{Mode: rest.ProxyEnvironment}, req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", errors.Errorf("vault returned HTTP %d", res.StatusCode)
+	}
+
+	var body struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return "", errors.Wrap(err, "invalid response from vault")
+	}
+	val, ok := body.Data.Data[field]
+	if !ok {
+		return "", errors.Errorf("field %s not found", field)
+	}
+	s, ok := val.(string)
+	if !ok {
+		return "", errors.Errorf("field %s is not a string", field)
+	}
+	return s, nil
+}
@@ -0,0 +1,99 @@
+package secret
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "db")
+	if err := ioutil.WriteFile(file, []byte("from-file\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	vault := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "root" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/secret/data/d2d/upload" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data": {"data": {"password": "from-vault", "port": 1}, "metadata": {"version": 3}}}`))
+	}))
+	defer vault.Close()
+
+	for k, v := range map[string]string{
+		"D2D_TEST_SECRET": "from-env",
+		EnvVaultAddr:      vault.URL,
+		EnvVaultToken:     "root",
+	} {
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Unsetenv(k)
+	}
+
+	for name, test := range map[string]struct {
+		Given   string
+		Want    string
+		WantErr bool
+	}{
+		"plain":           {Given: "secret", Want: "secret"},
+		"empty":           {Given: "", Want: ""},
+		"env":             {Given: "env:D2D_TEST_SECRET", Want: "from-env"},
+		"env not set":     {Given: "env:D2D_TEST_MISSING", WantErr: true},
+		"file":            {Given: "file:" + file, Want: "from-file"},
+		"file not found":  {Given: "file:" + file + ".missing", WantErr: true},
+		"vault":           {Given: "vault:secret/d2d/upload#password", Want: "from-vault"},
+		"vault no field":  {Given: "vault:secret/d2d/upload", WantErr: true},
+		"vault missing":   {Given: "vault:secret/d2d/upload#username", WantErr: true},
+		"vault no string": {Given: "vault:secret/d2d/upload#port", WantErr: true},
+		"vault not found": {Given: "vault:secret/other#password", WantErr: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := Resolve(context.Background(), test.Given)
+			if test.WantErr {
+				if _, ok := err.(*Error); !ok {
+					t.Errorf("Resolve() == _, *Error; got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.Want {
+				t.Errorf("Resolve() == %s, got %s", test.Want, got)
+			}
+		})
+	}
+}
+
+func TestResolveRotation(t *testing.T) {
+	defer os.Unsetenv("D2D_TEST_SECRET")
+
+	for _, want := range []string{"first", "second"} {
+		if err := os.Setenv("D2D_TEST_SECRET", want); err != nil {
+			t.Fatal(err)
+		}
+		got, err := Resolve(context.Background(), "env:D2D_TEST_SECRET")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Resolve() == %s, got %s", want, got)
+		}
+	}
+}
@@ -5,13 +5,18 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"4d63.com/tz"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/metrics"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/trace"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/web"
 	"github.com/kardianos/service"
 )
@@ -19,17 +24,21 @@ import (
 // Service contains the definition to run the application as a service.
 type Service struct {
 	dev      bool
-	version  string
+	build    metrics.BuildInfo
 	shutdown context.CancelFunc
 	srv      *http.Server
 	cfg      *config.Configuration
+	// path of the configuration file; if empty, the file in the configuration folder is used
+	configPath string
 }
 
-// NewService creates a new Service instance.
-func NewService(development bool, version string) *Service {
+// NewService creates a new Service instance, using the configuration file at configPath, or the one in the
+// configuration folder if configPath is empty.
+func NewService(development bool, build metrics.BuildInfo, configPath string) *Service {
 	return &Service{
-		dev:     development,
-		version: version,
+		dev:        development,
+		build:      build,
+		configPath: configPath,
 	}
 }
 
@@ -45,9 +54,17 @@ func (s *Service) Start(svc service.Service) error {
 
 	// load configuration
 	s.cfg = config.NewConfiguration()
+	if s.configPath != "" {
+		s.cfg.SetPath(s.configPath)
+	}
 	if err := s.cfg.Reload(); err != nil {
+		// the service does not run with a configuration that was only partially applied
+		dlog.Error("Failed to load the configuration from %s: %v", s.cfg.Path(), err)
 		return err
 	}
+	dlog.SetHTTPClient(func(req *http.Request) (*http.Response, error) {
+		return rest.Do(context.Background(), s.cfg.Proxy(), req)
+	})
 
 	// set up uploader
 	uploader := &Uploader{
@@ -57,13 +74,23 @@ func (s *Service) Start(svc service.Service) error {
 	}
 
 	// create HTTP server for configuration purposes
-	handler, err := web.NewServeMux(s.dev, s.version, s.cfg, h)
+	var auditLog *audit.Log
+	if folder := s.cfg.Dir(); folder != "" {
+		auditLog = audit.New(filepath.Join(folder, audit.FileName))
+		h.SetStore(history.NewStore(filepath.Join(folder, history.FileName), s.cfg.HistoryRetention, s.cfg))
+	}
+	handler, err := web.NewServeMux(s.dev, s.build.Version, s.cfg, h, uploader, auditLog)
 	if err != nil {
 		return err
 	}
 
+	// collect metrics from the uploads in the history
+	m := metrics.New(s.build, uploader.DBStats)
+	h.Observe(m.Observe)
+
 	mux := http.DefaultServeMux
 	mux.Handle("/", handler)
+	mux.Handle("/metrics", m)
 	s.srv = &http.Server{
 		Addr:    ":17226",
 		Handler: mux,
@@ -95,6 +122,14 @@ func (s *Service) Start(svc service.Service) error {
 		}
 	}()
 
+	// reload the configuration when it is changed on disk
+	go s.cfg.Watch(ctx, config.WatchInterval)
+
+	// export traces if a collector is configured
+	exporter := trace.NewExporter("d2d-upload", s.build.Version, s.cfg.TracingEndpoint)
+	trace.SetExporter(exporter)
+	go exporter.Run(ctx, trace.ExportInterval)
+
 	// run service
 	go func() {
 		// validate configuration
@@ -150,6 +185,8 @@ func (s *Service) run(ctx context.Context, uploader *Uploader) error {
 		case <-ctx.Done():
 			return ctx.Err()
 		case <-time.After(sleep):
+		case <-uploader.Triggered():
+			dlog.Info("Upload triggered")
 		}
 	}
 }
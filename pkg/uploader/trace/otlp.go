@@ -0,0 +1,277 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/pkg/errors"
+)
+
+const (
+	// pathTraces is appended to the endpoint of the collector, like OTEL_EXPORTER_OTLP_ENDPOINT does.
+	pathTraces = "/v1/traces"
+
+	// ExportInterval is the time between exports of spans.
+	ExportInterval = 5 * time.Second
+	// maxQueued is the maximum number of spans that are kept until the next export. Further spans are dropped.
+	maxQueued = 2048
+	// exportTimeout is the maximum time a request to the collector may take.
+	exportTimeout = 10 * time.Second
+)
+
+// Exporter sends spans to an OpenTelemetry collector, with OTLP/HTTP in JSON encoding. The endpoint is read before
+// every export, so that changes to the configuration take effect without restart; spans are only recorded while it
+// is set.
+type Exporter struct {
+	service  string
+	version  string
+	endpoint func() string
+	client   *http.Client
+
+	// enabled is 1 if the endpoint was set at the last export
+	enabled int32
+
+	mu      sync.Mutex
+	queue   []*Span
+	dropped int
+}
+
+// NewExporter returns an exporter for the given service and version, that sends spans to the collector at the base
+// URL returned by endpoint, like http://localhost:4318.
+func NewExporter(service, version string, endpoint func() string) *Exporter {
+	e := &Exporter{
+		service:  service,
+		version:  version,
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: exportTimeout},
+	}
+	e.updateEnabled()
+	return e
+}
+
+// Enabled returns whether spans are recorded.
+func (e *Exporter) Enabled() bool {
+	return e != nil && atomic.LoadInt32(&e.enabled) == 1
+}
+
+func (e *Exporter) updateEnabled() string {
+	endpoint := strings.TrimSpace(e.endpoint())
+	var enabled int32
+	if endpoint != "" {
+		enabled = 1
+	}
+	atomic.StoreInt32(&e.enabled, enabled)
+	return endpoint
+}
+
+func (e *Exporter) add(s *Span) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if len(e.queue) >= maxQueued {
+		e.dropped++
+		return
+	}
+	e.queue = append(e.queue, s)
+}
+
+// Run exports spans every interval until ctx is done, and once more after.
+func (e *Exporter) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+			defer cancel()
+			e.logExport(e.Export(flushCtx))
+			return
+		case <-ticker.C:
+			e.logExport(e.Export(ctx))
+		}
+	}
+}
+
+func (e *Exporter) logExport(err error) {
+	if err != nil {
+		dlog.Error("Failed to export traces: %v", err)
+	}
+}
+
+// Export sends the spans that finished since the last export. Spans are discarded if the endpoint is not set, or if
+// they cannot be sent.
+func (e *Exporter) Export(ctx context.Context) error {
+	endpoint := e.updateEnabled()
+
+	e.mu.Lock()
+	spans, dropped := e.queue, e.dropped
+	e.queue, e.dropped = nil, 0
+	e.mu.Unlock()
+
+	if endpoint == "" || len(spans) == 0 {
+		return nil
+	}
+	if dropped > 0 {
+		dlog.Warning("Dropped %d spans, because more than %d spans finished between exports", dropped, maxQueued)
+	}
+
+	bs, err := json.Marshal(e.request(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(endpoint, "/")+pathTraces, bytes.NewReader(bs))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := e.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer dlog.Close(res.Body)
+	body, _ := ioutil.ReadAll(io.LimitReader(res.Body, 1024))
+	if res.StatusCode/100 != 2 {
+		return errors.Errorf("unexpected response from %s: %s %s", endpoint, res.Status, body)
+	}
+	return nil
+}
+
+// The types below are the JSON encoding of an ExportTraceServiceRequest of OTLP.
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// Status codes of spans.
+const (
+	statusUnset = 0
+	statusError = 2
+)
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func newOTLPAttribute(key string, value interface{}) otlpAttribute {
+	var v otlpValue
+	switch value := value.(type) {
+	case string:
+		v.StringValue = &value
+	case bool:
+		v.BoolValue = &value
+	case int:
+		s := strconv.Itoa(value)
+		v.IntValue = &s
+	case int64:
+		s := strconv.FormatInt(value, 10)
+		v.IntValue = &s
+	case float64:
+		v.DoubleValue = &value
+	case time.Duration:
+		s := strconv.FormatInt(int64(value), 10)
+		v.IntValue = &s
+	default:
+		s := fmt.Sprint(value)
+		v.StringValue = &s
+	}
+	return otlpAttribute{Key: key, Value: v}
+}
+
+func (e *Exporter) request(spans []*Span) otlpRequest {
+	res := otlpResourceSpans{
+		Resource: otlpResource{Attributes: []otlpAttribute{
+			newOTLPAttribute("service.name", e.service),
+			newOTLPAttribute("service.version", e.version),
+		}},
+		ScopeSpans: []otlpScopeSpans{{
+			Scope: otlpScope{Name: "github.com/door2doc/d2d-uploader", Version: e.version},
+		}},
+	}
+	for _, s := range spans {
+		res.ScopeSpans[0].Spans = append(res.ScopeSpans[0].Spans, s.otlp())
+	}
+	return otlpRequest{ResourceSpans: []otlpResourceSpans{res}}
+}
+
+func (s *Span) otlp() otlpSpan {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := otlpSpan{
+		TraceID:           hex.EncodeToString(s.traceID[:]),
+		SpanID:            hex.EncodeToString(s.spanID[:]),
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+		Status:            otlpStatus{Code: statusUnset},
+	}
+	if s.parentID != [8]byte{} {
+		res.ParentSpanID = hex.EncodeToString(s.parentID[:])
+	}
+	for _, a := range s.attributes {
+		res.Attributes = append(res.Attributes, newOTLPAttribute(a.key, a.value))
+	}
+	if s.err != nil {
+		res.Status = otlpStatus{Code: statusError, Message: s.err.Error()}
+	}
+	return res
+}
@@ -0,0 +1,158 @@
+// Package trace records OpenTelemetry spans of uploads and validations, and exports them to a collector with OTLP/HTTP.
+// Spans are only recorded while an exporter with an endpoint is set; otherwise Start returns a nil span, and all
+// methods of Span accept a nil receiver.
+package trace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"io"
+	"sync"
+	"time"
+)
+
+// Attribute keys that are shared between spans.
+const (
+	AttributeDataset     = "d2d.dataset"
+	AttributeEnvironment = "d2d.environment"
+	AttributeRows        = "d2d.rows"
+	AttributeBytes       = "d2d.bytes"
+)
+
+var (
+	mu       sync.RWMutex
+	exporter *Exporter
+)
+
+// SetExporter sets the exporter that receives finished spans.
+func SetExporter(e *Exporter) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	exporter = e
+}
+
+func currentExporter() *Exporter {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return exporter
+}
+
+// Span is an operation in a trace.
+type Span struct {
+	exporter *Exporter
+
+	name     string
+	kind     int
+	traceID  [16]byte
+	spanID   [8]byte
+	parentID [8]byte
+	start    time.Time
+
+	mu         sync.Mutex
+	end        time.Time
+	attributes []attribute
+	err        error
+}
+
+type attribute struct {
+	key   string
+	value interface{}
+}
+
+// Kinds of spans, as defined by OpenTelemetry.
+const (
+	KindInternal = 1
+	KindClient   = 3
+)
+
+type spanKey struct{}
+
+// FromContext returns the span in ctx, if any.
+func FromContext(ctx context.Context) *Span {
+	s, _ := ctx.Value(spanKey{}).(*Span)
+	return s
+}
+
+// Start starts a span of the given kind, as a child of the span in ctx if there is one. The span is in the returned
+// context. It returns a nil span if tracing is off.
+func Start(ctx context.Context, name string, kind int) (context.Context, *Span) {
+	e := currentExporter()
+	if !e.Enabled() {
+		return ctx, nil
+	}
+
+	s := &Span{
+		exporter: e,
+		name:     name,
+		kind:     kind,
+		start:    time.Now(),
+	}
+	if parent := FromContext(ctx); parent != nil {
+		s.traceID = parent.traceID
+		s.parentID = parent.spanID
+	} else {
+		randomID(s.traceID[:])
+	}
+	randomID(s.spanID[:])
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func randomID(id []byte) {
+	// a failing random source leaves the ID zero, which collectors reject; that is preferable to failing the upload
+	_, _ = io.ReadFull(rand.Reader, id)
+}
+
+// SetAttribute sets an attribute of the span. Values are strings, integers, floats or booleans.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.attributes {
+		if s.attributes[i].key == key {
+			s.attributes[i].value = value
+			return
+		}
+	}
+	s.attributes = append(s.attributes, attribute{key: key, value: value})
+}
+
+// SetError marks the span as failed, if err is not nil.
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+}
+
+// End finishes the span, and passes it on to the exporter. Only the first call has effect.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mu.Lock()
+	if !s.end.IsZero() {
+		s.mu.Unlock()
+		return
+	}
+	s.end = time.Now()
+	s.mu.Unlock()
+
+	s.exporter.add(s)
+}
+
+// TraceID returns the ID of the trace of the span, in hexadecimal.
+func (s *Span) TraceID() string {
+	if s == nil {
+		return ""
+	}
+	return hex.EncodeToString(s.traceID[:])
+}
@@ -0,0 +1,97 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestStartWithoutExporter(t *testing.T) {
+	for name, e := range map[string]*Exporter{
+		"no exporter": nil,
+		"no endpoint": NewExporter("test", "1", func() string { return "" }),
+	} {
+		t.Run(name, func(t *testing.T) {
+			SetExporter(e)
+			defer SetExporter(nil)
+
+			ctx, span := Start(context.Background(), "upload", KindInternal)
+			if span != nil {
+				t.Errorf("Start() == nil, got %v", span)
+			}
+			if FromContext(ctx) != nil {
+				t.Errorf("FromContext() == nil, got %v", FromContext(ctx))
+			}
+			// a nil span is safe to use
+			span.SetAttribute(AttributeRows, 1)
+			span.SetError(errors.New("failed"))
+			span.End()
+		})
+	}
+}
+
+func TestExport(t *testing.T) {
+	var got otlpRequest
+	var path string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	e := NewExporter("test", "1.2.3", func() string { return srv.URL })
+	SetExporter(e)
+	defer SetExporter(nil)
+
+	ctx, parent := Start(context.Background(), "upload", KindInternal)
+	parent.SetAttribute(AttributeDataset, "visitor")
+	_, child := Start(ctx, "db.query", KindClient)
+	child.SetAttribute(AttributeRows, 12)
+	child.SetError(errors.New("timeout"))
+	child.End()
+	parent.End()
+
+	if err := e.Export(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if path != pathTraces {
+		t.Errorf("path == %s, got %s", pathTraces, path)
+	}
+	if len(got.ResourceSpans) != 1 || len(got.ResourceSpans[0].ScopeSpans) != 1 {
+		t.Fatalf("resourceSpans == 1 resource with 1 scope, got %+v", got)
+	}
+	spans := got.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("len(spans) == 2, got %d", len(spans))
+	}
+	c, p := spans[0], spans[1]
+	if c.Name != "db.query" || p.Name != "upload" {
+		t.Errorf("spans == [db.query upload], got [%s %s]", c.Name, p.Name)
+	}
+	if c.TraceID != p.TraceID || c.ParentSpanID != p.SpanID || p.ParentSpanID != "" {
+		t.Errorf("db.query is a child of upload, got %+v and %+v", c, p)
+	}
+	if len(c.Attributes) != 1 || c.Attributes[0].Key != AttributeRows || c.Attributes[0].Value.IntValue == nil || *c.Attributes[0].Value.IntValue != "12" {
+		t.Errorf("attributes == [d2d.rows=12], got %+v", c.Attributes)
+	}
+	if c.Status.Code != statusError || c.Status.Message != "timeout" {
+		t.Errorf("status == error timeout, got %+v", c.Status)
+	}
+	if p.Status.Code != statusUnset {
+		t.Errorf("status == unset, got %+v", p.Status)
+	}
+
+	// exported spans are not sent again
+	got = otlpRequest{}
+	if err := e.Export(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(got.ResourceSpans) != 0 {
+		t.Errorf("Export() sends nothing, got %+v", got)
+	}
+}
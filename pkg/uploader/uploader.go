@@ -5,7 +5,8 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
-	"io"
+	"fmt"
+	"io/ioutil"
 	"net/http"
 	"sync"
 	"time"
@@ -15,7 +16,7 @@ import (
 	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
-	"github.com/pkg/errors"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/trace"
 )
 
 type Uploader struct {
@@ -26,7 +27,35 @@ type Uploader struct {
 	mu         sync.Mutex
 	lastDriver string
 	lastDSN    string
-	db         *sql.DB
+	lastPool   db.PoolSettings
+
+	// dbMu guards db, so statistics can be read while an upload is running
+	dbMu sync.RWMutex
+	db   *sql.DB
+
+	triggerOnce sync.Once
+	trigger     chan struct{}
+}
+
+// Trigger requests an upload as soon as possible, instead of waiting for the interval to pass. Requests made while an
+// upload is pending are combined.
+func (u *Uploader) Trigger() {
+	select {
+	case u.triggered() <- struct{}{}:
+	default:
+	}
+}
+
+// Triggered returns a channel that receives a value when an upload has been requested with Trigger.
+func (u *Uploader) Triggered() <-chan struct{} {
+	return u.triggered()
+}
+
+func (u *Uploader) triggered() chan struct{} {
+	u.triggerOnce.Do(func() {
+		u.trigger = make(chan struct{}, 1)
+	})
+	return u.trigger
 }
 
 // Upload uses a configuration to run a query on the target database, convert the results to JSON, and upload
@@ -60,10 +89,26 @@ type queryFunc func(ctx context.Context) (interface{}, int, error)
 
 func (u *Uploader) upload(ctx context.Context, path string, q queryFunc) error {
 	evt := u.History.NewEvent(path)
+	evt.Environment = u.Configuration.Environment().Name
+	defer u.History.Finish(evt)
+
+	ctx, span := trace.Start(ctx, "upload", trace.KindInternal)
+	if ds, ok := config.DatasetForPath(path); ok {
+		span.SetAttribute(trace.AttributeDataset, string(ds))
+	}
+	span.SetAttribute(trace.AttributeEnvironment, evt.Environment)
+	defer func() {
+		span.SetError(evt.Error)
+		span.End()
+	}()
 
 	// ensure DB connection
-	if err := u.ensureDB(); err != nil {
-		evt.Error = err
+	connCtx, connSpan := trace.Start(ctx, "db.connect", trace.KindClient)
+	err := u.ensureDB(connCtx)
+	connSpan.SetError(err)
+	connSpan.End()
+	if err != nil {
+		evt.Error, evt.ErrorClass = err, history.ErrorClassDatabase
 		return err
 	}
 
@@ -71,53 +116,124 @@ func (u *Uploader) upload(ctx context.Context, path string, q queryFunc) error {
 	start := time.Now()
 	vRecs, size, err := q(ctx)
 	if err != nil {
-		evt.Error = err
+		evt.Error, evt.ErrorClass = err, history.ErrorClassQuery
 		return err
 	}
 	evt.QueryDuration = time.Since(start)
 	evt.Size = size
+	span.SetAttribute(trace.AttributeRows, size)
 
+	_, encSpan := trace.Start(ctx, "encode", trace.KindInternal)
 	buf := new(bytes.Buffer)
 	enc := json.NewEncoder(buf)
 	enc.SetIndent("", "  ")
-	if err := enc.Encode(vRecs); err != nil {
+	err = enc.Encode(vRecs)
+	encSpan.SetAttribute(trace.AttributeBytes, buf.Len())
+	encSpan.SetError(err)
+	encSpan.End()
+	if err != nil {
+		evt.Error, evt.ErrorClass = err, history.ErrorClassEncoding
 		return err
 	}
 	evt.JSON = buf.String()
+	evt.Bytes = buf.Len()
+	span.SetAttribute(trace.AttributeBytes, evt.Bytes)
 
 	// upload JSON to upload service
 	start = time.Now()
-	if err := u.UploadJSON(ctx, buf, path, false); err != nil {
-		evt.Error = err
+	res, err := u.UploadJSON(ctx, buf, path, false)
+	evt.Response = res
+	if err != nil {
+		evt.Error, evt.ErrorClass = err, history.ErrorClassConnection
+		if _, ok := err.(*ResponseError); ok {
+			evt.ErrorClass = history.ErrorClassResponse
+		}
 		return err
 	}
 	evt.UploadDuration = time.Since(start)
 	return nil
 }
 
-func (u *Uploader) ensureDB() error {
-	conn := u.Configuration.Connection()
+// ensureDB makes sure there is a working connection to the database. An existing connection is reused if the
+// configuration did not change and it still responds to a ping; otherwise a new connection is opened. Failures are
+// reported as a config.DatabaseInvalidError.
+func (u *Uploader) ensureDB(ctx context.Context) error {
+	// the password is resolved before comparing, so that a rotated secret results in a new connection
+	conn, err := u.Configuration.ResolvedConnection(ctx)
+	if err != nil {
+		return err
+	}
 	driver, dsn := conn.Driver, conn.DSN()
+	pool := u.Configuration.Pool()
+
 	if driver == u.lastDriver && dsn == u.lastDSN && u.db != nil {
-		return nil
-	}
+		if pool != u.lastPool {
+			pool.Apply(u.db)
+			u.lastPool = pool
+		}
 
-	if u.db != nil {
-		dlog.Close(u.db)
+		err := u.ping(ctx)
+		if err == nil {
+			return nil
+		}
+		dlog.Error("Database connection failed, reconnecting: %v", err)
 	}
 
-	var err error
-	u.db, err = sql.Open(driver, dsn)
+	u.closeDB()
+
+	newDB, err := db.Open(conn)
 	if err != nil {
-		return err
+		return &config.DatabaseInvalidError{Cause: err.Error()}
+	}
+	pool.Apply(newDB)
+
+	u.dbMu.Lock()
+	u.db = newDB
+	u.dbMu.Unlock()
+
+	if err := u.ping(ctx); err != nil {
+		u.closeDB()
+		return &config.DatabaseInvalidError{Cause: err.Error()}
 	}
+
 	u.lastDriver = driver
 	u.lastDSN = dsn
+	u.lastPool = pool
 	return nil
 }
 
+func (u *Uploader) ping(ctx context.Context) error {
+	pingCtx, cancel := context.WithTimeout(ctx, config.DBValidationTimeout)
+	defer cancel()
+	return u.db.PingContext(pingCtx)
+}
+
+func (u *Uploader) closeDB() {
+	u.dbMu.Lock()
+	defer u.dbMu.Unlock()
+
+	if u.db != nil {
+		dlog.Close(u.db)
+	}
+	u.db = nil
+	u.lastDriver = ""
+	u.lastDSN = ""
+}
+
+// DBStats returns the statistics of the database connection pool. It returns false if there is no open connection.
+func (u *Uploader) DBStats() (sql.DBStats, bool) {
+	u.dbMu.RLock()
+	defer u.dbMu.RUnlock()
+
+	if u.db == nil {
+		return sql.DBStats{}, false
+	}
+	return u.db.Stats(), true
+}
+
 func (u *Uploader) executeVisitorQuery(ctx context.Context) (interface{}, int, error) {
-	tx, err := u.db.BeginTx(ctx, nil)
+	opts := u.Configuration.QueryOptions(config.DatasetVisitor)
+	tx, err := u.db.BeginTx(ctx, opts.TxOptions())
 	if err != nil {
 		return nil, 0, err
 	}
@@ -127,16 +243,24 @@ func (u *Uploader) executeVisitorQuery(ctx context.Context) (interface{}, int, e
 		}
 	}()
 
-	records, err := db.ExecuteVisitorQuery(ctx, tx, u.Configuration.VisitorQuery(), u.Configuration.Timeout())
-	if err != nil {
-		return nil, 0, err
+	queryCtx, span := trace.Start(ctx, "db.query", trace.KindClient)
+	records, err := db.ExecuteVisitorQuery(queryCtx, tx, u.Configuration.VisitorQuery(), u.Configuration.QueryTimeout(config.DatasetVisitor))
+	if err == nil {
+		err = tx.Commit()
 	}
-	if err := tx.Commit(); err != nil {
+	span.SetAttribute(trace.AttributeRows, len(records))
+	span.SetError(err)
+	span.End()
+	if err != nil {
 		return nil, 0, err
 	}
 
 	// convert query to JSON
+	_, span = trace.Start(ctx, "map", trace.KindInternal)
 	vRecs, err := rest.VisitorRecordsFromDB(records, u.Location)
+	span.SetAttribute(trace.AttributeRows, len(vRecs))
+	span.SetError(err)
+	span.End()
 	if err != nil {
 		return nil, 0, err
 	}
@@ -144,7 +268,8 @@ func (u *Uploader) executeVisitorQuery(ctx context.Context) (interface{}, int, e
 }
 
 func (u *Uploader) executeRadiologieQuery(ctx context.Context) (interface{}, int, error) {
-	tx, err := u.db.BeginTx(ctx, nil)
+	opts := u.Configuration.QueryOptions(config.DatasetRadiologie)
+	tx, err := u.db.BeginTx(ctx, opts.TxOptions())
 	if err != nil {
 		return nil, 0, err
 	}
@@ -154,16 +279,24 @@ func (u *Uploader) executeRadiologieQuery(ctx context.Context) (interface{}, int
 		}
 	}()
 
-	records, err := db.ExecuteRadiologieQuery(ctx, tx, u.Configuration.RadiologieQuery(), u.Configuration.Timeout())
-	if err != nil {
-		return nil, 0, err
+	queryCtx, span := trace.Start(ctx, "db.query", trace.KindClient)
+	records, err := db.ExecuteRadiologieQuery(queryCtx, tx, u.Configuration.RadiologieQuery(), u.Configuration.QueryTimeout(config.DatasetRadiologie))
+	if err == nil {
+		err = tx.Commit()
 	}
-	if err := tx.Commit(); err != nil {
+	span.SetAttribute(trace.AttributeRows, len(records))
+	span.SetError(err)
+	span.End()
+	if err != nil {
 		return nil, 0, err
 	}
 
 	// convert query to JSON
+	_, span = trace.Start(ctx, "map", trace.KindInternal)
 	vRecs, err := rest.RadiologieRecordsFromDB(records, u.Location)
+	span.SetAttribute(trace.AttributeRows, len(vRecs))
+	span.SetError(err)
+	span.End()
 	if err != nil {
 		return nil, 0, err
 	}
@@ -171,7 +304,8 @@ func (u *Uploader) executeRadiologieQuery(ctx context.Context) (interface{}, int
 }
 
 func (u *Uploader) executeLabQuery(ctx context.Context) (interface{}, int, error) {
-	tx, err := u.db.BeginTx(ctx, nil)
+	opts := u.Configuration.QueryOptions(config.DatasetLab)
+	tx, err := u.db.BeginTx(ctx, opts.TxOptions())
 	if err != nil {
 		return nil, 0, err
 	}
@@ -181,16 +315,24 @@ func (u *Uploader) executeLabQuery(ctx context.Context) (interface{}, int, error
 		}
 	}()
 
-	records, err := db.ExecuteLabQuery(ctx, tx, u.Configuration.LabQuery(), u.Configuration.Timeout())
-	if err != nil {
-		return nil, 0, err
+	queryCtx, span := trace.Start(ctx, "db.query", trace.KindClient)
+	records, err := db.ExecuteLabQuery(queryCtx, tx, u.Configuration.LabQuery(), u.Configuration.QueryTimeout(config.DatasetLab))
+	if err == nil {
+		err = tx.Commit()
 	}
-	if err := tx.Commit(); err != nil {
+	span.SetAttribute(trace.AttributeRows, len(records))
+	span.SetError(err)
+	span.End()
+	if err != nil {
 		return nil, 0, err
 	}
 
 	// convert query to JSON
+	_, span = trace.Start(ctx, "map", trace.KindInternal)
 	vRecs, err := rest.LabRecordsFromDB(records, u.Location)
+	span.SetAttribute(trace.AttributeRows, len(vRecs))
+	span.SetError(err)
+	span.End()
 	if err != nil {
 		return nil, 0, err
 	}
@@ -198,7 +340,8 @@ func (u *Uploader) executeLabQuery(ctx context.Context) (interface{}, int, error
 }
 
 func (u *Uploader) executeConsultQuery(ctx context.Context) (interface{}, int, error) {
-	tx, err := u.db.BeginTx(ctx, nil)
+	opts := u.Configuration.QueryOptions(config.DatasetConsult)
+	tx, err := u.db.BeginTx(ctx, opts.TxOptions())
 	if err != nil {
 		return nil, 0, err
 	}
@@ -208,31 +351,49 @@ func (u *Uploader) executeConsultQuery(ctx context.Context) (interface{}, int, e
 		}
 	}()
 
-	records, err := db.ExecuteConsultQuery(ctx, tx, u.Configuration.ConsultQuery(), u.Configuration.Timeout())
-	if err != nil {
-		return nil, 0, err
+	queryCtx, span := trace.Start(ctx, "db.query", trace.KindClient)
+	records, err := db.ExecuteConsultQuery(queryCtx, tx, u.Configuration.ConsultQuery(), u.Configuration.QueryTimeout(config.DatasetConsult))
+	if err == nil {
+		err = tx.Commit()
 	}
-	if err := tx.Commit(); err != nil {
+	span.SetAttribute(trace.AttributeRows, len(records))
+	span.SetError(err)
+	span.End()
+	if err != nil {
 		return nil, 0, err
 	}
 
 	// convert query to JSON
+	_, span = trace.Start(ctx, "map", trace.KindInternal)
 	vRecs, err := rest.ConsultRecordsFromDB(records, u.Location)
+	span.SetAttribute(trace.AttributeRows, len(vRecs))
+	span.SetError(err)
+	span.End()
 	if err != nil {
 		return nil, 0, err
 	}
 	return vRecs, len(vRecs), nil
 }
 
-func (u *Uploader) UploadJSON(ctx context.Context, json *bytes.Buffer, path string, importMode bool) error {
-	req, err := http.NewRequest(http.MethodPost, config.Server, json)
+// UploadJSON posts the JSON to the upload service at path, and returns its response. A response with another status
+// than 200 OK is returned together with a ResponseError.
+func (u *Uploader) UploadJSON(ctx context.Context, json *bytes.Buffer, path string, importMode bool) (_ *history.Response, err error) {
+	ctx, span := trace.Start(ctx, "http.upload", trace.KindClient)
+	span.SetAttribute("http.method", http.MethodPost)
+	span.SetAttribute("http.target", path)
+	span.SetAttribute(trace.AttributeBytes, json.Len())
+	defer func() {
+		span.SetError(err)
+		span.End()
+	}()
+
+	req, err := http.NewRequest(http.MethodPost, u.Configuration.Server(), json)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	req.URL.Path = path
 
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Connection", "close")
 
 	if importMode {
 		req.URL.RawQuery = "import=true"
@@ -240,19 +401,32 @@ func (u *Uploader) UploadJSON(ctx context.Context, json *bytes.Buffer, path stri
 
 	res, err := u.Configuration.Do(ctx, req)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	span.SetAttribute("http.status_code", res.StatusCode)
 
-	var resBuf bytes.Buffer
-	_ = res.Header.Write(&resBuf)
-	_, _ = resBuf.WriteRune('\n')
-
-	_, _ = io.Copy(&resBuf, res.Body)
+	body, _ := ioutil.ReadAll(res.Body)
 	_ = res.Body.Close()
+	response := &history.Response{Status: res.Status, Header: res.Header, Body: string(body)}
 
 	if res.StatusCode != http.StatusOK {
-		return errors.Errorf("Unexpected response: %s\n%s", res.Status, resBuf.String())
+		var resBuf bytes.Buffer
+		_ = res.Header.Write(&resBuf)
+		_, _ = resBuf.WriteRune('\n')
+		_, _ = resBuf.Write(body)
+		return response, &ResponseError{Status: res.Status, Response: resBuf.String()}
 	}
 
-	return nil
+	return response, nil
+}
+
+// ResponseError is returned when door2doc responds to an upload with an unexpected status.
+type ResponseError struct {
+	Status string
+	// Response contains the headers and body of the response.
+	Response string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("Unexpected response: %s\n%s", e.Status, e.Response)
 }
@@ -0,0 +1,642 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/secret"
+)
+
+const (
+	pathAPI              = "/api/v1"
+	pathAPIVersion       = pathAPI + "/version"
+	pathAPIConfiguration = pathAPI + "/configuration"
+	pathAPIQueries       = pathAPI + "/queries"
+	pathAPIValidation    = pathAPI + "/validation"
+	pathAPIHistory       = pathAPI + "/history"
+	pathAPITrigger       = pathAPI + "/trigger"
+	pathAPIPause         = pathAPI + "/pause"
+	pathAPIOpenAPI       = pathAPI + "/openapi.json"
+
+	// maxAPIBody is the maximum size of the body of a request to the JSON API.
+	maxAPIBody = 1 << 20
+)
+
+// apiError is the body of all error responses of the JSON API.
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// writeJSON writes v as the body of a JSON response.
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	bs, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		dlog.Error("Error while encoding response: %v", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if _, err := w.Write(append(bs, '\n')); err != nil {
+		dlog.Error("Error while writing response: %v", err)
+	}
+}
+
+func writeAPIError(w http.ResponseWriter, status int, format string, args ...interface{}) {
+	writeJSON(w, status, apiError{Error: fmt.Sprintf(format, args...)})
+}
+
+// allowMethods responds with 405 Method Not Allowed unless r uses one of methods, and returns whether it does.
+func allowMethods(w http.ResponseWriter, r *http.Request, methods ...string) bool {
+	for _, method := range methods {
+		if r.Method == method {
+			return true
+		}
+	}
+	w.Header().Set("Allow", strings.Join(methods, ", "))
+	writeAPIError(w, http.StatusMethodNotAllowed, "method %s is not allowed", r.Method)
+	return false
+}
+
+// readJSON decodes the body of r into v, and responds with 400 Bad Request if that fails. Unknown fields are
+// rejected, so that typing errors in scripts do not go unnoticed.
+func readJSON(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxAPIBody))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		writeAPIError(w, http.StatusBadRequest, "invalid request body: %v", err)
+		return false
+	}
+	return true
+}
+
+// bearerToken returns the token in the Authorization header of r, if any.
+func bearerToken(r *http.Request) string {
+	const prefix = "bearer "
+	auth := r.Header.Get("Authorization")
+	if len(auth) < len(prefix) || !strings.EqualFold(auth[:len(prefix)], prefix) {
+		return ""
+	}
+	return strings.TrimSpace(auth[len(prefix):])
+}
+
+// APISecured requires an API token in the Authorization header. Reading requires role view; requests that may change
+// something require role change. Tokens are not sent by browsers on their own, so no CSRF token is needed. Remote
+// addresses are locked out after repeated invalid tokens, like after failed logins.
+func (m *ServeMux) APISecured(view, change config.Role, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		addr := remoteAddr(r)
+		if locked, remaining := m.lockout.Locked(addr); locked {
+			w.Header().Set("Retry-After", fmt.Sprintf("%d", int(remaining.Seconds())+1))
+			writeAPIError(w, http.StatusTooManyRequests, "too many invalid tokens, try again later")
+			return
+		}
+
+		token := bearerToken(r)
+		if token == "" {
+			w.Header().Set("WWW-Authenticate", `Bearer realm="door2doc"`)
+			writeAPIError(w, http.StatusUnauthorized, "API token required")
+			return
+		}
+		t, ok := m.cfg.AuthenticateAPIToken(token)
+		if !ok {
+			failures := m.lockout.Fail(addr)
+			dlog.Warning("Invalid API token from %s (%d consecutive failures)", addr, failures)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="door2doc", error="invalid_token"`)
+			writeAPIError(w, http.StatusUnauthorized, "invalid API token")
+			return
+		}
+		m.lockout.Succeed(addr)
+
+		required := view
+		if !isSafeMethod(r) {
+			required = change
+		}
+		if !t.Role.Allows(required) {
+			writeAPIError(w, http.StatusForbidden, "the token %q has role %s, which is not allowed to do this", t.Name, t.Role)
+			return
+		}
+		sess := &session{Username: "token:" + t.Name, Role: t.Role}
+		handler.ServeHTTP(w, r.WithContext(withSession(r.Context(), sess)))
+	})
+}
+
+// APINotFoundHandler responds to unknown paths of the JSON API.
+func (m *ServeMux) APINotFoundHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeAPIError(w, http.StatusNotFound, "unknown path %s", r.URL.Path)
+	})
+}
+
+// OpenAPIHandler serves the OpenAPI document that describes the JSON API. It contains nothing secret, so it is
+// available without token.
+func (m *ServeMux) OpenAPIHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+			return
+		}
+		f, err := m.fs.Open("/openapi.json")
+		if err != nil {
+			dlog.Error("Failed to open OpenAPI document: %v", err)
+			writeAPIError(w, http.StatusInternalServerError, "OpenAPI document not available")
+			return
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				dlog.Error("Failed to close OpenAPI document: %v", err)
+			}
+		}()
+		bs, err := ioutil.ReadAll(f)
+		if err != nil {
+			dlog.Error("Failed to read OpenAPI document: %v", err)
+			writeAPIError(w, http.StatusInternalServerError, "OpenAPI document not available")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(bs); err != nil {
+			dlog.Error("Error while writing response: %v", err)
+		}
+	})
+}
+
+type apiVersion struct {
+	Version string `json:"version"`
+	Schema  int    `json:"schema"`
+}
+
+func (m *ServeMux) APIVersionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+			return
+		}
+		writeJSON(w, http.StatusOK, apiVersion{Version: m.version, Schema: config.SchemaVersion})
+	})
+}
+
+// apiConfiguration is the configuration as exchanged with the JSON API. Passwords are never returned, except for secret
+// references; an empty password keeps the stored password, like in the web interface.
+type apiConfiguration struct {
+	Username        string               `json:"username"`
+	Password        string               `json:"password,omitempty"`
+	Environment     string               `json:"environment"`
+	Environments    []config.Environment `json:"environments"`
+	Proxy           apiProxy             `json:"proxy"`
+	Database        apiDatabase          `json:"database"`
+	Interval        int                  `json:"interval"`
+	Active          bool                 `json:"active"`
+	AccessBasicAuth bool                 `json:"accessBasicAuth"`
+	// Locked contains the environment variables that override settings, by name of the setting. It is ignored in
+	// requests.
+	Locked map[string]string `json:"locked,omitempty"`
+}
+
+type apiProxy struct {
+	Mode     string `json:"mode"`
+	URL      string `json:"url"`
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+	NoProxy  string `json:"noProxy"`
+}
+
+type apiDatabase struct {
+	// ConnectionString is the connection string without password.
+	ConnectionString   string `json:"connectionString"`
+	Password           string `json:"password,omitempty"`
+	Timeout            int    `json:"timeout"`
+	MaxOpenConnections int    `json:"maxOpenConnections"`
+	MaxIdleConnections int    `json:"maxIdleConnections"`
+	ConnectionLifetime int    `json:"connectionLifetime"`
+}
+
+// apiPassword returns a stored password as returned by the JSON API.
+func apiPassword(stored string) string {
+	if secret.IsReference(stored) {
+		return stored
+	}
+	return ""
+}
+
+// apiChangeResult is the response to a change of the configuration. Changes are only saved if the resulting
+// configuration is valid; they are applied nonetheless, like in the web interface.
+type apiChangeResult struct {
+	Saved      bool          `json:"saved"`
+	Validation apiValidation `json:"validation"`
+}
+
+// saveIfValid saves the configuration if it is valid, and responds with the result.
+func (m *ServeMux) saveIfValid(w http.ResponseWriter, r *http.Request) {
+	res := apiChangeResult{Validation: newAPIValidation(m.cfg.Validate())}
+	if res.Validation.Valid {
+		if err := m.save(r); err != nil {
+			dlog.Error("While saving configuration: %v", err)
+			writeAPIError(w, http.StatusInternalServerError, "failed to save the configuration")
+			return
+		}
+		res.Saved = true
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func (m *ServeMux) apiConfiguration() apiConfiguration {
+	username, password := m.cfg.Credentials()
+	proxy := m.cfg.Proxy()
+	conn := m.cfg.Connection()
+	dbPassword := conn.Password
+	conn.Password = ""
+	cs, err := conn.MarshalText()
+	if err != nil {
+		dlog.Error("Failed to format connection string: %v", err)
+	}
+	pool := m.cfg.Pool()
+
+	return apiConfiguration{
+		Username:     username,
+		Password:     apiPassword(password),
+		Environment:  m.cfg.Environment().Name,
+		Environments: m.cfg.Environments(),
+		Proxy: apiProxy{
+			Mode:     proxy.Mode,
+			URL:      proxy.URL,
+			Username: proxy.Username,
+			Password: apiPassword(proxy.Password),
+			NoProxy:  proxy.NoProxy,
+		},
+		Database: apiDatabase{
+			ConnectionString:   string(cs),
+			Password:           apiPassword(dbPassword),
+			Timeout:            int(m.cfg.Timeout() / time.Second),
+			MaxOpenConnections: pool.MaxOpen,
+			MaxIdleConnections: pool.MaxIdle,
+			ConnectionLifetime: int(pool.MaxLifetime / time.Second),
+		},
+		Interval:        int(m.cfg.Interval() / time.Second),
+		Active:          m.cfg.Enabled(),
+		AccessBasicAuth: m.cfg.AccessBasicAuth(),
+		Locked:          m.cfg.Locked(),
+	}
+}
+
+// APIConfigurationHandler returns the configuration, and changes it with PATCH. Settings that are missing from the
+// request are kept.
+func (m *ServeMux) APIConfigurationHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead, http.MethodPatch) {
+			return
+		}
+		before := m.apiConfiguration()
+		if r.Method != http.MethodPatch {
+			writeJSON(w, http.StatusOK, before)
+			return
+		}
+
+		// the request is decoded over the current configuration, so that missing settings keep their values
+		after := before
+		after.Environments = append([]config.Environment(nil), before.Environments...)
+		if !readJSON(w, r, &after) {
+			return
+		}
+
+		d := after.Database
+		if d.Timeout <= 0 || d.MaxOpenConnections <= 0 || d.MaxIdleConnections < 0 || d.ConnectionLifetime <= 0 {
+			writeAPIError(w, http.StatusBadRequest, "the database timeout, the maximum number of open connections and the connection lifetime must be positive")
+			return
+		}
+		if after.Interval <= 0 {
+			writeAPIError(w, http.StatusBadRequest, "the interval must be positive")
+			return
+		}
+		var conn db.ConnectionData
+		if d.ConnectionString != before.Database.ConnectionString || d.Password != before.Database.Password {
+			conn = m.cfg.Connection()
+			if d.ConnectionString != before.Database.ConnectionString {
+				password := conn.Password
+				conn = db.ConnectionData{}
+				if err := conn.UnmarshalText([]byte(d.ConnectionString)); err != nil {
+					writeAPIError(w, http.StatusBadRequest, "invalid connection string: %v", err)
+					return
+				}
+				if conn.Password == "" {
+					conn.Password = password
+				}
+			}
+			if d.Password != "" {
+				conn.Password = d.Password
+			}
+		}
+
+		// only changed settings are set, so that normalizing the others does not show up as a change
+		change := func(cfg *config.Configuration) {
+			if after.Username != before.Username || after.Password != before.Password {
+				_, password := cfg.Credentials()
+				if after.Password != "" {
+					password = after.Password
+				}
+				cfg.SetCredentials(after.Username, password)
+			}
+			if after.Environment != before.Environment || !equalEnvironments(after.Environments, before.Environments) {
+				cfg.SetEnvironments(after.Environments, after.Environment)
+			}
+			if after.Proxy != before.Proxy {
+				proxy := rest.Proxy{
+					Mode:     after.Proxy.Mode,
+					URL:      after.Proxy.URL,
+					Username: after.Proxy.Username,
+					Password: cfg.Proxy().Password,
+					NoProxy:  after.Proxy.NoProxy,
+				}
+				if after.Proxy.Password != "" {
+					proxy.Password = after.Proxy.Password
+				}
+				cfg.SetProxy(proxy)
+			}
+			if conn != (db.ConnectionData{}) {
+				cfg.SetConnection(conn)
+			}
+			if d.Timeout != before.Database.Timeout {
+				cfg.SetTimeout(time.Duration(d.Timeout) * time.Second)
+			}
+			if d.MaxOpenConnections != before.Database.MaxOpenConnections || d.MaxIdleConnections != before.Database.MaxIdleConnections || d.ConnectionLifetime != before.Database.ConnectionLifetime {
+				cfg.SetPool(db.PoolSettings{
+					MaxOpen:     d.MaxOpenConnections,
+					MaxIdle:     d.MaxIdleConnections,
+					MaxLifetime: time.Duration(d.ConnectionLifetime) * time.Second,
+				})
+			}
+			if after.Interval != before.Interval {
+				cfg.SetInterval(time.Duration(after.Interval) * time.Second)
+			}
+			if after.Active != before.Active {
+				cfg.SetEnabled(after.Active)
+			}
+			if after.AccessBasicAuth != before.AccessBasicAuth {
+				cfg.SetAccessBasicAuth(after.AccessBasicAuth)
+			}
+		}
+		// settings that are overridden by environment variables would be reverted silently, and reported as saved
+		if field, env := m.cfg.LockedChange(change); env != "" {
+			writeAPIError(w, http.StatusConflict, "%s is set by the environment variable %s", field, env)
+			return
+		}
+		change(m.cfg)
+
+		m.cfg.UpdateBaseValidation(r.Context())
+		m.saveIfValid(w, r)
+	})
+}
+
+func equalEnvironments(a, b []config.Environment) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// apiQuery is the query of a dataset as exchanged with the JSON API.
+type apiQuery struct {
+	Dataset config.Dataset `json:"dataset"`
+	Query   string         `json:"query"`
+	// Timeout is the timeout of the query in seconds, or zero to use the database timeout.
+	Timeout   int    `json:"timeout"`
+	Isolation string `json:"isolation"`
+	ReadOnly  bool   `json:"readOnly"`
+}
+
+func (m *ServeMux) apiQuery(ds config.Dataset) apiQuery {
+	opts := m.cfg.QueryOptions(ds)
+	return apiQuery{
+		Dataset:   ds,
+		Query:     m.cfg.Query(ds),
+		Timeout:   int(opts.Timeout / time.Second),
+		Isolation: opts.Isolation.String(),
+		ReadOnly:  opts.ReadOnly,
+	}
+}
+
+// APIQueriesHandler returns the queries of all datasets, or the query of the dataset in the path. The query of a
+// dataset is replaced with PUT.
+func (m *ServeMux) APIQueriesHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		name := strings.Trim(strings.TrimPrefix(r.URL.Path, pathAPIQueries), "/")
+		if name == "" {
+			if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+				return
+			}
+			res := []apiQuery{}
+			for _, ds := range config.Datasets {
+				res = append(res, m.apiQuery(ds))
+			}
+			writeJSON(w, http.StatusOK, res)
+			return
+		}
+
+		ds := config.Dataset(name)
+		if !ds.IsValid() {
+			writeAPIError(w, http.StatusNotFound, "unknown dataset %q", name)
+			return
+		}
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead, http.MethodPut) {
+			return
+		}
+		if r.Method != http.MethodPut {
+			writeJSON(w, http.StatusOK, m.apiQuery(ds))
+			return
+		}
+
+		q := apiQuery{Dataset: ds}
+		if !readJSON(w, r, &q) {
+			return
+		}
+		if q.Dataset != ds {
+			writeAPIError(w, http.StatusBadRequest, "the dataset in the body does not match the path")
+			return
+		}
+		if q.Timeout < 0 {
+			writeAPIError(w, http.StatusBadRequest, "the timeout must not be negative")
+			return
+		}
+		isolation, err := db.ParseIsolationLevel(q.Isolation)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, "%v", err)
+			return
+		}
+
+		change := func(cfg *config.Configuration) {
+			cfg.SetQuery(ds, q.Query)
+			cfg.SetQueryOptions(ds, db.QueryOptions{
+				Timeout:   time.Duration(q.Timeout) * time.Second,
+				Isolation: isolation,
+				ReadOnly:  q.ReadOnly,
+			})
+		}
+		if field, env := m.cfg.LockedChange(change); env != "" {
+			writeAPIError(w, http.StatusConflict, "%s is set by the environment variable %s", field, env)
+			return
+		}
+		change(m.cfg)
+		m.cfg.UpdateQueryValidation(r.Context(), ds)
+		m.saveIfValid(w, r)
+	})
+}
+
+// apiValidation is the result of validating the configuration. Only the errors in Errors and of the visitor query
+// make the configuration invalid; the other queries are optional.
+type apiValidation struct {
+	Valid   bool                                  `json:"valid"`
+	Errors  map[string]string                     `json:"errors"`
+	Queries map[config.Dataset]apiQueryValidation `json:"queries"`
+}
+
+type apiQueryValidation struct {
+	Error string `json:"error,omitempty"`
+	// Duration is how long the query took, in seconds.
+	Duration float64 `json:"duration"`
+}
+
+func newAPIValidation(v *config.ValidationResult) apiValidation {
+	res := apiValidation{
+		Errors:  make(map[string]string),
+		Queries: make(map[config.Dataset]apiQueryValidation),
+	}
+	if v == nil {
+		res.Errors["validation"] = "the configuration has not been validated yet"
+		return res
+	}
+
+	res.Valid = v.IsValid()
+	for name, err := range map[string]error{
+		"databaseConnection": v.DatabaseConnection,
+		"queryTimeout":       v.QueryTimeout,
+		"d2dConnection":      v.D2DConnection,
+		"d2dCredentials":     v.D2DCredentials,
+		"access":             v.Access,
+	} {
+		if err != nil {
+			res.Errors[name] = err.Error()
+		}
+	}
+	for _, ds := range config.Datasets {
+		duration, _, err := v.Query(ds)
+		q := apiQueryValidation{Duration: duration.Seconds()}
+		if err != nil {
+			q.Error = err.Error()
+		}
+		res.Queries[ds] = q
+	}
+	return res
+}
+
+func (m *ServeMux) APIValidationHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+			return
+		}
+		writeJSON(w, http.StatusOK, newAPIValidation(m.cfg.Validate()))
+	})
+}
+
+// apiEvent is an upload in the history, as returned by the JSON API.
+type apiEvent struct {
+	Type        string    `json:"type"`
+	Environment string    `json:"environment"`
+	Time        time.Time `json:"time"`
+	// QueryDuration and UploadDuration are in seconds.
+	QueryDuration  float64 `json:"queryDuration"`
+	UploadDuration float64 `json:"uploadDuration"`
+	Size           int     `json:"size"`
+	Error          string  `json:"error,omitempty"`
+}
+
+type apiHistory struct {
+	Events []apiEvent `json:"events"`
+}
+
+func (m *ServeMux) APIHistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+			return
+		}
+		res := apiHistory{Events: []apiEvent{}}
+		if m.history != nil {
+			for _, e := range m.history.Events() {
+				event := apiEvent{
+					Type:           e.Type,
+					Environment:    e.Environment,
+					Time:           e.Time,
+					QueryDuration:  e.QueryDuration.Seconds(),
+					UploadDuration: e.UploadDuration.Seconds(),
+					Size:           e.Size,
+				}
+				if e.Error != nil {
+					event.Error = e.Error.Error()
+				}
+				res.Events = append(res.Events, event)
+			}
+		}
+		writeJSON(w, http.StatusOK, res)
+	})
+}
+
+type apiTrigger struct {
+	Triggered bool `json:"triggered"`
+}
+
+func (m *ServeMux) APITriggerHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodPost) {
+			return
+		}
+		if m.uploader != nil {
+			m.uploader.Trigger()
+		}
+		writeJSON(w, http.StatusAccepted, apiTrigger{Triggered: m.uploader != nil})
+	})
+}
+
+type apiPause struct {
+	Paused bool `json:"paused"`
+	// Active is whether uploads run, which also requires a valid configuration. It is ignored in requests.
+	Active bool `json:"active"`
+}
+
+// APIPauseHandler returns whether uploads are paused, and pauses or resumes them with PUT.
+func (m *ServeMux) APIPauseHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead, http.MethodPut) {
+			return
+		}
+		if r.Method == http.MethodPut {
+			req := apiPause{Paused: m.cfg.Paused()}
+			if !readJSON(w, r, &req) {
+				return
+			}
+			m.cfg.SetPaused(req.Paused)
+			if sess := sessionFromContext(r.Context()); sess != nil {
+				dlog.Info("Uploads paused: %t, by %q", req.Paused, sess.Username)
+			}
+			// uploads remain paused after the service restarts
+			if err := m.save(r); err != nil {
+				dlog.Error("While saving pause: %v", err)
+			}
+		}
+		writeJSON(w, http.StatusOK, apiPause{Paused: m.cfg.Paused(), Active: m.cfg.Active()})
+	})
+}
@@ -0,0 +1,247 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+func TestAPI(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	// changes through the API save the configuration
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	tokens := make(map[config.Role]string)
+	for _, role := range []config.Role{config.RoleViewer, config.RoleOperator, config.RoleAdmin} {
+		if tokens[role], err = cfg.CreateAPIToken(string(role), role); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg.UpdateBaseValidation(context.Background())
+	uploader := &testUploader{}
+	m, err := NewServeMux(false, "testing", cfg, history.New(), uploader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, test := range map[string]struct {
+		Token  string
+		Method string
+		Path   string
+		Body   string
+		Want   int
+	}{
+		"no token":                 {Method: http.MethodGet, Path: pathAPIVersion, Want: http.StatusUnauthorized},
+		"invalid token":            {Token: "d2d_invalid", Method: http.MethodGet, Path: pathAPIVersion, Want: http.StatusUnauthorized},
+		"openapi without token":    {Method: http.MethodGet, Path: pathAPIOpenAPI, Want: http.StatusOK},
+		"viewer gets version":      {Token: tokens[config.RoleViewer], Method: http.MethodGet, Path: pathAPIVersion, Want: http.StatusOK},
+		"viewer gets config":       {Token: tokens[config.RoleViewer], Method: http.MethodGet, Path: pathAPIConfiguration, Want: http.StatusOK},
+		"viewer changes config":    {Token: tokens[config.RoleViewer], Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{}`, Want: http.StatusForbidden},
+		"viewer triggers":          {Token: tokens[config.RoleViewer], Method: http.MethodPost, Path: pathAPITrigger, Want: http.StatusForbidden},
+		"operator triggers":        {Token: tokens[config.RoleOperator], Method: http.MethodPost, Path: pathAPITrigger, Want: http.StatusAccepted},
+		"operator pauses":          {Token: tokens[config.RoleOperator], Method: http.MethodPut, Path: pathAPIPause, Body: `{"paused": false}`, Want: http.StatusOK},
+		"operator changes query":   {Token: tokens[config.RoleOperator], Method: http.MethodPut, Path: pathAPIQueries + "/lab", Body: `{}`, Want: http.StatusForbidden},
+		"admin changes config":     {Token: tokens[config.RoleAdmin], Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{"username": "api"}`, Want: http.StatusOK},
+		"admin misspells setting":  {Token: tokens[config.RoleAdmin], Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{"usernme": "api"}`, Want: http.StatusBadRequest},
+		"admin changes query":      {Token: tokens[config.RoleAdmin], Method: http.MethodPut, Path: pathAPIQueries + "/lab", Body: `{"query": "select 1", "isolation": "Snapshot"}`, Want: http.StatusOK},
+		"admin uses wrong method":  {Token: tokens[config.RoleAdmin], Method: http.MethodPost, Path: pathAPIConfiguration, Body: `{}`, Want: http.StatusMethodNotAllowed},
+		"unknown dataset":          {Token: tokens[config.RoleViewer], Method: http.MethodGet, Path: pathAPIQueries + "/xray", Want: http.StatusNotFound},
+		"unknown path":             {Token: tokens[config.RoleViewer], Method: http.MethodGet, Path: pathAPI + "/users", Want: http.StatusNotFound},
+		"viewer gets history":      {Token: tokens[config.RoleViewer], Method: http.MethodGet, Path: pathAPIHistory, Want: http.StatusOK},
+		"viewer gets validation":   {Token: tokens[config.RoleViewer], Method: http.MethodGet, Path: pathAPIValidation, Want: http.StatusOK},
+		"viewer gets pause status": {Token: tokens[config.RoleViewer], Method: http.MethodGet, Path: pathAPIPause, Want: http.StatusOK},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(test.Method, test.Path, strings.NewReader(test.Body))
+			if test.Token != "" {
+				r.Header.Set("Authorization", "Bearer "+test.Token)
+			}
+			w := httptest.NewRecorder()
+			m.ServeHTTP(w, r)
+			if w.Code != test.Want {
+				t.Errorf("%s %s == %d, got %d: %s", test.Method, test.Path, test.Want, w.Code, w.Body)
+			}
+			if ct := w.Header().Get("Content-Type"); !strings.HasPrefix(ct, "application/json") {
+				t.Errorf("Content-Type == application/json, got %q", ct)
+			}
+		})
+	}
+
+	if username, _ := cfg.Credentials(); username != "api" {
+		t.Errorf("Credentials() == api, got %q", username)
+	}
+	if got := cfg.Query(config.DatasetLab); got != "select 1" {
+		t.Errorf("Query(lab) == %q, got %q", "select 1", got)
+	}
+	if uploader.triggered != 1 {
+		t.Errorf("Trigger() called 1 time, got %d", uploader.triggered)
+	}
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	m, err := NewServeMux(false, "testing", config.NewConfiguration(), history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, pathAPIOpenAPI, nil))
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET %s == %d, got %d", pathAPIOpenAPI, http.StatusOK, w.Code)
+	}
+
+	var doc struct {
+		Paths map[string]interface{} `json:"paths"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &doc); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range []string{
+		pathAPIVersion,
+		pathAPIConfiguration,
+		pathAPIQueries,
+		pathAPIQueries + "/{dataset}",
+		pathAPIValidation,
+		pathAPIHistory,
+		pathAPITrigger,
+		pathAPIPause,
+		pathAPIOpenAPI,
+	} {
+		if _, ok := doc.Paths[strings.TrimPrefix(path, pathAPI)]; !ok {
+			t.Errorf("paths contains %s, got none", path)
+		}
+	}
+}
+
+func TestAPILockedSettings(t *testing.T) {
+	for env, value := range map[string]string{
+		"D2D_CONNECTION_LIFETIME": "600",
+		"D2D_PASSWORD":            "env-secret",
+		"D2D_PROXY":               "http://proxy:8080",
+		"D2D_DB_PASSWORD":         "env-db-secret",
+		"D2D_LAB_QUERY":           "select 1",
+	} {
+		if err := os.Setenv(env, value); err != nil {
+			t.Fatal(err)
+		}
+		defer os.Unsetenv(env)
+	}
+
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	if err := json.Unmarshal([]byte(`{}`), cfg); err != nil {
+		t.Fatal(err)
+	}
+	token, err := cfg.CreateAPIToken("admin", config.RoleAdmin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.UpdateBaseValidation(context.Background())
+	log := audit.New(filepath.Join(dir, audit.FileName))
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, test := range map[string]struct {
+		Method string
+		Path   string
+		Body   string
+		Want   int
+	}{
+		"zero idle connections": {Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{"database": {"maxIdleConnections": 0}}`, Want: http.StatusOK},
+		"locked lifetime":       {Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{"database": {"connectionLifetime": 60}}`, Want: http.StatusConflict},
+		"locked password":       {Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{"password": "new-secret"}`, Want: http.StatusConflict},
+		"locked proxy":          {Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{"proxy": {"mode": "manual", "url": "http://other:8080"}}`, Want: http.StatusConflict},
+		"locked db password":    {Method: http.MethodPatch, Path: pathAPIConfiguration, Body: `{"database": {"password": "new-db-secret"}}`, Want: http.StatusConflict},
+		"locked query":          {Method: http.MethodPut, Path: pathAPIQueries + "/lab", Body: `{"query": "select 2"}`, Want: http.StatusConflict},
+		"unlocked query":        {Method: http.MethodPut, Path: pathAPIQueries + "/consult", Body: `{"query": "select 2"}`, Want: http.StatusOK},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(test.Method, test.Path, strings.NewReader(test.Body))
+			r.Header.Set("Authorization", "Bearer "+token)
+			w := httptest.NewRecorder()
+			m.ServeHTTP(w, r)
+			if w.Code != test.Want {
+				t.Errorf("%s %s == %d, got %d: %s", test.Method, test.Path, test.Want, w.Code, w.Body)
+			}
+		})
+	}
+
+	if got := cfg.Query(config.DatasetLab); got != "select 1" {
+		t.Errorf("Query(lab) == %q, got %q", "select 1", got)
+	}
+	if got := cfg.Query(config.DatasetConsult); got != "select 2" {
+		t.Errorf("Query(consult) == %q, got %q", "select 2", got)
+	}
+
+	// the stored configuration keeps zero idle connections
+	bs, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored := config.NewConfiguration()
+	if err := json.Unmarshal(bs, stored); err != nil {
+		t.Fatal(err)
+	}
+	if got := stored.Pool().MaxIdle; got != 0 {
+		t.Errorf("Pool().MaxIdle == 0, got %d", got)
+	}
+}
+
+func TestAPIPauseAudited(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	// pausing saves the configuration
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	token, err := cfg.CreateAPIToken("operator", config.RoleOperator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := audit.New(filepath.Join(dir, audit.FileName))
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := httptest.NewRequest(http.MethodPut, pathAPIPause, strings.NewReader(`{"paused": true}`))
+	r.Header.Set("Authorization", "Bearer "+token)
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("PUT %s == %d, got %d: %s", pathAPIPause, http.StatusOK, w.Code, w.Body)
+	}
+
+	entries, err := log.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Field != "paused" || entries[0].After != "true" {
+		t.Errorf("Entries() == [paused: true], got %v", entries)
+	}
+}
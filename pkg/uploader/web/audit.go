@@ -0,0 +1,119 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+)
+
+// maxAuditEntries is the number of most recent audit entries shown on the audit page. All entries can be exported.
+const maxAuditEntries = 200
+
+// savedKey is the key of the marker in the request context that handlers set once they saved the configuration.
+type savedKey struct{}
+
+// markSaved records that the configuration was saved while handling r, so that the changes are audited.
+func markSaved(r *http.Request) {
+	if saved, ok := r.Context().Value(savedKey{}).(*bool); ok {
+		*saved = true
+	}
+}
+
+// Audited records the changes to the configuration made by a request to handler in the audit log. Changes are only
+// recorded if the handler saved the configuration, which it marks with markSaved; changes that were rejected, for
+// example because the configuration became invalid, never took effect.
+func (m *ServeMux) Audited(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if m.audit == nil || isSafeMethod(r) {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		// posts are handled one at a time, so that changes are attributed to the right user
+		m.auditMu.Lock()
+		defer m.auditMu.Unlock()
+
+		before := m.cfg.Fields()
+		saved := new(bool)
+		handler.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), savedKey{}, saved)))
+		if !*saved {
+			return
+		}
+
+		user := "anonymous"
+		if sess := sessionFromContext(r.Context()); sess != nil && sess.Username != "" {
+			user = sess.Username
+		}
+		now := time.Now()
+		entries := audit.Changes(before, m.cfg.Fields(), config.IsSecretField)
+		for i := range entries {
+			entries[i].Time = now
+			entries[i].User = user
+			entries[i].RemoteAddr = remoteAddr(r)
+		}
+		if err := m.audit.Append(entries...); err != nil {
+			dlog.Error("Failed to write audit log: %v", err)
+		}
+	})
+}
+
+type AuditPage struct {
+	*Page
+	Entries []audit.Entry
+	// Omitted is the number of older entries that are not shown.
+	Omitted int
+	Error   error
+}
+
+func (m *ServeMux) AuditHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		page := AuditPage{
+			Page: m.page(r.Context(), r.URL.Path),
+		}
+		var entries []audit.Entry
+		if m.audit != nil {
+			entries, page.Error = m.audit.Entries()
+		}
+		if len(entries) > maxAuditEntries {
+			page.Omitted = len(entries) - maxAuditEntries
+			entries = entries[page.Omitted:]
+		}
+		// newest first
+		for i := len(entries) - 1; i >= 0; i-- {
+			page.Entries = append(page.Entries, entries[i])
+		}
+
+		runTemplate(w, m.auditLog, page)
+	})
+}
+
+func (m *ServeMux) AuditExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entries := []audit.Entry{}
+		if m.audit != nil {
+			res, err := m.audit.Entries()
+			if err != nil {
+				dlog.Error("Failed to read audit log: %v", err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			entries = append(entries, res...)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", `attachment; filename="door2doc-audit.json"`)
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(entries); err != nil {
+			dlog.Error("Error while writing response: %v", err)
+		}
+	})
+}
@@ -0,0 +1,156 @@
+package web
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+func TestAudited(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	cfg.SetCredentials("user", "old-secret")
+	log := audit.New(filepath.Join(dir, audit.FileName))
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := m.Audited(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cfg.SetCredentials(r.FormValue("username"), r.FormValue("password"))
+		if r.FormValue("fail") == "" {
+			markSaved(r)
+		}
+		w.Header().Set("Location", pathUpload)
+		w.WriteHeader(http.StatusFound)
+	}))
+	post := func(query string) {
+		r := httptest.NewRequest(http.MethodPost, pathUpload+"?"+query, nil)
+		r.RemoteAddr = "10.0.0.1:12345"
+		r = r.WithContext(withSession(r.Context(), &session{Username: "admin", Role: config.RoleAdmin}))
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+	}
+
+	post("username=other&password=new-secret")
+	// the post that is not saved is not recorded, so the next post that leaves everything as is records nothing either
+	post("username=failed&password=new-secret&fail=1")
+	post("username=failed&password=new-secret")
+
+	entries, err := log.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []audit.Entry{
+		{User: "admin", RemoteAddr: "10.0.0.1", Field: "password", Before: audit.Redacted, After: audit.Redacted},
+		{User: "admin", RemoteAddr: "10.0.0.1", Field: "username", Before: "user", After: "other"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("Entries() == %d entries, got %v", len(want), entries)
+	}
+	for i, e := range entries {
+		if e.Time.IsZero() {
+			t.Errorf("Entries()[%d].Time is set, got zero", i)
+		}
+		e.Time = want[i].Time
+		if e != want[i] {
+			t.Errorf("Entries()[%d] == %v, got %v", i, want[i], e)
+		}
+	}
+}
+
+func TestAudited_InvalidPost(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// without database, the configuration is invalid, so changing the query does not save it
+	cfg := config.NewConfiguration()
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	log := audit.New(filepath.Join(dir, audit.FileName))
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := m.sessions.Create("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess.Role = config.RoleAdmin
+
+	form := url.Values{csrfField: {sess.CSRF}, "query": {"SELECT 1"}}
+	r := httptest.NewRequest(http.MethodPost, pathQuery, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.AddCookie(&http.Cookie{Name: sessionCookie, Value: m.sessions.CookieValue(sess)})
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	if w.Code != http.StatusFound {
+		t.Fatalf("POST %s == %d, got %d", pathQuery, http.StatusFound, w.Code)
+	}
+	if cfg.Validate().IsValid() {
+		t.Fatal("Validate().IsValid() == false, got true")
+	}
+
+	entries, err := log.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("Entries() == no entries, got %v", entries)
+	}
+}
+
+func TestAudited_Pause(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-audit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	// pausing saves the configuration
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	log := audit.New(filepath.Join(dir, audit.FileName))
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := m.sessions.Create("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess.Role = config.RoleOperator
+
+	form := url.Values{csrfField: {sess.CSRF}, "paused": {"true"}}
+	r := httptest.NewRequest(http.MethodPost, pathPause, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.AddCookie(&http.Cookie{Name: sessionCookie, Value: m.sessions.CookieValue(sess)})
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	if w.Code != http.StatusFound {
+		t.Fatalf("POST %s == %d, got %d", pathPause, http.StatusFound, w.Code)
+	}
+
+	entries, err := log.Entries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 || entries[0].Field != "paused" || entries[0].After != "true" {
+		t.Errorf("Entries() == [paused: true], got %v", entries)
+	}
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"html/template"
 	"strings"
+	"time"
 
 	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/secret"
 )
 
 // Humanize turns an error into a human-friendly error message.
@@ -21,12 +23,43 @@ func Humanize(err error) interface{} {
 			Please make sure the firewall allows outgoing connections to this server, or set your proxy server`)
 	case config.ErrQueryNotConfigured:
 		return `Query not configured.`
+	case config.ErrReadOnlyNotSupported:
+		return `SQL Server does not support read-only transactions. Please clear "Read-only transaction", and use a
+			database user with read-only permissions instead.`
 	case config.ErrDatabaseNotConfigured:
 		return `Database connection not configured.`
 	case config.ErrAccessNotConfigured:
-		return `The web interface is freely accessible. Consider setting a username and password.`
+		return `The web interface is freely accessible. Consider adding users.`
 	case config.ErrInvalidTimeout:
 		return `Invalid timeout.`
+	case config.ErrUsernameRequired:
+		return `Please enter a username.`
+	case config.ErrPasswordRequired:
+		return `Please enter a password for the new user.`
+	case config.ErrInvalidRole:
+		return `Please select a role.`
+	case config.ErrTokenNameRequired:
+		return `Please enter a name for the token.`
+	case config.ErrTokenExists:
+		return `A token with this name already exists. Revoke it first to replace it.`
+	case config.ErrVersionNotFound:
+		return `This version of the configuration is no longer available.`
+	case config.ErrInvalidBundle:
+		return `Please select a configuration file exported by the door2doc upload service.`
+	case config.ErrUnsupportedBundleVersion:
+		return `This configuration was exported by a newer version of the door2doc upload service. Please upgrade first.`
+	case config.ErrPassphraseRequired:
+		return `The passwords in this configuration are encrypted. Please enter the passphrase it was exported with.`
+	case config.ErrWrongPassphrase:
+		return `The passphrase is not the one the configuration was exported with.`
+	case ErrNoPendingImport:
+		return `There is no configuration to import anymore. Please select the file again.`
+	case config.ErrNoAdministrator:
+		return `At least one user must remain an administrator, or else nobody could change the configuration.`
+	case ErrLoginFailed:
+		return `Invalid username or password.`
+	case ErrInvalidHistoryFilter:
+		return `Please select a dataset and status from the lists, and enter times like 2020-03-01T12:00.`
 	}
 
 	switch e := err.(type) {
@@ -36,6 +69,35 @@ func Humanize(err error) interface{} {
 		return fmt.Sprintf(`Could not connect to the database. The database driver responded with: %s.`, e.Cause)
 	case *config.QueryError:
 		return fmt.Sprintf(`Failed to execute query. The database responsed with: %s.`, e.Cause)
+	case *config.TransactionError:
+		mode := "read-write"
+		if e.ReadOnly {
+			mode = "read-only"
+		}
+		return fmt.Sprintf(`Could not start a %s transaction with isolation level %s. The database responded with: %s.`, mode, e.Isolation, e.Cause)
+	case *db.ConnectionStringError:
+		return fmt.Sprintf(`The connection string is invalid at position %d: %s.`, e.Position, e.Msg)
+	case *LockedOutError:
+		return fmt.Sprintf(`Too many failed logins. Please try again in %v.`, e.Remaining.Round(time.Minute)+time.Minute)
+	case *config.InvalidConfigurationError:
+		const msg = `The configuration was not changed, because the new configuration is invalid: `
+		if inner, ok := Humanize(e.Validation.Err()).(template.HTML); ok {
+			return template.HTML(msg) + inner
+		}
+		return fmt.Sprintf(`%s%v`, msg, Humanize(e.Validation.Err()))
+	case *config.SchemaError:
+		msg := `The configuration file is invalid`
+		if e.Line > 0 {
+			msg += fmt.Sprintf(` at line %d, column %d`, e.Line, e.Column)
+		}
+		if e.Field != "" {
+			return fmt.Sprintf(`%s: %s: %s.`, msg, e.Field, e.Msg)
+		}
+		return fmt.Sprintf(`%s: %s.`, msg, e.Msg)
+	case *config.EnvironmentError:
+		return fmt.Sprintf(`The environment variable %s has an invalid value: %s.`, e.Env, e.Cause)
+	case *secret.Error:
+		return fmt.Sprintf(`Could not resolve the secret %s: %s.`, e.Reference, e.Cause)
 	case *db.SelectionError:
 		missing := strings.Join(e.Missing, "</code></li><li><code>")
 		return template.HTML(fmt.Sprintf(`Query is incomplete. The following columns are missing: <ul><li><code>%s</code></li></ul>`, missing))
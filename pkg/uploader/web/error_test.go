@@ -11,10 +11,14 @@ import (
 
 func TestHumanize(t *testing.T) {
 	for err, want := range map[error]interface{}{
-		config.ErrD2DCredentialsNotConfigured:                   `Username and/or password not configured.`,
-		config.D2DCredentialsStatusError{StatusCode: 404}:       `Could not verify credentials: the server returned HTTP 404. Please contact door2doc support.`,
-		&config.DatabaseInvalidError{Cause: `argh`}:             `Could not connect to the database. The database driver responded with: argh.`,
-		&db.SelectionError{Missing: []string{"hello", "world"}}: template.HTML(`Query is incomplete. The following columns are missing: <ul><li><code>hello</code></li><li><code>world</code></li></ul>`),
+		config.ErrD2DCredentialsNotConfigured:                                                                               `Username and/or password not configured.`,
+		config.D2DCredentialsStatusError{StatusCode: 404}:                                                                   `Could not verify credentials: the server returned HTTP 404. Please contact door2doc support.`,
+		&config.DatabaseInvalidError{Cause: `argh`}:                                                                         `Could not connect to the database. The database driver responded with: argh.`,
+		&db.ConnectionStringError{Position: 12, Msg: "empty key"}:                                                           `The connection string is invalid at position 12: empty key.`,
+		&db.SelectionError{Missing: []string{"hello", "world"}}:                                                             template.HTML(`Query is incomplete. The following columns are missing: <ul><li><code>hello</code></li><li><code>world</code></li></ul>`),
+		&config.InvalidConfigurationError{Validation: &config.ValidationResult{VisitorQuery: config.ErrQueryNotConfigured}}: `The configuration was not changed, because the new configuration is invalid: Query not configured.`,
+		&config.SchemaError{Field: "timeout", Line: 3, Column: 3, Msg: "expected a whole number, got string"}:               `The configuration file is invalid at line 3, column 3: timeout: expected a whole number, got string.`,
+		&config.EnvironmentError{Env: "D2D_TIMEOUT", Cause: "not a number"}:                                                 `The environment variable D2D_TIMEOUT has an invalid value: not a number.`,
 	} {
 		t.Run(err.Error(), func(t *testing.T) {
 			got := Humanize(err)
@@ -0,0 +1,119 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+const (
+	pathHealthz = "/healthz"
+	pathReadyz  = "/readyz"
+
+	// defaultReadyIntervals is the number of intervals without successful visitor upload after which the service is
+	// no longer ready.
+	defaultReadyIntervals = 3
+
+	checkOK = "ok"
+)
+
+// health is the body of the responses of the health endpoints. They are available without authentication, so checks
+// only report their outcome, never error messages or settings.
+type health struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// HealthzHandler reports that the process is up.
+func (m *ServeMux) HealthzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+		writeJSON(w, http.StatusOK, health{Status: checkOK})
+	})
+}
+
+// ReadyzHandler reports whether the service does its job: the configuration is valid, uploads are active and the
+// visitor upload succeeded within the last intervals, 3 unless set with the query parameter intervals. It responds
+// with 503 Service Unavailable if not.
+func (m *ServeMux) ReadyzHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethods(w, r, http.MethodGet, http.MethodHead) {
+			return
+		}
+		w.Header().Set("Cache-Control", "no-store")
+
+		intervals := defaultReadyIntervals
+		if s := r.URL.Query().Get("intervals"); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n < 1 {
+				writeAPIError(w, http.StatusBadRequest, "intervals must be a positive number")
+				return
+			}
+			intervals = n
+		}
+
+		res := health{
+			Status: checkOK,
+			Checks: map[string]string{
+				"configuration": m.checkConfiguration(),
+				"upload":        m.checkUpload(time.Now(), intervals),
+			},
+		}
+		status := http.StatusOK
+		for _, check := range res.Checks {
+			if check != checkOK {
+				res.Status = "unavailable"
+				status = http.StatusServiceUnavailable
+			}
+		}
+		writeJSON(w, status, res)
+	})
+}
+
+func (m *ServeMux) checkConfiguration() string {
+	v := m.cfg.Validate()
+	switch {
+	case v == nil:
+		return "not validated"
+	case !v.IsValid():
+		return "invalid"
+	}
+	return checkOK
+}
+
+// checkUpload returns whether the visitor upload succeeded within the last intervals at now. The first upload is
+// allowed as much time after the service started.
+func (m *ServeMux) checkUpload(now time.Time, intervals int) string {
+	switch {
+	case !m.cfg.Enabled():
+		return "disabled"
+	case m.cfg.Paused():
+		return "paused"
+	}
+
+	// the persisted history is queried, since the most recent events may all be uploads of other datasets
+	last := m.started
+	if m.history != nil {
+		records, _, err := m.history.Query(history.Filter{Type: config.PathVisitorUpload, Status: history.StatusSucceeded}, 0, 1)
+		if err != nil {
+			dlog.Error("Failed to read the upload history: %v", err)
+			return "failed to read the upload history"
+		}
+		if len(records) > 0 && records[0].Time.After(last) {
+			last = records[0].Time
+		}
+	}
+	window := time.Duration(intervals) * m.cfg.Interval()
+	if now.Sub(last) > window {
+		return fmt.Sprintf("no successful upload in %v", window)
+	}
+	return checkOK
+}
@@ -0,0 +1,147 @@
+package web
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+func TestHealthz(t *testing.T) {
+	m, err := NewServeMux(false, "testing", config.NewConfiguration(), history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, pathHealthz, nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("GET %s == %d, got %d", pathHealthz, http.StatusOK, w.Code)
+	}
+}
+
+func TestCheckUpload(t *testing.T) {
+	now := time.Now()
+	for name, test := range map[string]struct {
+		Started time.Time
+		Events  []history.Event
+		Paused  bool
+		Want    string
+	}{
+		"just started": {
+			Started: now.Add(-time.Minute),
+			Want:    checkOK,
+		},
+		"never uploaded": {
+			Started: now.Add(-time.Hour),
+			Want:    "no successful upload in 3m0s",
+		},
+		"recent upload": {
+			Started: now.Add(-time.Hour),
+			Events:  []history.Event{{Type: config.PathVisitorUpload, Time: now.Add(-2 * time.Minute), UploadDuration: time.Second}},
+			Want:    checkOK,
+		},
+		"old upload": {
+			Started: now.Add(-time.Hour),
+			Events:  []history.Event{{Type: config.PathVisitorUpload, Time: now.Add(-5 * time.Minute), UploadDuration: time.Second}},
+			Want:    "no successful upload in 3m0s",
+		},
+		"recent failure": {
+			Started: now.Add(-time.Hour),
+			Events: []history.Event{
+				{Type: config.PathVisitorUpload, Time: now.Add(-5 * time.Minute), UploadDuration: time.Second},
+				{Type: config.PathVisitorUpload, Time: now.Add(-time.Minute), Error: errors.New("failed")},
+			},
+			Want: "no successful upload in 3m0s",
+		},
+		"other dataset": {
+			Started: now.Add(-time.Hour),
+			Events:  []history.Event{{Type: config.PathLabUpload, Time: now.Add(-time.Minute), UploadDuration: time.Second}},
+			Want:    "no successful upload in 3m0s",
+		},
+		"paused": {
+			Started: now.Add(-time.Minute),
+			Paused:  true,
+			Want:    "paused",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := config.NewConfiguration()
+			cfg.SetPaused(test.Paused)
+			h := history.New()
+			for _, e := range test.Events {
+				e.Finished = e.Time.Add(time.Second)
+				*h.NewEvent(e.Type) = e
+			}
+			m, err := NewServeMux(false, "testing", cfg, h, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			m.started = test.Started
+
+			if got := m.checkUpload(now, defaultReadyIntervals); got != test.Want {
+				t.Errorf("checkUpload() == %q, got %q", test.Want, got)
+			}
+		})
+	}
+}
+
+func TestCheckUploadStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-health")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	h := history.New()
+	h.SetStore(history.NewStore(filepath.Join(dir, history.FileName), nil, nil))
+	m, err := NewServeMux(false, "testing", config.NewConfiguration(), h, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.started = time.Now().Add(-time.Hour)
+
+	// the visitor upload is no longer among the events in memory
+	finish := func(typ string) {
+		e := h.NewEvent(typ)
+		e.UploadDuration = time.Second
+		h.Finish(e)
+	}
+	finish(config.PathVisitorUpload)
+	for i := 0; i < history.MaxHistory; i++ {
+		finish(config.PathLabUpload)
+	}
+	if got := m.checkUpload(time.Now(), defaultReadyIntervals); got != checkOK {
+		t.Errorf("checkUpload() == %q, got %q", checkOK, got)
+	}
+}
+
+func TestReadyz(t *testing.T) {
+	m, err := NewServeMux(false, "testing", config.NewConfiguration(), history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, test := range map[string]struct {
+		Path string
+		Want int
+	}{
+		// the configuration has not been validated
+		"unavailable":       {Path: pathReadyz, Want: http.StatusServiceUnavailable},
+		"intervals":         {Path: pathReadyz + "?intervals=10", Want: http.StatusServiceUnavailable},
+		"invalid intervals": {Path: pathReadyz + "?intervals=0", Want: http.StatusBadRequest},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, test.Path, nil))
+			if w.Code != test.Want {
+				t.Errorf("GET %s == %d, got %d", test.Path, test.Want, w.Code)
+			}
+		})
+	}
+}
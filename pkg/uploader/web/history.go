@@ -0,0 +1,274 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/diff"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+	"github.com/pkg/errors"
+)
+
+const (
+	pathHistory        = "/history"
+	pathHistoryEvent   = "/history/event"
+	pathHistoryPayload = "/history/payload"
+
+	// historyPageSize is the number of uploads per page of the history.
+	historyPageSize = 50
+	// historyTimeFormat is the format of the time range of the history, as used by datetime-local inputs.
+	historyTimeFormat = "2006-01-02T15:04"
+	// historyDiffContext is the number of unchanged lines shown around the changes in a payload.
+	historyDiffContext = 3
+)
+
+// ErrInvalidHistoryFilter indicates that the filter of the history could not be parsed, for example because of a
+// time in the wrong format.
+var ErrInvalidHistoryFilter = errors.New("invalid history filter")
+
+// HistoryFilter contains the filter of the history as entered in the form.
+type HistoryFilter struct {
+	Dataset string
+	Status  string
+	From    string
+	To      string
+}
+
+// parse returns the filter for the history.
+func (f HistoryFilter) parse() (history.Filter, error) {
+	var res history.Filter
+	if f.Dataset != "" {
+		ds := config.Dataset(f.Dataset)
+		if !ds.IsValid() {
+			return res, ErrInvalidHistoryFilter
+		}
+		res.Type = ds.Path()
+	}
+	switch f.Status {
+	case "", history.StatusSucceeded, history.StatusFailed:
+		res.Status = f.Status
+	default:
+		return res, ErrInvalidHistoryFilter
+	}
+
+	var err error
+	if f.From != "" {
+		if res.From, err = time.ParseInLocation(historyTimeFormat, f.From, time.Local); err != nil {
+			return res, ErrInvalidHistoryFilter
+		}
+	}
+	if f.To != "" {
+		if res.To, err = time.ParseInLocation(historyTimeFormat, f.To, time.Local); err != nil {
+			return res, ErrInvalidHistoryFilter
+		}
+		// the end of the range includes the minute that was entered
+		res.To = res.To.Add(time.Minute)
+	}
+	return res, nil
+}
+
+// values returns the filter as query parameters.
+func (f HistoryFilter) values() url.Values {
+	res := url.Values{}
+	for key, value := range map[string]string{"dataset": f.Dataset, "status": f.Status, "from": f.From, "to": f.To} {
+		if value != "" {
+			res.Set(key, value)
+		}
+	}
+	return res
+}
+
+// HistoryRow is an upload in the history.
+type HistoryRow struct {
+	history.Record
+	Dataset config.Dataset
+}
+
+type HistoryPage struct {
+	*Page
+	Datasets []config.Dataset
+	Filter   HistoryFilter
+	Rows     []HistoryRow
+	// Total is the number of uploads that match the filter; First and Last are the positions of the uploads shown.
+	Total int
+	First int
+	Last  int
+	// NewerURL and OlderURL link to the adjacent pages, if any.
+	NewerURL string
+	OlderURL string
+	Error    error
+}
+
+func (m *ServeMux) HistoryHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		q := r.URL.Query()
+		page := HistoryPage{
+			Page:     m.page(r.Context(), r.URL.Path),
+			Datasets: config.Datasets,
+			Filter: HistoryFilter{
+				Dataset: q.Get("dataset"),
+				Status:  q.Get("status"),
+				From:    q.Get("from"),
+				To:      q.Get("to"),
+			},
+		}
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		if offset < 0 {
+			offset = 0
+		}
+
+		filter, err := page.Filter.parse()
+		if err != nil {
+			page.Error = err
+			runTemplate(w, m.historyPage, page)
+			return
+		}
+		var records []history.Record
+		if m.history != nil {
+			records, page.Total, page.Error = m.history.Query(filter, offset, historyPageSize)
+		}
+		for _, rec := range records {
+			ds, _ := config.DatasetForPath(rec.Type)
+			page.Rows = append(page.Rows, HistoryRow{Record: rec, Dataset: ds})
+		}
+		if len(records) > 0 {
+			page.First, page.Last = offset+1, offset+len(records)
+		}
+
+		link := func(offset int) string {
+			v := page.Filter.values()
+			if offset > 0 {
+				v.Set("offset", strconv.Itoa(offset))
+			}
+			if len(v) == 0 {
+				return pathHistory
+			}
+			return pathHistory + "?" + v.Encode()
+		}
+		if offset > 0 {
+			newer := offset - historyPageSize
+			if newer < 0 {
+				newer = 0
+			}
+			page.NewerURL = link(newer)
+		}
+		if offset+historyPageSize < page.Total {
+			page.OlderURL = link(offset + historyPageSize)
+		}
+
+		runTemplate(w, m.historyPage, page)
+	})
+}
+
+type HistoryEventPage struct {
+	*Page
+	Record  history.Record
+	Dataset config.Dataset
+	// Payload is the pretty-printed payload, and Response the response of door2doc, if they are still kept.
+	Payload  string
+	Response *history.Response
+	// Previous is the previous upload of the same dataset with a payload, if any, and Changes the differences between
+	// its payload and this one.
+	Previous *history.Record
+	Changes  []diff.Hunk
+	Error    error
+}
+
+// indentPayload returns the payload pretty-printed, or as is if it is not valid JSON.
+func indentPayload(payload string) string {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, []byte(payload), "", "  "); err != nil {
+		return payload
+	}
+	return buf.String()
+}
+
+// lookupEvent returns the record and detail of the finished event with the id in the request. It writes a response
+// and returns false if there is no such event.
+func (m *ServeMux) lookupEvent(w http.ResponseWriter, r *http.Request) (history.Record, history.Detail, bool) {
+	id, err := strconv.ParseInt(r.FormValue("id"), 10, 64)
+	if err != nil || m.history == nil {
+		http.NotFound(w, r)
+		return history.Record{}, history.Detail{}, false
+	}
+	rec, detail, ok, err := m.history.Lookup(id)
+	if err != nil {
+		dlog.Error("Failed to read history: %v", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return rec, detail, false
+	}
+	if !ok {
+		http.NotFound(w, r)
+	}
+	return rec, detail, ok
+}
+
+func (m *ServeMux) HistoryEventHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec, detail, ok := m.lookupEvent(w, r)
+		if !ok {
+			return
+		}
+
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		// the page is part of the history in the navigation
+		page := HistoryEventPage{
+			Page:   m.page(r.Context(), pathHistory),
+			Record: rec,
+		}
+		page.Dataset, _ = config.DatasetForPath(rec.Type)
+		// payloads and responses contain patient data, which viewers may not see
+		if page.CanOperate() {
+			page.Response = detail.Response
+		}
+		if detail.Payload != "" && page.CanOperate() {
+			page.Payload = indentPayload(detail.Payload)
+
+			prev, prevDetail, ok, err := m.history.Previous(rec)
+			page.Error = err
+			if ok {
+				page.Previous = &prev
+				page.Changes = diff.Hunks(diff.Lines(indentPayload(prevDetail.Payload), page.Payload), historyDiffContext)
+			}
+		}
+
+		runTemplate(w, m.historyEvent, page)
+	})
+}
+
+// HistoryPayloadHandler downloads the pretty-printed payload of an upload. Payloads contain patient data, so this
+// requires at least the operator role.
+func (m *ServeMux) HistoryPayloadHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec, detail, ok := m.lookupEvent(w, r)
+		if !ok {
+			return
+		}
+		if detail.Payload == "" {
+			http.NotFound(w, r)
+			return
+		}
+
+		name := "upload"
+		if ds, ok := config.DatasetForPath(rec.Type); ok {
+			name = string(ds)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="door2doc-%s-%d.json"`, name, rec.ID))
+		if _, err := w.Write([]byte(indentPayload(detail.Payload))); err != nil {
+			dlog.Error("Error while writing response: %v", err)
+		}
+	})
+}
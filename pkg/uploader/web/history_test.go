@@ -0,0 +1,179 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+func TestHistoryHandler(t *testing.T) {
+	h := history.New()
+	for i := 0; i < 3; i++ {
+		e := h.NewEvent(config.PathVisitorUpload)
+		e.UploadDuration = 1
+		h.Finish(e)
+	}
+	e := h.NewEvent(config.PathLabUpload)
+	e.Error = errors.New("lab failed")
+	h.Finish(e)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.NewConfiguration()
+	cfg.UpdateBaseValidation(ctx)
+	m, err := NewServeMux(false, "testing", cfg, h, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, test := range map[string]struct {
+		Query   string
+		Want    string
+		NotWant string
+	}{
+		"all":            {Query: "", Want: "Showing 1 to 4 of 4 uploads"},
+		"dataset":        {Query: "?dataset=lab", Want: "lab failed"},
+		"status":         {Query: "?status=succeeded", Want: "Showing 1 to 3 of 3 uploads", NotWant: "lab failed"},
+		"time range":     {Query: "?from=2000-01-01T00:00&to=2000-01-02T00:00", Want: "No uploads found"},
+		"paginated":      {Query: "?offset=2", Want: "Showing 3 to 4 of 4 uploads"},
+		"invalid status": {Query: "?status=pending", Want: "Please select a dataset and status"},
+		"invalid time":   {Query: "?from=yesterday", Want: "Please select a dataset and status"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, pathHistory+test.Query, nil))
+			if w.Code != http.StatusOK {
+				t.Fatalf("GET %s == %d, got %d", pathHistory+test.Query, http.StatusOK, w.Code)
+			}
+			if body := w.Body.String(); !strings.Contains(body, test.Want) {
+				t.Errorf("GET %s contains %q, got\n%s", pathHistory+test.Query, test.Want, body)
+			}
+			if test.NotWant != "" && strings.Contains(w.Body.String(), test.NotWant) {
+				t.Errorf("GET %s does not contain %q", pathHistory+test.Query, test.NotWant)
+			}
+		})
+	}
+}
+
+func TestHistoryEventHandler(t *testing.T) {
+	h := history.New()
+	upload := func(payload string, err error) *history.Event {
+		e := h.NewEvent(config.PathVisitorUpload)
+		e.JSON, e.Size, e.Error = payload, 1, err
+		e.Response = &history.Response{Status: "200 OK", Header: http.Header{"X-Request-Id": {"abc"}}, Body: "accepted"}
+		h.Finish(e)
+		return e
+	}
+	first := upload(`[{"id":1,"name":"first"}]`, nil)
+	second := upload(`[{"id":1,"name":"second"}]`, nil)
+	failed := h.NewEvent(config.PathVisitorUpload)
+	failed.Error = errors.New("query failed")
+	h.Finish(failed)
+	running := h.NewEvent(config.PathVisitorUpload)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.NewConfiguration()
+	cfg.UpdateBaseValidation(ctx)
+	m, err := NewServeMux(false, "testing", cfg, h, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	get := func(path string, id int64) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		m.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path+"?id="+strconv.FormatInt(id, 10), nil))
+		return w
+	}
+
+	for name, test := range map[string]struct {
+		ID   int64
+		Code int
+		Want []string
+	}{
+		"first": {
+			ID: first.ID, Code: http.StatusOK,
+			Want: []string{"200 OK", "X-Request-Id", "accepted", `&#34;name&#34;: &#34;first&#34;`, "There is no previous payload"},
+		},
+		"second": {
+			ID: second.ID, Code: http.StatusOK,
+			Want: []string{"Compared to the", `<td class="py-0"><pre class="mb-0">    &#34;name&#34;: &#34;first&#34;</pre></td>`},
+		},
+		"failed":  {ID: failed.ID, Code: http.StatusOK, Want: []string{"query failed", "No payload was sent"}},
+		"running": {ID: running.ID, Code: http.StatusNotFound},
+		"unknown": {ID: 1, Code: http.StatusNotFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := get(pathHistoryEvent, test.ID)
+			if w.Code != test.Code {
+				t.Fatalf("GET %s == %d, got %d", pathHistoryEvent, test.Code, w.Code)
+			}
+			for _, want := range test.Want {
+				if !strings.Contains(w.Body.String(), want) {
+					t.Errorf("GET %s contains %q, got\n%s", pathHistoryEvent, want, w.Body.String())
+				}
+			}
+		})
+	}
+
+	// the payload is downloaded pretty-printed
+	w := get(pathHistoryPayload, first.ID)
+	want := "[\n  {\n    \"id\": 1,\n    \"name\": \"first\"\n  }\n]"
+	if w.Code != http.StatusOK || w.Body.String() != want {
+		t.Errorf("GET %s == %q, got %d %q", pathHistoryPayload, want, w.Code, w.Body.String())
+	}
+	if got := w.Header().Get("Content-Disposition"); !strings.Contains(got, fmt.Sprintf("door2doc-visitor-%d.json", first.ID)) {
+		t.Errorf("Content-Disposition == attachment with file name, got %q", got)
+	}
+	if w := get(pathHistoryPayload, failed.ID); w.Code != http.StatusNotFound {
+		t.Errorf("GET %s without payload == %d, got %d", pathHistoryPayload, http.StatusNotFound, w.Code)
+	}
+
+	// payloads and responses contain patient data, and are not shown to viewers
+	for _, u := range []config.User{
+		{Username: "admin", Role: config.RoleAdmin},
+		{Username: "operator", Role: config.RoleOperator},
+		{Username: "viewer", Role: config.RoleViewer},
+	} {
+		if err := cfg.SetUser(u.Username, "secret", u.Role); err != nil {
+			t.Fatal(err)
+		}
+	}
+	request := func(username, path string) *httptest.ResponseRecorder {
+		sess, err := m.sessions.Create(username)
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := httptest.NewRequest(http.MethodGet, path+"?id="+strconv.FormatInt(second.ID, 10), nil)
+		r.AddCookie(&http.Cookie{Name: sessionCookie, Value: m.sessions.CookieValue(sess)})
+		w := httptest.NewRecorder()
+		m.ServeHTTP(w, r)
+		return w
+	}
+	for username, want := range map[string]bool{"viewer": false, "operator": true} {
+		w := request(username, pathHistoryEvent)
+		if got := strings.Contains(w.Body.String(), `&#34;second&#34;`); w.Code != http.StatusOK || got != want {
+			t.Errorf("GET %s as %s shows payload == %v, got %d %v", pathHistoryEvent, username, want, w.Code, got)
+		}
+		if got := strings.Contains(w.Body.String(), "accepted"); got != want {
+			t.Errorf("GET %s as %s shows response == %v, got %v", pathHistoryEvent, username, want, got)
+		}
+		code := http.StatusForbidden
+		if want {
+			code = http.StatusOK
+		}
+		if w := request(username, pathHistoryPayload); w.Code != code {
+			t.Errorf("GET %s as %s == %d, got %d", pathHistoryPayload, username, code, w.Code)
+		}
+	}
+}
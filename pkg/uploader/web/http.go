@@ -3,20 +3,25 @@ package web
 import (
 	"bytes"
 	"context"
+	"database/sql"
 	"fmt"
 	"html/template"
 	"io/ioutil"
 	"net/http"
 	"net/http/pprof"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/door2doc/d2d-uploader/pkg/uploader/assets"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/secret"
 )
 
 const (
@@ -27,26 +32,56 @@ const (
 	pathRadiology = "/orders/radiology"
 	pathLab       = "/orders/lab"
 	pathConsult   = "/orders/consult"
+	pathLogin     = "/login"
+	pathLogout    = "/logout"
+	pathTrigger   = "/trigger"
+	pathPause     = "/pause"
+	pathAudit     = "/audit"
+	pathAuditJSON = "/audit/export"
+	pathVersions  = "/versions"
+	pathTransfer  = "/transfer"
 )
 
+// Uploader provides the state of the upload process to the web interface.
+type Uploader interface {
+	// DBStats returns the statistics of the database connection pool, or false if there is no open connection.
+	DBStats() (sql.DBStats, bool)
+	// Trigger requests an upload as soon as possible.
+	Trigger()
+}
+
 type ServeMux struct {
 	*http.ServeMux
 
-	fs      http.FileSystem
-	version string
-	cfg     *config.Configuration
-	history *history.History
-
-	mu        sync.RWMutex
-	err       error
-	database  *template.Template
-	query     *template.Template
-	status    *template.Template
-	upload    *template.Template
-	access    *template.Template
-	radiology *template.Template
-	lab       *template.Template
-	consult   *template.Template
+	fs       http.FileSystem
+	version  string
+	cfg      *config.Configuration
+	history  *history.History
+	uploader Uploader
+	lockout  *lockout
+	sessions *sessions
+	audit    *audit.Log
+	auditMu  sync.Mutex
+	imports  pendingImports
+	// started is when the server started, from which the readiness check allows time for the first upload.
+	started time.Time
+
+	mu           sync.RWMutex
+	err          error
+	database     *template.Template
+	query        *template.Template
+	status       *template.Template
+	upload       *template.Template
+	access       *template.Template
+	login        *template.Template
+	radiology    *template.Template
+	lab          *template.Template
+	consult      *template.Template
+	auditLog     *template.Template
+	versions     *template.Template
+	transfer     *template.Template
+	historyPage  *template.Template
+	historyEvent *template.Template
 }
 
 func (m *ServeMux) load(templates ...string) *template.Template {
@@ -96,9 +131,24 @@ func (m *ServeMux) initTemplates() {
 	m.status = m.load("/status.html", "/_layout.html")
 	m.upload = m.load("/upload.html", "/_layout.html")
 	m.access = m.load("/access.html", "/_layout.html")
+	m.login = m.load("/login.html")
 	m.radiology = m.load("/orders-radiology.html", "/_layout.html")
 	m.lab = m.load("/orders-lab.html", "/_layout.html")
 	m.consult = m.load("/orders-consult.html", "/_layout.html")
+	m.auditLog = m.load("/audit.html", "/_layout.html")
+	m.versions = m.load("/versions.html", "/_layout.html")
+	m.transfer = m.load("/transfer.html", "/_layout.html")
+	m.historyPage = m.load("/history.html", "/_layout.html")
+	m.historyEvent = m.load("/history-event.html", "/_layout.html")
+}
+
+// save saves the configuration, and marks r as having saved it, so that the changes are audited.
+func (m *ServeMux) save(r *http.Request) error {
+	if err := m.cfg.Save(); err != nil {
+		return err
+	}
+	markSaved(r)
+	return nil
 }
 
 func runTemplate(w http.ResponseWriter, tmpl *template.Template, data interface{}) {
@@ -116,14 +166,24 @@ func runTemplate(w http.ResponseWriter, tmpl *template.Template, data interface{
 	}
 }
 
-// NewServeMux generates the toplevel http mux for managing the service.
-func NewServeMux(dev bool, version string, cfg *config.Configuration, h *history.History) (*ServeMux, error) {
+// NewServeMux generates the toplevel http mux for managing the service. Changes to the configuration are recorded in
+// the audit log a, if not nil.
+func NewServeMux(dev bool, version string, cfg *config.Configuration, h *history.History, u Uploader, a *audit.Log) (*ServeMux, error) {
 	res := &ServeMux{
 		ServeMux: http.NewServeMux(),
 		fs:       assets.FS(dev),
 		version:  version,
 		cfg:      cfg,
 		history:  h,
+		uploader: u,
+		lockout:  newLockout(),
+		audit:    a,
+		started:  time.Now(),
+	}
+
+	var err error
+	if res.sessions, err = newSessions(); err != nil {
+		return nil, err
 	}
 
 	res.initTemplates()
@@ -143,14 +203,37 @@ func NewServeMux(dev bool, version string, cfg *config.Configuration, h *history
 	}
 
 	res.Handle("/assets/", http.FileServer(res.fs))
-	res.Handle("/", res.Secured(res.StatusHandler()))
-	res.Handle(pathDatabase, res.Secured(res.DatabaseHandler()))
-	res.Handle(pathQuery, res.Secured(res.VisitorQueryHandler()))
-	res.Handle(pathUpload, res.Secured(res.UploadHandler()))
-	res.Handle(pathAccess, res.Secured(res.AccessHandler()))
-	res.Handle(pathRadiology, res.Secured(res.RadiologyQueryHandler()))
-	res.Handle(pathLab, res.Secured(res.LabQueryHandler()))
-	res.Handle(pathConsult, res.Secured(res.ConsultQueryHandler()))
+	res.Handle("/", res.Secured(config.RoleViewer, config.RoleViewer, res.StatusHandler()))
+	res.Handle(pathTrigger, res.Secured(config.RoleOperator, config.RoleOperator, res.TriggerHandler()))
+	res.Handle(pathPause, res.Secured(config.RoleOperator, config.RoleOperator, res.Audited(res.PauseHandler())))
+	res.Handle(pathDatabase, res.Secured(config.RoleViewer, config.RoleAdmin, res.Audited(res.DatabaseHandler())))
+	res.Handle(pathQuery, res.Secured(config.RoleViewer, config.RoleAdmin, res.Audited(res.VisitorQueryHandler())))
+	res.Handle(pathUpload, res.Secured(config.RoleViewer, config.RoleAdmin, res.Audited(res.UploadHandler())))
+	res.Handle(pathAccess, res.Secured(config.RoleAdmin, config.RoleAdmin, res.Audited(res.AccessHandler())))
+	res.Handle(pathRadiology, res.Secured(config.RoleViewer, config.RoleAdmin, res.Audited(res.RadiologyQueryHandler())))
+	res.Handle(pathLab, res.Secured(config.RoleViewer, config.RoleAdmin, res.Audited(res.LabQueryHandler())))
+	res.Handle(pathConsult, res.Secured(config.RoleViewer, config.RoleAdmin, res.Audited(res.ConsultQueryHandler())))
+	res.Handle(pathVersions, res.Secured(config.RoleViewer, config.RoleAdmin, res.Audited(res.VersionsHandler())))
+	res.Handle(pathTransfer, res.Secured(config.RoleAdmin, config.RoleAdmin, res.Audited(res.TransferHandler())))
+	res.Handle(pathHistory, res.Secured(config.RoleViewer, config.RoleViewer, res.HistoryHandler()))
+	res.Handle(pathHistoryEvent, res.Secured(config.RoleViewer, config.RoleViewer, res.HistoryEventHandler()))
+	res.Handle(pathHistoryPayload, res.Secured(config.RoleOperator, config.RoleOperator, res.HistoryPayloadHandler()))
+	res.Handle(pathAudit, res.Secured(config.RoleViewer, config.RoleViewer, res.AuditHandler()))
+	res.Handle(pathAuditJSON, res.Secured(config.RoleViewer, config.RoleViewer, res.AuditExportHandler()))
+	res.Handle(pathAPI+"/", res.APISecured(config.RoleViewer, config.RoleViewer, res.APINotFoundHandler()))
+	res.Handle(pathAPIOpenAPI, res.OpenAPIHandler())
+	res.Handle(pathAPIVersion, res.APISecured(config.RoleViewer, config.RoleViewer, res.APIVersionHandler()))
+	res.Handle(pathAPIConfiguration, res.APISecured(config.RoleViewer, config.RoleAdmin, res.Audited(res.APIConfigurationHandler())))
+	res.Handle(pathAPIQueries, res.APISecured(config.RoleViewer, config.RoleAdmin, res.Audited(res.APIQueriesHandler())))
+	res.Handle(pathAPIQueries+"/", res.APISecured(config.RoleViewer, config.RoleAdmin, res.Audited(res.APIQueriesHandler())))
+	res.Handle(pathAPIValidation, res.APISecured(config.RoleViewer, config.RoleViewer, res.APIValidationHandler()))
+	res.Handle(pathAPIHistory, res.APISecured(config.RoleViewer, config.RoleViewer, res.APIHistoryHandler()))
+	res.Handle(pathAPITrigger, res.APISecured(config.RoleOperator, config.RoleOperator, res.APITriggerHandler()))
+	res.Handle(pathAPIPause, res.APISecured(config.RoleViewer, config.RoleOperator, res.Audited(res.APIPauseHandler())))
+	res.Handle(pathHealthz, res.HealthzHandler())
+	res.Handle(pathReadyz, res.ReadyzHandler())
+	res.Handle(pathLogin, res.LoginHandler())
+	res.Handle(pathLogout, res.Secured(config.RoleViewer, config.RoleViewer, res.LogoutHandler()))
 	res.HandleFunc("/debug/pprof/", pprof.Index)
 	res.HandleFunc("/debug/pprof/profile", pprof.Profile)
 	res.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
@@ -166,6 +249,23 @@ type Page struct {
 	GlobalError   error
 	Validation    *config.ValidationResult
 	Configuration *config.Configuration
+
+	// User is the user that is logged in, if any.
+	User string
+	// Role is the role of the user, which determines which forms are shown.
+	Role config.Role
+	// CSRF is the token that must be included in every form.
+	CSRF string
+}
+
+// CanOperate returns whether the user may trigger uploads and pause the service.
+func (p *Page) CanOperate() bool {
+	return p.Role.Allows(config.RoleOperator)
+}
+
+// CanConfigure returns whether the user may change the configuration.
+func (p *Page) CanConfigure() bool {
+	return p.Role.Allows(config.RoleAdmin)
 }
 
 func (m *ServeMux) page(ctx context.Context, path string) *Page {
@@ -174,6 +274,12 @@ func (m *ServeMux) page(ctx context.Context, path string) *Page {
 		Path:    path,
 	}
 
+	if sess := sessionFromContext(ctx); sess != nil {
+		p.User = sess.Username
+		p.Role = sess.Role
+		p.CSRF = sess.CSRF
+	}
+
 	p.Configuration = m.cfg
 	p.Validation = p.Configuration.Validate()
 	p.Problems = map[string]bool{
@@ -194,6 +300,7 @@ func (m *ServeMux) page(ctx context.Context, path string) *Page {
 type StatusPage struct {
 	*Page
 	History *history.History
+	DBStats *sql.DBStats
 }
 
 func (m *ServeMux) StatusHandler() http.Handler {
@@ -201,9 +308,17 @@ func (m *ServeMux) StatusHandler() http.Handler {
 		m.mu.RLock()
 		defer m.mu.RUnlock()
 
+		var dbStats *sql.DBStats
+		if m.uploader != nil {
+			if stats, ok := m.uploader.DBStats(); ok {
+				dbStats = &stats
+			}
+		}
+
 		runTemplate(w, m.status, StatusPage{
 			Page:    m.page(r.Context(), r.URL.Path),
 			History: m.history,
+			DBStats: dbStats,
 		})
 	})
 }
@@ -213,8 +328,14 @@ type DatabasePage struct {
 
 	Config       db.ConnectionData
 	Timeout      string
+	Pool         db.PoolSettings
+	PasswordHint string
 	Error        error
 	TimeoutError error
+
+	// ConnectionString is a connection string that could not be parsed, with the reason in ConnectionStringError.
+	ConnectionString      string
+	ConnectionStringError error
 }
 
 func (m *ServeMux) DatabaseHandler() http.Handler {
@@ -222,6 +343,20 @@ func (m *ServeMux) DatabaseHandler() http.Handler {
 		m.mu.RLock()
 		defer m.mu.RUnlock()
 
+		current := m.cfg.Connection()
+		page := DatabasePage{
+			Page:         m.page(r.Context(), r.URL.Path),
+			Config:       current,
+			PasswordHint: passwordHint(current.Password),
+			Timeout:      fmt.Sprintf("%d", m.cfg.Timeout()/time.Second),
+			Pool:         m.cfg.Pool(),
+			Error:        m.cfg.Validate().DatabaseConnection,
+			TimeoutError: m.cfg.Validate().QueryTimeout,
+		}
+
+		// never send the stored password to the browser
+		page.Config.Password = ""
+
 		if r.Method == http.MethodPost {
 			c := db.ConnectionData{
 				Driver:   r.FormValue("driver"),
@@ -232,6 +367,22 @@ func (m *ServeMux) DatabaseHandler() http.Handler {
 				Username: r.FormValue("username"),
 				Password: r.FormValue("password"),
 				Params:   r.FormValue("params"),
+				Path:     r.FormValue("path"),
+			}
+			if c.Password == "" && c.Username != "" {
+				c.Password = current.Password
+			}
+			if m.cfg.LockedBy("dsn") != "" {
+				// the form is disabled
+				c = current
+			} else if cs := strings.TrimSpace(r.FormValue("connection-string")); cs != "" {
+				c = db.ConnectionData{}
+				if err := c.UnmarshalText([]byte(cs)); err != nil {
+					page.ConnectionString = cs
+					page.ConnectionStringError = err
+					runTemplate(w, m.database, page)
+					return
+				}
 			}
 			m.cfg.SetConnection(c)
 
@@ -241,10 +392,22 @@ func (m *ServeMux) DatabaseHandler() http.Handler {
 				m.cfg.SetTimeout(time.Duration(t) * time.Second)
 			}
 
+			pool := m.cfg.Pool()
+			if n, err := strconv.Atoi(r.FormValue("max-open")); err == nil && n > 0 {
+				pool.MaxOpen = n
+			}
+			if n, err := strconv.Atoi(r.FormValue("max-idle")); err == nil && n >= 0 {
+				pool.MaxIdle = n
+			}
+			if n, err := strconv.Atoi(r.FormValue("max-lifetime")); err == nil && n > 0 {
+				pool.MaxLifetime = time.Duration(n) * time.Minute
+			}
+			m.cfg.SetPool(pool)
+
 			m.cfg.UpdateBaseValidation(r.Context())
 
 			if m.cfg.Validate().IsValid() {
-				if err := m.cfg.Save(); err != nil {
+				if err := m.save(r); err != nil {
 					dlog.Error("While saving credentials: %v", err)
 				}
 			}
@@ -253,24 +416,20 @@ func (m *ServeMux) DatabaseHandler() http.Handler {
 			return
 		}
 
-		connectionData := m.cfg.Connection()
-		runTemplate(w, m.database, DatabasePage{
-			Page:         m.page(r.Context(), r.URL.Path),
-			Config:       connectionData,
-			Timeout:      fmt.Sprintf("%d", m.cfg.Timeout()/time.Second),
-			Error:        m.cfg.Validate().DatabaseConnection,
-			TimeoutError: m.cfg.Validate().QueryTimeout,
-		})
+		runTemplate(w, m.database, page)
 	})
 }
 
 type UploadPage struct {
 	*Page
 
-	Username string
-	Password string
-	Proxy    string
-	Error    error
+	Username          string
+	PasswordHint      string
+	Environment       string
+	Environments      []config.Environment
+	Proxy             rest.Proxy
+	ProxyPasswordHint string
+	Error             error
 }
 
 func (m *ServeMux) UploadHandler() http.Handler {
@@ -279,10 +438,37 @@ func (m *ServeMux) UploadHandler() http.Handler {
 		defer m.mu.RUnlock()
 
 		if r.Method == http.MethodPost {
-			m.cfg.SetCredentials(r.FormValue("username"), r.FormValue("password"))
-			m.cfg.SetProxy(r.FormValue("proxy"))
+			// empty passwords keep the stored password, as stored passwords are never sent to the browser
+			_, password := m.cfg.Credentials()
+			if p := r.FormValue("password"); p != "" {
+				password = p
+			}
+			m.cfg.SetCredentials(r.FormValue("username"), password)
+
+			names, urls := r.PostForm["environment-name"], r.PostForm["environment-url"]
+			var envs []config.Environment
+			for i := 0; i < len(names) && i < len(urls); i++ {
+				envs = append(envs, config.Environment{Name: names[i], URL: urls[i]})
+			}
+			if m.cfg.LockedBy("environments") != "" {
+				// the table is disabled, but the selected environment may still be changed
+				envs = m.cfg.Environments()
+			}
+			m.cfg.SetEnvironments(envs, r.FormValue("environment"))
+
+			proxy := rest.Proxy{
+				Mode:     r.FormValue("proxy-mode"),
+				URL:      r.FormValue("proxy"),
+				Username: r.FormValue("proxy-username"),
+				Password: r.FormValue("proxy-password"),
+				NoProxy:  r.FormValue("no-proxy"),
+			}
+			if proxy.Password == "" && (proxy.Username != "" || m.cfg.LockedBy("proxyUsername") != "") {
+				proxy.Password = m.cfg.Proxy().Password
+			}
+			m.cfg.SetProxy(proxy)
 			m.cfg.UpdateBaseValidation(r.Context())
-			if err := m.cfg.Save(); err != nil {
+			if err := m.save(r); err != nil {
 				dlog.Error("While saving credentials: %v", err)
 			}
 
@@ -293,28 +479,103 @@ func (m *ServeMux) UploadHandler() http.Handler {
 
 		username, password := m.cfg.Credentials()
 		proxy := m.cfg.Proxy()
+		proxyPasswordHint := passwordHint(proxy.Password)
+		proxy.Password = ""
 		err := m.cfg.Validate().D2DCredentials
 		if err == nil {
 			err = m.cfg.Validate().D2DConnection
 		}
 
 		runTemplate(w, m.upload, UploadPage{
-			Page:     m.page(r.Context(), r.URL.Path),
-			Username: username,
-			Password: password,
-			Proxy:    proxy,
-			Error:    err,
+			Page:              m.page(r.Context(), r.URL.Path),
+			Username:          username,
+			PasswordHint:      passwordHint(password),
+			Environment:       m.cfg.Environment().Name,
+			Environments:      m.cfg.Environments(),
+			Proxy:             proxy,
+			ProxyPasswordHint: proxyPasswordHint,
+			Error:             err,
 		})
 	})
 }
 
+func (m *ServeMux) TriggerHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if m.uploader != nil {
+			m.uploader.Trigger()
+		}
+		w.Header().Set("Location", "/")
+		w.WriteHeader(http.StatusFound)
+	})
+}
+
+func (m *ServeMux) PauseHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		paused := r.FormValue("paused") == "true"
+		m.cfg.SetPaused(paused)
+		if sess := sessionFromContext(r.Context()); sess != nil {
+			dlog.Info("Uploads paused: %t, by %q", paused, sess.Username)
+		}
+		// uploads remain paused after the service restarts
+		if err := m.save(r); err != nil {
+			dlog.Error("While saving pause: %v", err)
+		}
+		w.Header().Set("Location", "/")
+		w.WriteHeader(http.StatusFound)
+	})
+}
+
 type QueryPage struct {
 	*Page
 	Query         string
+	Options       db.QueryOptions
+	Timeout       time.Duration
+	Isolations    []sql.IsolationLevel
 	Error         error
 	Columns       []db.Column
 	QueryDuration time.Duration
 	QueryResults  config.QueryResult
+	// Locked contains the environment variables that override the query and its options, by name of the setting.
+	Locked map[string]string
+}
+
+// ReadOnlySupported returns whether the query can run in a read-only transaction with the configured database.
+func (p QueryPage) ReadOnlySupported() bool {
+	return db.SupportsReadOnly(p.Configuration.Connection().Driver)
+}
+
+// queryLocks returns the environment variables that override the query in field, and the options of the query of ds.
+func (m *ServeMux) queryLocks(field string, ds config.Dataset) map[string]string {
+	res := map[string]string{"query": m.cfg.LockedBy(field)}
+	for _, option := range []string{"timeout", "isolation", "readOnly"} {
+		res[option] = m.cfg.LockedBy(fmt.Sprintf("queryOptions.%s.%s", ds, option))
+	}
+	return res
+}
+
+// queryOptionsFromForm reads the query options posted from one of the query pages. Invalid values are ignored.
+func queryOptionsFromForm(r *http.Request) db.QueryOptions {
+	var opts db.QueryOptions
+	if t, err := strconv.Atoi(r.FormValue("query-timeout")); err == nil && t > 0 {
+		opts.Timeout = time.Duration(t) * time.Second
+	}
+	if isolation, err := db.ParseIsolationLevel(r.FormValue("isolation")); err == nil {
+		opts.Isolation = isolation
+	}
+	opts.ReadOnly = r.FormValue("read-only") != ""
+	return opts
 }
 
 func (m *ServeMux) VisitorQueryHandler() http.Handler {
@@ -324,9 +585,10 @@ func (m *ServeMux) VisitorQueryHandler() http.Handler {
 
 		if r.Method == http.MethodPost {
 			m.cfg.SetVisitorQuery(r.FormValue("query"))
+			m.cfg.SetQueryOptions(config.DatasetVisitor, queryOptionsFromForm(r))
 			m.cfg.UpdateBaseValidation(r.Context())
 			if m.cfg.Validate().IsValid() {
-				if err := m.cfg.Save(); err != nil {
+				if err := m.save(r); err != nil {
 					dlog.Error("While saving query: %v", err)
 				}
 			}
@@ -340,10 +602,14 @@ func (m *ServeMux) VisitorQueryHandler() http.Handler {
 		runTemplate(w, m.query, QueryPage{
 			Page:          m.page(r.Context(), r.URL.Path),
 			Query:         m.cfg.VisitorQuery(),
+			Options:       m.cfg.QueryOptions(config.DatasetVisitor),
+			Timeout:       m.cfg.QueryTimeout(config.DatasetVisitor),
+			Isolations:    db.IsolationLevels,
 			Error:         v.VisitorQuery,
 			Columns:       db.VisitorColumns,
 			QueryDuration: v.VisitorQueryDuration,
 			QueryResults:  v.VisitorQueryResults,
+			Locked:        m.queryLocks("query", config.DatasetVisitor),
 		})
 	})
 }
@@ -355,9 +621,10 @@ func (m *ServeMux) RadiologyQueryHandler() http.Handler {
 
 		if r.Method == http.MethodPost {
 			m.cfg.SetRadiologieQuery(r.FormValue("query"))
+			m.cfg.SetQueryOptions(config.DatasetRadiologie, queryOptionsFromForm(r))
 			m.cfg.UpdateRadiologieValidation(r.Context())
 			if m.cfg.Validate().IsValid() {
-				if err := m.cfg.Save(); err != nil {
+				if err := m.save(r); err != nil {
 					dlog.Error("While saving query: %v", err)
 				}
 			}
@@ -371,10 +638,14 @@ func (m *ServeMux) RadiologyQueryHandler() http.Handler {
 		runTemplate(w, m.radiology, QueryPage{
 			Page:          m.page(r.Context(), r.URL.Path),
 			Query:         m.cfg.RadiologieQuery(),
+			Options:       m.cfg.QueryOptions(config.DatasetRadiologie),
+			Timeout:       m.cfg.QueryTimeout(config.DatasetRadiologie),
+			Isolations:    db.IsolationLevels,
 			Error:         v.RadiologieQuery,
 			Columns:       db.RadiologieColumns,
 			QueryDuration: v.RadiologieQueryDuration,
 			QueryResults:  v.RadiologieQueryResults,
+			Locked:        m.queryLocks("radiologie", config.DatasetRadiologie),
 		})
 	})
 }
@@ -386,9 +657,10 @@ func (m *ServeMux) LabQueryHandler() http.Handler {
 
 		if r.Method == http.MethodPost {
 			m.cfg.SetLabQuery(r.FormValue("query"))
+			m.cfg.SetQueryOptions(config.DatasetLab, queryOptionsFromForm(r))
 			m.cfg.UpdateLabValidation(r.Context())
 			if m.cfg.Validate().IsValid() {
-				if err := m.cfg.Save(); err != nil {
+				if err := m.save(r); err != nil {
 					dlog.Error("While saving query: %v", err)
 				}
 			}
@@ -402,10 +674,14 @@ func (m *ServeMux) LabQueryHandler() http.Handler {
 		runTemplate(w, m.lab, QueryPage{
 			Page:          m.page(r.Context(), r.URL.Path),
 			Query:         m.cfg.LabQuery(),
+			Options:       m.cfg.QueryOptions(config.DatasetLab),
+			Timeout:       m.cfg.QueryTimeout(config.DatasetLab),
+			Isolations:    db.IsolationLevels,
 			Error:         v.LabQuery,
 			Columns:       db.LabColumns,
 			QueryDuration: v.LabQueryDuration,
 			QueryResults:  v.LabQueryResults,
+			Locked:        m.queryLocks("lab", config.DatasetLab),
 		})
 	})
 }
@@ -417,9 +693,10 @@ func (m *ServeMux) ConsultQueryHandler() http.Handler {
 
 		if r.Method == http.MethodPost {
 			m.cfg.SetConsultQuery(r.FormValue("query"))
+			m.cfg.SetQueryOptions(config.DatasetConsult, queryOptionsFromForm(r))
 			m.cfg.UpdateConsultValidation(r.Context())
 			if m.cfg.Validate().IsValid() {
-				if err := m.cfg.Save(); err != nil {
+				if err := m.save(r); err != nil {
 					dlog.Error("While saving query: %v", err)
 				}
 			}
@@ -433,19 +710,37 @@ func (m *ServeMux) ConsultQueryHandler() http.Handler {
 		runTemplate(w, m.consult, QueryPage{
 			Page:          m.page(r.Context(), r.URL.Path),
 			Query:         m.cfg.ConsultQuery(),
+			Options:       m.cfg.QueryOptions(config.DatasetConsult),
+			Timeout:       m.cfg.QueryTimeout(config.DatasetConsult),
+			Isolations:    db.IsolationLevels,
 			Error:         v.ConsultQuery,
 			Columns:       db.ConsultColumns,
 			QueryDuration: v.ConsultQueryDuration,
 			QueryResults:  v.ConsultQueryResults,
+			Locked:        m.queryLocks("consult", config.DatasetConsult),
 		})
 	})
 }
 
 type AccessPage struct {
 	*Page
-	Username string
-	Password string
+	Users     []AccessUser
+	Roles     []config.Role
+	BasicAuth bool
+	Tokens    []config.APIToken
+	// NewToken is the API token that was just created. It is shown only once.
+	NewToken string
 	Error    error
+	// FormError is the reason the last change was rejected, and FormAction the form that was posted.
+	FormError  error
+	FormAction string
+}
+
+// AccessUser is a user of the web interface as shown on the access page, without password.
+type AccessUser struct {
+	Username     string
+	Role         config.Role
+	PasswordHint string
 }
 
 func (m *ServeMux) AccessHandler() http.Handler {
@@ -453,46 +748,75 @@ func (m *ServeMux) AccessHandler() http.Handler {
 		m.mu.RLock()
 		defer m.mu.RUnlock()
 
+		var formErr error
 		if r.Method == http.MethodPost {
-			m.cfg.SetAccessCredentials(r.FormValue("username"), r.FormValue("password"))
-			m.cfg.UpdateBaseValidation(r.Context())
-			if m.cfg.Validate().IsValid() {
-				if err := m.cfg.Save(); err != nil {
+			switch r.FormValue("action") {
+			case "user":
+				username, password := r.FormValue("username"), r.FormValue("password")
+				formErr = m.cfg.SetUser(username, password, config.Role(r.FormValue("role")))
+				if formErr == nil && password != "" {
+					// existing sessions were authenticated with the old password
+					m.sessions.DeleteUser(username)
+				}
+			case "delete":
+				formErr = m.cfg.DeleteUser(r.FormValue("username"))
+			case "settings":
+				m.cfg.SetAccessBasicAuth(r.FormValue("basic-auth") != "")
+			case "token":
+				var token string
+				token, formErr = m.cfg.CreateAPIToken(r.FormValue("name"), config.Role(r.FormValue("role")))
+				if sess := sessionFromContext(r.Context()); formErr == nil && sess != nil {
+					m.sessions.SetNewToken(sess.ID, token)
+				}
+			case "revoke":
+				m.cfg.DeleteAPIToken(r.FormValue("name"))
+			}
+
+			if formErr == nil {
+				m.cfg.UpdateBaseValidation(r.Context())
+				// saved even if the configuration is invalid, so that a deleted user or revoked token does not come back
+				if err := m.cfg.SaveAccess(); err != nil {
 					dlog.Error("While saving access credentials: %v", err)
+				} else {
+					markSaved(r)
 				}
+				w.Header().Set("Location", pathAccess)
+				w.WriteHeader(http.StatusFound)
+				return
 			}
-			w.Header().Set("Location", pathAccess)
-			w.WriteHeader(http.StatusFound)
-			return
 		}
 
-		v := m.cfg.Validate()
-		username, password := m.cfg.AccessCredentials()
+		var users []AccessUser
+		for _, u := range m.cfg.Users() {
+			users = append(users, AccessUser{Username: u.Username, Role: u.Role, PasswordHint: passwordHint(u.Password)})
+		}
+		var newToken string
+		if sess := sessionFromContext(r.Context()); sess != nil && sess.ID != "" {
+			newToken = m.sessions.TakeNewToken(sess.ID)
+		}
 		runTemplate(w, m.access, AccessPage{
-			Page:     m.page(r.Context(), r.URL.Path),
-			Username: username,
-			Password: password,
-			Error:    v.Access,
+			Page:       m.page(r.Context(), r.URL.Path),
+			Users:      users,
+			Roles:      config.Roles,
+			BasicAuth:  m.cfg.AccessBasicAuth(),
+			Tokens:     m.cfg.APITokens(),
+			NewToken:   newToken,
+			Error:      m.cfg.Validate().Access,
+			FormError:  formErr,
+			FormAction: r.FormValue("action"),
 		})
 	})
 }
 
-func (m *ServeMux) Secured(handler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		m.mu.RLock()
-		m.mu.RUnlock()
-
-		username, password := m.cfg.AccessCredentials()
-		if username != "" && password != "" {
-			// access required
-			u, p, _ := r.BasicAuth()
-			if username != u && password != p {
-				w.Header().Set("WWW-Authenticate", `Basic realm="Door2doc Upload Service Configuration"`)
-				w.WriteHeader(http.StatusUnauthorized)
-				return
-			}
-		}
-
-		handler.ServeHTTP(w, r)
-	})
+// passwordHint returns the placeholder for a password field. Stored passwords are never sent to the browser, but
+// secret references are not secret themselves and are shown, so that it is clear where the password comes from.
+func passwordHint(stored string) string {
+	switch {
+	case stored == "":
+		return ""
+	case secret.IsReference(stored):
+		return stored
+	default:
+		return "Unchanged"
+	}
 }
@@ -2,13 +2,25 @@ package web
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"html/template"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/db"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/diff"
 	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/rest"
 )
 
 func TestTemplatesDontFail(t *testing.T) {
@@ -18,7 +30,7 @@ func TestTemplatesDontFail(t *testing.T) {
 	cfg := config.NewConfiguration()
 	cfg.UpdateBaseValidation(ctx)
 
-	m, err := NewServeMux(false, "testing", cfg, history.New())
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -33,6 +45,14 @@ func TestTemplatesDontFail(t *testing.T) {
 				Page: m.page(ctx, "/"),
 			},
 		},
+		"status with database statistics": {
+			Template: m.status,
+			Page: StatusPage{
+				Page:    m.page(ctx, "/"),
+				History: history.New(),
+				DBStats: &sql.DBStats{OpenConnections: 1},
+			},
+		},
 		"query": {
 			Template: m.query,
 			Page: QueryPage{
@@ -51,6 +71,88 @@ func TestTemplatesDontFail(t *testing.T) {
 				Page: m.page(ctx, "/"),
 			},
 		},
+		"login": {
+			Template: m.login,
+			Page: LoginPage{
+				Page:  m.page(ctx, "/login"),
+				Next:  "/",
+				Error: ErrLoginFailed,
+			},
+		},
+		"access": {
+			Template: m.access,
+			Page: AccessPage{
+				Page:      m.page(ctx, "/"),
+				Users:     []AccessUser{{Username: "admin", Role: config.RoleAdmin, PasswordHint: "Unchanged"}},
+				Roles:     config.Roles,
+				FormError: config.ErrNoAdministrator,
+			},
+		},
+		"versions": {
+			Template: m.versions,
+			Page: VersionsPage{
+				Page:     m.page(ctx, "/versions"),
+				Versions: []config.Version{{ID: "20200301T120000.000000000Z", Time: time.Now()}, {ID: "20200301T110000.000000000Z", Time: time.Now()}},
+				From:     "20200301T110000.000000000Z",
+				To:       "20200301T120000.000000000Z",
+				Changes: []FieldChange{
+					{Field: "query", Lines: []diff.Line{{Op: diff.Delete, Text: "select 1"}, {Op: diff.Insert, Text: "select 2"}}},
+					{Field: "password", Secret: true},
+				},
+				Error: config.ErrVersionNotFound,
+			},
+		},
+		"transfer": {
+			Template: m.transfer,
+			Page: TransferPage{
+				Page: m.page(ctx, "/transfer"),
+			},
+		},
+		"transfer preview": {
+			Template: m.transfer,
+			Page: TransferPage{
+				Page:       m.page(ctx, "/transfer"),
+				Preview:    true,
+				Changes:    []FieldChange{{Field: "query", Lines: []diff.Line{{Op: diff.Insert, Text: "select 1"}}}},
+				Validation: &config.ValidationResult{VisitorQuery: config.ErrQueryNotConfigured},
+			},
+		},
+		"history": {
+			Template: m.historyPage,
+			Page: HistoryPage{
+				Page:     m.page(ctx, "/history"),
+				Datasets: config.Datasets,
+				Filter:   HistoryFilter{Dataset: "lab", Status: history.StatusFailed},
+				Rows: []HistoryRow{
+					{Record: history.Record{Type: config.PathLabUpload, Time: time.Now(), Size: 3}, Dataset: config.DatasetLab},
+					{Record: history.Record{Type: config.PathLabUpload, Time: time.Now(), Error: "failed"}, Dataset: config.DatasetLab},
+				},
+				Total:    60,
+				First:    51,
+				Last:     60,
+				NewerURL: "/history?offset=0",
+			},
+		},
+		"history event": {
+			Template: m.historyEvent,
+			Page: HistoryEventPage{
+				Page:     m.page(ctx, "/history"),
+				Record:   history.Record{ID: 2, Type: config.PathLabUpload, Time: time.Now(), Error: "failed", ErrorClass: history.ErrorClassResponse},
+				Dataset:  config.DatasetLab,
+				Payload:  "[\n  {}\n]",
+				Response: &history.Response{Status: "400 Bad Request", Header: http.Header{"Content-Type": {"text/plain"}}, Body: "invalid"},
+				Previous: &history.Record{ID: 1, Type: config.PathLabUpload, Time: time.Now()},
+				Changes:  diff.Hunks(diff.Lines("[]", "[\n  {}\n]"), 3),
+			},
+		},
+		"audit": {
+			Template: m.auditLog,
+			Page: AuditPage{
+				Page:    m.page(ctx, "/audit"),
+				Entries: []audit.Entry{{Time: time.Now(), User: "admin", Field: "password", After: audit.Redacted}},
+				Omitted: 1,
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			w := httptest.NewRecorder()
@@ -64,3 +166,414 @@ func TestTemplatesDontFail(t *testing.T) {
 		})
 	}
 }
+
+func TestStoredPasswordsAreNotEchoed(t *testing.T) {
+	cfg := config.NewConfiguration()
+	cfg.SetCredentials("user", "d2d-secret")
+	cfg.SetProxy(rest.Proxy{Mode: rest.ProxyManual, URL: "http://proxy:8080", Username: "proxy", Password: "proxy-secret"})
+	cfg.SetConnection(db.ConnectionData{Driver: "postgres", Host: "localhost", Username: "pguser", Password: "db-secret"})
+	cfg.UpdateBaseValidation(context.Background())
+
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for path, handler := range map[string]http.Handler{
+		pathUpload:   m.UploadHandler(),
+		pathDatabase: m.DatabaseHandler(),
+		pathAccess:   m.AccessHandler(),
+	} {
+		t.Run(path, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+			body := w.Body.String()
+			for _, secret := range []string{"d2d-secret", "proxy-secret", "db-secret"} {
+				if strings.Contains(body, secret) {
+					t.Errorf("GET %s contains %s", path, secret)
+				}
+			}
+		})
+	}
+}
+
+func TestLockedSettings(t *testing.T) {
+	if err := os.Setenv("D2D_USERNAME", "env-user"); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Unsetenv("D2D_USERNAME")
+
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	if err := json.Unmarshal([]byte(`{"username": "file-user"}`), cfg); err != nil {
+		t.Fatal(err)
+	}
+	cfg.UpdateBaseValidation(context.Background())
+
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	m.UploadHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, pathUpload, nil))
+	body := w.Body.String()
+	if !strings.Contains(body, `value="env-user"`) {
+		t.Errorf("GET %s shows username env-user", pathUpload)
+	}
+	if !strings.Contains(body, "Set by environment variable <code>D2D_USERNAME</code>") {
+		t.Errorf("GET %s shows that the username is set by D2D_USERNAME", pathUpload)
+	}
+
+	form := url.Values{"username": {"changed"}, "password": {"changed"}}
+	r := httptest.NewRequest(http.MethodPost, pathUpload, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	m.UploadHandler().ServeHTTP(httptest.NewRecorder(), r)
+	if username, _ := cfg.Credentials(); username != "env-user" {
+		t.Errorf("Credentials() after POST == env-user, got %s", username)
+	}
+}
+
+func TestSecured(t *testing.T) {
+	cfg := config.NewConfiguration()
+	if err := cfg.SetUser("admin", "secret", config.RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	cfg.SetAccessBasicAuth(true)
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := m.Secured(config.RoleViewer, config.RoleAdmin, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	sess, err := m.sessions.Create("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	stale, err := m.sessions.Create("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cookie := func(sess *session) *http.Cookie {
+		return &http.Cookie{Name: sessionCookie, Value: m.sessions.CookieValue(sess)}
+	}
+
+	for name, test := range map[string]struct {
+		Method   string
+		Remote   string
+		Cookie   *http.Cookie
+		Username string
+		Password string
+		CSRF     string
+		Origin   string
+		Want     int
+	}{
+		"no credentials":              {Method: http.MethodGet, Want: http.StatusFound},
+		"no credentials post":         {Method: http.MethodPost, Want: http.StatusUnauthorized},
+		"session":                     {Method: http.MethodGet, Cookie: cookie(sess), Want: http.StatusNoContent},
+		"session post":                {Method: http.MethodPost, Cookie: cookie(sess), CSRF: sess.CSRF, Want: http.StatusNoContent},
+		"session post without csrf":   {Method: http.MethodPost, Cookie: cookie(sess), Want: http.StatusForbidden},
+		"session post wrong csrf":     {Method: http.MethodPost, Cookie: cookie(sess), CSRF: stale.CSRF, Want: http.StatusForbidden},
+		"anonymous session":           {Method: http.MethodGet, Cookie: cookie(stale), Want: http.StatusFound},
+		"forged cookie":               {Method: http.MethodGet, Cookie: &http.Cookie{Name: sessionCookie, Value: sess.ID + ".forged"}, Want: http.StatusFound},
+		"basic auth":                  {Method: http.MethodPost, Username: "admin", Password: "secret", Want: http.StatusNoContent},
+		"basic auth wrong password":   {Method: http.MethodPost, Username: "admin", Password: "guess", Want: http.StatusUnauthorized},
+		"basic auth wrong username":   {Method: http.MethodPost, Username: "root", Password: "secret", Want: http.StatusUnauthorized},
+		"basic auth same origin":      {Method: http.MethodPost, Username: "admin", Password: "secret", Origin: "http://example.com", Want: http.StatusNoContent},
+		"basic auth other origin":     {Method: http.MethodPost, Username: "admin", Password: "secret", Origin: "http://evil.example.org", Want: http.StatusForbidden},
+		"basic auth from other place": {Method: http.MethodGet, Remote: "10.0.0.9", Username: "admin", Password: "secret", Want: http.StatusNoContent},
+	} {
+		t.Run(name, func(t *testing.T) {
+			form := url.Values{}
+			if test.CSRF != "" {
+				form.Set(csrfField, test.CSRF)
+			}
+			r := httptest.NewRequest(test.Method, "/", strings.NewReader(form.Encode()))
+			r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if test.Remote != "" {
+				r.RemoteAddr = test.Remote + ":12345"
+			}
+			if test.Cookie != nil {
+				r.AddCookie(test.Cookie)
+			}
+			if test.Username != "" {
+				r.SetBasicAuth(test.Username, test.Password)
+			}
+			if test.Origin != "" {
+				r.Header.Set("Origin", test.Origin)
+			}
+
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			if got := w.Result().StatusCode; got != test.Want {
+				t.Errorf("ServeHTTP() == %d, got %d", test.Want, got)
+			}
+		})
+	}
+}
+
+func TestSecuredLockout(t *testing.T) {
+	cfg := config.NewConfiguration()
+	if err := cfg.SetUser("admin", "secret", config.RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	cfg.SetAccessBasicAuth(true)
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := m.Secured(config.RoleViewer, config.RoleAdmin, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	request := func(remote, username, password string) int {
+		r := httptest.NewRequest(http.MethodGet, "/", nil)
+		r.RemoteAddr = remote + ":12345"
+		r.SetBasicAuth(username, password)
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Result().StatusCode
+	}
+
+	for i := 0; i < maxLoginFailures; i++ {
+		request("10.0.0.2", "admin", "guess")
+	}
+	if got := request("10.0.0.2", "admin", "secret"); got != http.StatusTooManyRequests {
+		t.Errorf("ServeHTTP() after %d failures == %d, got %d", maxLoginFailures, http.StatusTooManyRequests, got)
+	}
+	if got := request("10.0.0.1", "admin", "secret"); got != http.StatusNoContent {
+		t.Errorf("ServeHTTP() from other address == %d, got %d", http.StatusNoContent, got)
+	}
+}
+
+func TestSecuredAnonymous(t *testing.T) {
+	m, err := NewServeMux(false, "testing", config.NewConfiguration(), history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := m.Secured(config.RoleViewer, config.RoleAdmin, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	// without access credentials, a GET starts an anonymous session
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if got := w.Result().StatusCode; got != http.StatusNoContent {
+		t.Fatalf("GET == %d, got %d", http.StatusNoContent, got)
+	}
+	cookies := w.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != sessionCookie {
+		t.Fatalf("GET sets cookie %s, got %v", sessionCookie, cookies)
+	}
+
+	// but a POST still needs the CSRF token of that session
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r.AddCookie(cookies[0])
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	if got := w.Result().StatusCode; got != http.StatusForbidden {
+		t.Errorf("POST without CSRF token == %d, got %d", http.StatusForbidden, got)
+	}
+}
+
+func TestLogin(t *testing.T) {
+	cfg := config.NewConfiguration()
+	if err := cfg.SetUser("admin", "secret", config.RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	cfg.UpdateBaseValidation(context.Background())
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	login := func(password, next string) *http.Response {
+		form := url.Values{"username": {"admin"}, "password": {password}, "next": {next}}
+		r := httptest.NewRequest(http.MethodPost, pathLogin, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		m.ServeHTTP(w, r)
+		return w.Result()
+	}
+
+	if res := login("guess", "/database"); res.StatusCode != http.StatusOK || len(res.Cookies()) != 0 {
+		t.Errorf("POST %s with wrong password == 200 without cookie, got %d, %v", pathLogin, res.StatusCode, res.Cookies())
+	}
+	if res := login("secret", "//evil.example.org"); res.Header.Get("Location") != "/" {
+		t.Errorf("POST %s redirects to /, got %s", pathLogin, res.Header.Get("Location"))
+	}
+
+	res := login("secret", "/database")
+	if res.StatusCode != http.StatusFound || res.Header.Get("Location") != "/database" {
+		t.Fatalf("POST %s == 302 to /database, got %d to %s", pathLogin, res.StatusCode, res.Header.Get("Location"))
+	}
+	cookies := res.Cookies()
+	if len(cookies) != 1 || !cookies[0].HttpOnly {
+		t.Fatalf("POST %s sets HTTP-only session cookie, got %v", pathLogin, cookies)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, pathAccess, nil)
+	r.AddCookie(cookies[0])
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GET %s with session == 200, got %d", pathAccess, w.Code)
+	}
+	sess, _ := m.sessions.Get(cookies[0].Value)
+	if !strings.Contains(w.Body.String(), sess.CSRF) {
+		t.Errorf("GET %s contains CSRF token", pathAccess)
+	}
+
+	form := url.Values{csrfField: {sess.CSRF}}
+	r = httptest.NewRequest(http.MethodPost, pathLogout, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.AddCookie(cookies[0])
+	w = httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	if w.Code != http.StatusFound {
+		t.Fatalf("POST %s == 302, got %d", pathLogout, w.Code)
+	}
+	if _, ok := m.sessions.Get(cookies[0].Value); ok {
+		t.Errorf("Get() after logout == false, got true")
+	}
+}
+
+type testUploader struct {
+	triggered int
+}
+
+func (u *testUploader) DBStats() (sql.DBStats, bool) {
+	return sql.DBStats{}, false
+}
+
+func (u *testUploader) Trigger() {
+	u.triggered++
+}
+
+func TestRoles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	// pausing saves the configuration
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	for _, u := range []config.User{
+		{Username: "admin", Password: "secret", Role: config.RoleAdmin},
+		{Username: "operator", Password: "secret", Role: config.RoleOperator},
+		{Username: "viewer", Password: "secret", Role: config.RoleViewer},
+	} {
+		if err := cfg.SetUser(u.Username, u.Password, u.Role); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfg.UpdateBaseValidation(context.Background())
+	uploader := &testUploader{}
+	m, err := NewServeMux(false, "testing", cfg, history.New(), uploader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sessions := make(map[string]*session)
+	for _, username := range []string{"admin", "operator", "viewer"} {
+		if sessions[username], err = m.sessions.Create(username); err != nil {
+			t.Fatal(err)
+		}
+	}
+	request := func(username, method, path string) *httptest.ResponseRecorder {
+		sess := sessions[username]
+		form := url.Values{csrfField: {sess.CSRF}, "paused": {"false"}}
+		r := httptest.NewRequest(method, path, strings.NewReader(form.Encode()))
+		r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		r.AddCookie(&http.Cookie{Name: sessionCookie, Value: m.sessions.CookieValue(sess)})
+		w := httptest.NewRecorder()
+		m.ServeHTTP(w, r)
+		return w
+	}
+
+	for name, test := range map[string]struct {
+		Username string
+		Method   string
+		Path     string
+		Want     int
+	}{
+		"viewer views status":       {Username: "viewer", Method: http.MethodGet, Path: "/", Want: http.StatusOK},
+		"viewer views database":     {Username: "viewer", Method: http.MethodGet, Path: pathDatabase, Want: http.StatusOK},
+		"viewer triggers":           {Username: "viewer", Method: http.MethodPost, Path: pathTrigger, Want: http.StatusForbidden},
+		"viewer pauses":             {Username: "viewer", Method: http.MethodPost, Path: pathPause, Want: http.StatusForbidden},
+		"viewer changes query":      {Username: "viewer", Method: http.MethodPost, Path: pathQuery, Want: http.StatusForbidden},
+		"viewer views users":        {Username: "viewer", Method: http.MethodGet, Path: pathAccess, Want: http.StatusForbidden},
+		"operator triggers":         {Username: "operator", Method: http.MethodPost, Path: pathTrigger, Want: http.StatusFound},
+		"operator pauses":           {Username: "operator", Method: http.MethodPost, Path: pathPause, Want: http.StatusFound},
+		"operator changes database": {Username: "operator", Method: http.MethodPost, Path: pathDatabase, Want: http.StatusForbidden},
+		"admin views users":         {Username: "admin", Method: http.MethodGet, Path: pathAccess, Want: http.StatusOK},
+		"admin triggers":            {Username: "admin", Method: http.MethodPost, Path: pathTrigger, Want: http.StatusFound},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if got := request(test.Username, test.Method, test.Path).Code; got != test.Want {
+				t.Errorf("%s %s == %d, got %d", test.Method, test.Path, test.Want, got)
+			}
+		})
+	}
+	if uploader.triggered != 2 {
+		t.Errorf("Trigger() called 2 times, got %d", uploader.triggered)
+	}
+
+	// forms are hidden from users who cannot submit them
+	for username, want := range map[string]bool{"viewer": false, "admin": true} {
+		body := request(username, http.MethodGet, pathDatabase).Body.String()
+		if got := strings.Contains(body, `>Update</button>`); got != want {
+			t.Errorf("GET %s as %s shows Update button == %v, got %v", pathDatabase, username, want, got)
+		}
+	}
+}
+
+func TestAccessSavedWhenInvalid(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	if _, err := cfg.CreateAPIToken("script", config.RoleViewer); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// without a database, the configuration is invalid
+	form := url.Values{"action": {"revoke"}, "name": {"script"}}
+	r := httptest.NewRequest(http.MethodPost, pathAccess, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	m.AccessHandler().ServeHTTP(httptest.NewRecorder(), r)
+	if cfg.Validate().IsValid() {
+		t.Fatal("Validate().IsValid() == false, got true")
+	}
+
+	stored := config.NewConfiguration()
+	stored.SetPath(cfg.Path())
+	if err := stored.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := stored.APITokens(); len(tokens) != 0 {
+		t.Errorf("stored APITokens() after revoking == [], got %v", tokens)
+	}
+}
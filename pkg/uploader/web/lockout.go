@@ -0,0 +1,90 @@
+package web
+
+import (
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// maxLoginFailures is the number of failed logins after which a remote address is locked out.
+	maxLoginFailures = 5
+	// lockoutDuration is how long a remote address is locked out, counted from its last failed login.
+	lockoutDuration = 15 * time.Minute
+)
+
+// lockout keeps track of failed logins per remote address, to slow down guessing of the access password.
+type lockout struct {
+	mu       sync.Mutex
+	failures map[string]*loginFailures
+	now      func() time.Time
+}
+
+type loginFailures struct {
+	count int
+	last  time.Time
+}
+
+func newLockout() *lockout {
+	return &lockout{
+		failures: make(map[string]*loginFailures),
+		now:      time.Now,
+	}
+}
+
+// Locked returns whether addr is locked out, and if so, for how long.
+func (l *lockout) Locked(addr string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	f, ok := l.failures[addr]
+	if !ok || f.count < maxLoginFailures {
+		return false, 0
+	}
+	remaining := f.last.Add(lockoutDuration).Sub(l.now())
+	if remaining <= 0 {
+		delete(l.failures, addr)
+		return false, 0
+	}
+	return true, remaining
+}
+
+// Fail registers a failed login from addr, and returns the number of consecutive failures.
+func (l *lockout) Fail(addr string) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for a, f := range l.failures {
+		// forget failures that have expired, so that the map does not grow without bounds
+		if now.Sub(f.last) > lockoutDuration {
+			delete(l.failures, a)
+		}
+	}
+
+	f, ok := l.failures[addr]
+	if !ok {
+		f = &loginFailures{}
+		l.failures[addr] = f
+	}
+	f.count++
+	f.last = now
+	return f.count
+}
+
+// Succeed resets the failed logins of addr.
+func (l *lockout) Succeed(addr string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.failures, addr)
+}
+
+// remoteAddr returns the IP address of the client that sent r.
+func remoteAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
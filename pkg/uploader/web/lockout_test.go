@@ -0,0 +1,37 @@
+package web
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLockout(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	l := newLockout()
+	l.now = func() time.Time { return now }
+
+	for i := 1; i < maxLoginFailures; i++ {
+		l.Fail("10.0.0.1")
+		if locked, _ := l.Locked("10.0.0.1"); locked {
+			t.Fatalf("Locked() after %d failures == false, got true", i)
+		}
+	}
+	l.Fail("10.0.0.1")
+	if locked, remaining := l.Locked("10.0.0.1"); !locked || remaining != lockoutDuration {
+		t.Errorf("Locked() == true, %v; got %v, %v", lockoutDuration, locked, remaining)
+	}
+	if locked, _ := l.Locked("10.0.0.2"); locked {
+		t.Error("Locked() for other address == false, got true")
+	}
+
+	now = now.Add(lockoutDuration)
+	if locked, _ := l.Locked("10.0.0.1"); locked {
+		t.Error("Locked() after lockout == false, got true")
+	}
+
+	l.Fail("10.0.0.3")
+	l.Succeed("10.0.0.3")
+	if got := l.Fail("10.0.0.3"); got != 1 {
+		t.Errorf("Fail() after Succeed() == 1, got %d", got)
+	}
+}
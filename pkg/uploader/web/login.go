@@ -0,0 +1,201 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/pkg/errors"
+)
+
+// ErrLoginFailed indicates that the username or password is wrong. It does not tell which of the two.
+var ErrLoginFailed = errors.New("invalid username or password")
+
+// LockedOutError indicates that logins from the remote address are refused after too many failed attempts.
+type LockedOutError struct {
+	Remaining time.Duration
+}
+
+func (e *LockedOutError) Error() string {
+	return fmt.Sprintf("too many failed logins, try again in %v", e.Remaining.Round(time.Second))
+}
+
+// authenticate checks credentials sent with r against the configured users, and returns the role of the user.
+// Remote addresses are locked out after repeated failures, and every failure is logged.
+func (m *ServeMux) authenticate(r *http.Request, username, password string) (config.Role, error) {
+	addr := remoteAddr(r)
+	if locked, remaining := m.lockout.Locked(addr); locked {
+		return "", &LockedOutError{Remaining: remaining}
+	}
+
+	role, valid, err := m.cfg.AuthenticateUser(r.Context(), username, password)
+	if err != nil {
+		return "", err
+	}
+	if !valid {
+		failures := m.lockout.Fail(addr)
+		dlog.Warning("Failed login for user %q from %s (%d consecutive failures)", username, addr, failures)
+		return "", ErrLoginFailed
+	}
+	m.lockout.Succeed(addr)
+	return role, nil
+}
+
+// Secured requires a session if users are configured. Requests without session are redirected to the login page,
+// unless HTTP basic authentication is enabled for scripts and the request carries credentials. Viewing requires role
+// view; requests that may change something require role change, and must carry the CSRF token of the session.
+func (m *ServeMux) Secured(view, change config.Role, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorized := func(sess *session) bool {
+			required := view
+			if !isSafeMethod(r) {
+				required = change
+			}
+			if !sess.Role.Allows(required) {
+				http.Error(w, "You are not allowed to do this. Please ask an administrator.", http.StatusForbidden)
+				return false
+			}
+			return true
+		}
+
+		sess, ok := m.session(r)
+		if !ok && !m.cfg.AccessRequired() {
+			// without users everybody gets an anonymous session, so that forms are protected against CSRF nonetheless
+			var err error
+			if sess, err = m.sessions.Create(""); err != nil {
+				dlog.Error("Failed to create session: %v", err)
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				return
+			}
+			sess.Role = config.RoleAdmin
+			m.setSessionCookie(w, r, sess)
+			ok = true
+		}
+
+		if ok {
+			if !validCSRF(r, sess) {
+				http.Error(w, "Invalid or missing CSRF token, please reload the page and try again.", http.StatusForbidden)
+				return
+			}
+			if authorized(sess) {
+				handler.ServeHTTP(w, r.WithContext(withSession(r.Context(), sess)))
+			}
+			return
+		}
+
+		if username, password, basic := r.BasicAuth(); basic && m.cfg.AccessBasicAuth() {
+			// browsers send cached basic credentials along with cross-site requests as well
+			if !sameOrigin(r) {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+			role, err := m.authenticate(r, username, password)
+			if err != nil {
+				writeAuthError(w, err)
+				return
+			}
+			sess := &session{Username: username, Role: role}
+			if authorized(sess) {
+				handler.ServeHTTP(w, r.WithContext(withSession(r.Context(), sess)))
+			}
+			return
+		}
+
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			w.Header().Set("Location", pathLogin+"?next="+url.QueryEscape(r.URL.RequestURI()))
+			w.WriteHeader(http.StatusFound)
+			return
+		}
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+	})
+}
+
+// writeAuthError responds to a request with credentials that were not accepted.
+func writeAuthError(w http.ResponseWriter, err error) {
+	if e, ok := err.(*LockedOutError); ok {
+		w.Header().Set("Retry-After", fmt.Sprintf("%d", int(e.Remaining.Seconds())+1))
+		http.Error(w, "Too many failed logins, please try again later.", http.StatusTooManyRequests)
+		return
+	}
+	if err == ErrLoginFailed {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+		return
+	}
+	dlog.Error("Failed to check access credentials: %v", err)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
+
+type LoginPage struct {
+	*Page
+	Username string
+	Next     string
+	Error    error
+}
+
+func (m *ServeMux) LoginHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		next := r.FormValue("next")
+		if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+			// only redirect within the web interface
+			next = "/"
+		}
+		if !m.cfg.AccessRequired() {
+			w.Header().Set("Location", next)
+			w.WriteHeader(http.StatusFound)
+			return
+		}
+
+		page := LoginPage{
+			Page: m.page(r.Context(), r.URL.Path),
+			Next: next,
+		}
+
+		if r.Method == http.MethodPost {
+			if !sameOrigin(r) {
+				http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+				return
+			}
+
+			page.Username = r.FormValue("username")
+			_, page.Error = m.authenticate(r, page.Username, r.FormValue("password"))
+			if page.Error == nil {
+				sess, err := m.sessions.Create(page.Username)
+				if err != nil {
+					dlog.Error("Failed to create session: %v", err)
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					return
+				}
+				m.setSessionCookie(w, r, sess)
+				w.Header().Set("Location", next)
+				w.WriteHeader(http.StatusFound)
+				return
+			}
+		}
+
+		runTemplate(w, m.login, page)
+	})
+}
+
+func (m *ServeMux) LogoutHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		if sess := sessionFromContext(r.Context()); sess != nil {
+			m.sessions.Delete(sess.ID)
+		}
+		m.setSessionCookie(w, r, nil)
+		w.Header().Set("Location", pathLogin)
+		w.WriteHeader(http.StatusFound)
+	})
+}
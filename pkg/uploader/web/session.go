@@ -0,0 +1,277 @@
+package web
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+)
+
+const (
+	// sessionCookie is the name of the cookie containing the signed session ID.
+	sessionCookie = "d2d_session"
+	// sessionIdleTimeout is how long a session remains valid without any requests.
+	sessionIdleTimeout = 30 * time.Minute
+
+	// csrfField is the name of the hidden form field containing the CSRF token.
+	csrfField = "csrf"
+	// csrfHeader is the name of the header containing the CSRF token, for requests that are not form posts.
+	csrfHeader = "X-CSRF-Token"
+)
+
+// session is a logged in user of the web interface. Username is empty for anonymous sessions, which are used when no
+// users are configured, so that forms are protected against CSRF nonetheless.
+type session struct {
+	ID       string
+	Username string
+	CSRF     string
+	LastSeen time.Time
+
+	// Role is the current role of the user. It is looked up for every request, so that changes take effect
+	// immediately.
+	Role config.Role
+
+	// NewToken is an API token that was created in this session, and has not been shown yet.
+	NewToken string
+}
+
+// sessions keeps track of the sessions of the web interface. Sessions are kept in memory, so that logging out ends a
+// session for good; the cookie only contains the session ID, signed with a key that is generated at startup.
+type sessions struct {
+	mu   sync.Mutex
+	key  []byte
+	byID map[string]*session
+	now  func() time.Time
+}
+
+func newSessions() (*sessions, error) {
+	key, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	return &sessions{
+		key:  []byte(key),
+		byID: make(map[string]*session),
+		now:  time.Now,
+	}, nil
+}
+
+// randomToken returns a random, URL-safe token with 256 bits of entropy.
+func randomToken() (string, error) {
+	bs := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, bs); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(bs), nil
+}
+
+// Create starts a new session for username.
+func (s *sessions) Create(username string) (*session, error) {
+	id, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	csrf, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	for id, sess := range s.byID {
+		// forget expired sessions, so that the map does not grow without bounds
+		if now.Sub(sess.LastSeen) > sessionIdleTimeout {
+			delete(s.byID, id)
+		}
+	}
+
+	sess := &session{ID: id, Username: username, CSRF: csrf, LastSeen: now}
+	s.byID[id] = sess
+	res := *sess
+	return &res, nil
+}
+
+// Get returns the session for a cookie value, if the signature is valid and the session has not expired. Getting a
+// session counts as activity for the idle timeout.
+func (s *sessions) Get(value string) (*session, bool) {
+	i := strings.LastIndexByte(value, '.')
+	if i < 0 {
+		return nil, false
+	}
+	id, sig := value[:i], value[i+1:]
+	if !hmac.Equal([]byte(sig), []byte(s.sign(id))) {
+		return nil, false
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sess, ok := s.byID[id]
+	if !ok {
+		return nil, false
+	}
+	now := s.now()
+	if now.Sub(sess.LastSeen) > sessionIdleTimeout {
+		delete(s.byID, id)
+		return nil, false
+	}
+	sess.LastSeen = now
+	res := *sess
+	return &res, true
+}
+
+// Delete ends a session.
+func (s *sessions) Delete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.byID, id)
+}
+
+// DeleteUser ends all sessions of a user, for example because the password changed.
+func (s *sessions) DeleteUser(username string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, sess := range s.byID {
+		if sess.Username == username {
+			delete(s.byID, id)
+		}
+	}
+}
+
+// SetNewToken remembers an API token that was just created in a session, so that it can be shown once after the
+// redirect back to the form.
+func (s *sessions) SetNewToken(id, token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if sess, ok := s.byID[id]; ok {
+		sess.NewToken = token
+	}
+}
+
+// TakeNewToken returns the API token that was just created in a session, if any, and forgets it.
+func (s *sessions) TakeNewToken(id string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sess, ok := s.byID[id]
+	if !ok {
+		return ""
+	}
+	token := sess.NewToken
+	sess.NewToken = ""
+	return token
+}
+
+// CookieValue returns the signed value of the session cookie for sess.
+func (s *sessions) CookieValue(sess *session) string {
+	return sess.ID + "." + s.sign(sess.ID)
+}
+
+func (s *sessions) sign(id string) string {
+	mac := hmac.New(sha256.New, s.key)
+	_, _ = mac.Write([]byte(id))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// setSessionCookie sends the session cookie for sess. A nil session clears the cookie.
+func (m *ServeMux) setSessionCookie(w http.ResponseWriter, r *http.Request, sess *session) {
+	c := &http.Cookie{
+		Name:     sessionCookie,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	}
+	if sess == nil {
+		c.MaxAge = -1
+	} else {
+		c.Value = m.sessions.CookieValue(sess)
+	}
+	http.SetCookie(w, c)
+}
+
+// session returns the session of the user that sent r, if any, with the current role of the user. Sessions of users
+// that no longer exist are not valid, nor are anonymous sessions once users have been configured.
+func (m *ServeMux) session(r *http.Request) (*session, bool) {
+	c, err := r.Cookie(sessionCookie)
+	if err != nil {
+		return nil, false
+	}
+	sess, ok := m.sessions.Get(c.Value)
+	if !ok {
+		return nil, false
+	}
+	if !m.cfg.AccessRequired() {
+		if sess.Username != "" {
+			return nil, false
+		}
+		sess.Role = config.RoleAdmin
+		return sess, true
+	}
+	role, ok := m.cfg.UserRole(sess.Username)
+	if !ok {
+		return nil, false
+	}
+	sess.Role = role
+	return sess, true
+}
+
+// validCSRF returns whether r carries the CSRF token of sess. Requests that cannot change anything do not need a
+// token.
+func validCSRF(r *http.Request, sess *session) bool {
+	if isSafeMethod(r) {
+		return true
+	}
+	token := r.Header.Get(csrfHeader)
+	if token == "" {
+		token = r.PostFormValue(csrfField)
+	}
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(sess.CSRF)) == 1
+}
+
+// isSafeMethod returns whether r cannot change anything.
+func isSafeMethod(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// sameOrigin returns whether r was sent from a page of this web interface, or from a client that does not send an
+// origin at all, like a script.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		origin = r.Header.Get("Referer")
+	}
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	return err == nil && u.Host == r.Host
+}
+
+type contextKey int
+
+const sessionKey contextKey = iota
+
+func withSession(ctx context.Context, sess *session) context.Context {
+	return context.WithValue(ctx, sessionKey, sess)
+}
+
+func sessionFromContext(ctx context.Context) *session {
+	sess, _ := ctx.Value(sessionKey).(*session)
+	return sess
+}
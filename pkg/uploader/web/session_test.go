@@ -0,0 +1,65 @@
+package web
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessions(t *testing.T) {
+	now := time.Date(2020, 1, 1, 12, 0, 0, 0, time.UTC)
+	s, err := newSessions()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.now = func() time.Time { return now }
+
+	sess, err := s.Create("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := s.CookieValue(sess)
+
+	for name, test := range map[string]struct {
+		Value string
+		Want  bool
+	}{
+		"valid":           {Value: value, Want: true},
+		"empty":           {Value: ""},
+		"unsigned":        {Value: sess.ID},
+		"wrong signature": {Value: sess.ID + "." + s.sign("other")},
+		"unknown":         {Value: "other." + s.sign("other")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, ok := s.Get(test.Value)
+			if ok != test.Want {
+				t.Fatalf("Get() == _, %v; got %v", test.Want, ok)
+			}
+			if ok && (got.Username != "admin" || got.CSRF != sess.CSRF) {
+				t.Errorf("Get() == %v, got %v", sess, got)
+			}
+		})
+	}
+
+	// activity extends the session
+	now = now.Add(sessionIdleTimeout - time.Minute)
+	if _, ok := s.Get(value); !ok {
+		t.Error("Get() before idle timeout == _, true; got false")
+	}
+	now = now.Add(sessionIdleTimeout - time.Minute)
+	if _, ok := s.Get(value); !ok {
+		t.Error("Get() after activity == _, true; got false")
+	}
+	now = now.Add(sessionIdleTimeout + time.Minute)
+	if _, ok := s.Get(value); ok {
+		t.Error("Get() after idle timeout == _, false; got true")
+	}
+
+	sess, err = s.Create("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Delete(sess.ID)
+	if _, ok := s.Get(s.CookieValue(sess)); ok {
+		t.Error("Get() after Delete() == _, false; got true")
+	}
+}
@@ -0,0 +1,145 @@
+package web
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+	"github.com/pkg/errors"
+)
+
+// ErrNoPendingImport indicates that there is no previewed import to apply, for example because the session expired.
+var ErrNoPendingImport = errors.New("no configuration bundle to import")
+
+// pendingImport is a configuration bundle that has been previewed, but not yet applied.
+type pendingImport struct {
+	Bundle     []byte
+	Passphrase string
+}
+
+// pendingImports keeps the previewed imports by session, so that the passphrase does not have to be sent back to the
+// browser.
+type pendingImports struct {
+	mu        sync.Mutex
+	bySession map[string]pendingImport
+}
+
+func (p *pendingImports) Set(sessionID string, pending pendingImport) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.bySession == nil {
+		p.bySession = make(map[string]pendingImport)
+	}
+	p.bySession[sessionID] = pending
+}
+
+// Take returns the pending import of a session and forgets it.
+func (p *pendingImports) Take(sessionID string) (pendingImport, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pending, ok := p.bySession[sessionID]
+	delete(p.bySession, sessionID)
+	return pending, ok
+}
+
+type TransferPage struct {
+	*Page
+	// Preview is true if an uploaded bundle is shown, waiting to be applied.
+	Preview bool
+	Changes []FieldChange
+	// Missing contains the secrets that are in neither the bundle nor the current configuration.
+	Missing    []string
+	Validation *config.ValidationResult
+	Error      error
+}
+
+func (m *ServeMux) TransferHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		page := TransferPage{
+			Page: m.page(r.Context(), r.URL.Path),
+		}
+		var sessionID string
+		if sess := sessionFromContext(r.Context()); sess != nil {
+			sessionID = sess.ID
+		}
+
+		if r.Method == http.MethodPost {
+			switch r.FormValue("action") {
+			case "export":
+				bs, err := m.cfg.Export(r.FormValue("passphrase"))
+				if err != nil {
+					dlog.Error("Failed to export configuration: %v", err)
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+					return
+				}
+				name := fmt.Sprintf("door2doc-configuration-%s.json", time.Now().Format("20060102"))
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+				if _, err := w.Write(bs); err != nil {
+					dlog.Error("Error while writing response: %v", err)
+				}
+				return
+
+			case "preview":
+				pending := pendingImport{Passphrase: r.FormValue("passphrase")}
+				pending.Bundle, page.Error = readBundle(r)
+				if page.Error == nil {
+					page.Changes, page.Missing, page.Validation, page.Error = m.previewImport(r, pending)
+				}
+				if page.Error == nil {
+					page.Preview = true
+					m.imports.Set(sessionID, pending)
+				}
+
+			case "apply":
+				pending, ok := m.imports.Take(sessionID)
+				if !ok {
+					page.Error = ErrNoPendingImport
+					break
+				}
+				page.Error = m.cfg.Import(r.Context(), pending.Bundle, pending.Passphrase)
+				if page.Error == nil {
+					markSaved(r)
+					w.Header().Set("Location", pathTransfer)
+					w.WriteHeader(http.StatusFound)
+					return
+				}
+
+			case "cancel":
+				m.imports.Take(sessionID)
+				w.Header().Set("Location", pathTransfer)
+				w.WriteHeader(http.StatusFound)
+				return
+			}
+		}
+
+		runTemplate(w, m.transfer, page)
+	})
+}
+
+// readBundle returns the configuration bundle uploaded with r.
+func readBundle(r *http.Request) ([]byte, error) {
+	f, _, err := r.FormFile("bundle")
+	if err != nil {
+		return nil, config.ErrInvalidBundle
+	}
+	defer f.Close()
+	return ioutil.ReadAll(f)
+}
+
+// previewImport returns the changes an import would make, the secrets that are missing, and the validation results
+// of the imported configuration.
+func (m *ServeMux) previewImport(r *http.Request, pending pendingImport) ([]FieldChange, []string, *config.ValidationResult, error) {
+	fields, missing, validation, err := m.cfg.PreviewImport(r.Context(), pending.Bundle, pending.Passphrase)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return fieldChanges(m.cfg.Fields(), fields), missing, validation, nil
+}
@@ -0,0 +1,112 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/audit"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/diff"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/dlog"
+)
+
+type VersionsPage struct {
+	*Page
+	Versions []config.Version
+	// MaxVersions is the number of versions that are kept.
+	MaxVersions int
+	// From and To are the IDs of the versions that are compared.
+	From    string
+	To      string
+	Changes []FieldChange
+	Error   error
+}
+
+// FieldChange is the difference in a single field between two versions of the configuration. The values of secrets
+// are never shown, only that they changed.
+type FieldChange struct {
+	Field  string
+	Secret bool
+	Lines  []diff.Line
+}
+
+// compareVersions returns the differences between two versions of the configuration.
+func (m *ServeMux) compareVersions(from, to string) ([]FieldChange, error) {
+	before, err := m.cfg.VersionFields(from)
+	if err != nil {
+		return nil, err
+	}
+	after, err := m.cfg.VersionFields(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return fieldChanges(before, after), nil
+}
+
+// fieldChanges returns the differences between two sets of configuration fields.
+func fieldChanges(before, after map[string]string) []FieldChange {
+	var res []FieldChange
+	for _, e := range audit.Changes(before, after, config.IsSecretField) {
+		res = append(res, FieldChange{
+			Field:  e.Field,
+			Secret: config.IsSecretField(e.Field),
+			Lines:  diff.Lines(e.Before, e.After),
+		})
+	}
+	return res
+}
+
+func (m *ServeMux) VersionsHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		m.mu.RLock()
+		defer m.mu.RUnlock()
+
+		page := VersionsPage{
+			Page:        m.page(r.Context(), r.URL.Path),
+			MaxVersions: m.cfg.MaxVersions(),
+			From:        r.FormValue("from"),
+			To:          r.FormValue("to"),
+		}
+
+		if r.Method == http.MethodPost {
+			id := r.FormValue("version")
+			page.Error = m.cfg.Rollback(r.Context(), id)
+			if page.Error == nil {
+				markSaved(r)
+				if sess := sessionFromContext(r.Context()); sess != nil {
+					dlog.Info("Configuration rolled back to version %s, by %q", id, sess.Username)
+				}
+				w.Header().Set("Location", pathVersions)
+				w.WriteHeader(http.StatusFound)
+				return
+			}
+			// show what the rejected rollback would have changed
+			page.From, page.To = "", id
+		}
+
+		var err error
+		if page.Versions, err = m.cfg.Versions(); err != nil && page.Error == nil {
+			page.Error = err
+		}
+		if len(page.Versions) > 0 {
+			// by default, compare the current version to the one before it, or to the selected one
+			current := page.Versions[0].ID
+			if page.To == "" {
+				page.To = current
+			}
+			if page.From == "" {
+				page.From = current
+				if page.To == current && len(page.Versions) > 1 {
+					page.From = page.Versions[1].ID
+				}
+			}
+			if changes, err := m.compareVersions(page.From, page.To); err != nil && page.Error == nil {
+				page.Error = err
+			} else {
+				page.Changes = changes
+			}
+		}
+
+		runTemplate(w, m.versions, page)
+	})
+}
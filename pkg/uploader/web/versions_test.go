@@ -0,0 +1,74 @@
+package web
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/door2doc/d2d-uploader/pkg/uploader/config"
+	"github.com/door2doc/d2d-uploader/pkg/uploader/history"
+)
+
+func TestVersionsRollbackRequiresAdmin(t *testing.T) {
+	dir, err := ioutil.TempDir("", "d2d-config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	cfg := config.NewConfiguration()
+	cfg.SetPath(filepath.Join(dir, "door2doc.json"))
+	cfg.UpdateBaseValidation(context.Background())
+
+	// an earlier version in which the operator was an administrator
+	if err := cfg.SetUser("admin", "secret", config.RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.SetUser("operator", "secret", config.RoleAdmin); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+	versions, err := cfg.Versions()
+	if err != nil || len(versions) != 1 {
+		t.Fatalf("Versions() == 1 version, got %v, %v", versions, err)
+	}
+	if err := cfg.SetUser("operator", "", config.RoleOperator); err != nil {
+		t.Fatal(err)
+	}
+	if err := cfg.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := NewServeMux(false, "testing", cfg, history.New(), nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sess, err := m.sessions.Create("operator")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	form := url.Values{csrfField: {sess.CSRF}, "version": {versions[0].ID}}
+	r := httptest.NewRequest(http.MethodPost, pathVersions, strings.NewReader(form.Encode()))
+	r.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	r.AddCookie(&http.Cookie{Name: sessionCookie, Value: m.sessions.CookieValue(sess)})
+	w := httptest.NewRecorder()
+	m.ServeHTTP(w, r)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("POST %s as operator == %d, got %d", pathVersions, http.StatusForbidden, w.Code)
+	}
+
+	for _, u := range cfg.Users() {
+		if u.Username == "operator" && u.Role != config.RoleOperator {
+			t.Errorf("role of operator == %s, got %s", config.RoleOperator, u.Role)
+		}
+	}
+}